@@ -8,6 +8,7 @@ import (
 	"path"
 	"path/filepath"
 	"regexp"
+	"slices"
 	"strings"
 	"unicode"
 )
@@ -35,6 +36,52 @@ func New(args []string, workingDir string, evaler Evaler, stater Stater) (*Parse
 		false,
 		"Whether or not to generate a package shim",
 	)
+	includeFlag := fs.String(
+		"include",
+		"",
+		"A comma separated list of the names or regular expressions of the functions to include in a package shim",
+	)
+	excludeFlag := fs.String(
+		"exclude",
+		"",
+		"A comma separated list of the names or regular expressions of the functions to leave out of a package shim",
+	)
+	var instantiateFlag stringsFlag
+	fs.Var(
+		&instantiateFlag,
+		"instantiate",
+		"An instantiation of a generic function to add to a package shim, such as 'Decode[User]', leaving out the type arguments that can be inferred (can be repeated)",
+	)
+	variablesFlag := fs.Bool(
+		"variables",
+		false,
+		"Whether or not to add a getter and a setter for each exported variable to a package shim",
+	)
+	withDefaultFlag := fs.Bool(
+		"with-default",
+		false,
+		"Whether or not to add a package level DefaultMyShim holding the shim, and a SwapMyShimForTest function, to a package shim",
+	)
+	wrapFlag := fs.String(
+		"wrap",
+		"",
+		"A comma separated list of the concrete types, such as 'os.File', that a package shim returns as interfaces of their methods",
+	)
+	wrapDepthFlag := fs.Int(
+		"wrap-depth",
+		1,
+		"How many levels of results of functions and methods to find the types to wrap in",
+	)
+	withFakeFlag := fs.Bool(
+		"with-fake",
+		false,
+		"Whether or not to generate a fake of the interface of a package shim in the same run",
+	)
+	extractFlag := fs.Bool(
+		"extract",
+		false,
+		"Whether or not to extract an interface and adapter from a concrete type, along with a fake of the interface",
+	)
 	generateFlag := fs.Bool(
 		"generate",
 		false,
@@ -45,6 +92,36 @@ func New(args []string, workingDir string, evaler Evaler, stater Stater) (*Parse
 		"",
 		"A path to a file that should be used as a header for the generated fake",
 	)
+	constructorFlag := fs.Bool(
+		"constructor",
+		false,
+		"Generate a constructor that verifies the fake when the test completes",
+	)
+	strictFlag := fs.Bool(
+		"strict",
+		false,
+		"Fail when a method of the fake is called without a configured stub or return value",
+	)
+	delegateFlag := fs.Bool(
+		"delegate",
+		false,
+		"Forward calls without a configured stub or return value to a real implementation",
+	)
+	deepCopyFlag := fs.Bool(
+		"deep-copy",
+		false,
+		"Record deep copies of the arguments passed to the fake",
+	)
+	expectationsFlag := fs.Bool(
+		"expectations",
+		false,
+		"Generate methods for declaring and verifying expected calls on the fake",
+	)
+	styleFlag := fs.String(
+		"style",
+		"",
+		"The flavor of fake to generate: counterfeiter (the default), gomock, funcs or replay",
+	)
 	quietFlag := fs.Bool(
 		"q",
 		false,
@@ -72,8 +149,31 @@ func New(args []string, workingDir string, evaler Evaler, stater Stater) (*Parse
 		PrintToStdOut: any(args, "-"),
 		GenerateInterfaceAndShimFromPackageDirectory: packageMode,
 		GenerateMode: *generateFlag,
+		ExtractMode:  *extractFlag,
+		WithFake:     *withFakeFlag,
+		WithDefault:  *withDefaultFlag,
+		Variables:    *variablesFlag,
 		HeaderFile:   *headerFlag,
 		Quiet:        *quietFlag,
+		Constructor:  *constructorFlag,
+		Strict:       *strictFlag,
+		Delegate:     *delegateFlag,
+		DeepCopy:     *deepCopyFlag,
+		Expectations: *expectationsFlag,
+		Style:        *styleFlag,
+		Include:      *includeFlag,
+		Exclude:      *excludeFlag,
+		Wrap:         *wrapFlag,
+		WrapDepth:    *wrapDepthFlag,
+		Instantiate:  instantiateFlag,
+	}
+	err = result.ValidateStyleOptions()
+	if err != nil {
+		return nil, err
+	}
+	err = result.ValidatePackageOptions()
+	if err != nil {
+		return nil, err
 	}
 	if *generateFlag {
 		return result, nil
@@ -84,12 +184,74 @@ func New(args []string, workingDir string, evaler Evaler, stater Stater) (*Parse
 	}
 	result.parseInterfaceName(packageMode, fs.Args())
 	result.parseFakeName(packageMode, *fakeNameFlag, fs.Args())
+	if result.ExtractMode {
+		result.parseExtractedInterfacePath(workingDir, fs.Args())
+	}
 	result.parseOutputPath(packageMode, workingDir, *outputPathFlag, fs.Args())
 	result.parseDestinationPackageName(packageMode, fs.Args())
 	result.parsePackagePath(packageMode, fs.Args())
+	if result.WithFake {
+		result.parseShimFakePath()
+	}
 	return result, nil
 }
 
+// styleOptions are the options supported by each style other than the
+// counterfeiter style, which supports all of them.
+var styleOptions = map[string][]string{
+	"funcs": {"strict"},
+}
+
+// ValidateStyleOptions reports options that the style of the fake does not
+// support, which would otherwise be ignored.
+func (a *ParsedArguments) ValidateStyleOptions() error {
+	if a.Style == "" || a.Style == "counterfeiter" {
+		return nil
+	}
+	options := []struct {
+		name string
+		set  bool
+	}{
+		{"constructor", a.Constructor},
+		{"strict", a.Strict},
+		{"delegate", a.Delegate},
+		{"deep-copy", a.DeepCopy},
+		{"expectations", a.Expectations},
+	}
+	for _, option := range options {
+		if option.set && !slices.Contains(styleOptions[a.Style], option.name) {
+			return fmt.Errorf("the -%s flag cannot be used with the %s style", option.name, a.Style)
+		}
+	}
+	return nil
+}
+
+// ValidatePackageOptions reports options that only configure package shims
+// when they are used without -p, which would otherwise be ignored.
+func (a *ParsedArguments) ValidatePackageOptions() error {
+	if a.GenerateInterfaceAndShimFromPackageDirectory {
+		return nil
+	}
+	options := []struct {
+		name string
+		set  bool
+	}{
+		{"include", a.Include != ""},
+		{"exclude", a.Exclude != ""},
+		{"instantiate", len(a.Instantiate) > 0},
+		{"variables", a.Variables},
+		{"with-default", a.WithDefault},
+		{"wrap", a.Wrap != ""},
+		{"with-fake", a.WithFake},
+	}
+	for _, option := range options {
+		if option.set {
+			return fmt.Errorf("the -%s flag requires -p", option.name)
+		}
+	}
+	return nil
+}
+
 func (a *ParsedArguments) PrettyPrint() {
 	b, _ := json.MarshalIndent(a, "", " ")
 	fmt.Println(string(b))
@@ -128,6 +290,9 @@ func (a *ParsedArguments) parseFakeName(packageMode bool, fakeName string, args
 	if packageMode {
 		a.parsePackagePath(packageMode, args)
 		a.FakeImplName = strings.ToUpper(path.Base(a.PackagePath))[:1] + path.Base(a.PackagePath)[1:]
+		if fakeName != "" {
+			a.FakeImplName = fakeName
+		}
 		return
 	}
 	if fakeName == "" {
@@ -161,12 +326,42 @@ func (a *ParsedArguments) parseOutputPath(packageMode bool, workingDir string, o
 	}
 
 	d := workingDir
-	if len(args) > 1 {
+	if a.ExtractMode {
+		d = filepath.Dir(a.ExtractedInterfacePath)
+	} else if len(args) > 1 {
 		d = a.SourcePackageDir
 	}
 	a.OutputPath = filepath.Join(d, packageNameForPath(d), snakeCaseName+".go")
 }
 
+// parseExtractedInterfacePath places the interface extracted from a concrete
+// type in a <package>shim directory, as package mode does with its shims.
+func (a *ParsedArguments) parseExtractedInterfacePath(workingDir string, args []string) {
+	a.parsePackagePath(false, args)
+	a.ExtractedPackageName = restrictToValidPackageName(path.Base(filepath.ToSlash(a.PackagePath))) + "shim"
+	snakeCaseName := strings.ToLower(camelRegexp.ReplaceAllString(a.InterfaceName, "${1}_${2}"))
+	a.ExtractedInterfacePath = filepath.Join(workingDir, a.ExtractedPackageName, snakeCaseName+".go")
+}
+
+// parseShimFakePath places the fake of the interface of a package shim where
+// the counterfeiter:generate directive in the shim would write it.
+func (a *ParsedArguments) parseShimFakePath() {
+	d := filepath.Dir(a.OutputPath)
+	a.ShimFakeName = "Fake" + a.FakeImplName
+	snakeCaseName := strings.ToLower(camelRegexp.ReplaceAllString(a.ShimFakeName, "${1}_${2}"))
+	a.ShimFakePath = filepath.Join(d, packageNameForPath(d), snakeCaseName+".go")
+	a.ShimFakePackageName = restrictToValidPackageName(packageNameForPath(d))
+}
+
+// WrappedFakePath returns the name of the fake of the interface of a type that
+// a package shim wraps, and the path to write it to, next to the fake of the
+// shim.
+func (a *ParsedArguments) WrappedFakePath(interfaceName string) (string, string) {
+	fakeName := "Fake" + interfaceName
+	snakeCaseName := strings.ToLower(camelRegexp.ReplaceAllString(fakeName, "${1}_${2}"))
+	return fakeName, filepath.Join(filepath.Dir(a.ShimFakePath), snakeCaseName+".go")
+}
+
 func (a *ParsedArguments) parseDestinationPackageName(packageMode bool, args []string) {
 	if packageMode {
 		a.parsePackagePath(packageMode, args)
@@ -180,6 +375,12 @@ func (a *ParsedArguments) parseDestinationPackageName(packageMode bool, args []s
 func (a *ParsedArguments) parsePackagePath(packageMode bool, args []string) {
 	if packageMode {
 		a.PackagePath = args[0]
+		a.CombinedPackagePaths = nil
+		for _, arg := range args[1:] {
+			if arg != "-" {
+				a.CombinedPackagePaths = append(a.CombinedPackagePaths, arg)
+			}
+		}
 		return
 	}
 	if len(args) == 1 {
@@ -201,18 +402,56 @@ type ParsedArguments struct {
 	PackagePath      string // package path to the package containing the interface to fake
 	OutputPath       string // path to write the fake file to
 
+	CombinedPackagePaths []string // package paths of more packages to add to a package shim
+
 	DestinationPackageName string // often the base-dir for OutputPath but must be a valid package name
 
 	InterfaceName string // the interface to counterfeit
 	FakeImplName  string // the name of the struct implementing the given interface
 
+	ExtractedInterfacePath string // path to write the interface extracted from a concrete type to
+	ExtractedPackageName   string // the package name of the extracted interface
+
+	ShimFakeName        string // the name of the fake of the interface of a package shim
+	ShimFakePath        string // path to write the fake of the interface of a package shim to
+	ShimFakePackageName string // the package name of the fake of the interface of a package shim
+
 	PrintToStdOut bool
 	GenerateMode  bool
+	ExtractMode   bool // extract an interface from the concrete type named by InterfaceName
+	WithFake      bool // also generate a fake of the interface of a package shim
+	WithDefault   bool // add a package level DefaultMyShim and SwapMyShimForTest to a package shim
+	Variables     bool // add getters and setters for exported variables to a package shim
 	Quiet         bool
+	Constructor   bool   // generate a constructor that verifies the fake
+	Strict        bool   // fail on calls without a configured stub or return value
+	Delegate      bool   // forward calls without a configured stub or return value
+	DeepCopy      bool   // record deep copies of arguments
+	Expectations  bool   // declare and verify expected calls
+	Style         string // the flavor of fake to generate
+	Include       string // the functions to include in a package shim
+	Exclude       string // the functions to leave out of a package shim
+	Wrap          string // the concrete types that a package shim wraps
+	WrapDepth     int    // how deep a package shim finds the types to wrap
+
+	Instantiate []string // the instantiations of generic functions to add to a package shim
 
 	HeaderFile string
 }
 
+// stringsFlag is a flag that collects the values it is given each time it is
+// used.
+type stringsFlag []string
+
+func (s *stringsFlag) String() string {
+	return strings.Join(*s, " ")
+}
+
+func (s *stringsFlag) Set(value string) error {
+	*s = append(*s, value)
+	return nil
+}
+
 func fixupUnexportedNames(interfaceName string) string {
 	asRunes := []rune(interfaceName)
 	if len(asRunes) == 0 || !unicode.IsLower(asRunes[0]) {
@@ -72,6 +72,135 @@ func testParsingArguments(t *testing.T, when spec.G, it spec.S) {
 				Expect(parsedArgs.DestinationPackageName).To(Equal("osshim"))
 			})
 		})
+
+		when("the -instantiate flag is provided more than once", func() {
+			it.Before(func() {
+				args = []string{"counterfeiter", "-p", "-instantiate", "Decode[User]", "-instantiate", "DecodeAccount=Decode[store.Account]", "./store"}
+				justBefore()
+			})
+
+			it("collects the instantiations", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(parsedArgs.Instantiate).To(Equal([]string{"Decode[User]", "DecodeAccount=Decode[store.Account]"}))
+				Expect(parsedArgs.PackagePath).To(Equal("./store"))
+			})
+		})
+
+		when("the -include and -exclude flags are provided", func() {
+			it.Before(func() {
+				args = []string{"counterfeiter", "-p", "-include", "Open,Stat,Read.*", "-exclude", "Readlink", "--fake-name", "FileSystem", "os"}
+				justBefore()
+			})
+
+			it("sets the Include and Exclude attributes on the parsedArgs struct", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(parsedArgs.Include).To(Equal("Open,Stat,Read.*"))
+				Expect(parsedArgs.Exclude).To(Equal("Readlink"))
+				Expect(parsedArgs.PackagePath).To(Equal("os"))
+			})
+
+			it("uses the fake name as the name of the interface", func() {
+				Expect(parsedArgs.FakeImplName).To(Equal("FileSystem"))
+				Expect(parsedArgs.OutputPath).To(Equal(path.Join(workingDir, "osshim", "file_system.go")))
+			})
+		})
+
+		when("the -with-fake flag is provided", func() {
+			it.Before(func() {
+				args = []string{"counterfeiter", "-p", "-with-fake", "--fake-name", "FileSystem", "os"}
+				justBefore()
+			})
+
+			it("places the fake where the directive in the shim would", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(parsedArgs.WithFake).To(BeTrue())
+				Expect(parsedArgs.OutputPath).To(Equal(path.Join(workingDir, "osshim", "file_system.go")))
+				Expect(parsedArgs.ShimFakeName).To(Equal("FakeFileSystem"))
+				Expect(parsedArgs.ShimFakePath).To(Equal(path.Join(workingDir, "osshim", "osshimfakes", "fake_file_system.go")))
+				Expect(parsedArgs.ShimFakePackageName).To(Equal("osshimfakes"))
+			})
+
+			when("the -o flag is provided", func() {
+				it.Before(func() {
+					args = []string{"counterfeiter", "-p", "-with-fake", "-o", "internal/fs", "os"}
+					justBefore()
+				})
+
+				it("places the fake next to the shim", func() {
+					Expect(parsedArgs.ShimFakePath).To(Equal(path.Join(workingDir, "internal", "fs", "fsfakes", "fake_os.go")))
+					Expect(parsedArgs.ShimFakePackageName).To(Equal("fsfakes"))
+				})
+			})
+		})
+
+		when("the -variables flag is provided", func() {
+			it.Before(func() {
+				args = []string{"counterfeiter", "-p", "-variables", "os"}
+				justBefore()
+			})
+
+			it("sets the Variables attribute on the parsedArgs struct", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(parsedArgs.Variables).To(BeTrue())
+			})
+		})
+
+		when("the -with-default flag is provided", func() {
+			it.Before(func() {
+				args = []string{"counterfeiter", "-p", "-with-default", "os"}
+				justBefore()
+			})
+
+			it("sets the WithDefault attribute on the parsedArgs struct", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(parsedArgs.WithDefault).To(BeTrue())
+			})
+		})
+
+		when("the -wrap and -wrap-depth flags are provided", func() {
+			it.Before(func() {
+				args = []string{"counterfeiter", "-p", "-wrap", "File,Process", "-wrap-depth", "2", "os"}
+				justBefore()
+			})
+
+			it("sets the Wrap and WrapDepth attributes on the parsedArgs struct", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(parsedArgs.Wrap).To(Equal("File,Process"))
+				Expect(parsedArgs.WrapDepth).To(Equal(2))
+			})
+		})
+
+		when("the -with-fake flag is provided for a shim that wraps types", func() {
+			it.Before(func() {
+				args = []string{"counterfeiter", "-p", "-with-fake", "-wrap", "ProcessState", "os"}
+				justBefore()
+			})
+
+			it("places the fakes of the wrapped types next to the fake of the shim", func() {
+				Expect(parsedArgs.WrapDepth).To(Equal(1))
+				fakeName, fakePath := parsedArgs.WrappedFakePath("ProcessState")
+				Expect(fakeName).To(Equal("FakeProcessState"))
+				Expect(fakePath).To(Equal(path.Join(workingDir, "osshim", "osshimfakes", "fake_process_state.go")))
+			})
+		})
+
+		when("more than one package is provided", func() {
+			it.Before(func() {
+				args = []string{"counterfeiter", "-p", "os", "io/fs", "-", "path/filepath"}
+				justBefore()
+			})
+
+			it("names the shim after the first package", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(parsedArgs.PackagePath).To(Equal("os"))
+				Expect(parsedArgs.FakeImplName).To(Equal("Os"))
+				Expect(parsedArgs.OutputPath).To(Equal(path.Join(workingDir, "osshim", "os.go")))
+			})
+
+			it("collects the other packages", func() {
+				Expect(parsedArgs.CombinedPackagePaths).To(Equal([]string{"io/fs", "path/filepath"}))
+			})
+		})
 	})
 
 	when("when a single argument is provided", func() {
@@ -161,6 +290,59 @@ func testParsingArguments(t *testing.T, when spec.G, it spec.S) {
 		})
 	})
 
+	when("when the -extract flag is provided", func() {
+		it.Before(func() {
+			args = []string{"counterfeiter", "-extract", "./store", "SqlStore"}
+			justBefore()
+		})
+
+		it("treats the second argument as the type to extract an interface from", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(parsedArgs.ExtractMode).To(BeTrue())
+			Expect(parsedArgs.InterfaceName).To(Equal("SqlStore"))
+			Expect(parsedArgs.PackagePath).To(Equal(path.Join(workingDir, "store")))
+		})
+
+		it("writes the interface to a shim package, as package mode does", func() {
+			Expect(parsedArgs.ExtractedInterfacePath).To(Equal(path.Join(workingDir, "storeshim", "sql_store.go")))
+			Expect(parsedArgs.ExtractedPackageName).To(Equal("storeshim"))
+		})
+
+		it("writes the fake next to the interface", func() {
+			Expect(parsedArgs.FakeImplName).To(Equal("FakeSqlStore"))
+			Expect(parsedArgs.OutputPath).To(Equal(path.Join(workingDir, "storeshim", "storeshimfakes", "fake_sql_store.go")))
+			Expect(parsedArgs.DestinationPackageName).To(Equal("storeshimfakes"))
+		})
+
+		when("given a type in a stdlib package", func() {
+			it.Before(func() {
+				args = []string{"counterfeiter", "-extract", "net/http.Client"}
+				justBefore()
+			})
+
+			it("names the shim package after the package", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(parsedArgs.PackagePath).To(Equal("net/http"))
+				Expect(parsedArgs.ExtractedInterfacePath).To(Equal(path.Join(workingDir, "httpshim", "client.go")))
+				Expect(parsedArgs.OutputPath).To(Equal(path.Join(workingDir, "httpshim", "httpshimfakes", "fake_client.go")))
+			})
+		})
+
+		when("the output path is provided", func() {
+			it.Before(func() {
+				args = []string{"counterfeiter", "-extract", "-o", "fakes", "./store", "SqlStore"}
+				justBefore()
+			})
+
+			it("only changes where the fake is written", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(parsedArgs.ExtractedInterfacePath).To(Equal(path.Join(workingDir, "storeshim", "sql_store.go")))
+				Expect(parsedArgs.OutputPath).To(Equal(path.Join(workingDir, "fakes", "fake_sql_store.go")))
+				Expect(parsedArgs.DestinationPackageName).To(Equal("fakes"))
+			})
+		})
+	})
+
 	when("when two arguments are provided", func() {
 		it.Before(func() {
 			args = []string{"counterfeiter", "my/my5package", "MySpecialInterface"}
@@ -344,6 +526,129 @@ func testParsingArguments(t *testing.T, when spec.G, it spec.S) {
 		})
 	})
 
+	when("when '-constructor' is used", func() {
+		it.Before(func() {
+			args = []string{"counterfeiter", "-constructor", "some.interface"}
+			justBefore()
+		})
+
+		it("sets the Constructor attribute on the parsedArgs struct", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(parsedArgs.Constructor).To(BeTrue())
+		})
+	})
+
+	when("when '-strict' is used", func() {
+		it.Before(func() {
+			args = []string{"counterfeiter", "-strict", "some.interface"}
+			justBefore()
+		})
+
+		it("sets the Strict attribute on the parsedArgs struct", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(parsedArgs.Strict).To(BeTrue())
+		})
+	})
+
+	when("when '-delegate' is used", func() {
+		it.Before(func() {
+			args = []string{"counterfeiter", "-delegate", "some.interface"}
+			justBefore()
+		})
+
+		it("sets the Delegate attribute on the parsedArgs struct", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(parsedArgs.Delegate).To(BeTrue())
+		})
+	})
+
+	when("when '-deep-copy' is used", func() {
+		it.Before(func() {
+			args = []string{"counterfeiter", "-deep-copy", "some.interface"}
+			justBefore()
+		})
+
+		it("sets the DeepCopy attribute on the parsedArgs struct", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(parsedArgs.DeepCopy).To(BeTrue())
+		})
+	})
+
+	when("when '-expectations' is used", func() {
+		it.Before(func() {
+			args = []string{"counterfeiter", "-expectations", "some.interface"}
+			justBefore()
+		})
+
+		it("sets the Expectations attribute on the parsedArgs struct", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(parsedArgs.Expectations).To(BeTrue())
+		})
+	})
+
+	when("when '-style' is used", func() {
+		it.Before(func() {
+			args = []string{"counterfeiter", "-style", "gomock", "some.interface"}
+			justBefore()
+		})
+
+		it("sets the Style attribute on the parsedArgs struct", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(parsedArgs.Style).To(Equal("gomock"))
+		})
+	})
+
+	when("options of package shims are used without '-p'", func() {
+		it("returns an error for each of them", func() {
+			for option, value := range map[string]string{
+				"-include":      "Open",
+				"-exclude":      "Open",
+				"-instantiate":  "Decode[User]",
+				"-variables":    "",
+				"-with-default": "",
+				"-wrap":         "File",
+				"-with-fake":    "",
+			} {
+				args = []string{"counterfeiter", option}
+				if value != "" {
+					args = append(args, value)
+				}
+				args = append(args, "some.interface")
+				justBefore()
+				Expect(err).To(MatchError(fmt.Sprintf("the %s flag requires -p", option)))
+			}
+		})
+
+		it("returns an error in generate mode", func() {
+			args = []string{"counterfeiter", "-generate", "-include", "Open"}
+			justBefore()
+			Expect(err).To(MatchError("the -include flag requires -p"))
+		})
+	})
+
+	when("when '-style' is used with options the style does not support", func() {
+		it("returns an error for an option of the counterfeiter style", func() {
+			for _, option := range []string{"-constructor", "-strict", "-delegate", "-deep-copy", "-expectations"} {
+				args = []string{"counterfeiter", option, "-style", "gomock", "some.interface"}
+				justBefore()
+				Expect(err).To(MatchError(fmt.Sprintf("the %s flag cannot be used with the gomock style", option)))
+			}
+		})
+
+		it("returns an error in generate mode", func() {
+			args = []string{"counterfeiter", "-generate", "-style", "replay", "-strict"}
+			justBefore()
+			Expect(err).To(MatchError("the -strict flag cannot be used with the replay style"))
+		})
+
+		it("accepts the options the style supports", func() {
+			args = []string{"counterfeiter", "-strict", "-style", "funcs", "some.interface"}
+			justBefore()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(parsedArgs.Strict).To(BeTrue())
+		})
+	})
+
 	when("when '-header' is used", func() {
 		it.Before(func() {
 			args = []string{"counterfeiter", "-header", "some/header/file", "some.interface"}
@@ -3,9 +3,15 @@ package arguments
 const usage = `
 USAGE
 	counterfeiter
-		[-generate>] [-o <output-path>] [-p] [--fake-name <fake-name>]
-		[-header <header-file>]
+		[-generate>] [-o <output-path>] [-p] [-include <names>]
+		[-exclude <names>] [-instantiate <instantiation>] [-wrap <types>]
+		[-wrap-depth <depth>] [-variables] [-with-fake] [-with-default]
+		[-extract]
+		[--fake-name <fake-name>]
+		[-header <header-file>] [-constructor] [-strict] [-delegate]
+		[-deep-copy] [-expectations] [-style <style>]
 		[<source-path>] <interface> [-]
+	counterfeiter -p [<options>] <source-path> [<source-path>...] [-]
 
 ARGUMENTS
 	source-path
@@ -13,6 +19,8 @@ ARGUMENTS
 		In package mode (-p), source-path should instead specify the path
 		of the input package; alternatively you can use the package name
 		(e.g. "os") and the path will be inferred from your GOROOT.
+		More than one package can be given in package mode, in which case
+		a single interface and shim are generated for all of them.
 
 	interface
 		If source-path is specified: Name of the interface to fake.
@@ -79,6 +87,140 @@ OPTIONS
 		# now generate fake in ${PWD}/osshim/os_fake (fake_os.go)
 		go generate osshim/...
 
+		When more than one package is given, the functions of all of them
+		are added to a single interface and shim, which are named after the
+		first package. When two packages have a function with the same
+		name, the methods for it are prefixed with the name of their
+		package, e.g. OsReadFile and FsReadFile.
+
+	example:
+		# generates a FileSystem interface and shim in ${PWD}/osshim with
+		# Open, OsReadFile, FsReadFile, FsGlob, FilepathGlob and Join
+		counterfeiter -p -include Open,ReadFile,Glob,Join --fake-name FileSystem os io/fs path/filepath
+
+	-include, -exclude
+		A comma separated list of names or regular expressions, which must
+		match the whole name of a function. In package mode (-p), only the
+		functions matched by -include, and not matched by -exclude, are
+		added to the generated interface and shim.
+
+	example:
+		# generates a FileSystem interface and shim with just Open, Stat and ReadFile
+		counterfeiter -p -include Open,Stat,ReadFile --fake-name FileSystem os
+
+		# generates an interface and shim with the Read* functions except Readlink
+		counterfeiter -p -include 'Read.*' -exclude Readlink os
+
+	-instantiate
+		An instantiation of a generic function, such as 'Decode[User]',
+		to add to the interface and shim in package mode (-p). The type
+		arguments may refer to the types of the package, and to the
+		packages it depends on by name, and must not contain spaces when
+		used in a directive. The method is named after the function and
+		the type arguments, unless a name is given as in
+		'DecodeAsUser=Decode[User]'. The flag can be repeated. A generic
+		function of another package given to -p is referred to by its
+		package name, as in 'store.Decode[User]', and its type arguments
+		refer to the types of that package. The trailing type arguments
+		can be left out when they can be inferred from the constraints,
+		as in 'slices.Sort[[]int]'.
+
+		Generic functions that are not instantiated, and functions that
+		use unexported types, cannot be methods of an interface, so they
+		are left out. They are reported, and listed in the doc comment of
+		the generated interface.
+
+	example:
+		# adds DecodeUser(data []byte) (store.User, error) to the interface and shim
+		counterfeiter -p -instantiate 'Decode[User]' ./store
+
+	-wrap
+		A comma separated list of concrete types, such as 'os.File', or
+		'File' for a type of the package given to -p. In package mode,
+		the functions that return a pointer to one of the types return an
+		interface of its exported methods instead, implemented by an
+		adapter that is generated along with the shim. A nil pointer is
+		returned as a nil interface. The shim has a
+		counterfeiter:generate directive for each of these interfaces.
+
+	-wrap-depth
+		How deep the types of -wrap are found, which is 1 by default. At
+		a depth of 1, only the results of the functions of the package are
+		wrapped. At each further level, so are the results of the methods
+		of the types that were wrapped at the level before.
+
+	example:
+		# Open and Create return an osshim.File, and its methods, such as
+		# Stat, keep returning the types of the os package
+		counterfeiter -p -include Open,Create -wrap File os
+
+		# StartProcess returns an osshim.Process, and its Wait method
+		# returns an osshim.ProcessState
+		counterfeiter -p -wrap Process,ProcessState -wrap-depth 2 os
+
+	-variables
+		In package mode (-p), add a getter and a setter for each of the
+		exported variables of the package to the interface and shim, such
+		as Args() []string and SetArgs([]string) for os.Args. The -include
+		and -exclude flags match the names of the variables. A getter of
+		a type that is wrapped (-wrap) returns its interface, while the
+		setter takes the concrete type.
+
+	example:
+		# adds Args, SetArgs, Stdout and SetStdout to the interface and shim
+		counterfeiter -p -variables -include Args,Stdout os
+
+	-with-fake
+		In package mode (-p), also generate a fake of the interface in the
+		same run, instead of leaving it to go generate, along with fakes of
+		the interfaces of the types it wraps (-wrap). The fake is written
+		where the counterfeiter:generate directive in the shim would write
+		it, and the packages are only loaded once. The shim and the fake are
+		only written once both have been generated, so that they cannot get
+		out of sync.
+
+	example:
+		# generates os.go (interface and shim) in ${PWD}/osshim
+		# and fake_os.go (fake) in ${PWD}/osshim/osshimfakes
+		counterfeiter -p -with-fake os
+
+	-with-default
+		In package mode (-p), add a package level variable named after the
+		shim, such as DefaultOs, which holds the shim, and a function such
+		as SwapOsForTest, which makes a fake the default until the end of a
+		test. Code that calls the functions of a package directly can call
+		them on the default instead, without having a shim injected. Tests
+		that swap the default cannot run in parallel.
+
+	example:
+		# generates os.go with osshim.DefaultOs and osshim.SwapOsForTest
+		counterfeiter -p -with-fake -with-default os
+
+		# in the code, replace os.Open(name) with
+		f, err := osshim.DefaultOs.Open(name)
+
+		# in a test
+		fake := new(osshimfakes.FakeOs)
+		osshim.SwapOsForTest(t, fake)
+
+	-extract
+		Extract mode: When invoked in extract mode, counterfeiter
+		generates an interface from the exported methods of the concrete
+		type <interface>, including the ones with pointer receivers and
+		the ones promoted from embedded fields, together with an adapter
+		that forwards calls to a pointer to the type. Both are written to
+		${PWD}/<package>shim, and a fake of the interface is written to
+		<package>shimfakes inside it, in the same run. The -o flag only
+		changes where the fake is written.
+
+	example:
+		# generates store.go (interface and adapter) in ${PWD}/storeshim
+		# and fake_store.go (fake) in ${PWD}/storeshim/storeshimfakes
+		counterfeiter -extract ./store Store
+
+		# use the adapter in production code
+		var s storeshim.Store = storeshim.NewStoreAdapter(realStore)
+
 	-header
 		Path to the file which should be used as a header for all generated fakes.
 		By default, no special header is used.
@@ -101,10 +243,168 @@ OPTIONS
 		# writes "FakeMyInterface" with ./specific.go.txt as a header
 		# writes "FakeMyOtherInterface" & "FakeMyThirdInterface" with ./generic.go.txt as a header
 
+	-constructor
+		Generate a NewMyInterface constructor for the fake, which takes a
+		testing.TB and verifies the fake when the test completes. It
+		reports return values configured with ReturnsOnCall for calls that
+		were never made, and, with -strict, the calls that had no stub or
+		return values configured. In package mode (-p), the generated
+		counterfeiter:generate directive for the interface includes this
+		flag.
+
+		If the generate mode is used, the flag can be set on the "go:generate"
+		line to apply to all "counterfeiter:generate" lines.
+
+	example:
+		# writes "FakeMyInterface" and "NewFakeMyInterface" to ./mypackagefakes/fake_my_interface.go
+		counterfeiter -constructor ./mypackage MyInterface
+
+		# in a test, create a fake that is verified when the test completes
+		fake := mypackagefakes.NewFakeMyInterface(t)
+
+	-strict
+		Generate a fake that fails when a method returning values is called
+		without a stub or return values having been configured, instead of
+		silently returning zero values. The fake panics, unless a
+		StrictHandler has been set on it. In package mode (-p), the
+		generated counterfeiter:generate directive for the interface
+		includes this flag.
+
+		If the generate mode is used, the flag can be set on the "go:generate"
+		line to apply to all "counterfeiter:generate" lines.
+
+	example:
+		# writes a strict "FakeMyInterface" to ./mypackagefakes/fake_my_interface.go
+		counterfeiter -strict ./mypackage MyInterface
+
+		# in a test, report unconfigured calls instead of panicking
+		fake.StrictHandler = func(method string, call int, args []interface{}) {
+			t.Errorf("unexpected call %d to %s with %v", call, method, args)
+		}
+
+	-delegate
+		Generate a fake with a Delegate field, which holds a real
+		implementation of the interface or function. Calls without a stub
+		or return values configured are forwarded to the Delegate, if it is
+		set, and are recorded like any other call. In package mode (-p),
+		the generated counterfeiter:generate directive for the interface
+		includes this flag, so that the shim can be used as the Delegate.
+
+		If the generate mode is used, the flag can be set on the "go:generate"
+		line to apply to all "counterfeiter:generate" lines.
+
+	example:
+		# writes a delegating "FakeMyInterface" to ./mypackagefakes/fake_my_interface.go
+		counterfeiter -delegate ./mypackage MyInterface
+
+		# in a test, only override one method of the real implementation
+		fake := &mypackagefakes.FakeMyInterface{Delegate: realImplementation}
+		fake.SomeMethodReturns(errors.New("the-error"))
+
+	-deep-copy
+		Generate a fake that records deep copies of the arguments it is
+		called with, so that mutating a slice, map or struct after passing
+		it to the fake does not change what the fake recorded. Values that
+		cannot be copied by the generated code, such as interfaces or structs
+		with unexported fields, are passed to the DeepCopyFallback of the
+		fake, if it is set, and recorded as they are otherwise. Arguments
+		must not contain cycles of pointers. In package
+		mode (-p), the generated counterfeiter:generate directive for the
+		interface includes this flag.
+
+		If the generate mode is used, the flag can be set on the "go:generate"
+		line to apply to all "counterfeiter:generate" lines.
+
+	example:
+		# writes a deep copying "FakeMyInterface" to ./mypackagefakes/fake_my_interface.go
+		counterfeiter -deep-copy ./mypackage MyInterface
+
+		# in a test, copy values the generated code cannot copy
+		fake.DeepCopyFallback = func(v interface{}) interface{} {
+			if b, ok := v.(*bytes.Buffer); ok {
+				return bytes.NewBufferString(b.String())
+			}
+			return nil
+		}
+
+	-expectations
+		Generate a fake on which the expected calls can be declared up front,
+		with ExpectMyMethod, and verified afterwards, with VerifyExpectations.
+		By default, each expectation must be met exactly once, in any order.
+		Calls to a method that has expectations, but match none of them, are
+		reported as unexpected. In package mode (-p), the generated
+		counterfeiter:generate directive for the interface includes this
+		flag.
+
+		If the generate mode is used, the flag can be set on the "go:generate"
+		line to apply to all "counterfeiter:generate" lines.
+
+	example:
+		# writes "FakeMyInterface" with expectations to ./mypackagefakes/fake_my_interface.go
+		counterfeiter -expectations ./mypackage MyInterface
+
+		# in a test, declare the expected calls and verify them afterwards
+		fake.ExpectMyMethod("stuff").Times(2).Return(nil)
+		fake.ExpectMyOtherMethodWhen(func(n int) bool { return n > 0 }).AnyTimes()
+		subject.Run()
+		Expect(fake.VerifyExpectations()).To(Succeed())
+
+	-style
+		The flavor of fake to generate for an interface. One of:
+
+		counterfeiter	The default.
+		gomock		A mock that is driven by a *gomock.Controller from
+				go.uber.org/mock, with an EXPECT() recorder. This
+				allows a codebase to generate both flavors with the
+				same tool, e.g. while migrating from one to the other.
+		funcs		A minimal fake with a MyMethodFunc field for each
+				method, and a MyMethodCalls method that returns the
+				calls that were made. With -strict, calling a method
+				whose function is not set panics.
+		replay		A MyInterfaceRecorder that wraps a real implementation
+				and saves its calls to a JSON file, and a fake that
+				loads that file and returns the recorded results for
+				calls with the same arguments.
+
+		The -constructor, -delegate, -deep-copy and -expectations flags
+		can only be used with the counterfeiter style, and the -strict
+		flag only with the counterfeiter and funcs styles.
+
+		In package mode (-p), the generated counterfeiter:generate
+		directive for the interface includes this flag.
+
+		If the generate mode is used, the flag can be set on the "go:generate"
+		line to apply to all "counterfeiter:generate" lines that do not set
+		it themselves.
+
+	example:
+		# writes a gomock style "FakeMyInterface" to ./mypackagefakes/fake_my_interface.go
+		counterfeiter -style gomock ./mypackage MyInterface
+
+		# in a test, use it like a mock generated by mockgen
+		ctrl := gomock.NewController(t)
+		fake := mypackagefakes.NewFakeMyInterface(ctrl)
+		fake.EXPECT().MyMethod("stuff").Return(nil)
+
+		# writes a funcs style "FakeMyInterface" to ./mypackagefakes/fake_my_interface.go
+		counterfeiter -style funcs ./mypackage MyInterface
+
+		# writes a replay style "FakeMyInterface" to ./mypackagefakes/fake_my_interface.go
+		counterfeiter -style replay ./mypackage MyInterface
+
+		# capture the traffic of a real implementation once
+		recorder := mypackagefakes.NewFakeMyInterfaceRecorder(realThing)
+		subject.Run(recorder)
+		err := recorder.Save("testdata/my_interface.json")
+
+		# and replay it in tests
+		fake, err := mypackagefakes.LoadFakeMyInterface("testdata/my_interface.json")
+
 	--fake-name
 		Name of the fake struct to generate. By default, 'Fake' will
-		be prepended to the name of the original interface. (ignored in
-		-p mode)
+		be prepended to the name of the original interface. In package
+		mode (-p), the name of the interface to generate, which defaults
+		to the name of the package.
 
 	example:
 		# writes "CoolThing" to ./mypackagefakes/cool_thing.go
@@ -0,0 +1,33 @@
+// Package collisions has functions and variables that are given the same names
+// in a package shim.
+package collisions
+
+// Level collides with SetLevel in a package shim with variables, because its
+// setter is named SetLevel too.
+var Level int
+
+func SetLevel(level int) {
+	Level = level
+}
+
+// Arg is prefixed when this package is combined with the flag package, and
+// then flag.Arg collides with FlagArg.
+func Arg(i int) string {
+	return ""
+}
+
+func FlagArg(i int) string {
+	return ""
+}
+
+// DefaultCollisions collides with the default of a package shim named
+// Collisions that wraps it.
+type DefaultCollisions struct{}
+
+func (DefaultCollisions) Level() int {
+	return Level
+}
+
+func Current() *DefaultCollisions {
+	return &DefaultCollisions{}
+}
@@ -2,7 +2,9 @@
 package customfakesdir
 
 import (
+	"context"
 	"sync"
+	"sync/atomic"
 
 	"github.com/maxbrunsfeld/counterfeiter/v6/fixtures"
 )
@@ -12,7 +14,19 @@ type FakeCustomOutput struct {
 	customFolderMutex       sync.RWMutex
 	customFolderArgsForCall []struct {
 	}
-	invocations      map[string][][]interface{}
+	invocations        map[string][][]interface{}
+	orderedInvocations []struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}
+	sequencer          *atomic.Uint64
+	invocationsChanged chan struct{}
+	callsSubscribers   map[string][]struct {
+		publish func(args []interface{})
+		cancel  context.CancelFunc
+		done    <-chan struct{}
+	}
 	invocationsMutex sync.RWMutex
 }
 
@@ -34,12 +48,51 @@ func (fake *FakeCustomOutput) CustomFolderCallCount() int {
 	return len(fake.customFolderArgsForCall)
 }
 
+func (fake *FakeCustomOutput) WaitForCustomFolderCalls(ctx context.Context, n int) error {
+	return fake.waitForCalls(ctx, fake.CustomFolderCallCount, n)
+}
+
+func (fake *FakeCustomOutput) CustomFolderCallsChan(ctx context.Context) <-chan []interface{} {
+	return fake.callsChan(ctx, "CustomFolder")
+}
+
 func (fake *FakeCustomOutput) CustomFolderCalls(stub func()) {
 	fake.customFolderMutex.Lock()
 	defer fake.customFolderMutex.Unlock()
 	fake.CustomFolderStub = stub
 }
 
+func (fake *FakeCustomOutput) ResetCustomFolder() {
+	fake.ResetCustomFolderCalls()
+	fake.ResetCustomFolderStubs()
+}
+
+func (fake *FakeCustomOutput) ResetCustomFolderCalls() {
+	fake.customFolderMutex.Lock()
+	defer fake.customFolderMutex.Unlock()
+	fake.customFolderArgsForCall = nil
+	fake.forgetInvocations("CustomFolder")
+}
+
+func (fake *FakeCustomOutput) ResetCustomFolderStubs() {
+	fake.customFolderMutex.Lock()
+	defer fake.customFolderMutex.Unlock()
+	fake.CustomFolderStub = nil
+}
+
+func (fake *FakeCustomOutput) Reset() {
+	fake.ResetCalls()
+	fake.ResetStubs()
+}
+
+func (fake *FakeCustomOutput) ResetCalls() {
+	fake.ResetCustomFolderCalls()
+}
+
+func (fake *FakeCustomOutput) ResetStubs() {
+	fake.ResetCustomFolderStubs()
+}
+
 func (fake *FakeCustomOutput) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -50,6 +103,97 @@ func (fake *FakeCustomOutput) Invocations() map[string][][]interface{} {
 	return copiedInvocations
 }
 
+func (fake *FakeCustomOutput) OrderedInvocations() []struct {
+	Seq    uint64
+	Method string
+	Args   []interface{}
+} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	return append([]struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}{}, fake.orderedInvocations...)
+}
+
+func (fake *FakeCustomOutput) SetSequencer(sequencer *atomic.Uint64) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	fake.sequencer = sequencer
+}
+
+func (fake *FakeCustomOutput) waitForCalls(ctx context.Context, callCount func() int, n int) error {
+	for {
+		fake.invocationsMutex.Lock()
+		if fake.invocationsChanged == nil {
+			fake.invocationsChanged = make(chan struct{})
+		}
+		changed := fake.invocationsChanged
+		fake.invocationsMutex.Unlock()
+		if callCount() >= n {
+			return nil
+		}
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (fake *FakeCustomOutput) callsChan(ctx context.Context, key string) <-chan []interface{} {
+	ctx, cancel := context.WithCancel(ctx)
+	calls := make(chan []interface{})
+	ready := make(chan struct{}, 1)
+	var pendingMutex sync.Mutex
+	var pending [][]interface{}
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.callsSubscribers == nil {
+		fake.callsSubscribers = map[string][]struct {
+			publish func(args []interface{})
+			cancel  context.CancelFunc
+			done    <-chan struct{}
+		}{}
+	}
+	fake.callsSubscribers[key] = append(fake.callsSubscribers[key], struct {
+		publish func(args []interface{})
+		cancel  context.CancelFunc
+		done    <-chan struct{}
+	}{func(args []interface{}) {
+		pendingMutex.Lock()
+		pending = append(pending, args)
+		pendingMutex.Unlock()
+		select {
+		case ready <- struct{}{}:
+		default:
+		}
+	}, cancel, ctx.Done()})
+	go func() {
+		defer close(calls)
+		for {
+			select {
+			case <-ready:
+			case <-ctx.Done():
+				return
+			}
+			pendingMutex.Lock()
+			queued := pending
+			pending = nil
+			pendingMutex.Unlock()
+			for _, args := range queued {
+				select {
+				case calls <- args:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return calls
+}
+
 func (fake *FakeCustomOutput) recordInvocation(key string, args []interface{}) {
 	fake.invocationsMutex.Lock()
 	defer fake.invocationsMutex.Unlock()
@@ -60,6 +204,54 @@ func (fake *FakeCustomOutput) recordInvocation(key string, args []interface{}) {
 		fake.invocations[key] = [][]interface{}{}
 	}
 	fake.invocations[key] = append(fake.invocations[key], args)
+	if fake.sequencer == nil {
+		fake.sequencer = new(atomic.Uint64)
+	}
+	fake.orderedInvocations = append(fake.orderedInvocations, struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}{fake.sequencer.Add(1), key, args})
+	if fake.invocationsChanged != nil {
+		close(fake.invocationsChanged)
+		fake.invocationsChanged = nil
+	}
+	subscribers := fake.callsSubscribers[key][:0]
+	for _, subscriber := range fake.callsSubscribers[key] {
+		select {
+		case <-subscriber.done:
+			continue
+		default:
+		}
+		subscriber.publish(args)
+		subscribers = append(subscribers, subscriber)
+	}
+	if len(subscribers) > 0 {
+		fake.callsSubscribers[key] = subscribers
+	} else {
+		delete(fake.callsSubscribers, key)
+	}
+}
+
+func (fake *FakeCustomOutput) forgetInvocations(key string) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	delete(fake.invocations, key)
+	for _, subscriber := range fake.callsSubscribers[key] {
+		subscriber.cancel()
+	}
+	delete(fake.callsSubscribers, key)
+	var orderedInvocations []struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}
+	for _, invocation := range fake.orderedInvocations {
+		if invocation.Method != key {
+			orderedInvocations = append(orderedInvocations, invocation)
+		}
+	}
+	fake.orderedInvocations = orderedInvocations
 }
 
 var _ fixtures.CustomOutput = new(FakeCustomOutput)
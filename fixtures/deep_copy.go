@@ -0,0 +1,39 @@
+package fixtures
+
+import (
+	"io"
+	"sync"
+)
+
+type Order struct {
+	ID    string
+	Lines []OrderLine
+	Tags  map[string][]string
+	Next  *Order
+}
+
+type Receipt struct {
+	Total int
+	items []string
+}
+
+type Ledger struct {
+	sync.Mutex
+	Orders []*Order
+}
+
+type OrderLine struct {
+	SKU      string
+	Quantity *int
+}
+
+//counterfeiter:generate -deep-copy . DeepCopySomething
+type DeepCopySomething interface {
+	Save(*Order, []*OrderLine, map[string]Receipt) error
+	Write(io.Writer, [2][]byte, func()) error
+	Tag(string, ...[]string)
+	Settle(*Ledger, *sync.WaitGroup, []*Ledger)
+}
+
+//counterfeiter:generate -deep-copy . DeepCopyFunction
+type DeepCopyFunction func([]Order) error
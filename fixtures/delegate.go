@@ -0,0 +1,4 @@
+package fixtures
+
+//counterfeiter:generate -delegate -fake-name DelegatingSomething . Something
+//counterfeiter:generate -delegate -fake-name DelegatingSomethingFactory . SomethingFactory
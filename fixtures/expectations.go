@@ -0,0 +1,8 @@
+package fixtures
+
+//counterfeiter:generate -constructor -expectations -fake-name ExpectingSomething . Something
+//counterfeiter:generate -expectations -fake-name ExpectingSomethingFactory . SomethingFactory
+
+type Verifier interface {
+	VerifyExpectations() error
+}
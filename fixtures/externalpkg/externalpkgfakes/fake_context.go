@@ -2,7 +2,9 @@
 package externalpkgfakes
 
 import (
+	"context"
 	"sync"
+	"sync/atomic"
 
 	"github.com/maxbrunsfeld/counterfeiter/v6/fixtures/internalpkg"
 )
@@ -12,7 +14,19 @@ type FakeContext struct {
 	doSomethingMutex       sync.RWMutex
 	doSomethingArgsForCall []struct {
 	}
-	invocations      map[string][][]interface{}
+	invocations        map[string][][]interface{}
+	orderedInvocations []struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}
+	sequencer          *atomic.Uint64
+	invocationsChanged chan struct{}
+	callsSubscribers   map[string][]struct {
+		publish func(args []interface{})
+		cancel  context.CancelFunc
+		done    <-chan struct{}
+	}
 	invocationsMutex sync.RWMutex
 }
 
@@ -34,12 +48,51 @@ func (fake *FakeContext) DoSomethingCallCount() int {
 	return len(fake.doSomethingArgsForCall)
 }
 
+func (fake *FakeContext) WaitForDoSomethingCalls(ctx context.Context, n int) error {
+	return fake.waitForCalls(ctx, fake.DoSomethingCallCount, n)
+}
+
+func (fake *FakeContext) DoSomethingCallsChan(ctx context.Context) <-chan []interface{} {
+	return fake.callsChan(ctx, "DoSomething")
+}
+
 func (fake *FakeContext) DoSomethingCalls(stub func()) {
 	fake.doSomethingMutex.Lock()
 	defer fake.doSomethingMutex.Unlock()
 	fake.DoSomethingStub = stub
 }
 
+func (fake *FakeContext) ResetDoSomething() {
+	fake.ResetDoSomethingCalls()
+	fake.ResetDoSomethingStubs()
+}
+
+func (fake *FakeContext) ResetDoSomethingCalls() {
+	fake.doSomethingMutex.Lock()
+	defer fake.doSomethingMutex.Unlock()
+	fake.doSomethingArgsForCall = nil
+	fake.forgetInvocations("DoSomething")
+}
+
+func (fake *FakeContext) ResetDoSomethingStubs() {
+	fake.doSomethingMutex.Lock()
+	defer fake.doSomethingMutex.Unlock()
+	fake.DoSomethingStub = nil
+}
+
+func (fake *FakeContext) Reset() {
+	fake.ResetCalls()
+	fake.ResetStubs()
+}
+
+func (fake *FakeContext) ResetCalls() {
+	fake.ResetDoSomethingCalls()
+}
+
+func (fake *FakeContext) ResetStubs() {
+	fake.ResetDoSomethingStubs()
+}
+
 func (fake *FakeContext) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -50,6 +103,97 @@ func (fake *FakeContext) Invocations() map[string][][]interface{} {
 	return copiedInvocations
 }
 
+func (fake *FakeContext) OrderedInvocations() []struct {
+	Seq    uint64
+	Method string
+	Args   []interface{}
+} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	return append([]struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}{}, fake.orderedInvocations...)
+}
+
+func (fake *FakeContext) SetSequencer(sequencer *atomic.Uint64) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	fake.sequencer = sequencer
+}
+
+func (fake *FakeContext) waitForCalls(ctx context.Context, callCount func() int, n int) error {
+	for {
+		fake.invocationsMutex.Lock()
+		if fake.invocationsChanged == nil {
+			fake.invocationsChanged = make(chan struct{})
+		}
+		changed := fake.invocationsChanged
+		fake.invocationsMutex.Unlock()
+		if callCount() >= n {
+			return nil
+		}
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (fake *FakeContext) callsChan(ctx context.Context, key string) <-chan []interface{} {
+	ctx, cancel := context.WithCancel(ctx)
+	calls := make(chan []interface{})
+	ready := make(chan struct{}, 1)
+	var pendingMutex sync.Mutex
+	var pending [][]interface{}
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.callsSubscribers == nil {
+		fake.callsSubscribers = map[string][]struct {
+			publish func(args []interface{})
+			cancel  context.CancelFunc
+			done    <-chan struct{}
+		}{}
+	}
+	fake.callsSubscribers[key] = append(fake.callsSubscribers[key], struct {
+		publish func(args []interface{})
+		cancel  context.CancelFunc
+		done    <-chan struct{}
+	}{func(args []interface{}) {
+		pendingMutex.Lock()
+		pending = append(pending, args)
+		pendingMutex.Unlock()
+		select {
+		case ready <- struct{}{}:
+		default:
+		}
+	}, cancel, ctx.Done()})
+	go func() {
+		defer close(calls)
+		for {
+			select {
+			case <-ready:
+			case <-ctx.Done():
+				return
+			}
+			pendingMutex.Lock()
+			queued := pending
+			pending = nil
+			pendingMutex.Unlock()
+			for _, args := range queued {
+				select {
+				case calls <- args:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return calls
+}
+
 func (fake *FakeContext) recordInvocation(key string, args []interface{}) {
 	fake.invocationsMutex.Lock()
 	defer fake.invocationsMutex.Unlock()
@@ -60,6 +204,54 @@ func (fake *FakeContext) recordInvocation(key string, args []interface{}) {
 		fake.invocations[key] = [][]interface{}{}
 	}
 	fake.invocations[key] = append(fake.invocations[key], args)
+	if fake.sequencer == nil {
+		fake.sequencer = new(atomic.Uint64)
+	}
+	fake.orderedInvocations = append(fake.orderedInvocations, struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}{fake.sequencer.Add(1), key, args})
+	if fake.invocationsChanged != nil {
+		close(fake.invocationsChanged)
+		fake.invocationsChanged = nil
+	}
+	subscribers := fake.callsSubscribers[key][:0]
+	for _, subscriber := range fake.callsSubscribers[key] {
+		select {
+		case <-subscriber.done:
+			continue
+		default:
+		}
+		subscriber.publish(args)
+		subscribers = append(subscribers, subscriber)
+	}
+	if len(subscribers) > 0 {
+		fake.callsSubscribers[key] = subscribers
+	} else {
+		delete(fake.callsSubscribers, key)
+	}
+}
+
+func (fake *FakeContext) forgetInvocations(key string) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	delete(fake.invocations, key)
+	for _, subscriber := range fake.callsSubscribers[key] {
+		subscriber.cancel()
+	}
+	delete(fake.callsSubscribers, key)
+	var orderedInvocations []struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}
+	for _, invocation := range fake.orderedInvocations {
+		if invocation.Method != key {
+			orderedInvocations = append(orderedInvocations, invocation)
+		}
+	}
+	fake.orderedInvocations = orderedInvocations
 }
 
 var _ internalpkg.Context = new(FakeContext)
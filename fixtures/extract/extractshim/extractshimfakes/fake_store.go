@@ -0,0 +1,759 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package extractshimfakes
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"sync/atomic"
+
+	"github.com/maxbrunsfeld/counterfeiter/v6/fixtures/extract"
+	"github.com/maxbrunsfeld/counterfeiter/v6/fixtures/extract/extractshim"
+)
+
+type FakeStore struct {
+	CloseStub        func() error
+	closeMutex       sync.RWMutex
+	closeArgsForCall []struct {
+	}
+	closeReturns struct {
+		result1 error
+	}
+	closeReturnsOnCall map[int]struct {
+		result1 error
+	}
+	GetStub        func(string) (extract.Item, bool)
+	getMutex       sync.RWMutex
+	getArgsForCall []struct {
+		arg1 string
+	}
+	getWhen []struct {
+		matcher func(string) bool
+		stub    func(string) (extract.Item, bool)
+	}
+	getReturns struct {
+		result1 extract.Item
+		result2 bool
+	}
+	getReturnsOnCall map[int]struct {
+		result1 extract.Item
+		result2 bool
+	}
+	LenStub        func() int
+	lenMutex       sync.RWMutex
+	lenArgsForCall []struct {
+	}
+	lenReturns struct {
+		result1 int
+	}
+	lenReturnsOnCall map[int]struct {
+		result1 int
+	}
+	PutStub        func(context.Context, ...extract.Item) error
+	putMutex       sync.RWMutex
+	putArgsForCall []struct {
+		arg1 context.Context
+		arg2 []extract.Item
+	}
+	putWhen []struct {
+		matcher func(context.Context, ...extract.Item) bool
+		stub    func(context.Context, ...extract.Item) error
+	}
+	putReturns struct {
+		result1 error
+	}
+	putReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ArgsComparer       func(expected interface{}, actual interface{}) bool
+	invocations        map[string][][]interface{}
+	orderedInvocations []struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}
+	sequencer          *atomic.Uint64
+	invocationsChanged chan struct{}
+	callsSubscribers   map[string][]struct {
+		publish func(args []interface{})
+		cancel  context.CancelFunc
+		done    <-chan struct{}
+	}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeStore) Close() error {
+	fake.closeMutex.Lock()
+	ret, specificReturn := fake.closeReturnsOnCall[len(fake.closeArgsForCall)]
+	fake.closeArgsForCall = append(fake.closeArgsForCall, struct {
+	}{})
+	stub := fake.CloseStub
+	fakeReturns := fake.closeReturns
+	fake.recordInvocation("Close", []interface{}{})
+	fake.closeMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStore) CloseCallCount() int {
+	fake.closeMutex.RLock()
+	defer fake.closeMutex.RUnlock()
+	return len(fake.closeArgsForCall)
+}
+
+func (fake *FakeStore) WaitForCloseCalls(ctx context.Context, n int) error {
+	return fake.waitForCalls(ctx, fake.CloseCallCount, n)
+}
+
+func (fake *FakeStore) CloseCallsChan(ctx context.Context) <-chan []interface{} {
+	return fake.callsChan(ctx, "Close")
+}
+
+func (fake *FakeStore) CloseCalls(stub func() error) {
+	fake.closeMutex.Lock()
+	defer fake.closeMutex.Unlock()
+	fake.CloseStub = stub
+}
+
+func (fake *FakeStore) CloseReturns(result1 error) {
+	fake.closeMutex.Lock()
+	defer fake.closeMutex.Unlock()
+	fake.CloseStub = nil
+	fake.closeReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) CloseReturnsOnCall(i int, result1 error) {
+	fake.closeMutex.Lock()
+	defer fake.closeMutex.Unlock()
+	fake.CloseStub = nil
+	if fake.closeReturnsOnCall == nil {
+		fake.closeReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.closeReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) ResetClose() {
+	fake.ResetCloseCalls()
+	fake.ResetCloseStubs()
+}
+
+func (fake *FakeStore) ResetCloseCalls() {
+	fake.closeMutex.Lock()
+	defer fake.closeMutex.Unlock()
+	fake.closeArgsForCall = nil
+	fake.forgetInvocations("Close")
+}
+
+func (fake *FakeStore) ResetCloseStubs() {
+	fake.closeMutex.Lock()
+	defer fake.closeMutex.Unlock()
+	fake.CloseStub = nil
+	fake.closeReturns = struct {
+		result1 error
+	}{}
+	fake.closeReturnsOnCall = nil
+}
+
+type FakeStoreGetCall struct {
+	Key string
+}
+
+func (fake *FakeStore) Get(arg1 string) (extract.Item, bool) {
+	fake.getMutex.Lock()
+	ret, specificReturn := fake.getReturnsOnCall[len(fake.getArgsForCall)]
+	fake.getArgsForCall = append(fake.getArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.GetStub
+	whens := fake.getWhen
+	fakeReturns := fake.getReturns
+	fake.recordInvocation("Get", []interface{}{arg1})
+	fake.getMutex.Unlock()
+	for i := len(whens) - 1; i >= 0; i-- {
+		if whens[i].matcher(arg1) {
+			return whens[i].stub(arg1)
+		}
+	}
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStore) GetCallCount() int {
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	return len(fake.getArgsForCall)
+}
+
+func (fake *FakeStore) WaitForGetCalls(ctx context.Context, n int) error {
+	return fake.waitForCalls(ctx, fake.GetCallCount, n)
+}
+
+func (fake *FakeStore) GetCallsChan(ctx context.Context) <-chan []interface{} {
+	return fake.callsChan(ctx, "Get")
+}
+
+func (fake *FakeStore) GetCalls(stub func(string) (extract.Item, bool)) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = stub
+}
+
+// GetCallsWhen calls the stub for the calls whose arguments match.
+// Matchers are checked before the stub, and the matcher registered last wins.
+func (fake *FakeStore) GetCallsWhen(matcher func(string) bool, stub func(string) (extract.Item, bool)) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.getWhen = append(fake.getWhen, struct {
+		matcher func(string) bool
+		stub    func(string) (extract.Item, bool)
+	}{matcher, stub})
+}
+
+func (fake *FakeStore) GetArgsForCall(i int) string {
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	argsForCall := fake.getArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeStore) GetCallHistory() []FakeStoreGetCall {
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	history := make([]FakeStoreGetCall, len(fake.getArgsForCall))
+	for i, argsForCall := range fake.getArgsForCall {
+		history[i] = FakeStoreGetCall{argsForCall.arg1}
+	}
+	return history
+}
+
+func (fake *FakeStore) GetReturns(result1 extract.Item, result2 bool) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = nil
+	fake.getReturns = struct {
+		result1 extract.Item
+		result2 bool
+	}{result1, result2}
+}
+
+func (fake *FakeStore) GetReturnsOnCall(i int, result1 extract.Item, result2 bool) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = nil
+	if fake.getReturnsOnCall == nil {
+		fake.getReturnsOnCall = make(map[int]struct {
+			result1 extract.Item
+			result2 bool
+		})
+	}
+	fake.getReturnsOnCall[i] = struct {
+		result1 extract.Item
+		result2 bool
+	}{result1, result2}
+}
+
+// GetReturnsForArgs returns the values for the calls with the given
+// arguments. Like GetCallsWhen, it is checked before the stub, and the
+// arguments or matcher registered last win.
+func (fake *FakeStore) GetReturnsForArgs(arg1 string) func(extract.Item, bool) {
+	args := []interface{}{arg1}
+	return func(result1 extract.Item, result2 bool) {
+		fake.getMutex.Lock()
+		defer fake.getMutex.Unlock()
+		fake.GetStub = nil
+		fake.getWhen = append(fake.getWhen, struct {
+			matcher func(string) bool
+			stub    func(string) (extract.Item, bool)
+		}{
+			func(arg1 string) bool {
+				return fake.argsMatch(args, []interface{}{arg1})
+			},
+			func(string) (extract.Item, bool) {
+				return result1, result2
+			},
+		})
+	}
+}
+
+// GetReturnsWhen returns the values for the calls whose arguments
+// match. Like GetCallsWhen, it is checked before the stub, and the
+// matcher registered last wins.
+func (fake *FakeStore) GetReturnsWhen(matcher func(string) bool, result1 extract.Item, result2 bool) {
+	fake.GetCallsWhen(matcher, func(string) (extract.Item, bool) {
+		return result1, result2
+	})
+}
+
+func (fake *FakeStore) ResetGet() {
+	fake.ResetGetCalls()
+	fake.ResetGetStubs()
+}
+
+func (fake *FakeStore) ResetGetCalls() {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.getArgsForCall = nil
+	fake.forgetInvocations("Get")
+}
+
+func (fake *FakeStore) ResetGetStubs() {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = nil
+	fake.getWhen = nil
+	fake.getReturns = struct {
+		result1 extract.Item
+		result2 bool
+	}{}
+	fake.getReturnsOnCall = nil
+}
+
+func (fake *FakeStore) Len() int {
+	fake.lenMutex.Lock()
+	ret, specificReturn := fake.lenReturnsOnCall[len(fake.lenArgsForCall)]
+	fake.lenArgsForCall = append(fake.lenArgsForCall, struct {
+	}{})
+	stub := fake.LenStub
+	fakeReturns := fake.lenReturns
+	fake.recordInvocation("Len", []interface{}{})
+	fake.lenMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStore) LenCallCount() int {
+	fake.lenMutex.RLock()
+	defer fake.lenMutex.RUnlock()
+	return len(fake.lenArgsForCall)
+}
+
+func (fake *FakeStore) WaitForLenCalls(ctx context.Context, n int) error {
+	return fake.waitForCalls(ctx, fake.LenCallCount, n)
+}
+
+func (fake *FakeStore) LenCallsChan(ctx context.Context) <-chan []interface{} {
+	return fake.callsChan(ctx, "Len")
+}
+
+func (fake *FakeStore) LenCalls(stub func() int) {
+	fake.lenMutex.Lock()
+	defer fake.lenMutex.Unlock()
+	fake.LenStub = stub
+}
+
+func (fake *FakeStore) LenReturns(result1 int) {
+	fake.lenMutex.Lock()
+	defer fake.lenMutex.Unlock()
+	fake.LenStub = nil
+	fake.lenReturns = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeStore) LenReturnsOnCall(i int, result1 int) {
+	fake.lenMutex.Lock()
+	defer fake.lenMutex.Unlock()
+	fake.LenStub = nil
+	if fake.lenReturnsOnCall == nil {
+		fake.lenReturnsOnCall = make(map[int]struct {
+			result1 int
+		})
+	}
+	fake.lenReturnsOnCall[i] = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeStore) ResetLen() {
+	fake.ResetLenCalls()
+	fake.ResetLenStubs()
+}
+
+func (fake *FakeStore) ResetLenCalls() {
+	fake.lenMutex.Lock()
+	defer fake.lenMutex.Unlock()
+	fake.lenArgsForCall = nil
+	fake.forgetInvocations("Len")
+}
+
+func (fake *FakeStore) ResetLenStubs() {
+	fake.lenMutex.Lock()
+	defer fake.lenMutex.Unlock()
+	fake.LenStub = nil
+	fake.lenReturns = struct {
+		result1 int
+	}{}
+	fake.lenReturnsOnCall = nil
+}
+
+type FakeStorePutCall struct {
+	Ctx   context.Context
+	Items []extract.Item
+}
+
+func (fake *FakeStore) Put(arg1 context.Context, arg2 ...extract.Item) error {
+	fake.putMutex.Lock()
+	ret, specificReturn := fake.putReturnsOnCall[len(fake.putArgsForCall)]
+	fake.putArgsForCall = append(fake.putArgsForCall, struct {
+		arg1 context.Context
+		arg2 []extract.Item
+	}{arg1, arg2})
+	stub := fake.PutStub
+	whens := fake.putWhen
+	fakeReturns := fake.putReturns
+	fake.recordInvocation("Put", []interface{}{arg1, arg2})
+	fake.putMutex.Unlock()
+	for i := len(whens) - 1; i >= 0; i-- {
+		if whens[i].matcher(arg1, arg2...) {
+			return whens[i].stub(arg1, arg2...)
+		}
+	}
+	if stub != nil {
+		return stub(arg1, arg2...)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStore) PutCallCount() int {
+	fake.putMutex.RLock()
+	defer fake.putMutex.RUnlock()
+	return len(fake.putArgsForCall)
+}
+
+func (fake *FakeStore) WaitForPutCalls(ctx context.Context, n int) error {
+	return fake.waitForCalls(ctx, fake.PutCallCount, n)
+}
+
+func (fake *FakeStore) PutCallsChan(ctx context.Context) <-chan []interface{} {
+	return fake.callsChan(ctx, "Put")
+}
+
+func (fake *FakeStore) PutCalls(stub func(context.Context, ...extract.Item) error) {
+	fake.putMutex.Lock()
+	defer fake.putMutex.Unlock()
+	fake.PutStub = stub
+}
+
+// PutCallsWhen calls the stub for the calls whose arguments match.
+// Matchers are checked before the stub, and the matcher registered last wins.
+func (fake *FakeStore) PutCallsWhen(matcher func(context.Context, ...extract.Item) bool, stub func(context.Context, ...extract.Item) error) {
+	fake.putMutex.Lock()
+	defer fake.putMutex.Unlock()
+	fake.putWhen = append(fake.putWhen, struct {
+		matcher func(context.Context, ...extract.Item) bool
+		stub    func(context.Context, ...extract.Item) error
+	}{matcher, stub})
+}
+
+func (fake *FakeStore) PutArgsForCall(i int) (context.Context, []extract.Item) {
+	fake.putMutex.RLock()
+	defer fake.putMutex.RUnlock()
+	argsForCall := fake.putArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStore) PutCallHistory() []FakeStorePutCall {
+	fake.putMutex.RLock()
+	defer fake.putMutex.RUnlock()
+	history := make([]FakeStorePutCall, len(fake.putArgsForCall))
+	for i, argsForCall := range fake.putArgsForCall {
+		history[i] = FakeStorePutCall{argsForCall.arg1, argsForCall.arg2}
+	}
+	return history
+}
+
+func (fake *FakeStore) PutReturns(result1 error) {
+	fake.putMutex.Lock()
+	defer fake.putMutex.Unlock()
+	fake.PutStub = nil
+	fake.putReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) PutReturnsOnCall(i int, result1 error) {
+	fake.putMutex.Lock()
+	defer fake.putMutex.Unlock()
+	fake.PutStub = nil
+	if fake.putReturnsOnCall == nil {
+		fake.putReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.putReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+// PutReturnsForArgs returns the values for the calls with the given
+// arguments. Like PutCallsWhen, it is checked before the stub, and the
+// arguments or matcher registered last win.
+func (fake *FakeStore) PutReturnsForArgs(arg1 context.Context, arg2 ...extract.Item) func(error) {
+	args := []interface{}{arg1, arg2}
+	return func(result1 error) {
+		fake.putMutex.Lock()
+		defer fake.putMutex.Unlock()
+		fake.PutStub = nil
+		fake.putWhen = append(fake.putWhen, struct {
+			matcher func(context.Context, ...extract.Item) bool
+			stub    func(context.Context, ...extract.Item) error
+		}{
+			func(arg1 context.Context, arg2 ...extract.Item) bool {
+				return fake.argsMatch(args, []interface{}{arg1, arg2})
+			},
+			func(context.Context, ...extract.Item) error {
+				return result1
+			},
+		})
+	}
+}
+
+// PutReturnsWhen returns the values for the calls whose arguments
+// match. Like PutCallsWhen, it is checked before the stub, and the
+// matcher registered last wins.
+func (fake *FakeStore) PutReturnsWhen(matcher func(context.Context, ...extract.Item) bool, result1 error) {
+	fake.PutCallsWhen(matcher, func(context.Context, ...extract.Item) error {
+		return result1
+	})
+}
+
+func (fake *FakeStore) ResetPut() {
+	fake.ResetPutCalls()
+	fake.ResetPutStubs()
+}
+
+func (fake *FakeStore) ResetPutCalls() {
+	fake.putMutex.Lock()
+	defer fake.putMutex.Unlock()
+	fake.putArgsForCall = nil
+	fake.forgetInvocations("Put")
+}
+
+func (fake *FakeStore) ResetPutStubs() {
+	fake.putMutex.Lock()
+	defer fake.putMutex.Unlock()
+	fake.PutStub = nil
+	fake.putWhen = nil
+	fake.putReturns = struct {
+		result1 error
+	}{}
+	fake.putReturnsOnCall = nil
+}
+
+func (fake *FakeStore) Reset() {
+	fake.ResetCalls()
+	fake.ResetStubs()
+}
+
+func (fake *FakeStore) ResetCalls() {
+	fake.ResetCloseCalls()
+	fake.ResetGetCalls()
+	fake.ResetLenCalls()
+	fake.ResetPutCalls()
+}
+
+func (fake *FakeStore) ResetStubs() {
+	fake.ResetCloseStubs()
+	fake.ResetGetStubs()
+	fake.ResetLenStubs()
+	fake.ResetPutStubs()
+}
+
+func (fake *FakeStore) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeStore) argsMatch(expected []interface{}, actual []interface{}) bool {
+	if len(expected) != len(actual) {
+		return false
+	}
+	for i := range expected {
+		if fake.ArgsComparer != nil && !fake.ArgsComparer(expected[i], actual[i]) || fake.ArgsComparer == nil && !reflect.DeepEqual(expected[i], actual[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (fake *FakeStore) OrderedInvocations() []struct {
+	Seq    uint64
+	Method string
+	Args   []interface{}
+} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	return append([]struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}{}, fake.orderedInvocations...)
+}
+
+func (fake *FakeStore) SetSequencer(sequencer *atomic.Uint64) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	fake.sequencer = sequencer
+}
+
+func (fake *FakeStore) waitForCalls(ctx context.Context, callCount func() int, n int) error {
+	for {
+		fake.invocationsMutex.Lock()
+		if fake.invocationsChanged == nil {
+			fake.invocationsChanged = make(chan struct{})
+		}
+		changed := fake.invocationsChanged
+		fake.invocationsMutex.Unlock()
+		if callCount() >= n {
+			return nil
+		}
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (fake *FakeStore) callsChan(ctx context.Context, key string) <-chan []interface{} {
+	ctx, cancel := context.WithCancel(ctx)
+	calls := make(chan []interface{})
+	ready := make(chan struct{}, 1)
+	var pendingMutex sync.Mutex
+	var pending [][]interface{}
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.callsSubscribers == nil {
+		fake.callsSubscribers = map[string][]struct {
+			publish func(args []interface{})
+			cancel  context.CancelFunc
+			done    <-chan struct{}
+		}{}
+	}
+	fake.callsSubscribers[key] = append(fake.callsSubscribers[key], struct {
+		publish func(args []interface{})
+		cancel  context.CancelFunc
+		done    <-chan struct{}
+	}{func(args []interface{}) {
+		pendingMutex.Lock()
+		pending = append(pending, args)
+		pendingMutex.Unlock()
+		select {
+		case ready <- struct{}{}:
+		default:
+		}
+	}, cancel, ctx.Done()})
+	go func() {
+		defer close(calls)
+		for {
+			select {
+			case <-ready:
+			case <-ctx.Done():
+				return
+			}
+			pendingMutex.Lock()
+			queued := pending
+			pending = nil
+			pendingMutex.Unlock()
+			for _, args := range queued {
+				select {
+				case calls <- args:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return calls
+}
+
+func (fake *FakeStore) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+	if fake.sequencer == nil {
+		fake.sequencer = new(atomic.Uint64)
+	}
+	fake.orderedInvocations = append(fake.orderedInvocations, struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}{fake.sequencer.Add(1), key, args})
+	if fake.invocationsChanged != nil {
+		close(fake.invocationsChanged)
+		fake.invocationsChanged = nil
+	}
+	subscribers := fake.callsSubscribers[key][:0]
+	for _, subscriber := range fake.callsSubscribers[key] {
+		select {
+		case <-subscriber.done:
+			continue
+		default:
+		}
+		subscriber.publish(args)
+		subscribers = append(subscribers, subscriber)
+	}
+	if len(subscribers) > 0 {
+		fake.callsSubscribers[key] = subscribers
+	} else {
+		delete(fake.callsSubscribers, key)
+	}
+}
+
+func (fake *FakeStore) forgetInvocations(key string) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	delete(fake.invocations, key)
+	for _, subscriber := range fake.callsSubscribers[key] {
+		subscriber.cancel()
+	}
+	delete(fake.callsSubscribers, key)
+	var orderedInvocations []struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}
+	for _, invocation := range fake.orderedInvocations {
+		if invocation.Method != key {
+			orderedInvocations = append(orderedInvocations, invocation)
+		}
+	}
+	fake.orderedInvocations = orderedInvocations
+}
+
+var _ extractshim.Store = new(FakeStore)
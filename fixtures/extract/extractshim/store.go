@@ -0,0 +1,46 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package extractshim
+
+import (
+	"context"
+
+	"github.com/maxbrunsfeld/counterfeiter/v6/fixtures/extract"
+)
+
+// Store is a generated interface representing the exported methods of
+// github.com/maxbrunsfeld/counterfeiter/v6/fixtures/extract.Store.
+type Store interface {
+	Close() error
+	Get(arg1 string) (extract.Item, bool)
+	Len() int
+	Put(arg1 context.Context, arg2 ...extract.Item) error
+}
+
+// StoreAdapter implements Store by forwarding every call to a
+// github.com/maxbrunsfeld/counterfeiter/v6/fixtures/extract.Store.
+type StoreAdapter struct {
+	target *extract.Store
+}
+
+// NewStoreAdapter returns a StoreAdapter that forwards calls to target.
+func NewStoreAdapter(target *extract.Store) *StoreAdapter {
+	return &StoreAdapter{target: target}
+}
+
+func (a *StoreAdapter) Close() error {
+	return a.target.Close()
+}
+
+func (a *StoreAdapter) Get(arg1 string) (extract.Item, bool) {
+	return a.target.Get(arg1)
+}
+
+func (a *StoreAdapter) Len() int {
+	return a.target.Len()
+}
+
+func (a *StoreAdapter) Put(arg1 context.Context, arg2 ...extract.Item) error {
+	return a.target.Put(arg1, arg2...)
+}
+
+var _ Store = new(StoreAdapter)
@@ -0,0 +1,56 @@
+package extract
+
+import (
+	"context"
+	"io"
+)
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -generate
+
+//counterfeiter:generate -extract . Store
+
+// Item is stored in a Store.
+type Item struct {
+	Key   string
+	Value []byte
+}
+
+// Store is a concrete type without an interface.
+type Store struct {
+	io.Closer
+	items map[string]Item
+}
+
+func NewStore(closer io.Closer) *Store {
+	return &Store{Closer: closer, items: map[string]Item{}}
+}
+
+func (s *Store) Put(ctx context.Context, items ...Item) error {
+	for _, item := range items {
+		s.items[item.Key] = item
+	}
+	return ctx.Err()
+}
+
+func (s *Store) Get(key string) (Item, bool) {
+	item, ok := s.items[key]
+	return item, ok
+}
+
+func (s Store) Len() int {
+	return len(s.items)
+}
+
+func (s *Store) reset() {
+	s.items = map[string]Item{}
+}
+
+// Cursor has a method that uses an unexported type, so no interface can be
+// extracted from it.
+type Cursor struct{}
+
+type position int
+
+func (c *Cursor) Position() position {
+	return 0
+}
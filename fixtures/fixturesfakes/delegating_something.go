@@ -0,0 +1,687 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package fixturesfakes
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"sync/atomic"
+
+	"github.com/maxbrunsfeld/counterfeiter/v6/fixtures"
+)
+
+type DelegatingSomething struct {
+	DoASliceStub        func([]byte)
+	doASliceMutex       sync.RWMutex
+	doASliceArgsForCall []struct {
+		arg1 []byte
+	}
+	doASliceWhen []struct {
+		matcher func([]byte) bool
+		stub    func([]byte)
+	}
+	DoAnArrayStub        func([4]byte)
+	doAnArrayMutex       sync.RWMutex
+	doAnArrayArgsForCall []struct {
+		arg1 [4]byte
+	}
+	doAnArrayWhen []struct {
+		matcher func([4]byte) bool
+		stub    func([4]byte)
+	}
+	DoNothingStub        func()
+	doNothingMutex       sync.RWMutex
+	doNothingArgsForCall []struct {
+	}
+	DoThingsStub        func(string, uint64) (int, error)
+	doThingsMutex       sync.RWMutex
+	doThingsArgsForCall []struct {
+		arg1 string
+		arg2 uint64
+	}
+	doThingsWhen []struct {
+		matcher func(string, uint64) bool
+		stub    func(string, uint64) (int, error)
+	}
+	doThingsReturns struct {
+		result1 int
+		result2 error
+	}
+	doThingsReturnsOnCall map[int]struct {
+		result1 int
+		result2 error
+	}
+	doThingsReturnsConfigured bool
+	Delegate                  fixtures.Something
+	ArgsComparer              func(expected interface{}, actual interface{}) bool
+	invocations               map[string][][]interface{}
+	orderedInvocations        []struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}
+	sequencer          *atomic.Uint64
+	invocationsChanged chan struct{}
+	callsSubscribers   map[string][]struct {
+		publish func(args []interface{})
+		cancel  context.CancelFunc
+		done    <-chan struct{}
+	}
+	invocationsMutex sync.RWMutex
+}
+
+type DelegatingSomethingDoASliceCall struct {
+	Arg1 []byte
+}
+
+func (fake *DelegatingSomething) DoASlice(arg1 []byte) {
+	var arg1Copy []byte
+	if arg1 != nil {
+		arg1Copy = make([]byte, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.doASliceMutex.Lock()
+	fake.doASliceArgsForCall = append(fake.doASliceArgsForCall, struct {
+		arg1 []byte
+	}{arg1Copy})
+	stub := fake.DoASliceStub
+	whens := fake.doASliceWhen
+	delegate := fake.Delegate
+	fake.recordInvocation("DoASlice", []interface{}{arg1Copy})
+	fake.doASliceMutex.Unlock()
+	for i := len(whens) - 1; i >= 0; i-- {
+		if whens[i].matcher(arg1) {
+			whens[i].stub(arg1)
+			return
+		}
+	}
+	if stub != nil {
+		fake.DoASliceStub(arg1)
+		return
+	}
+	if delegate != nil {
+		delegate.DoASlice(arg1)
+	}
+}
+
+func (fake *DelegatingSomething) DoASliceCallCount() int {
+	fake.doASliceMutex.RLock()
+	defer fake.doASliceMutex.RUnlock()
+	return len(fake.doASliceArgsForCall)
+}
+
+func (fake *DelegatingSomething) WaitForDoASliceCalls(ctx context.Context, n int) error {
+	return fake.waitForCalls(ctx, fake.DoASliceCallCount, n)
+}
+
+func (fake *DelegatingSomething) DoASliceCallsChan(ctx context.Context) <-chan []interface{} {
+	return fake.callsChan(ctx, "DoASlice")
+}
+
+func (fake *DelegatingSomething) DoASliceCalls(stub func([]byte)) {
+	fake.doASliceMutex.Lock()
+	defer fake.doASliceMutex.Unlock()
+	fake.DoASliceStub = stub
+}
+
+// DoASliceCallsWhen calls the stub for the calls whose arguments match.
+// Matchers are checked before the stub, and the matcher registered last wins.
+func (fake *DelegatingSomething) DoASliceCallsWhen(matcher func([]byte) bool, stub func([]byte)) {
+	fake.doASliceMutex.Lock()
+	defer fake.doASliceMutex.Unlock()
+	fake.doASliceWhen = append(fake.doASliceWhen, struct {
+		matcher func([]byte) bool
+		stub    func([]byte)
+	}{matcher, stub})
+}
+
+func (fake *DelegatingSomething) DoASliceArgsForCall(i int) []byte {
+	fake.doASliceMutex.RLock()
+	defer fake.doASliceMutex.RUnlock()
+	argsForCall := fake.doASliceArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *DelegatingSomething) DoASliceCallHistory() []DelegatingSomethingDoASliceCall {
+	fake.doASliceMutex.RLock()
+	defer fake.doASliceMutex.RUnlock()
+	history := make([]DelegatingSomethingDoASliceCall, len(fake.doASliceArgsForCall))
+	for i, argsForCall := range fake.doASliceArgsForCall {
+		history[i] = DelegatingSomethingDoASliceCall{argsForCall.arg1}
+	}
+	return history
+}
+
+func (fake *DelegatingSomething) ResetDoASlice() {
+	fake.ResetDoASliceCalls()
+	fake.ResetDoASliceStubs()
+}
+
+func (fake *DelegatingSomething) ResetDoASliceCalls() {
+	fake.doASliceMutex.Lock()
+	defer fake.doASliceMutex.Unlock()
+	fake.doASliceArgsForCall = nil
+	fake.forgetInvocations("DoASlice")
+}
+
+func (fake *DelegatingSomething) ResetDoASliceStubs() {
+	fake.doASliceMutex.Lock()
+	defer fake.doASliceMutex.Unlock()
+	fake.DoASliceStub = nil
+	fake.doASliceWhen = nil
+}
+
+type DelegatingSomethingDoAnArrayCall struct {
+	Arg1 [4]byte
+}
+
+func (fake *DelegatingSomething) DoAnArray(arg1 [4]byte) {
+	fake.doAnArrayMutex.Lock()
+	fake.doAnArrayArgsForCall = append(fake.doAnArrayArgsForCall, struct {
+		arg1 [4]byte
+	}{arg1})
+	stub := fake.DoAnArrayStub
+	whens := fake.doAnArrayWhen
+	delegate := fake.Delegate
+	fake.recordInvocation("DoAnArray", []interface{}{arg1})
+	fake.doAnArrayMutex.Unlock()
+	for i := len(whens) - 1; i >= 0; i-- {
+		if whens[i].matcher(arg1) {
+			whens[i].stub(arg1)
+			return
+		}
+	}
+	if stub != nil {
+		fake.DoAnArrayStub(arg1)
+		return
+	}
+	if delegate != nil {
+		delegate.DoAnArray(arg1)
+	}
+}
+
+func (fake *DelegatingSomething) DoAnArrayCallCount() int {
+	fake.doAnArrayMutex.RLock()
+	defer fake.doAnArrayMutex.RUnlock()
+	return len(fake.doAnArrayArgsForCall)
+}
+
+func (fake *DelegatingSomething) WaitForDoAnArrayCalls(ctx context.Context, n int) error {
+	return fake.waitForCalls(ctx, fake.DoAnArrayCallCount, n)
+}
+
+func (fake *DelegatingSomething) DoAnArrayCallsChan(ctx context.Context) <-chan []interface{} {
+	return fake.callsChan(ctx, "DoAnArray")
+}
+
+func (fake *DelegatingSomething) DoAnArrayCalls(stub func([4]byte)) {
+	fake.doAnArrayMutex.Lock()
+	defer fake.doAnArrayMutex.Unlock()
+	fake.DoAnArrayStub = stub
+}
+
+// DoAnArrayCallsWhen calls the stub for the calls whose arguments match.
+// Matchers are checked before the stub, and the matcher registered last wins.
+func (fake *DelegatingSomething) DoAnArrayCallsWhen(matcher func([4]byte) bool, stub func([4]byte)) {
+	fake.doAnArrayMutex.Lock()
+	defer fake.doAnArrayMutex.Unlock()
+	fake.doAnArrayWhen = append(fake.doAnArrayWhen, struct {
+		matcher func([4]byte) bool
+		stub    func([4]byte)
+	}{matcher, stub})
+}
+
+func (fake *DelegatingSomething) DoAnArrayArgsForCall(i int) [4]byte {
+	fake.doAnArrayMutex.RLock()
+	defer fake.doAnArrayMutex.RUnlock()
+	argsForCall := fake.doAnArrayArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *DelegatingSomething) DoAnArrayCallHistory() []DelegatingSomethingDoAnArrayCall {
+	fake.doAnArrayMutex.RLock()
+	defer fake.doAnArrayMutex.RUnlock()
+	history := make([]DelegatingSomethingDoAnArrayCall, len(fake.doAnArrayArgsForCall))
+	for i, argsForCall := range fake.doAnArrayArgsForCall {
+		history[i] = DelegatingSomethingDoAnArrayCall{argsForCall.arg1}
+	}
+	return history
+}
+
+func (fake *DelegatingSomething) ResetDoAnArray() {
+	fake.ResetDoAnArrayCalls()
+	fake.ResetDoAnArrayStubs()
+}
+
+func (fake *DelegatingSomething) ResetDoAnArrayCalls() {
+	fake.doAnArrayMutex.Lock()
+	defer fake.doAnArrayMutex.Unlock()
+	fake.doAnArrayArgsForCall = nil
+	fake.forgetInvocations("DoAnArray")
+}
+
+func (fake *DelegatingSomething) ResetDoAnArrayStubs() {
+	fake.doAnArrayMutex.Lock()
+	defer fake.doAnArrayMutex.Unlock()
+	fake.DoAnArrayStub = nil
+	fake.doAnArrayWhen = nil
+}
+
+func (fake *DelegatingSomething) DoNothing() {
+	fake.doNothingMutex.Lock()
+	fake.doNothingArgsForCall = append(fake.doNothingArgsForCall, struct {
+	}{})
+	stub := fake.DoNothingStub
+	delegate := fake.Delegate
+	fake.recordInvocation("DoNothing", []interface{}{})
+	fake.doNothingMutex.Unlock()
+	if stub != nil {
+		fake.DoNothingStub()
+		return
+	}
+	if delegate != nil {
+		delegate.DoNothing()
+	}
+}
+
+func (fake *DelegatingSomething) DoNothingCallCount() int {
+	fake.doNothingMutex.RLock()
+	defer fake.doNothingMutex.RUnlock()
+	return len(fake.doNothingArgsForCall)
+}
+
+func (fake *DelegatingSomething) WaitForDoNothingCalls(ctx context.Context, n int) error {
+	return fake.waitForCalls(ctx, fake.DoNothingCallCount, n)
+}
+
+func (fake *DelegatingSomething) DoNothingCallsChan(ctx context.Context) <-chan []interface{} {
+	return fake.callsChan(ctx, "DoNothing")
+}
+
+func (fake *DelegatingSomething) DoNothingCalls(stub func()) {
+	fake.doNothingMutex.Lock()
+	defer fake.doNothingMutex.Unlock()
+	fake.DoNothingStub = stub
+}
+
+func (fake *DelegatingSomething) ResetDoNothing() {
+	fake.ResetDoNothingCalls()
+	fake.ResetDoNothingStubs()
+}
+
+func (fake *DelegatingSomething) ResetDoNothingCalls() {
+	fake.doNothingMutex.Lock()
+	defer fake.doNothingMutex.Unlock()
+	fake.doNothingArgsForCall = nil
+	fake.forgetInvocations("DoNothing")
+}
+
+func (fake *DelegatingSomething) ResetDoNothingStubs() {
+	fake.doNothingMutex.Lock()
+	defer fake.doNothingMutex.Unlock()
+	fake.DoNothingStub = nil
+}
+
+type DelegatingSomethingDoThingsCall struct {
+	Arg1 string
+	Arg2 uint64
+}
+
+func (fake *DelegatingSomething) DoThings(arg1 string, arg2 uint64) (int, error) {
+	fake.doThingsMutex.Lock()
+	ret, specificReturn := fake.doThingsReturnsOnCall[len(fake.doThingsArgsForCall)]
+	fake.doThingsArgsForCall = append(fake.doThingsArgsForCall, struct {
+		arg1 string
+		arg2 uint64
+	}{arg1, arg2})
+	stub := fake.DoThingsStub
+	whens := fake.doThingsWhen
+	delegate := fake.Delegate
+	fakeReturns := fake.doThingsReturns
+	returnsConfigured := fake.doThingsReturnsConfigured
+	fake.recordInvocation("DoThings", []interface{}{arg1, arg2})
+	fake.doThingsMutex.Unlock()
+	for i := len(whens) - 1; i >= 0; i-- {
+		if whens[i].matcher(arg1, arg2) {
+			return whens[i].stub(arg1, arg2)
+		}
+	}
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	if !returnsConfigured {
+		if delegate != nil {
+			return delegate.DoThings(arg1, arg2)
+		}
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *DelegatingSomething) DoThingsCallCount() int {
+	fake.doThingsMutex.RLock()
+	defer fake.doThingsMutex.RUnlock()
+	return len(fake.doThingsArgsForCall)
+}
+
+func (fake *DelegatingSomething) WaitForDoThingsCalls(ctx context.Context, n int) error {
+	return fake.waitForCalls(ctx, fake.DoThingsCallCount, n)
+}
+
+func (fake *DelegatingSomething) DoThingsCallsChan(ctx context.Context) <-chan []interface{} {
+	return fake.callsChan(ctx, "DoThings")
+}
+
+func (fake *DelegatingSomething) DoThingsCalls(stub func(string, uint64) (int, error)) {
+	fake.doThingsMutex.Lock()
+	defer fake.doThingsMutex.Unlock()
+	fake.DoThingsStub = stub
+}
+
+// DoThingsCallsWhen calls the stub for the calls whose arguments match.
+// Matchers are checked before the stub, and the matcher registered last wins.
+func (fake *DelegatingSomething) DoThingsCallsWhen(matcher func(string, uint64) bool, stub func(string, uint64) (int, error)) {
+	fake.doThingsMutex.Lock()
+	defer fake.doThingsMutex.Unlock()
+	fake.doThingsWhen = append(fake.doThingsWhen, struct {
+		matcher func(string, uint64) bool
+		stub    func(string, uint64) (int, error)
+	}{matcher, stub})
+}
+
+func (fake *DelegatingSomething) DoThingsArgsForCall(i int) (string, uint64) {
+	fake.doThingsMutex.RLock()
+	defer fake.doThingsMutex.RUnlock()
+	argsForCall := fake.doThingsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *DelegatingSomething) DoThingsCallHistory() []DelegatingSomethingDoThingsCall {
+	fake.doThingsMutex.RLock()
+	defer fake.doThingsMutex.RUnlock()
+	history := make([]DelegatingSomethingDoThingsCall, len(fake.doThingsArgsForCall))
+	for i, argsForCall := range fake.doThingsArgsForCall {
+		history[i] = DelegatingSomethingDoThingsCall{argsForCall.arg1, argsForCall.arg2}
+	}
+	return history
+}
+
+func (fake *DelegatingSomething) DoThingsReturns(result1 int, result2 error) {
+	fake.doThingsMutex.Lock()
+	defer fake.doThingsMutex.Unlock()
+	fake.DoThingsStub = nil
+	fake.doThingsReturns = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+	fake.doThingsReturnsConfigured = true
+}
+
+func (fake *DelegatingSomething) DoThingsReturnsOnCall(i int, result1 int, result2 error) {
+	fake.doThingsMutex.Lock()
+	defer fake.doThingsMutex.Unlock()
+	fake.DoThingsStub = nil
+	if fake.doThingsReturnsOnCall == nil {
+		fake.doThingsReturnsOnCall = make(map[int]struct {
+			result1 int
+			result2 error
+		})
+	}
+	fake.doThingsReturnsOnCall[i] = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+// DoThingsReturnsForArgs returns the values for the calls with the given
+// arguments. Like DoThingsCallsWhen, it is checked before the stub, and the
+// arguments or matcher registered last win.
+func (fake *DelegatingSomething) DoThingsReturnsForArgs(arg1 string, arg2 uint64) func(int, error) {
+	args := []interface{}{arg1, arg2}
+	return func(result1 int, result2 error) {
+		fake.doThingsMutex.Lock()
+		defer fake.doThingsMutex.Unlock()
+		fake.DoThingsStub = nil
+		fake.doThingsWhen = append(fake.doThingsWhen, struct {
+			matcher func(string, uint64) bool
+			stub    func(string, uint64) (int, error)
+		}{
+			func(arg1 string, arg2 uint64) bool {
+				return fake.argsMatch(args, []interface{}{arg1, arg2})
+			},
+			func(string, uint64) (int, error) {
+				return result1, result2
+			},
+		})
+	}
+}
+
+// DoThingsReturnsWhen returns the values for the calls whose arguments
+// match. Like DoThingsCallsWhen, it is checked before the stub, and the
+// matcher registered last wins.
+func (fake *DelegatingSomething) DoThingsReturnsWhen(matcher func(string, uint64) bool, result1 int, result2 error) {
+	fake.DoThingsCallsWhen(matcher, func(string, uint64) (int, error) {
+		return result1, result2
+	})
+}
+
+func (fake *DelegatingSomething) ResetDoThings() {
+	fake.ResetDoThingsCalls()
+	fake.ResetDoThingsStubs()
+}
+
+func (fake *DelegatingSomething) ResetDoThingsCalls() {
+	fake.doThingsMutex.Lock()
+	defer fake.doThingsMutex.Unlock()
+	fake.doThingsArgsForCall = nil
+	fake.forgetInvocations("DoThings")
+}
+
+func (fake *DelegatingSomething) ResetDoThingsStubs() {
+	fake.doThingsMutex.Lock()
+	defer fake.doThingsMutex.Unlock()
+	fake.DoThingsStub = nil
+	fake.doThingsWhen = nil
+	fake.doThingsReturns = struct {
+		result1 int
+		result2 error
+	}{}
+	fake.doThingsReturnsOnCall = nil
+	fake.doThingsReturnsConfigured = false
+}
+
+func (fake *DelegatingSomething) Reset() {
+	fake.ResetCalls()
+	fake.ResetStubs()
+}
+
+func (fake *DelegatingSomething) ResetCalls() {
+	fake.ResetDoASliceCalls()
+	fake.ResetDoAnArrayCalls()
+	fake.ResetDoNothingCalls()
+	fake.ResetDoThingsCalls()
+}
+
+func (fake *DelegatingSomething) ResetStubs() {
+	fake.ResetDoASliceStubs()
+	fake.ResetDoAnArrayStubs()
+	fake.ResetDoNothingStubs()
+	fake.ResetDoThingsStubs()
+}
+
+func (fake *DelegatingSomething) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *DelegatingSomething) argsMatch(expected []interface{}, actual []interface{}) bool {
+	if len(expected) != len(actual) {
+		return false
+	}
+	for i := range expected {
+		if fake.ArgsComparer != nil && !fake.ArgsComparer(expected[i], actual[i]) || fake.ArgsComparer == nil && !reflect.DeepEqual(expected[i], actual[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (fake *DelegatingSomething) OrderedInvocations() []struct {
+	Seq    uint64
+	Method string
+	Args   []interface{}
+} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	return append([]struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}{}, fake.orderedInvocations...)
+}
+
+func (fake *DelegatingSomething) SetSequencer(sequencer *atomic.Uint64) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	fake.sequencer = sequencer
+}
+
+func (fake *DelegatingSomething) waitForCalls(ctx context.Context, callCount func() int, n int) error {
+	for {
+		fake.invocationsMutex.Lock()
+		if fake.invocationsChanged == nil {
+			fake.invocationsChanged = make(chan struct{})
+		}
+		changed := fake.invocationsChanged
+		fake.invocationsMutex.Unlock()
+		if callCount() >= n {
+			return nil
+		}
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (fake *DelegatingSomething) callsChan(ctx context.Context, key string) <-chan []interface{} {
+	ctx, cancel := context.WithCancel(ctx)
+	calls := make(chan []interface{})
+	ready := make(chan struct{}, 1)
+	var pendingMutex sync.Mutex
+	var pending [][]interface{}
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.callsSubscribers == nil {
+		fake.callsSubscribers = map[string][]struct {
+			publish func(args []interface{})
+			cancel  context.CancelFunc
+			done    <-chan struct{}
+		}{}
+	}
+	fake.callsSubscribers[key] = append(fake.callsSubscribers[key], struct {
+		publish func(args []interface{})
+		cancel  context.CancelFunc
+		done    <-chan struct{}
+	}{func(args []interface{}) {
+		pendingMutex.Lock()
+		pending = append(pending, args)
+		pendingMutex.Unlock()
+		select {
+		case ready <- struct{}{}:
+		default:
+		}
+	}, cancel, ctx.Done()})
+	go func() {
+		defer close(calls)
+		for {
+			select {
+			case <-ready:
+			case <-ctx.Done():
+				return
+			}
+			pendingMutex.Lock()
+			queued := pending
+			pending = nil
+			pendingMutex.Unlock()
+			for _, args := range queued {
+				select {
+				case calls <- args:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return calls
+}
+
+func (fake *DelegatingSomething) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+	if fake.sequencer == nil {
+		fake.sequencer = new(atomic.Uint64)
+	}
+	fake.orderedInvocations = append(fake.orderedInvocations, struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}{fake.sequencer.Add(1), key, args})
+	if fake.invocationsChanged != nil {
+		close(fake.invocationsChanged)
+		fake.invocationsChanged = nil
+	}
+	subscribers := fake.callsSubscribers[key][:0]
+	for _, subscriber := range fake.callsSubscribers[key] {
+		select {
+		case <-subscriber.done:
+			continue
+		default:
+		}
+		subscriber.publish(args)
+		subscribers = append(subscribers, subscriber)
+	}
+	if len(subscribers) > 0 {
+		fake.callsSubscribers[key] = subscribers
+	} else {
+		delete(fake.callsSubscribers, key)
+	}
+}
+
+func (fake *DelegatingSomething) forgetInvocations(key string) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	delete(fake.invocations, key)
+	for _, subscriber := range fake.callsSubscribers[key] {
+		subscriber.cancel()
+	}
+	delete(fake.callsSubscribers, key)
+	var orderedInvocations []struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}
+	for _, invocation := range fake.orderedInvocations {
+		if invocation.Method != key {
+			orderedInvocations = append(orderedInvocations, invocation)
+		}
+	}
+	fake.orderedInvocations = orderedInvocations
+}
+
+var _ fixtures.Something = new(DelegatingSomething)
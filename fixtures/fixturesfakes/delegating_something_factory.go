@@ -0,0 +1,329 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package fixturesfakes
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"sync/atomic"
+
+	"github.com/maxbrunsfeld/counterfeiter/v6/fixtures"
+)
+
+type DelegatingSomethingFactory struct {
+	Stub        func(string, map[string]interface{}) string
+	mutex       sync.RWMutex
+	argsForCall []struct {
+		arg1 string
+		arg2 map[string]interface{}
+	}
+	returns struct {
+		result1 string
+	}
+	returnsOnCall map[int]struct {
+		result1 string
+	}
+	returnsForArgs []struct {
+		args    []interface{}
+		result1 string
+	}
+	returnsConfigured  bool
+	Delegate           fixtures.SomethingFactory
+	ArgsComparer       func(expected interface{}, actual interface{}) bool
+	invocations        map[string][][]interface{}
+	orderedInvocations []struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}
+	sequencer          *atomic.Uint64
+	invocationsChanged chan struct{}
+	callsSubscribers   []struct {
+		publish func(args []interface{})
+		cancel  context.CancelFunc
+		done    <-chan struct{}
+	}
+	invocationsMutex sync.RWMutex
+}
+
+type DelegatingSomethingFactoryCall struct {
+	Arg1 string
+	Arg2 map[string]interface{}
+}
+
+func (fake *DelegatingSomethingFactory) Spy(arg1 string, arg2 map[string]interface{}) string {
+	fake.mutex.Lock()
+	ret, specificReturn := fake.returnsOnCall[len(fake.argsForCall)]
+	fake.argsForCall = append(fake.argsForCall, struct {
+		arg1 string
+		arg2 map[string]interface{}
+	}{arg1, arg2})
+	stub := fake.Stub
+	delegate := fake.Delegate
+	returnsForArgs := fake.returnsForArgs
+	returns := fake.returns
+	returnsConfigured := fake.returnsConfigured
+	fake.recordInvocation("SomethingFactory", []interface{}{arg1, arg2})
+	fake.mutex.Unlock()
+	for i := len(returnsForArgs) - 1; i >= 0; i-- {
+		if fake.argsMatch(returnsForArgs[i].args, []interface{}{arg1, arg2}) {
+			return returnsForArgs[i].result1
+		}
+	}
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	if !returnsConfigured {
+		if delegate != nil {
+			return delegate(arg1, arg2)
+		}
+	}
+	return returns.result1
+}
+
+func (fake *DelegatingSomethingFactory) CallCount() int {
+	fake.mutex.RLock()
+	defer fake.mutex.RUnlock()
+	return len(fake.argsForCall)
+}
+
+func (fake *DelegatingSomethingFactory) WaitForCalls(ctx context.Context, n int) error {
+	for {
+		fake.invocationsMutex.Lock()
+		if fake.invocationsChanged == nil {
+			fake.invocationsChanged = make(chan struct{})
+		}
+		changed := fake.invocationsChanged
+		fake.invocationsMutex.Unlock()
+		if fake.CallCount() >= n {
+			return nil
+		}
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (fake *DelegatingSomethingFactory) CallsChan(ctx context.Context) <-chan []interface{} {
+	ctx, cancel := context.WithCancel(ctx)
+	calls := make(chan []interface{})
+	ready := make(chan struct{}, 1)
+	var pendingMutex sync.Mutex
+	var pending [][]interface{}
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	fake.callsSubscribers = append(fake.callsSubscribers, struct {
+		publish func(args []interface{})
+		cancel  context.CancelFunc
+		done    <-chan struct{}
+	}{func(args []interface{}) {
+		pendingMutex.Lock()
+		pending = append(pending, args)
+		pendingMutex.Unlock()
+		select {
+		case ready <- struct{}{}:
+		default:
+		}
+	}, cancel, ctx.Done()})
+	go func() {
+		defer close(calls)
+		for {
+			select {
+			case <-ready:
+			case <-ctx.Done():
+				return
+			}
+			pendingMutex.Lock()
+			queued := pending
+			pending = nil
+			pendingMutex.Unlock()
+			for _, args := range queued {
+				select {
+				case calls <- args:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return calls
+}
+
+func (fake *DelegatingSomethingFactory) Calls(stub func(string, map[string]interface{}) string) {
+	fake.mutex.Lock()
+	defer fake.mutex.Unlock()
+	fake.Stub = stub
+}
+
+func (fake *DelegatingSomethingFactory) ArgsForCall(i int) (string, map[string]interface{}) {
+	fake.mutex.RLock()
+	defer fake.mutex.RUnlock()
+	return fake.argsForCall[i].arg1, fake.argsForCall[i].arg2
+}
+
+func (fake *DelegatingSomethingFactory) CallHistory() []DelegatingSomethingFactoryCall {
+	fake.mutex.RLock()
+	defer fake.mutex.RUnlock()
+	history := make([]DelegatingSomethingFactoryCall, len(fake.argsForCall))
+	for i, argsForCall := range fake.argsForCall {
+		history[i] = DelegatingSomethingFactoryCall{argsForCall.arg1, argsForCall.arg2}
+	}
+	return history
+}
+
+func (fake *DelegatingSomethingFactory) Returns(result1 string) {
+	fake.mutex.Lock()
+	defer fake.mutex.Unlock()
+	fake.Stub = nil
+	fake.returns = struct {
+		result1 string
+	}{result1}
+	fake.returnsConfigured = true
+}
+
+func (fake *DelegatingSomethingFactory) ReturnsOnCall(i int, result1 string) {
+	fake.mutex.Lock()
+	defer fake.mutex.Unlock()
+	fake.Stub = nil
+	if fake.returnsOnCall == nil {
+		fake.returnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.returnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+// ReturnsForArgs returns the values for the calls with the given arguments.
+// The arguments are checked before the stub, and the arguments registered last
+// win.
+func (fake *DelegatingSomethingFactory) ReturnsForArgs(arg1 string, arg2 map[string]interface{}) func(string) {
+	args := []interface{}{arg1, arg2}
+	return func(result1 string) {
+		fake.mutex.Lock()
+		defer fake.mutex.Unlock()
+		fake.Stub = nil
+		fake.returnsForArgs = append(fake.returnsForArgs, struct {
+			args    []interface{}
+			result1 string
+		}{args, result1})
+	}
+}
+
+func (fake *DelegatingSomethingFactory) Reset() {
+	fake.ResetCalls()
+	fake.ResetStubs()
+}
+
+func (fake *DelegatingSomethingFactory) ResetCalls() {
+	fake.mutex.Lock()
+	fake.argsForCall = nil
+	fake.mutex.Unlock()
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	fake.invocations = nil
+	fake.orderedInvocations = nil
+	for _, subscriber := range fake.callsSubscribers {
+		subscriber.cancel()
+	}
+	fake.callsSubscribers = nil
+}
+
+func (fake *DelegatingSomethingFactory) ResetStubs() {
+	fake.mutex.Lock()
+	defer fake.mutex.Unlock()
+	fake.Stub = nil
+	fake.returns = struct {
+		result1 string
+	}{}
+	fake.returnsOnCall = nil
+	fake.returnsForArgs = nil
+	fake.returnsConfigured = false
+}
+
+func (fake *DelegatingSomethingFactory) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.mutex.RLock()
+	defer fake.mutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *DelegatingSomethingFactory) OrderedInvocations() []struct {
+	Seq    uint64
+	Method string
+	Args   []interface{}
+} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	return append([]struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}{}, fake.orderedInvocations...)
+}
+
+func (fake *DelegatingSomethingFactory) SetSequencer(sequencer *atomic.Uint64) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	fake.sequencer = sequencer
+}
+
+func (fake *DelegatingSomethingFactory) argsMatch(expected []interface{}, actual []interface{}) bool {
+	if len(expected) != len(actual) {
+		return false
+	}
+	for i := range expected {
+		if fake.ArgsComparer != nil && !fake.ArgsComparer(expected[i], actual[i]) || fake.ArgsComparer == nil && !reflect.DeepEqual(expected[i], actual[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (fake *DelegatingSomethingFactory) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+	if fake.sequencer == nil {
+		fake.sequencer = new(atomic.Uint64)
+	}
+	fake.orderedInvocations = append(fake.orderedInvocations, struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}{fake.sequencer.Add(1), key, args})
+	if fake.invocationsChanged != nil {
+		close(fake.invocationsChanged)
+		fake.invocationsChanged = nil
+	}
+	subscribers := fake.callsSubscribers[:0]
+	for _, subscriber := range fake.callsSubscribers {
+		select {
+		case <-subscriber.done:
+			continue
+		default:
+		}
+		subscriber.publish(args)
+		subscribers = append(subscribers, subscriber)
+	}
+	fake.callsSubscribers = subscribers
+}
+
+var _ fixtures.SomethingFactory = new(DelegatingSomethingFactory).Spy
@@ -0,0 +1,1156 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package fixturesfakes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/maxbrunsfeld/counterfeiter/v6/fixtures"
+)
+
+type ExpectingSomething struct {
+	DoASliceStub        func([]byte)
+	doASliceMutex       sync.RWMutex
+	doASliceArgsForCall []struct {
+		arg1 []byte
+	}
+	doASliceWhen []struct {
+		matcher func([]byte) bool
+		stub    func([]byte)
+	}
+	doASliceExpectations []*ExpectingSomethingDoASliceExpectation
+	DoAnArrayStub        func([4]byte)
+	doAnArrayMutex       sync.RWMutex
+	doAnArrayArgsForCall []struct {
+		arg1 [4]byte
+	}
+	doAnArrayWhen []struct {
+		matcher func([4]byte) bool
+		stub    func([4]byte)
+	}
+	doAnArrayExpectations []*ExpectingSomethingDoAnArrayExpectation
+	DoNothingStub         func()
+	doNothingMutex        sync.RWMutex
+	doNothingArgsForCall  []struct {
+	}
+	doNothingExpectations []*ExpectingSomethingDoNothingExpectation
+	DoThingsStub          func(string, uint64) (int, error)
+	doThingsMutex         sync.RWMutex
+	doThingsArgsForCall   []struct {
+		arg1 string
+		arg2 uint64
+	}
+	doThingsWhen []struct {
+		matcher func(string, uint64) bool
+		stub    func(string, uint64) (int, error)
+	}
+	doThingsReturns struct {
+		result1 int
+		result2 error
+	}
+	doThingsReturnsOnCall map[int]struct {
+		result1 int
+		result2 error
+	}
+	doThingsExpectations []*ExpectingSomethingDoThingsExpectation
+	ArgsComparer         func(expected interface{}, actual interface{}) bool
+	invocations          map[string][][]interface{}
+	orderedInvocations   []struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}
+	sequencer          *atomic.Uint64
+	invocationsChanged chan struct{}
+	callsSubscribers   map[string][]struct {
+		publish func(args []interface{})
+		cancel  context.CancelFunc
+		done    <-chan struct{}
+	}
+	invocationsMutex    sync.RWMutex
+	expectations        []*ExpectingSomethingExpectation
+	expectationsInOrder bool
+	unexpectedCalls     []struct {
+		method string
+		args   []interface{}
+	}
+	expectationsMutex sync.Mutex
+}
+
+// NewExpectingSomething returns a fake that is verified when the test completes.
+func NewExpectingSomething(t testing.TB) *ExpectingSomething {
+	fake := &ExpectingSomething{}
+	t.Cleanup(func() {
+		fake.verify(t)
+	})
+	return fake
+}
+
+type ExpectingSomethingExpectation struct {
+	method      string
+	description string
+	min         int
+	max         int
+	calls       int
+}
+
+type ExpectingSomethingDoASliceCall struct {
+	Arg1 []byte
+}
+
+type ExpectingSomethingDoASliceExpectation struct {
+	*ExpectingSomethingExpectation
+	fake    *ExpectingSomething
+	args    []interface{}
+	matcher func([]byte) bool
+}
+
+func (expectation *ExpectingSomethingDoASliceExpectation) Times(n int) *ExpectingSomethingDoASliceExpectation {
+	expectation.fake.setExpectedCalls(expectation.ExpectingSomethingExpectation, n, n)
+	return expectation
+}
+
+func (expectation *ExpectingSomethingDoASliceExpectation) MinTimes(n int) *ExpectingSomethingDoASliceExpectation {
+	expectation.fake.setExpectedCalls(expectation.ExpectingSomethingExpectation, n, -1)
+	return expectation
+}
+
+func (expectation *ExpectingSomethingDoASliceExpectation) MaxTimes(n int) *ExpectingSomethingDoASliceExpectation {
+	expectation.fake.setExpectedCalls(expectation.ExpectingSomethingExpectation, 0, n)
+	return expectation
+}
+
+func (expectation *ExpectingSomethingDoASliceExpectation) TimesBetween(min int, max int) *ExpectingSomethingDoASliceExpectation {
+	expectation.fake.setExpectedCalls(expectation.ExpectingSomethingExpectation, min, max)
+	return expectation
+}
+
+func (expectation *ExpectingSomethingDoASliceExpectation) AnyTimes() *ExpectingSomethingDoASliceExpectation {
+	expectation.fake.setExpectedCalls(expectation.ExpectingSomethingExpectation, 0, -1)
+	return expectation
+}
+
+func (fake *ExpectingSomething) DoASlice(arg1 []byte) {
+	var arg1Copy []byte
+	if arg1 != nil {
+		arg1Copy = make([]byte, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.doASliceMutex.Lock()
+	fake.doASliceArgsForCall = append(fake.doASliceArgsForCall, struct {
+		arg1 []byte
+	}{arg1Copy})
+	stub := fake.DoASliceStub
+	whens := fake.doASliceWhen
+	fake.recordInvocation("DoASlice", []interface{}{arg1Copy})
+	fake.doASliceMutex.Unlock()
+	fake.matchDoASliceExpectation(arg1)
+	for i := len(whens) - 1; i >= 0; i-- {
+		if whens[i].matcher(arg1) {
+			whens[i].stub(arg1)
+			return
+		}
+	}
+	if stub != nil {
+		fake.DoASliceStub(arg1)
+	}
+}
+
+func (fake *ExpectingSomething) DoASliceCallCount() int {
+	fake.doASliceMutex.RLock()
+	defer fake.doASliceMutex.RUnlock()
+	return len(fake.doASliceArgsForCall)
+}
+
+func (fake *ExpectingSomething) WaitForDoASliceCalls(ctx context.Context, n int) error {
+	return fake.waitForCalls(ctx, fake.DoASliceCallCount, n)
+}
+
+func (fake *ExpectingSomething) DoASliceCallsChan(ctx context.Context) <-chan []interface{} {
+	return fake.callsChan(ctx, "DoASlice")
+}
+
+func (fake *ExpectingSomething) DoASliceCalls(stub func([]byte)) {
+	fake.doASliceMutex.Lock()
+	defer fake.doASliceMutex.Unlock()
+	fake.DoASliceStub = stub
+}
+
+// DoASliceCallsWhen calls the stub for the calls whose arguments match.
+// Matchers are checked before the stub, and the matcher registered last wins.
+func (fake *ExpectingSomething) DoASliceCallsWhen(matcher func([]byte) bool, stub func([]byte)) {
+	fake.doASliceMutex.Lock()
+	defer fake.doASliceMutex.Unlock()
+	fake.doASliceWhen = append(fake.doASliceWhen, struct {
+		matcher func([]byte) bool
+		stub    func([]byte)
+	}{matcher, stub})
+}
+
+func (fake *ExpectingSomething) DoASliceArgsForCall(i int) []byte {
+	fake.doASliceMutex.RLock()
+	defer fake.doASliceMutex.RUnlock()
+	argsForCall := fake.doASliceArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *ExpectingSomething) DoASliceCallHistory() []ExpectingSomethingDoASliceCall {
+	fake.doASliceMutex.RLock()
+	defer fake.doASliceMutex.RUnlock()
+	history := make([]ExpectingSomethingDoASliceCall, len(fake.doASliceArgsForCall))
+	for i, argsForCall := range fake.doASliceArgsForCall {
+		history[i] = ExpectingSomethingDoASliceCall{argsForCall.arg1}
+	}
+	return history
+}
+
+func (fake *ExpectingSomething) ExpectDoASlice(arg1 []byte) *ExpectingSomethingDoASliceExpectation {
+	args := []interface{}{arg1}
+	return fake.expectDoASlice(args, fmt.Sprintf("DoASlice with arguments %v", args), nil)
+}
+
+func (fake *ExpectingSomething) ExpectDoASliceWhen(matcher func([]byte) bool) *ExpectingSomethingDoASliceExpectation {
+	return fake.expectDoASlice(nil, "DoASlice with matching arguments", matcher)
+}
+
+func (fake *ExpectingSomething) expectDoASlice(args []interface{}, description string, matcher func([]byte) bool) *ExpectingSomethingDoASliceExpectation {
+	fake.expectationsMutex.Lock()
+	defer fake.expectationsMutex.Unlock()
+	expectation := &ExpectingSomethingDoASliceExpectation{
+		ExpectingSomethingExpectation: &ExpectingSomethingExpectation{method: "DoASlice", description: description, min: 1, max: 1},
+		fake:                          fake,
+		args:                          args,
+		matcher:                       matcher,
+	}
+	fake.expectations = append(fake.expectations, expectation.ExpectingSomethingExpectation)
+	fake.doASliceExpectations = append(fake.doASliceExpectations, expectation)
+	return expectation
+}
+
+func (fake *ExpectingSomething) matchDoASliceExpectation(arg1 []byte) (ExpectingSomethingDoASliceExpectation, bool) {
+	fake.expectationsMutex.Lock()
+	defer fake.expectationsMutex.Unlock()
+	args := []interface{}{arg1}
+	for _, expectation := range fake.doASliceExpectations {
+		if !fake.expectationCanMatch(expectation.ExpectingSomethingExpectation) {
+			continue
+		}
+		if expectation.matcher != nil && !expectation.matcher(arg1) || expectation.matcher == nil && !reflect.DeepEqual(expectation.args, args) {
+			continue
+		}
+		expectation.calls++
+		return *expectation, true
+	}
+	if len(fake.doASliceExpectations) > 0 {
+		fake.unexpectedCalls = append(fake.unexpectedCalls, struct {
+			method string
+			args   []interface{}
+		}{"DoASlice", args})
+	}
+	return ExpectingSomethingDoASliceExpectation{}, false
+}
+
+func (fake *ExpectingSomething) ResetDoASlice() {
+	fake.ResetDoASliceCalls()
+	fake.ResetDoASliceStubs()
+}
+
+func (fake *ExpectingSomething) ResetDoASliceCalls() {
+	fake.doASliceMutex.Lock()
+	defer fake.doASliceMutex.Unlock()
+	fake.doASliceArgsForCall = nil
+	fake.forgetInvocations("DoASlice")
+}
+
+func (fake *ExpectingSomething) ResetDoASliceStubs() {
+	fake.doASliceMutex.Lock()
+	defer fake.doASliceMutex.Unlock()
+	fake.DoASliceStub = nil
+	fake.doASliceWhen = nil
+	fake.expectationsMutex.Lock()
+	defer fake.expectationsMutex.Unlock()
+	fake.doASliceExpectations = nil
+	fake.forgetExpectations("DoASlice")
+}
+
+type ExpectingSomethingDoAnArrayCall struct {
+	Arg1 [4]byte
+}
+
+type ExpectingSomethingDoAnArrayExpectation struct {
+	*ExpectingSomethingExpectation
+	fake    *ExpectingSomething
+	args    []interface{}
+	matcher func([4]byte) bool
+}
+
+func (expectation *ExpectingSomethingDoAnArrayExpectation) Times(n int) *ExpectingSomethingDoAnArrayExpectation {
+	expectation.fake.setExpectedCalls(expectation.ExpectingSomethingExpectation, n, n)
+	return expectation
+}
+
+func (expectation *ExpectingSomethingDoAnArrayExpectation) MinTimes(n int) *ExpectingSomethingDoAnArrayExpectation {
+	expectation.fake.setExpectedCalls(expectation.ExpectingSomethingExpectation, n, -1)
+	return expectation
+}
+
+func (expectation *ExpectingSomethingDoAnArrayExpectation) MaxTimes(n int) *ExpectingSomethingDoAnArrayExpectation {
+	expectation.fake.setExpectedCalls(expectation.ExpectingSomethingExpectation, 0, n)
+	return expectation
+}
+
+func (expectation *ExpectingSomethingDoAnArrayExpectation) TimesBetween(min int, max int) *ExpectingSomethingDoAnArrayExpectation {
+	expectation.fake.setExpectedCalls(expectation.ExpectingSomethingExpectation, min, max)
+	return expectation
+}
+
+func (expectation *ExpectingSomethingDoAnArrayExpectation) AnyTimes() *ExpectingSomethingDoAnArrayExpectation {
+	expectation.fake.setExpectedCalls(expectation.ExpectingSomethingExpectation, 0, -1)
+	return expectation
+}
+
+func (fake *ExpectingSomething) DoAnArray(arg1 [4]byte) {
+	fake.doAnArrayMutex.Lock()
+	fake.doAnArrayArgsForCall = append(fake.doAnArrayArgsForCall, struct {
+		arg1 [4]byte
+	}{arg1})
+	stub := fake.DoAnArrayStub
+	whens := fake.doAnArrayWhen
+	fake.recordInvocation("DoAnArray", []interface{}{arg1})
+	fake.doAnArrayMutex.Unlock()
+	fake.matchDoAnArrayExpectation(arg1)
+	for i := len(whens) - 1; i >= 0; i-- {
+		if whens[i].matcher(arg1) {
+			whens[i].stub(arg1)
+			return
+		}
+	}
+	if stub != nil {
+		fake.DoAnArrayStub(arg1)
+	}
+}
+
+func (fake *ExpectingSomething) DoAnArrayCallCount() int {
+	fake.doAnArrayMutex.RLock()
+	defer fake.doAnArrayMutex.RUnlock()
+	return len(fake.doAnArrayArgsForCall)
+}
+
+func (fake *ExpectingSomething) WaitForDoAnArrayCalls(ctx context.Context, n int) error {
+	return fake.waitForCalls(ctx, fake.DoAnArrayCallCount, n)
+}
+
+func (fake *ExpectingSomething) DoAnArrayCallsChan(ctx context.Context) <-chan []interface{} {
+	return fake.callsChan(ctx, "DoAnArray")
+}
+
+func (fake *ExpectingSomething) DoAnArrayCalls(stub func([4]byte)) {
+	fake.doAnArrayMutex.Lock()
+	defer fake.doAnArrayMutex.Unlock()
+	fake.DoAnArrayStub = stub
+}
+
+// DoAnArrayCallsWhen calls the stub for the calls whose arguments match.
+// Matchers are checked before the stub, and the matcher registered last wins.
+func (fake *ExpectingSomething) DoAnArrayCallsWhen(matcher func([4]byte) bool, stub func([4]byte)) {
+	fake.doAnArrayMutex.Lock()
+	defer fake.doAnArrayMutex.Unlock()
+	fake.doAnArrayWhen = append(fake.doAnArrayWhen, struct {
+		matcher func([4]byte) bool
+		stub    func([4]byte)
+	}{matcher, stub})
+}
+
+func (fake *ExpectingSomething) DoAnArrayArgsForCall(i int) [4]byte {
+	fake.doAnArrayMutex.RLock()
+	defer fake.doAnArrayMutex.RUnlock()
+	argsForCall := fake.doAnArrayArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *ExpectingSomething) DoAnArrayCallHistory() []ExpectingSomethingDoAnArrayCall {
+	fake.doAnArrayMutex.RLock()
+	defer fake.doAnArrayMutex.RUnlock()
+	history := make([]ExpectingSomethingDoAnArrayCall, len(fake.doAnArrayArgsForCall))
+	for i, argsForCall := range fake.doAnArrayArgsForCall {
+		history[i] = ExpectingSomethingDoAnArrayCall{argsForCall.arg1}
+	}
+	return history
+}
+
+func (fake *ExpectingSomething) ExpectDoAnArray(arg1 [4]byte) *ExpectingSomethingDoAnArrayExpectation {
+	args := []interface{}{arg1}
+	return fake.expectDoAnArray(args, fmt.Sprintf("DoAnArray with arguments %v", args), nil)
+}
+
+func (fake *ExpectingSomething) ExpectDoAnArrayWhen(matcher func([4]byte) bool) *ExpectingSomethingDoAnArrayExpectation {
+	return fake.expectDoAnArray(nil, "DoAnArray with matching arguments", matcher)
+}
+
+func (fake *ExpectingSomething) expectDoAnArray(args []interface{}, description string, matcher func([4]byte) bool) *ExpectingSomethingDoAnArrayExpectation {
+	fake.expectationsMutex.Lock()
+	defer fake.expectationsMutex.Unlock()
+	expectation := &ExpectingSomethingDoAnArrayExpectation{
+		ExpectingSomethingExpectation: &ExpectingSomethingExpectation{method: "DoAnArray", description: description, min: 1, max: 1},
+		fake:                          fake,
+		args:                          args,
+		matcher:                       matcher,
+	}
+	fake.expectations = append(fake.expectations, expectation.ExpectingSomethingExpectation)
+	fake.doAnArrayExpectations = append(fake.doAnArrayExpectations, expectation)
+	return expectation
+}
+
+func (fake *ExpectingSomething) matchDoAnArrayExpectation(arg1 [4]byte) (ExpectingSomethingDoAnArrayExpectation, bool) {
+	fake.expectationsMutex.Lock()
+	defer fake.expectationsMutex.Unlock()
+	args := []interface{}{arg1}
+	for _, expectation := range fake.doAnArrayExpectations {
+		if !fake.expectationCanMatch(expectation.ExpectingSomethingExpectation) {
+			continue
+		}
+		if expectation.matcher != nil && !expectation.matcher(arg1) || expectation.matcher == nil && !reflect.DeepEqual(expectation.args, args) {
+			continue
+		}
+		expectation.calls++
+		return *expectation, true
+	}
+	if len(fake.doAnArrayExpectations) > 0 {
+		fake.unexpectedCalls = append(fake.unexpectedCalls, struct {
+			method string
+			args   []interface{}
+		}{"DoAnArray", args})
+	}
+	return ExpectingSomethingDoAnArrayExpectation{}, false
+}
+
+func (fake *ExpectingSomething) ResetDoAnArray() {
+	fake.ResetDoAnArrayCalls()
+	fake.ResetDoAnArrayStubs()
+}
+
+func (fake *ExpectingSomething) ResetDoAnArrayCalls() {
+	fake.doAnArrayMutex.Lock()
+	defer fake.doAnArrayMutex.Unlock()
+	fake.doAnArrayArgsForCall = nil
+	fake.forgetInvocations("DoAnArray")
+}
+
+func (fake *ExpectingSomething) ResetDoAnArrayStubs() {
+	fake.doAnArrayMutex.Lock()
+	defer fake.doAnArrayMutex.Unlock()
+	fake.DoAnArrayStub = nil
+	fake.doAnArrayWhen = nil
+	fake.expectationsMutex.Lock()
+	defer fake.expectationsMutex.Unlock()
+	fake.doAnArrayExpectations = nil
+	fake.forgetExpectations("DoAnArray")
+}
+
+type ExpectingSomethingDoNothingExpectation struct {
+	*ExpectingSomethingExpectation
+	fake *ExpectingSomething
+}
+
+func (expectation *ExpectingSomethingDoNothingExpectation) Times(n int) *ExpectingSomethingDoNothingExpectation {
+	expectation.fake.setExpectedCalls(expectation.ExpectingSomethingExpectation, n, n)
+	return expectation
+}
+
+func (expectation *ExpectingSomethingDoNothingExpectation) MinTimes(n int) *ExpectingSomethingDoNothingExpectation {
+	expectation.fake.setExpectedCalls(expectation.ExpectingSomethingExpectation, n, -1)
+	return expectation
+}
+
+func (expectation *ExpectingSomethingDoNothingExpectation) MaxTimes(n int) *ExpectingSomethingDoNothingExpectation {
+	expectation.fake.setExpectedCalls(expectation.ExpectingSomethingExpectation, 0, n)
+	return expectation
+}
+
+func (expectation *ExpectingSomethingDoNothingExpectation) TimesBetween(min int, max int) *ExpectingSomethingDoNothingExpectation {
+	expectation.fake.setExpectedCalls(expectation.ExpectingSomethingExpectation, min, max)
+	return expectation
+}
+
+func (expectation *ExpectingSomethingDoNothingExpectation) AnyTimes() *ExpectingSomethingDoNothingExpectation {
+	expectation.fake.setExpectedCalls(expectation.ExpectingSomethingExpectation, 0, -1)
+	return expectation
+}
+
+func (fake *ExpectingSomething) DoNothing() {
+	fake.doNothingMutex.Lock()
+	fake.doNothingArgsForCall = append(fake.doNothingArgsForCall, struct {
+	}{})
+	stub := fake.DoNothingStub
+	fake.recordInvocation("DoNothing", []interface{}{})
+	fake.doNothingMutex.Unlock()
+	fake.matchDoNothingExpectation()
+	if stub != nil {
+		fake.DoNothingStub()
+	}
+}
+
+func (fake *ExpectingSomething) DoNothingCallCount() int {
+	fake.doNothingMutex.RLock()
+	defer fake.doNothingMutex.RUnlock()
+	return len(fake.doNothingArgsForCall)
+}
+
+func (fake *ExpectingSomething) WaitForDoNothingCalls(ctx context.Context, n int) error {
+	return fake.waitForCalls(ctx, fake.DoNothingCallCount, n)
+}
+
+func (fake *ExpectingSomething) DoNothingCallsChan(ctx context.Context) <-chan []interface{} {
+	return fake.callsChan(ctx, "DoNothing")
+}
+
+func (fake *ExpectingSomething) DoNothingCalls(stub func()) {
+	fake.doNothingMutex.Lock()
+	defer fake.doNothingMutex.Unlock()
+	fake.DoNothingStub = stub
+}
+
+func (fake *ExpectingSomething) ExpectDoNothing() *ExpectingSomethingDoNothingExpectation {
+	return fake.expectDoNothing("DoNothing")
+}
+
+func (fake *ExpectingSomething) expectDoNothing(description string) *ExpectingSomethingDoNothingExpectation {
+	fake.expectationsMutex.Lock()
+	defer fake.expectationsMutex.Unlock()
+	expectation := &ExpectingSomethingDoNothingExpectation{
+		ExpectingSomethingExpectation: &ExpectingSomethingExpectation{method: "DoNothing", description: description, min: 1, max: 1},
+		fake:                          fake,
+	}
+	fake.expectations = append(fake.expectations, expectation.ExpectingSomethingExpectation)
+	fake.doNothingExpectations = append(fake.doNothingExpectations, expectation)
+	return expectation
+}
+
+func (fake *ExpectingSomething) matchDoNothingExpectation() (ExpectingSomethingDoNothingExpectation, bool) {
+	fake.expectationsMutex.Lock()
+	defer fake.expectationsMutex.Unlock()
+	for _, expectation := range fake.doNothingExpectations {
+		if !fake.expectationCanMatch(expectation.ExpectingSomethingExpectation) {
+			continue
+		}
+		expectation.calls++
+		return *expectation, true
+	}
+	if len(fake.doNothingExpectations) > 0 {
+		fake.unexpectedCalls = append(fake.unexpectedCalls, struct {
+			method string
+			args   []interface{}
+		}{"DoNothing", nil})
+	}
+	return ExpectingSomethingDoNothingExpectation{}, false
+}
+
+func (fake *ExpectingSomething) ResetDoNothing() {
+	fake.ResetDoNothingCalls()
+	fake.ResetDoNothingStubs()
+}
+
+func (fake *ExpectingSomething) ResetDoNothingCalls() {
+	fake.doNothingMutex.Lock()
+	defer fake.doNothingMutex.Unlock()
+	fake.doNothingArgsForCall = nil
+	fake.forgetInvocations("DoNothing")
+}
+
+func (fake *ExpectingSomething) ResetDoNothingStubs() {
+	fake.doNothingMutex.Lock()
+	defer fake.doNothingMutex.Unlock()
+	fake.DoNothingStub = nil
+	fake.expectationsMutex.Lock()
+	defer fake.expectationsMutex.Unlock()
+	fake.doNothingExpectations = nil
+	fake.forgetExpectations("DoNothing")
+}
+
+type ExpectingSomethingDoThingsCall struct {
+	Arg1 string
+	Arg2 uint64
+}
+
+type ExpectingSomethingDoThingsExpectation struct {
+	*ExpectingSomethingExpectation
+	fake    *ExpectingSomething
+	args    []interface{}
+	matcher func(string, uint64) bool
+	returns struct {
+		result1 int
+		result2 error
+	}
+	hasReturns bool
+}
+
+func (expectation *ExpectingSomethingDoThingsExpectation) Times(n int) *ExpectingSomethingDoThingsExpectation {
+	expectation.fake.setExpectedCalls(expectation.ExpectingSomethingExpectation, n, n)
+	return expectation
+}
+
+func (expectation *ExpectingSomethingDoThingsExpectation) MinTimes(n int) *ExpectingSomethingDoThingsExpectation {
+	expectation.fake.setExpectedCalls(expectation.ExpectingSomethingExpectation, n, -1)
+	return expectation
+}
+
+func (expectation *ExpectingSomethingDoThingsExpectation) MaxTimes(n int) *ExpectingSomethingDoThingsExpectation {
+	expectation.fake.setExpectedCalls(expectation.ExpectingSomethingExpectation, 0, n)
+	return expectation
+}
+
+func (expectation *ExpectingSomethingDoThingsExpectation) TimesBetween(min int, max int) *ExpectingSomethingDoThingsExpectation {
+	expectation.fake.setExpectedCalls(expectation.ExpectingSomethingExpectation, min, max)
+	return expectation
+}
+
+func (expectation *ExpectingSomethingDoThingsExpectation) AnyTimes() *ExpectingSomethingDoThingsExpectation {
+	expectation.fake.setExpectedCalls(expectation.ExpectingSomethingExpectation, 0, -1)
+	return expectation
+}
+
+func (expectation *ExpectingSomethingDoThingsExpectation) Return(result1 int, result2 error) *ExpectingSomethingDoThingsExpectation {
+	expectation.fake.expectationsMutex.Lock()
+	defer expectation.fake.expectationsMutex.Unlock()
+	expectation.returns = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+	expectation.hasReturns = true
+	return expectation
+}
+
+func (fake *ExpectingSomething) DoThings(arg1 string, arg2 uint64) (int, error) {
+	fake.doThingsMutex.Lock()
+	ret, specificReturn := fake.doThingsReturnsOnCall[len(fake.doThingsArgsForCall)]
+	fake.doThingsArgsForCall = append(fake.doThingsArgsForCall, struct {
+		arg1 string
+		arg2 uint64
+	}{arg1, arg2})
+	stub := fake.DoThingsStub
+	whens := fake.doThingsWhen
+	fakeReturns := fake.doThingsReturns
+	fake.recordInvocation("DoThings", []interface{}{arg1, arg2})
+	fake.doThingsMutex.Unlock()
+	if expectation, ok := fake.matchDoThingsExpectation(arg1, arg2); ok && expectation.hasReturns {
+		return expectation.returns.result1, expectation.returns.result2
+	}
+	for i := len(whens) - 1; i >= 0; i-- {
+		if whens[i].matcher(arg1, arg2) {
+			return whens[i].stub(arg1, arg2)
+		}
+	}
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *ExpectingSomething) DoThingsCallCount() int {
+	fake.doThingsMutex.RLock()
+	defer fake.doThingsMutex.RUnlock()
+	return len(fake.doThingsArgsForCall)
+}
+
+func (fake *ExpectingSomething) WaitForDoThingsCalls(ctx context.Context, n int) error {
+	return fake.waitForCalls(ctx, fake.DoThingsCallCount, n)
+}
+
+func (fake *ExpectingSomething) DoThingsCallsChan(ctx context.Context) <-chan []interface{} {
+	return fake.callsChan(ctx, "DoThings")
+}
+
+func (fake *ExpectingSomething) DoThingsCalls(stub func(string, uint64) (int, error)) {
+	fake.doThingsMutex.Lock()
+	defer fake.doThingsMutex.Unlock()
+	fake.DoThingsStub = stub
+}
+
+// DoThingsCallsWhen calls the stub for the calls whose arguments match.
+// Matchers are checked before the stub, and the matcher registered last wins.
+func (fake *ExpectingSomething) DoThingsCallsWhen(matcher func(string, uint64) bool, stub func(string, uint64) (int, error)) {
+	fake.doThingsMutex.Lock()
+	defer fake.doThingsMutex.Unlock()
+	fake.doThingsWhen = append(fake.doThingsWhen, struct {
+		matcher func(string, uint64) bool
+		stub    func(string, uint64) (int, error)
+	}{matcher, stub})
+}
+
+func (fake *ExpectingSomething) DoThingsArgsForCall(i int) (string, uint64) {
+	fake.doThingsMutex.RLock()
+	defer fake.doThingsMutex.RUnlock()
+	argsForCall := fake.doThingsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *ExpectingSomething) DoThingsCallHistory() []ExpectingSomethingDoThingsCall {
+	fake.doThingsMutex.RLock()
+	defer fake.doThingsMutex.RUnlock()
+	history := make([]ExpectingSomethingDoThingsCall, len(fake.doThingsArgsForCall))
+	for i, argsForCall := range fake.doThingsArgsForCall {
+		history[i] = ExpectingSomethingDoThingsCall{argsForCall.arg1, argsForCall.arg2}
+	}
+	return history
+}
+
+func (fake *ExpectingSomething) DoThingsReturns(result1 int, result2 error) {
+	fake.doThingsMutex.Lock()
+	defer fake.doThingsMutex.Unlock()
+	fake.DoThingsStub = nil
+	fake.doThingsReturns = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *ExpectingSomething) DoThingsReturnsOnCall(i int, result1 int, result2 error) {
+	fake.doThingsMutex.Lock()
+	defer fake.doThingsMutex.Unlock()
+	fake.DoThingsStub = nil
+	if fake.doThingsReturnsOnCall == nil {
+		fake.doThingsReturnsOnCall = make(map[int]struct {
+			result1 int
+			result2 error
+		})
+	}
+	fake.doThingsReturnsOnCall[i] = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+// DoThingsReturnsForArgs returns the values for the calls with the given
+// arguments. Like DoThingsCallsWhen, it is checked before the stub, and the
+// arguments or matcher registered last win.
+func (fake *ExpectingSomething) DoThingsReturnsForArgs(arg1 string, arg2 uint64) func(int, error) {
+	args := []interface{}{arg1, arg2}
+	return func(result1 int, result2 error) {
+		fake.doThingsMutex.Lock()
+		defer fake.doThingsMutex.Unlock()
+		fake.DoThingsStub = nil
+		fake.doThingsWhen = append(fake.doThingsWhen, struct {
+			matcher func(string, uint64) bool
+			stub    func(string, uint64) (int, error)
+		}{
+			func(arg1 string, arg2 uint64) bool {
+				return fake.argsMatch(args, []interface{}{arg1, arg2})
+			},
+			func(string, uint64) (int, error) {
+				return result1, result2
+			},
+		})
+	}
+}
+
+// DoThingsReturnsWhen returns the values for the calls whose arguments
+// match. Like DoThingsCallsWhen, it is checked before the stub, and the
+// matcher registered last wins.
+func (fake *ExpectingSomething) DoThingsReturnsWhen(matcher func(string, uint64) bool, result1 int, result2 error) {
+	fake.DoThingsCallsWhen(matcher, func(string, uint64) (int, error) {
+		return result1, result2
+	})
+}
+
+func (fake *ExpectingSomething) ExpectDoThings(arg1 string, arg2 uint64) *ExpectingSomethingDoThingsExpectation {
+	args := []interface{}{arg1, arg2}
+	return fake.expectDoThings(args, fmt.Sprintf("DoThings with arguments %v", args), nil)
+}
+
+func (fake *ExpectingSomething) ExpectDoThingsWhen(matcher func(string, uint64) bool) *ExpectingSomethingDoThingsExpectation {
+	return fake.expectDoThings(nil, "DoThings with matching arguments", matcher)
+}
+
+func (fake *ExpectingSomething) expectDoThings(args []interface{}, description string, matcher func(string, uint64) bool) *ExpectingSomethingDoThingsExpectation {
+	fake.expectationsMutex.Lock()
+	defer fake.expectationsMutex.Unlock()
+	expectation := &ExpectingSomethingDoThingsExpectation{
+		ExpectingSomethingExpectation: &ExpectingSomethingExpectation{method: "DoThings", description: description, min: 1, max: 1},
+		fake:                          fake,
+		args:                          args,
+		matcher:                       matcher,
+	}
+	fake.expectations = append(fake.expectations, expectation.ExpectingSomethingExpectation)
+	fake.doThingsExpectations = append(fake.doThingsExpectations, expectation)
+	return expectation
+}
+
+func (fake *ExpectingSomething) matchDoThingsExpectation(arg1 string, arg2 uint64) (ExpectingSomethingDoThingsExpectation, bool) {
+	fake.expectationsMutex.Lock()
+	defer fake.expectationsMutex.Unlock()
+	args := []interface{}{arg1, arg2}
+	for _, expectation := range fake.doThingsExpectations {
+		if !fake.expectationCanMatch(expectation.ExpectingSomethingExpectation) {
+			continue
+		}
+		if expectation.matcher != nil && !expectation.matcher(arg1, arg2) || expectation.matcher == nil && !reflect.DeepEqual(expectation.args, args) {
+			continue
+		}
+		expectation.calls++
+		return *expectation, true
+	}
+	if len(fake.doThingsExpectations) > 0 {
+		fake.unexpectedCalls = append(fake.unexpectedCalls, struct {
+			method string
+			args   []interface{}
+		}{"DoThings", args})
+	}
+	return ExpectingSomethingDoThingsExpectation{}, false
+}
+
+func (fake *ExpectingSomething) ResetDoThings() {
+	fake.ResetDoThingsCalls()
+	fake.ResetDoThingsStubs()
+}
+
+func (fake *ExpectingSomething) ResetDoThingsCalls() {
+	fake.doThingsMutex.Lock()
+	defer fake.doThingsMutex.Unlock()
+	fake.doThingsArgsForCall = nil
+	fake.forgetInvocations("DoThings")
+}
+
+func (fake *ExpectingSomething) ResetDoThingsStubs() {
+	fake.doThingsMutex.Lock()
+	defer fake.doThingsMutex.Unlock()
+	fake.DoThingsStub = nil
+	fake.doThingsWhen = nil
+	fake.doThingsReturns = struct {
+		result1 int
+		result2 error
+	}{}
+	fake.doThingsReturnsOnCall = nil
+	fake.expectationsMutex.Lock()
+	defer fake.expectationsMutex.Unlock()
+	fake.doThingsExpectations = nil
+	fake.forgetExpectations("DoThings")
+}
+
+func (fake *ExpectingSomething) Reset() {
+	fake.ResetCalls()
+	fake.ResetStubs()
+}
+
+func (fake *ExpectingSomething) ResetCalls() {
+	fake.ResetDoASliceCalls()
+	fake.ResetDoAnArrayCalls()
+	fake.ResetDoNothingCalls()
+	fake.ResetDoThingsCalls()
+}
+
+func (fake *ExpectingSomething) ResetStubs() {
+	fake.ResetDoASliceStubs()
+	fake.ResetDoAnArrayStubs()
+	fake.ResetDoNothingStubs()
+	fake.ResetDoThingsStubs()
+}
+
+func (fake *ExpectingSomething) OrderExpectations() {
+	fake.expectationsMutex.Lock()
+	defer fake.expectationsMutex.Unlock()
+	fake.expectationsInOrder = true
+}
+
+func (fake *ExpectingSomething) VerifyExpectations() error {
+	return fake.verifyExpectations()
+}
+
+func (fake *ExpectingSomething) ResetExpectations() {
+	fake.expectationsMutex.Lock()
+	defer fake.expectationsMutex.Unlock()
+	fake.doASliceExpectations = nil
+	fake.doAnArrayExpectations = nil
+	fake.doNothingExpectations = nil
+	fake.doThingsExpectations = nil
+	fake.expectations = nil
+	fake.expectationsInOrder = false
+	fake.unexpectedCalls = nil
+}
+
+func (fake *ExpectingSomething) verifyExpectations() error {
+	fake.expectationsMutex.Lock()
+	defer fake.expectationsMutex.Unlock()
+	var problems []string
+	for _, expectation := range fake.expectations {
+		if expectation.calls < expectation.min {
+			problems = append(problems, fmt.Sprintf("ExpectingSomething.%s: expected %s, but got %d", expectation.description, expectation.expectedCalls(), expectation.calls))
+		}
+	}
+	for _, call := range fake.unexpectedCalls {
+		problems = append(problems, fmt.Sprintf("ExpectingSomething.%s: unexpected call with arguments %v", call.method, call.args))
+	}
+	if len(problems) == 0 {
+		return nil
+	}
+	return errors.New(strings.Join(problems, "\n"))
+}
+
+func (fake *ExpectingSomething) setExpectedCalls(expectation *ExpectingSomethingExpectation, min int, max int) {
+	fake.expectationsMutex.Lock()
+	defer fake.expectationsMutex.Unlock()
+	expectation.min = min
+	expectation.max = max
+}
+
+func (fake *ExpectingSomething) expectationCanMatch(expectation *ExpectingSomethingExpectation) bool {
+	if expectation.max >= 0 && expectation.calls >= expectation.max {
+		return false
+	}
+	if !fake.expectationsInOrder {
+		return true
+	}
+	for _, previous := range fake.expectations {
+		if previous == expectation {
+			break
+		}
+		if previous.calls < previous.min {
+			return false
+		}
+	}
+	return true
+}
+
+func (fake *ExpectingSomething) forgetExpectations(method string) {
+	var expectations []*ExpectingSomethingExpectation
+	for _, expectation := range fake.expectations {
+		if expectation.method != method {
+			expectations = append(expectations, expectation)
+		}
+	}
+	fake.expectations = expectations
+	var unexpectedCalls []struct {
+		method string
+		args   []interface{}
+	}
+	for _, call := range fake.unexpectedCalls {
+		if call.method != method {
+			unexpectedCalls = append(unexpectedCalls, call)
+		}
+	}
+	fake.unexpectedCalls = unexpectedCalls
+}
+
+func (expectation *ExpectingSomethingExpectation) expectedCalls() string {
+	calls := "calls"
+	if expectation.min == 1 && (expectation.max == 1 || expectation.max < 0) {
+		calls = "call"
+	}
+	switch {
+	case expectation.max < 0:
+		return fmt.Sprintf("at least %d %s", expectation.min, calls)
+	case expectation.min == expectation.max:
+		return fmt.Sprintf("%d %s", expectation.min, calls)
+	default:
+		return fmt.Sprintf("between %d and %d %s", expectation.min, expectation.max, calls)
+	}
+}
+
+func (fake *ExpectingSomething) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *ExpectingSomething) argsMatch(expected []interface{}, actual []interface{}) bool {
+	if len(expected) != len(actual) {
+		return false
+	}
+	for i := range expected {
+		if fake.ArgsComparer != nil && !fake.ArgsComparer(expected[i], actual[i]) || fake.ArgsComparer == nil && !reflect.DeepEqual(expected[i], actual[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (fake *ExpectingSomething) OrderedInvocations() []struct {
+	Seq    uint64
+	Method string
+	Args   []interface{}
+} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	return append([]struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}{}, fake.orderedInvocations...)
+}
+
+func (fake *ExpectingSomething) SetSequencer(sequencer *atomic.Uint64) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	fake.sequencer = sequencer
+}
+
+func (fake *ExpectingSomething) waitForCalls(ctx context.Context, callCount func() int, n int) error {
+	for {
+		fake.invocationsMutex.Lock()
+		if fake.invocationsChanged == nil {
+			fake.invocationsChanged = make(chan struct{})
+		}
+		changed := fake.invocationsChanged
+		fake.invocationsMutex.Unlock()
+		if callCount() >= n {
+			return nil
+		}
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (fake *ExpectingSomething) callsChan(ctx context.Context, key string) <-chan []interface{} {
+	ctx, cancel := context.WithCancel(ctx)
+	calls := make(chan []interface{})
+	ready := make(chan struct{}, 1)
+	var pendingMutex sync.Mutex
+	var pending [][]interface{}
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.callsSubscribers == nil {
+		fake.callsSubscribers = map[string][]struct {
+			publish func(args []interface{})
+			cancel  context.CancelFunc
+			done    <-chan struct{}
+		}{}
+	}
+	fake.callsSubscribers[key] = append(fake.callsSubscribers[key], struct {
+		publish func(args []interface{})
+		cancel  context.CancelFunc
+		done    <-chan struct{}
+	}{func(args []interface{}) {
+		pendingMutex.Lock()
+		pending = append(pending, args)
+		pendingMutex.Unlock()
+		select {
+		case ready <- struct{}{}:
+		default:
+		}
+	}, cancel, ctx.Done()})
+	go func() {
+		defer close(calls)
+		for {
+			select {
+			case <-ready:
+			case <-ctx.Done():
+				return
+			}
+			pendingMutex.Lock()
+			queued := pending
+			pending = nil
+			pendingMutex.Unlock()
+			for _, args := range queued {
+				select {
+				case calls <- args:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return calls
+}
+
+func (fake *ExpectingSomething) verify(t testing.TB) {
+	t.Helper()
+	fake.doThingsMutex.RLock()
+	var unusedDoThingsReturns []int
+	for call := range fake.doThingsReturnsOnCall {
+		if call >= len(fake.doThingsArgsForCall) {
+			unusedDoThingsReturns = append(unusedDoThingsReturns, call)
+		}
+	}
+	fake.doThingsMutex.RUnlock()
+	fake.reportUnusedReturns(t, "DoThings", unusedDoThingsReturns)
+	if err := fake.verifyExpectations(); err != nil {
+		t.Error(err)
+	}
+}
+
+func (fake *ExpectingSomething) reportUnusedReturns(t testing.TB, method string, calls []int) {
+	t.Helper()
+	if len(calls) == 0 {
+		return
+	}
+	sort.Ints(calls)
+	t.Errorf("ExpectingSomething.%s: return values were configured for calls %v, which were never made", method, calls)
+}
+
+func (fake *ExpectingSomething) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+	if fake.sequencer == nil {
+		fake.sequencer = new(atomic.Uint64)
+	}
+	fake.orderedInvocations = append(fake.orderedInvocations, struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}{fake.sequencer.Add(1), key, args})
+	if fake.invocationsChanged != nil {
+		close(fake.invocationsChanged)
+		fake.invocationsChanged = nil
+	}
+	subscribers := fake.callsSubscribers[key][:0]
+	for _, subscriber := range fake.callsSubscribers[key] {
+		select {
+		case <-subscriber.done:
+			continue
+		default:
+		}
+		subscriber.publish(args)
+		subscribers = append(subscribers, subscriber)
+	}
+	if len(subscribers) > 0 {
+		fake.callsSubscribers[key] = subscribers
+	} else {
+		delete(fake.callsSubscribers, key)
+	}
+}
+
+func (fake *ExpectingSomething) forgetInvocations(key string) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	delete(fake.invocations, key)
+	for _, subscriber := range fake.callsSubscribers[key] {
+		subscriber.cancel()
+	}
+	delete(fake.callsSubscribers, key)
+	var orderedInvocations []struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}
+	for _, invocation := range fake.orderedInvocations {
+		if invocation.Method != key {
+			orderedInvocations = append(orderedInvocations, invocation)
+		}
+	}
+	fake.orderedInvocations = orderedInvocations
+}
+
+var _ fixtures.Something = new(ExpectingSomething)
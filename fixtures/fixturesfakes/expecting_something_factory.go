@@ -0,0 +1,494 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package fixturesfakes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/maxbrunsfeld/counterfeiter/v6/fixtures"
+)
+
+type ExpectingSomethingFactory struct {
+	Stub        func(string, map[string]interface{}) string
+	mutex       sync.RWMutex
+	argsForCall []struct {
+		arg1 string
+		arg2 map[string]interface{}
+	}
+	returns struct {
+		result1 string
+	}
+	returnsOnCall map[int]struct {
+		result1 string
+	}
+	returnsForArgs []struct {
+		args    []interface{}
+		result1 string
+	}
+	ArgsComparer       func(expected interface{}, actual interface{}) bool
+	invocations        map[string][][]interface{}
+	orderedInvocations []struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}
+	sequencer          *atomic.Uint64
+	invocationsChanged chan struct{}
+	callsSubscribers   []struct {
+		publish func(args []interface{})
+		cancel  context.CancelFunc
+		done    <-chan struct{}
+	}
+	invocationsMutex    sync.RWMutex
+	expectations        []*ExpectingSomethingFactoryExpectation
+	expectationsInOrder bool
+	unexpectedCalls     [][]interface{}
+	expectationsMutex   sync.Mutex
+}
+
+type ExpectingSomethingFactoryCall struct {
+	Arg1 string
+	Arg2 map[string]interface{}
+}
+
+type ExpectingSomethingFactoryExpectation struct {
+	fake        *ExpectingSomethingFactory
+	description string
+	min         int
+	max         int
+	calls       int
+	args        []interface{}
+	matcher     func(string, map[string]interface{}) bool
+	returns     struct {
+		result1 string
+	}
+	hasReturns bool
+}
+
+func (expectation *ExpectingSomethingFactoryExpectation) Times(n int) *ExpectingSomethingFactoryExpectation {
+	expectation.fake.setExpectedCalls(expectation, n, n)
+	return expectation
+}
+
+func (expectation *ExpectingSomethingFactoryExpectation) MinTimes(n int) *ExpectingSomethingFactoryExpectation {
+	expectation.fake.setExpectedCalls(expectation, n, -1)
+	return expectation
+}
+
+func (expectation *ExpectingSomethingFactoryExpectation) MaxTimes(n int) *ExpectingSomethingFactoryExpectation {
+	expectation.fake.setExpectedCalls(expectation, 0, n)
+	return expectation
+}
+
+func (expectation *ExpectingSomethingFactoryExpectation) TimesBetween(min int, max int) *ExpectingSomethingFactoryExpectation {
+	expectation.fake.setExpectedCalls(expectation, min, max)
+	return expectation
+}
+
+func (expectation *ExpectingSomethingFactoryExpectation) AnyTimes() *ExpectingSomethingFactoryExpectation {
+	expectation.fake.setExpectedCalls(expectation, 0, -1)
+	return expectation
+}
+
+func (expectation *ExpectingSomethingFactoryExpectation) Return(result1 string) *ExpectingSomethingFactoryExpectation {
+	expectation.fake.expectationsMutex.Lock()
+	defer expectation.fake.expectationsMutex.Unlock()
+	expectation.returns = struct {
+		result1 string
+	}{result1}
+	expectation.hasReturns = true
+	return expectation
+}
+
+func (expectation *ExpectingSomethingFactoryExpectation) expectedCalls() string {
+	calls := "calls"
+	if expectation.min == 1 && (expectation.max == 1 || expectation.max < 0) {
+		calls = "call"
+	}
+	switch {
+	case expectation.max < 0:
+		return fmt.Sprintf("at least %d %s", expectation.min, calls)
+	case expectation.min == expectation.max:
+		return fmt.Sprintf("%d %s", expectation.min, calls)
+	default:
+		return fmt.Sprintf("between %d and %d %s", expectation.min, expectation.max, calls)
+	}
+}
+
+func (fake *ExpectingSomethingFactory) Spy(arg1 string, arg2 map[string]interface{}) string {
+	fake.mutex.Lock()
+	ret, specificReturn := fake.returnsOnCall[len(fake.argsForCall)]
+	fake.argsForCall = append(fake.argsForCall, struct {
+		arg1 string
+		arg2 map[string]interface{}
+	}{arg1, arg2})
+	stub := fake.Stub
+	returnsForArgs := fake.returnsForArgs
+	returns := fake.returns
+	fake.recordInvocation("SomethingFactory", []interface{}{arg1, arg2})
+	fake.mutex.Unlock()
+	if expectation, ok := fake.matchExpectation(arg1, arg2); ok && expectation.hasReturns {
+		return expectation.returns.result1
+	}
+	for i := len(returnsForArgs) - 1; i >= 0; i-- {
+		if fake.argsMatch(returnsForArgs[i].args, []interface{}{arg1, arg2}) {
+			return returnsForArgs[i].result1
+		}
+	}
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return returns.result1
+}
+
+func (fake *ExpectingSomethingFactory) CallCount() int {
+	fake.mutex.RLock()
+	defer fake.mutex.RUnlock()
+	return len(fake.argsForCall)
+}
+
+func (fake *ExpectingSomethingFactory) WaitForCalls(ctx context.Context, n int) error {
+	for {
+		fake.invocationsMutex.Lock()
+		if fake.invocationsChanged == nil {
+			fake.invocationsChanged = make(chan struct{})
+		}
+		changed := fake.invocationsChanged
+		fake.invocationsMutex.Unlock()
+		if fake.CallCount() >= n {
+			return nil
+		}
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (fake *ExpectingSomethingFactory) CallsChan(ctx context.Context) <-chan []interface{} {
+	ctx, cancel := context.WithCancel(ctx)
+	calls := make(chan []interface{})
+	ready := make(chan struct{}, 1)
+	var pendingMutex sync.Mutex
+	var pending [][]interface{}
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	fake.callsSubscribers = append(fake.callsSubscribers, struct {
+		publish func(args []interface{})
+		cancel  context.CancelFunc
+		done    <-chan struct{}
+	}{func(args []interface{}) {
+		pendingMutex.Lock()
+		pending = append(pending, args)
+		pendingMutex.Unlock()
+		select {
+		case ready <- struct{}{}:
+		default:
+		}
+	}, cancel, ctx.Done()})
+	go func() {
+		defer close(calls)
+		for {
+			select {
+			case <-ready:
+			case <-ctx.Done():
+				return
+			}
+			pendingMutex.Lock()
+			queued := pending
+			pending = nil
+			pendingMutex.Unlock()
+			for _, args := range queued {
+				select {
+				case calls <- args:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return calls
+}
+
+func (fake *ExpectingSomethingFactory) Calls(stub func(string, map[string]interface{}) string) {
+	fake.mutex.Lock()
+	defer fake.mutex.Unlock()
+	fake.Stub = stub
+}
+
+func (fake *ExpectingSomethingFactory) ArgsForCall(i int) (string, map[string]interface{}) {
+	fake.mutex.RLock()
+	defer fake.mutex.RUnlock()
+	return fake.argsForCall[i].arg1, fake.argsForCall[i].arg2
+}
+
+func (fake *ExpectingSomethingFactory) CallHistory() []ExpectingSomethingFactoryCall {
+	fake.mutex.RLock()
+	defer fake.mutex.RUnlock()
+	history := make([]ExpectingSomethingFactoryCall, len(fake.argsForCall))
+	for i, argsForCall := range fake.argsForCall {
+		history[i] = ExpectingSomethingFactoryCall{argsForCall.arg1, argsForCall.arg2}
+	}
+	return history
+}
+
+func (fake *ExpectingSomethingFactory) Returns(result1 string) {
+	fake.mutex.Lock()
+	defer fake.mutex.Unlock()
+	fake.Stub = nil
+	fake.returns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *ExpectingSomethingFactory) ReturnsOnCall(i int, result1 string) {
+	fake.mutex.Lock()
+	defer fake.mutex.Unlock()
+	fake.Stub = nil
+	if fake.returnsOnCall == nil {
+		fake.returnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.returnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+// ReturnsForArgs returns the values for the calls with the given arguments.
+// The arguments are checked before the stub, and the arguments registered last
+// win.
+func (fake *ExpectingSomethingFactory) ReturnsForArgs(arg1 string, arg2 map[string]interface{}) func(string) {
+	args := []interface{}{arg1, arg2}
+	return func(result1 string) {
+		fake.mutex.Lock()
+		defer fake.mutex.Unlock()
+		fake.Stub = nil
+		fake.returnsForArgs = append(fake.returnsForArgs, struct {
+			args    []interface{}
+			result1 string
+		}{args, result1})
+	}
+}
+
+func (fake *ExpectingSomethingFactory) Reset() {
+	fake.ResetCalls()
+	fake.ResetStubs()
+}
+
+func (fake *ExpectingSomethingFactory) ResetCalls() {
+	fake.mutex.Lock()
+	fake.argsForCall = nil
+	fake.mutex.Unlock()
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	fake.invocations = nil
+	fake.orderedInvocations = nil
+	for _, subscriber := range fake.callsSubscribers {
+		subscriber.cancel()
+	}
+	fake.callsSubscribers = nil
+}
+
+func (fake *ExpectingSomethingFactory) ResetStubs() {
+	fake.mutex.Lock()
+	defer fake.mutex.Unlock()
+	fake.Stub = nil
+	fake.returns = struct {
+		result1 string
+	}{}
+	fake.returnsOnCall = nil
+	fake.returnsForArgs = nil
+	fake.ResetExpectations()
+}
+
+func (fake *ExpectingSomethingFactory) Expect(arg1 string, arg2 map[string]interface{}) *ExpectingSomethingFactoryExpectation {
+	args := []interface{}{arg1, arg2}
+	return fake.expect(args, fmt.Sprintf("SomethingFactory with arguments %v", args), nil)
+}
+
+func (fake *ExpectingSomethingFactory) ExpectWhen(matcher func(string, map[string]interface{}) bool) *ExpectingSomethingFactoryExpectation {
+	return fake.expect(nil, "SomethingFactory with matching arguments", matcher)
+}
+
+func (fake *ExpectingSomethingFactory) expect(args []interface{}, description string, matcher func(string, map[string]interface{}) bool) *ExpectingSomethingFactoryExpectation {
+	fake.expectationsMutex.Lock()
+	defer fake.expectationsMutex.Unlock()
+	expectation := &ExpectingSomethingFactoryExpectation{
+		fake:        fake,
+		description: description,
+		min:         1,
+		max:         1,
+		args:        args,
+		matcher:     matcher,
+	}
+	fake.expectations = append(fake.expectations, expectation)
+	return expectation
+}
+
+func (fake *ExpectingSomethingFactory) matchExpectation(arg1 string, arg2 map[string]interface{}) (ExpectingSomethingFactoryExpectation, bool) {
+	fake.expectationsMutex.Lock()
+	defer fake.expectationsMutex.Unlock()
+	args := []interface{}{arg1, arg2}
+	for _, expectation := range fake.expectations {
+		if !fake.expectationCanMatch(expectation) {
+			continue
+		}
+		if expectation.matcher != nil && !expectation.matcher(arg1, arg2) || expectation.matcher == nil && !reflect.DeepEqual(expectation.args, args) {
+			continue
+		}
+		expectation.calls++
+		return *expectation, true
+	}
+	if len(fake.expectations) > 0 {
+		fake.unexpectedCalls = append(fake.unexpectedCalls, args)
+	}
+	return ExpectingSomethingFactoryExpectation{}, false
+}
+
+func (fake *ExpectingSomethingFactory) OrderExpectations() {
+	fake.expectationsMutex.Lock()
+	defer fake.expectationsMutex.Unlock()
+	fake.expectationsInOrder = true
+}
+
+func (fake *ExpectingSomethingFactory) VerifyExpectations() error {
+	fake.expectationsMutex.Lock()
+	defer fake.expectationsMutex.Unlock()
+	var problems []string
+	for _, expectation := range fake.expectations {
+		if expectation.calls < expectation.min {
+			problems = append(problems, fmt.Sprintf("ExpectingSomethingFactory.%s: expected %s, but got %d", expectation.description, expectation.expectedCalls(), expectation.calls))
+		}
+	}
+	for _, args := range fake.unexpectedCalls {
+		problems = append(problems, fmt.Sprintf("ExpectingSomethingFactory.SomethingFactory: unexpected call with arguments %v", args))
+	}
+	if len(problems) == 0 {
+		return nil
+	}
+	return errors.New(strings.Join(problems, "\n"))
+}
+
+func (fake *ExpectingSomethingFactory) ResetExpectations() {
+	fake.expectationsMutex.Lock()
+	defer fake.expectationsMutex.Unlock()
+	fake.expectations = nil
+	fake.expectationsInOrder = false
+	fake.unexpectedCalls = nil
+}
+
+func (fake *ExpectingSomethingFactory) setExpectedCalls(expectation *ExpectingSomethingFactoryExpectation, min int, max int) {
+	fake.expectationsMutex.Lock()
+	defer fake.expectationsMutex.Unlock()
+	expectation.min = min
+	expectation.max = max
+}
+
+func (fake *ExpectingSomethingFactory) expectationCanMatch(expectation *ExpectingSomethingFactoryExpectation) bool {
+	if expectation.max >= 0 && expectation.calls >= expectation.max {
+		return false
+	}
+	if !fake.expectationsInOrder {
+		return true
+	}
+	for _, previous := range fake.expectations {
+		if previous == expectation {
+			break
+		}
+		if previous.calls < previous.min {
+			return false
+		}
+	}
+	return true
+}
+
+func (fake *ExpectingSomethingFactory) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.mutex.RLock()
+	defer fake.mutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *ExpectingSomethingFactory) OrderedInvocations() []struct {
+	Seq    uint64
+	Method string
+	Args   []interface{}
+} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	return append([]struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}{}, fake.orderedInvocations...)
+}
+
+func (fake *ExpectingSomethingFactory) SetSequencer(sequencer *atomic.Uint64) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	fake.sequencer = sequencer
+}
+
+func (fake *ExpectingSomethingFactory) argsMatch(expected []interface{}, actual []interface{}) bool {
+	if len(expected) != len(actual) {
+		return false
+	}
+	for i := range expected {
+		if fake.ArgsComparer != nil && !fake.ArgsComparer(expected[i], actual[i]) || fake.ArgsComparer == nil && !reflect.DeepEqual(expected[i], actual[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (fake *ExpectingSomethingFactory) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+	if fake.sequencer == nil {
+		fake.sequencer = new(atomic.Uint64)
+	}
+	fake.orderedInvocations = append(fake.orderedInvocations, struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}{fake.sequencer.Add(1), key, args})
+	if fake.invocationsChanged != nil {
+		close(fake.invocationsChanged)
+		fake.invocationsChanged = nil
+	}
+	subscribers := fake.callsSubscribers[:0]
+	for _, subscriber := range fake.callsSubscribers {
+		select {
+		case <-subscriber.done:
+			continue
+		default:
+		}
+		subscriber.publish(args)
+		subscribers = append(subscribers, subscriber)
+	}
+	fake.callsSubscribers = subscribers
+}
+
+var _ fixtures.SomethingFactory = new(ExpectingSomethingFactory).Spy
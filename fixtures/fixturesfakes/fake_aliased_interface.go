@@ -2,7 +2,11 @@
 package fixturesfakes
 
 import (
+	"context"
+	"fmt"
+	"reflect"
 	"sync"
+	"sync/atomic"
 
 	"github.com/maxbrunsfeld/counterfeiter/v6/fixtures"
 	"github.com/maxbrunsfeld/counterfeiter/v6/fixtures/another_package"
@@ -18,10 +22,35 @@ type FakeAliasedInterface struct {
 		arg4 another_package.SomeType
 		arg5 chan another_package.SomeType
 	}
-	invocations      map[string][][]interface{}
+	anotherMethodWhen []struct {
+		matcher func([]another_package.SomeType, map[another_package.SomeType]another_package.SomeType, *another_package.SomeType, another_package.SomeType, chan another_package.SomeType) bool
+		stub    func([]another_package.SomeType, map[another_package.SomeType]another_package.SomeType, *another_package.SomeType, another_package.SomeType, chan another_package.SomeType)
+	}
+	anotherMethodSetsArgs map[int]interface{}
+	invocations           map[string][][]interface{}
+	orderedInvocations    []struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}
+	sequencer          *atomic.Uint64
+	invocationsChanged chan struct{}
+	callsSubscribers   map[string][]struct {
+		publish func(args []interface{})
+		cancel  context.CancelFunc
+		done    <-chan struct{}
+	}
 	invocationsMutex sync.RWMutex
 }
 
+type FakeAliasedInterfaceAnotherMethodCall struct {
+	Arg1 []another_package.SomeType
+	Arg2 map[another_package.SomeType]another_package.SomeType
+	Arg3 *another_package.SomeType
+	Arg4 another_package.SomeType
+	Arg5 chan another_package.SomeType
+}
+
 func (fake *FakeAliasedInterface) AnotherMethod(arg1 []another_package.SomeType, arg2 map[another_package.SomeType]another_package.SomeType, arg3 *another_package.SomeType, arg4 another_package.SomeType, arg5 chan another_package.SomeType) {
 	var arg1Copy []another_package.SomeType
 	if arg1 != nil {
@@ -37,8 +66,20 @@ func (fake *FakeAliasedInterface) AnotherMethod(arg1 []another_package.SomeType,
 		arg5 chan another_package.SomeType
 	}{arg1Copy, arg2, arg3, arg4, arg5})
 	stub := fake.AnotherMethodStub
+	whens := fake.anotherMethodWhen
+	setsArgs := fake.anotherMethodSetsArgs
 	fake.recordInvocation("AnotherMethod", []interface{}{arg1Copy, arg2, arg3, arg4, arg5})
 	fake.anotherMethodMutex.Unlock()
+	if len(setsArgs) > 0 {
+		args := []interface{}{arg1, arg2, arg3, arg4, arg5}
+		fake.setArgs("AnotherMethod", setsArgs, args)
+	}
+	for i := len(whens) - 1; i >= 0; i-- {
+		if whens[i].matcher(arg1, arg2, arg3, arg4, arg5) {
+			whens[i].stub(arg1, arg2, arg3, arg4, arg5)
+			return
+		}
+	}
 	if stub != nil {
 		fake.AnotherMethodStub(arg1, arg2, arg3, arg4, arg5)
 	}
@@ -50,12 +91,43 @@ func (fake *FakeAliasedInterface) AnotherMethodCallCount() int {
 	return len(fake.anotherMethodArgsForCall)
 }
 
+func (fake *FakeAliasedInterface) WaitForAnotherMethodCalls(ctx context.Context, n int) error {
+	return fake.waitForCalls(ctx, fake.AnotherMethodCallCount, n)
+}
+
+func (fake *FakeAliasedInterface) AnotherMethodCallsChan(ctx context.Context) <-chan []interface{} {
+	return fake.callsChan(ctx, "AnotherMethod")
+}
+
 func (fake *FakeAliasedInterface) AnotherMethodCalls(stub func([]another_package.SomeType, map[another_package.SomeType]another_package.SomeType, *another_package.SomeType, another_package.SomeType, chan another_package.SomeType)) {
 	fake.anotherMethodMutex.Lock()
 	defer fake.anotherMethodMutex.Unlock()
 	fake.AnotherMethodStub = stub
 }
 
+func (fake *FakeAliasedInterface) AnotherMethodSetsArg(i int, value interface{}) {
+	fake.anotherMethodMutex.Lock()
+	defer fake.anotherMethodMutex.Unlock()
+	setsArgs := map[int]interface{}{i: value}
+	for j, v := range fake.anotherMethodSetsArgs {
+		if j != i {
+			setsArgs[j] = v
+		}
+	}
+	fake.anotherMethodSetsArgs = setsArgs
+}
+
+// AnotherMethodCallsWhen calls the stub for the calls whose arguments match.
+// Matchers are checked before the stub, and the matcher registered last wins.
+func (fake *FakeAliasedInterface) AnotherMethodCallsWhen(matcher func([]another_package.SomeType, map[another_package.SomeType]another_package.SomeType, *another_package.SomeType, another_package.SomeType, chan another_package.SomeType) bool, stub func([]another_package.SomeType, map[another_package.SomeType]another_package.SomeType, *another_package.SomeType, another_package.SomeType, chan another_package.SomeType)) {
+	fake.anotherMethodMutex.Lock()
+	defer fake.anotherMethodMutex.Unlock()
+	fake.anotherMethodWhen = append(fake.anotherMethodWhen, struct {
+		matcher func([]another_package.SomeType, map[another_package.SomeType]another_package.SomeType, *another_package.SomeType, another_package.SomeType, chan another_package.SomeType) bool
+		stub    func([]another_package.SomeType, map[another_package.SomeType]another_package.SomeType, *another_package.SomeType, another_package.SomeType, chan another_package.SomeType)
+	}{matcher, stub})
+}
+
 func (fake *FakeAliasedInterface) AnotherMethodArgsForCall(i int) ([]another_package.SomeType, map[another_package.SomeType]another_package.SomeType, *another_package.SomeType, another_package.SomeType, chan another_package.SomeType) {
 	fake.anotherMethodMutex.RLock()
 	defer fake.anotherMethodMutex.RUnlock()
@@ -63,6 +135,49 @@ func (fake *FakeAliasedInterface) AnotherMethodArgsForCall(i int) ([]another_pac
 	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
 }
 
+func (fake *FakeAliasedInterface) AnotherMethodCallHistory() []FakeAliasedInterfaceAnotherMethodCall {
+	fake.anotherMethodMutex.RLock()
+	defer fake.anotherMethodMutex.RUnlock()
+	history := make([]FakeAliasedInterfaceAnotherMethodCall, len(fake.anotherMethodArgsForCall))
+	for i, argsForCall := range fake.anotherMethodArgsForCall {
+		history[i] = FakeAliasedInterfaceAnotherMethodCall{argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5}
+	}
+	return history
+}
+
+func (fake *FakeAliasedInterface) ResetAnotherMethod() {
+	fake.ResetAnotherMethodCalls()
+	fake.ResetAnotherMethodStubs()
+}
+
+func (fake *FakeAliasedInterface) ResetAnotherMethodCalls() {
+	fake.anotherMethodMutex.Lock()
+	defer fake.anotherMethodMutex.Unlock()
+	fake.anotherMethodArgsForCall = nil
+	fake.forgetInvocations("AnotherMethod")
+}
+
+func (fake *FakeAliasedInterface) ResetAnotherMethodStubs() {
+	fake.anotherMethodMutex.Lock()
+	defer fake.anotherMethodMutex.Unlock()
+	fake.AnotherMethodStub = nil
+	fake.anotherMethodWhen = nil
+	fake.anotherMethodSetsArgs = nil
+}
+
+func (fake *FakeAliasedInterface) Reset() {
+	fake.ResetCalls()
+	fake.ResetStubs()
+}
+
+func (fake *FakeAliasedInterface) ResetCalls() {
+	fake.ResetAnotherMethodCalls()
+}
+
+func (fake *FakeAliasedInterface) ResetStubs() {
+	fake.ResetAnotherMethodStubs()
+}
+
 func (fake *FakeAliasedInterface) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -73,6 +188,117 @@ func (fake *FakeAliasedInterface) Invocations() map[string][][]interface{} {
 	return copiedInvocations
 }
 
+func (fake *FakeAliasedInterface) setArgs(method string, values map[int]interface{}, args []interface{}) {
+	for i, value := range values {
+		if i < 0 || i >= len(args) {
+			panic(fmt.Sprintf("FakeAliasedInterface.%s: cannot set argument %d, because it was called with %d arguments", method, i, len(args)))
+		}
+		target := reflect.ValueOf(args[i])
+		if target.Kind() != reflect.Ptr || target.IsNil() {
+			panic(fmt.Sprintf("FakeAliasedInterface.%s: cannot set argument %d, because it is %T instead of a non-nil pointer", method, i, args[i]))
+		}
+		v := reflect.ValueOf(value)
+		if !v.IsValid() {
+			v = reflect.Zero(target.Elem().Type())
+		}
+		if !v.Type().AssignableTo(target.Elem().Type()) {
+			panic(fmt.Sprintf("FakeAliasedInterface.%s: cannot set argument %d, because %T is not assignable to %s", method, i, value, target.Elem().Type()))
+		}
+		target.Elem().Set(v)
+	}
+}
+
+func (fake *FakeAliasedInterface) OrderedInvocations() []struct {
+	Seq    uint64
+	Method string
+	Args   []interface{}
+} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	return append([]struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}{}, fake.orderedInvocations...)
+}
+
+func (fake *FakeAliasedInterface) SetSequencer(sequencer *atomic.Uint64) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	fake.sequencer = sequencer
+}
+
+func (fake *FakeAliasedInterface) waitForCalls(ctx context.Context, callCount func() int, n int) error {
+	for {
+		fake.invocationsMutex.Lock()
+		if fake.invocationsChanged == nil {
+			fake.invocationsChanged = make(chan struct{})
+		}
+		changed := fake.invocationsChanged
+		fake.invocationsMutex.Unlock()
+		if callCount() >= n {
+			return nil
+		}
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (fake *FakeAliasedInterface) callsChan(ctx context.Context, key string) <-chan []interface{} {
+	ctx, cancel := context.WithCancel(ctx)
+	calls := make(chan []interface{})
+	ready := make(chan struct{}, 1)
+	var pendingMutex sync.Mutex
+	var pending [][]interface{}
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.callsSubscribers == nil {
+		fake.callsSubscribers = map[string][]struct {
+			publish func(args []interface{})
+			cancel  context.CancelFunc
+			done    <-chan struct{}
+		}{}
+	}
+	fake.callsSubscribers[key] = append(fake.callsSubscribers[key], struct {
+		publish func(args []interface{})
+		cancel  context.CancelFunc
+		done    <-chan struct{}
+	}{func(args []interface{}) {
+		pendingMutex.Lock()
+		pending = append(pending, args)
+		pendingMutex.Unlock()
+		select {
+		case ready <- struct{}{}:
+		default:
+		}
+	}, cancel, ctx.Done()})
+	go func() {
+		defer close(calls)
+		for {
+			select {
+			case <-ready:
+			case <-ctx.Done():
+				return
+			}
+			pendingMutex.Lock()
+			queued := pending
+			pending = nil
+			pendingMutex.Unlock()
+			for _, args := range queued {
+				select {
+				case calls <- args:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return calls
+}
+
 func (fake *FakeAliasedInterface) recordInvocation(key string, args []interface{}) {
 	fake.invocationsMutex.Lock()
 	defer fake.invocationsMutex.Unlock()
@@ -83,6 +309,54 @@ func (fake *FakeAliasedInterface) recordInvocation(key string, args []interface{
 		fake.invocations[key] = [][]interface{}{}
 	}
 	fake.invocations[key] = append(fake.invocations[key], args)
+	if fake.sequencer == nil {
+		fake.sequencer = new(atomic.Uint64)
+	}
+	fake.orderedInvocations = append(fake.orderedInvocations, struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}{fake.sequencer.Add(1), key, args})
+	if fake.invocationsChanged != nil {
+		close(fake.invocationsChanged)
+		fake.invocationsChanged = nil
+	}
+	subscribers := fake.callsSubscribers[key][:0]
+	for _, subscriber := range fake.callsSubscribers[key] {
+		select {
+		case <-subscriber.done:
+			continue
+		default:
+		}
+		subscriber.publish(args)
+		subscribers = append(subscribers, subscriber)
+	}
+	if len(subscribers) > 0 {
+		fake.callsSubscribers[key] = subscribers
+	} else {
+		delete(fake.callsSubscribers, key)
+	}
+}
+
+func (fake *FakeAliasedInterface) forgetInvocations(key string) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	delete(fake.invocations, key)
+	for _, subscriber := range fake.callsSubscribers[key] {
+		subscriber.cancel()
+	}
+	delete(fake.callsSubscribers, key)
+	var orderedInvocations []struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}
+	for _, invocation := range fake.orderedInvocations {
+		if invocation.Method != key {
+			orderedInvocations = append(orderedInvocations, invocation)
+		}
+	}
+	fake.orderedInvocations = orderedInvocations
 }
 
 var _ fixtures.AliasedInterface = new(FakeAliasedInterface)
@@ -0,0 +1,344 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package fixturesfakes
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+
+	"github.com/maxbrunsfeld/counterfeiter/v6/fixtures"
+)
+
+type FakeCollidingResetHelpers struct {
+	CallsStub        func() []string
+	callsMutex       sync.RWMutex
+	callsArgsForCall []struct {
+	}
+	callsReturns struct {
+		result1 []string
+	}
+	callsReturnsOnCall map[int]struct {
+		result1 []string
+	}
+	ResetCallsStub        func()
+	resetCallsMutex       sync.RWMutex
+	resetCallsArgsForCall []struct {
+	}
+	invocations        map[string][][]interface{}
+	orderedInvocations []struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}
+	sequencer          *atomic.Uint64
+	invocationsChanged chan struct{}
+	callsSubscribers   map[string][]struct {
+		publish func(args []interface{})
+		cancel  context.CancelFunc
+		done    <-chan struct{}
+	}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeCollidingResetHelpers) Calls() []string {
+	fake.callsMutex.Lock()
+	ret, specificReturn := fake.callsReturnsOnCall[len(fake.callsArgsForCall)]
+	fake.callsArgsForCall = append(fake.callsArgsForCall, struct {
+	}{})
+	stub := fake.CallsStub
+	fakeReturns := fake.callsReturns
+	fake.recordInvocation("Calls", []interface{}{})
+	fake.callsMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeCollidingResetHelpers) CallsCallCount() int {
+	fake.callsMutex.RLock()
+	defer fake.callsMutex.RUnlock()
+	return len(fake.callsArgsForCall)
+}
+
+func (fake *FakeCollidingResetHelpers) WaitForCallsCalls(ctx context.Context, n int) error {
+	return fake.waitForCalls(ctx, fake.CallsCallCount, n)
+}
+
+func (fake *FakeCollidingResetHelpers) CallsCallsChan(ctx context.Context) <-chan []interface{} {
+	return fake.callsChan(ctx, "Calls")
+}
+
+func (fake *FakeCollidingResetHelpers) CallsCalls(stub func() []string) {
+	fake.callsMutex.Lock()
+	defer fake.callsMutex.Unlock()
+	fake.CallsStub = stub
+}
+
+func (fake *FakeCollidingResetHelpers) CallsReturns(result1 []string) {
+	fake.callsMutex.Lock()
+	defer fake.callsMutex.Unlock()
+	fake.CallsStub = nil
+	fake.callsReturns = struct {
+		result1 []string
+	}{result1}
+}
+
+func (fake *FakeCollidingResetHelpers) CallsReturnsOnCall(i int, result1 []string) {
+	fake.callsMutex.Lock()
+	defer fake.callsMutex.Unlock()
+	fake.CallsStub = nil
+	if fake.callsReturnsOnCall == nil {
+		fake.callsReturnsOnCall = make(map[int]struct {
+			result1 []string
+		})
+	}
+	fake.callsReturnsOnCall[i] = struct {
+		result1 []string
+	}{result1}
+}
+
+func (fake *FakeCollidingResetHelpers) resetCallsCalls() {
+	fake.callsMutex.Lock()
+	defer fake.callsMutex.Unlock()
+	fake.callsArgsForCall = nil
+	fake.forgetInvocations("Calls")
+}
+
+func (fake *FakeCollidingResetHelpers) ResetCallsStubs() {
+	fake.callsMutex.Lock()
+	defer fake.callsMutex.Unlock()
+	fake.CallsStub = nil
+	fake.callsReturns = struct {
+		result1 []string
+	}{}
+	fake.callsReturnsOnCall = nil
+}
+
+func (fake *FakeCollidingResetHelpers) ResetCalls() {
+	fake.resetCallsMutex.Lock()
+	fake.resetCallsArgsForCall = append(fake.resetCallsArgsForCall, struct {
+	}{})
+	stub := fake.ResetCallsStub
+	fake.recordInvocation("ResetCalls", []interface{}{})
+	fake.resetCallsMutex.Unlock()
+	if stub != nil {
+		fake.ResetCallsStub()
+	}
+}
+
+func (fake *FakeCollidingResetHelpers) ResetCallsCallCount() int {
+	fake.resetCallsMutex.RLock()
+	defer fake.resetCallsMutex.RUnlock()
+	return len(fake.resetCallsArgsForCall)
+}
+
+func (fake *FakeCollidingResetHelpers) WaitForResetCallsCalls(ctx context.Context, n int) error {
+	return fake.waitForCalls(ctx, fake.ResetCallsCallCount, n)
+}
+
+func (fake *FakeCollidingResetHelpers) ResetCallsCallsChan(ctx context.Context) <-chan []interface{} {
+	return fake.callsChan(ctx, "ResetCalls")
+}
+
+func (fake *FakeCollidingResetHelpers) ResetCallsCalls(stub func()) {
+	fake.resetCallsMutex.Lock()
+	defer fake.resetCallsMutex.Unlock()
+	fake.ResetCallsStub = stub
+}
+
+func (fake *FakeCollidingResetHelpers) ResetResetCalls() {
+	fake.ResetResetCallsCalls()
+	fake.ResetResetCallsStubs()
+}
+
+func (fake *FakeCollidingResetHelpers) ResetResetCallsCalls() {
+	fake.resetCallsMutex.Lock()
+	defer fake.resetCallsMutex.Unlock()
+	fake.resetCallsArgsForCall = nil
+	fake.forgetInvocations("ResetCalls")
+}
+
+func (fake *FakeCollidingResetHelpers) ResetResetCallsStubs() {
+	fake.resetCallsMutex.Lock()
+	defer fake.resetCallsMutex.Unlock()
+	fake.ResetCallsStub = nil
+}
+
+func (fake *FakeCollidingResetHelpers) Reset() {
+	fake.resetCallsCalls()
+	fake.ResetCallsStubs()
+	fake.ResetResetCallsCalls()
+	fake.ResetResetCallsStubs()
+}
+
+func (fake *FakeCollidingResetHelpers) ResetStubs() {
+	fake.ResetCallsStubs()
+	fake.ResetResetCallsStubs()
+}
+
+func (fake *FakeCollidingResetHelpers) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeCollidingResetHelpers) OrderedInvocations() []struct {
+	Seq    uint64
+	Method string
+	Args   []interface{}
+} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	return append([]struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}{}, fake.orderedInvocations...)
+}
+
+func (fake *FakeCollidingResetHelpers) SetSequencer(sequencer *atomic.Uint64) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	fake.sequencer = sequencer
+}
+
+func (fake *FakeCollidingResetHelpers) waitForCalls(ctx context.Context, callCount func() int, n int) error {
+	for {
+		fake.invocationsMutex.Lock()
+		if fake.invocationsChanged == nil {
+			fake.invocationsChanged = make(chan struct{})
+		}
+		changed := fake.invocationsChanged
+		fake.invocationsMutex.Unlock()
+		if callCount() >= n {
+			return nil
+		}
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (fake *FakeCollidingResetHelpers) callsChan(ctx context.Context, key string) <-chan []interface{} {
+	ctx, cancel := context.WithCancel(ctx)
+	calls := make(chan []interface{})
+	ready := make(chan struct{}, 1)
+	var pendingMutex sync.Mutex
+	var pending [][]interface{}
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.callsSubscribers == nil {
+		fake.callsSubscribers = map[string][]struct {
+			publish func(args []interface{})
+			cancel  context.CancelFunc
+			done    <-chan struct{}
+		}{}
+	}
+	fake.callsSubscribers[key] = append(fake.callsSubscribers[key], struct {
+		publish func(args []interface{})
+		cancel  context.CancelFunc
+		done    <-chan struct{}
+	}{func(args []interface{}) {
+		pendingMutex.Lock()
+		pending = append(pending, args)
+		pendingMutex.Unlock()
+		select {
+		case ready <- struct{}{}:
+		default:
+		}
+	}, cancel, ctx.Done()})
+	go func() {
+		defer close(calls)
+		for {
+			select {
+			case <-ready:
+			case <-ctx.Done():
+				return
+			}
+			pendingMutex.Lock()
+			queued := pending
+			pending = nil
+			pendingMutex.Unlock()
+			for _, args := range queued {
+				select {
+				case calls <- args:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return calls
+}
+
+func (fake *FakeCollidingResetHelpers) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+	if fake.sequencer == nil {
+		fake.sequencer = new(atomic.Uint64)
+	}
+	fake.orderedInvocations = append(fake.orderedInvocations, struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}{fake.sequencer.Add(1), key, args})
+	if fake.invocationsChanged != nil {
+		close(fake.invocationsChanged)
+		fake.invocationsChanged = nil
+	}
+	subscribers := fake.callsSubscribers[key][:0]
+	for _, subscriber := range fake.callsSubscribers[key] {
+		select {
+		case <-subscriber.done:
+			continue
+		default:
+		}
+		subscriber.publish(args)
+		subscribers = append(subscribers, subscriber)
+	}
+	if len(subscribers) > 0 {
+		fake.callsSubscribers[key] = subscribers
+	} else {
+		delete(fake.callsSubscribers, key)
+	}
+}
+
+func (fake *FakeCollidingResetHelpers) forgetInvocations(key string) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	delete(fake.invocations, key)
+	for _, subscriber := range fake.callsSubscribers[key] {
+		subscriber.cancel()
+	}
+	delete(fake.callsSubscribers, key)
+	var orderedInvocations []struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}
+	for _, invocation := range fake.orderedInvocations {
+		if invocation.Method != key {
+			orderedInvocations = append(orderedInvocations, invocation)
+		}
+	}
+	fake.orderedInvocations = orderedInvocations
+}
+
+var _ fixtures.CollidingResetHelpers = new(FakeCollidingResetHelpers)
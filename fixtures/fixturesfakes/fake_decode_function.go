@@ -0,0 +1,363 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package fixturesfakes
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+	"sync/atomic"
+
+	"github.com/maxbrunsfeld/counterfeiter/v6/fixtures"
+)
+
+type FakeDecodeFunction struct {
+	Stub        func([]byte, interface{}) error
+	mutex       sync.RWMutex
+	argsForCall []struct {
+		arg1 []byte
+		arg2 interface{}
+	}
+	returns struct {
+		result1 error
+	}
+	returnsOnCall map[int]struct {
+		result1 error
+	}
+	returnsForArgs []struct {
+		args    []interface{}
+		result1 error
+	}
+	setsArgs           map[int]interface{}
+	ArgsComparer       func(expected interface{}, actual interface{}) bool
+	invocations        map[string][][]interface{}
+	orderedInvocations []struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}
+	sequencer          *atomic.Uint64
+	invocationsChanged chan struct{}
+	callsSubscribers   []struct {
+		publish func(args []interface{})
+		cancel  context.CancelFunc
+		done    <-chan struct{}
+	}
+	invocationsMutex sync.RWMutex
+}
+
+type FakeDecodeFunctionCall struct {
+	Data []byte
+	V    interface{}
+}
+
+func (fake *FakeDecodeFunction) Spy(arg1 []byte, arg2 interface{}) error {
+	var arg1Copy []byte
+	if arg1 != nil {
+		arg1Copy = make([]byte, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.mutex.Lock()
+	ret, specificReturn := fake.returnsOnCall[len(fake.argsForCall)]
+	fake.argsForCall = append(fake.argsForCall, struct {
+		arg1 []byte
+		arg2 interface{}
+	}{arg1Copy, arg2})
+	stub := fake.Stub
+	setsArgs := fake.setsArgs
+	returnsForArgs := fake.returnsForArgs
+	returns := fake.returns
+	fake.recordInvocation("DecodeFunction", []interface{}{arg1Copy, arg2})
+	fake.mutex.Unlock()
+	if len(setsArgs) > 0 {
+		args := []interface{}{arg1, arg2}
+		fake.setArgs("DecodeFunction", setsArgs, args)
+	}
+	for i := len(returnsForArgs) - 1; i >= 0; i-- {
+		if fake.argsMatch(returnsForArgs[i].args, []interface{}{arg1, arg2}) {
+			return returnsForArgs[i].result1
+		}
+	}
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return returns.result1
+}
+
+func (fake *FakeDecodeFunction) CallCount() int {
+	fake.mutex.RLock()
+	defer fake.mutex.RUnlock()
+	return len(fake.argsForCall)
+}
+
+func (fake *FakeDecodeFunction) WaitForCalls(ctx context.Context, n int) error {
+	for {
+		fake.invocationsMutex.Lock()
+		if fake.invocationsChanged == nil {
+			fake.invocationsChanged = make(chan struct{})
+		}
+		changed := fake.invocationsChanged
+		fake.invocationsMutex.Unlock()
+		if fake.CallCount() >= n {
+			return nil
+		}
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (fake *FakeDecodeFunction) CallsChan(ctx context.Context) <-chan []interface{} {
+	ctx, cancel := context.WithCancel(ctx)
+	calls := make(chan []interface{})
+	ready := make(chan struct{}, 1)
+	var pendingMutex sync.Mutex
+	var pending [][]interface{}
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	fake.callsSubscribers = append(fake.callsSubscribers, struct {
+		publish func(args []interface{})
+		cancel  context.CancelFunc
+		done    <-chan struct{}
+	}{func(args []interface{}) {
+		pendingMutex.Lock()
+		pending = append(pending, args)
+		pendingMutex.Unlock()
+		select {
+		case ready <- struct{}{}:
+		default:
+		}
+	}, cancel, ctx.Done()})
+	go func() {
+		defer close(calls)
+		for {
+			select {
+			case <-ready:
+			case <-ctx.Done():
+				return
+			}
+			pendingMutex.Lock()
+			queued := pending
+			pending = nil
+			pendingMutex.Unlock()
+			for _, args := range queued {
+				select {
+				case calls <- args:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return calls
+}
+
+func (fake *FakeDecodeFunction) Calls(stub func([]byte, interface{}) error) {
+	fake.mutex.Lock()
+	defer fake.mutex.Unlock()
+	fake.Stub = stub
+}
+
+func (fake *FakeDecodeFunction) ArgsForCall(i int) ([]byte, interface{}) {
+	fake.mutex.RLock()
+	defer fake.mutex.RUnlock()
+	return fake.argsForCall[i].arg1, fake.argsForCall[i].arg2
+}
+
+func (fake *FakeDecodeFunction) CallHistory() []FakeDecodeFunctionCall {
+	fake.mutex.RLock()
+	defer fake.mutex.RUnlock()
+	history := make([]FakeDecodeFunctionCall, len(fake.argsForCall))
+	for i, argsForCall := range fake.argsForCall {
+		history[i] = FakeDecodeFunctionCall{argsForCall.arg1, argsForCall.arg2}
+	}
+	return history
+}
+
+func (fake *FakeDecodeFunction) SetsArg(i int, value interface{}) {
+	fake.mutex.Lock()
+	defer fake.mutex.Unlock()
+	setsArgs := map[int]interface{}{i: value}
+	for j, v := range fake.setsArgs {
+		if j != i {
+			setsArgs[j] = v
+		}
+	}
+	fake.setsArgs = setsArgs
+}
+
+func (fake *FakeDecodeFunction) Returns(result1 error) {
+	fake.mutex.Lock()
+	defer fake.mutex.Unlock()
+	fake.Stub = nil
+	fake.returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDecodeFunction) ReturnsOnCall(i int, result1 error) {
+	fake.mutex.Lock()
+	defer fake.mutex.Unlock()
+	fake.Stub = nil
+	if fake.returnsOnCall == nil {
+		fake.returnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.returnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+// ReturnsForArgs returns the values for the calls with the given arguments.
+// The arguments are checked before the stub, and the arguments registered last
+// win.
+func (fake *FakeDecodeFunction) ReturnsForArgs(arg1 []byte, arg2 interface{}) func(error) {
+	args := []interface{}{arg1, arg2}
+	return func(result1 error) {
+		fake.mutex.Lock()
+		defer fake.mutex.Unlock()
+		fake.Stub = nil
+		fake.returnsForArgs = append(fake.returnsForArgs, struct {
+			args    []interface{}
+			result1 error
+		}{args, result1})
+	}
+}
+
+func (fake *FakeDecodeFunction) Reset() {
+	fake.ResetCalls()
+	fake.ResetStubs()
+}
+
+func (fake *FakeDecodeFunction) ResetCalls() {
+	fake.mutex.Lock()
+	fake.argsForCall = nil
+	fake.mutex.Unlock()
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	fake.invocations = nil
+	fake.orderedInvocations = nil
+	for _, subscriber := range fake.callsSubscribers {
+		subscriber.cancel()
+	}
+	fake.callsSubscribers = nil
+}
+
+func (fake *FakeDecodeFunction) ResetStubs() {
+	fake.mutex.Lock()
+	defer fake.mutex.Unlock()
+	fake.Stub = nil
+	fake.setsArgs = nil
+	fake.returns = struct {
+		result1 error
+	}{}
+	fake.returnsOnCall = nil
+	fake.returnsForArgs = nil
+}
+
+func (fake *FakeDecodeFunction) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.mutex.RLock()
+	defer fake.mutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeDecodeFunction) OrderedInvocations() []struct {
+	Seq    uint64
+	Method string
+	Args   []interface{}
+} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	return append([]struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}{}, fake.orderedInvocations...)
+}
+
+func (fake *FakeDecodeFunction) SetSequencer(sequencer *atomic.Uint64) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	fake.sequencer = sequencer
+}
+
+func (fake *FakeDecodeFunction) setArgs(method string, values map[int]interface{}, args []interface{}) {
+	for i, value := range values {
+		if i < 0 || i >= len(args) {
+			panic(fmt.Sprintf("FakeDecodeFunction.%s: cannot set argument %d, because it was called with %d arguments", method, i, len(args)))
+		}
+		target := reflect.ValueOf(args[i])
+		if target.Kind() != reflect.Ptr || target.IsNil() {
+			panic(fmt.Sprintf("FakeDecodeFunction.%s: cannot set argument %d, because it is %T instead of a non-nil pointer", method, i, args[i]))
+		}
+		v := reflect.ValueOf(value)
+		if !v.IsValid() {
+			v = reflect.Zero(target.Elem().Type())
+		}
+		if !v.Type().AssignableTo(target.Elem().Type()) {
+			panic(fmt.Sprintf("FakeDecodeFunction.%s: cannot set argument %d, because %T is not assignable to %s", method, i, value, target.Elem().Type()))
+		}
+		target.Elem().Set(v)
+	}
+}
+
+func (fake *FakeDecodeFunction) argsMatch(expected []interface{}, actual []interface{}) bool {
+	if len(expected) != len(actual) {
+		return false
+	}
+	for i := range expected {
+		if fake.ArgsComparer != nil && !fake.ArgsComparer(expected[i], actual[i]) || fake.ArgsComparer == nil && !reflect.DeepEqual(expected[i], actual[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (fake *FakeDecodeFunction) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+	if fake.sequencer == nil {
+		fake.sequencer = new(atomic.Uint64)
+	}
+	fake.orderedInvocations = append(fake.orderedInvocations, struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}{fake.sequencer.Add(1), key, args})
+	if fake.invocationsChanged != nil {
+		close(fake.invocationsChanged)
+		fake.invocationsChanged = nil
+	}
+	subscribers := fake.callsSubscribers[:0]
+	for _, subscriber := range fake.callsSubscribers {
+		select {
+		case <-subscriber.done:
+			continue
+		default:
+		}
+		subscriber.publish(args)
+		subscribers = append(subscribers, subscriber)
+	}
+	fake.callsSubscribers = subscribers
+}
+
+var _ fixtures.DecodeFunction = new(FakeDecodeFunction).Spy
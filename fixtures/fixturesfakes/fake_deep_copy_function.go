@@ -0,0 +1,399 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package fixturesfakes
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"sync/atomic"
+
+	"github.com/maxbrunsfeld/counterfeiter/v6/fixtures"
+)
+
+type FakeDeepCopyFunction struct {
+	Stub        func([]fixtures.Order) error
+	mutex       sync.RWMutex
+	argsForCall []struct {
+		arg1 []fixtures.Order
+	}
+	returns struct {
+		result1 error
+	}
+	returnsOnCall map[int]struct {
+		result1 error
+	}
+	returnsForArgs []struct {
+		args    []interface{}
+		result1 error
+	}
+	DeepCopyFallback   func(v interface{}) interface{}
+	ArgsComparer       func(expected interface{}, actual interface{}) bool
+	invocations        map[string][][]interface{}
+	orderedInvocations []struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}
+	sequencer          *atomic.Uint64
+	invocationsChanged chan struct{}
+	callsSubscribers   []struct {
+		publish func(args []interface{})
+		cancel  context.CancelFunc
+		done    <-chan struct{}
+	}
+	invocationsMutex sync.RWMutex
+}
+
+type FakeDeepCopyFunctionCall struct {
+	Arg1 []fixtures.Order
+}
+
+func (fake *FakeDeepCopyFunction) Spy(arg1 []fixtures.Order) error {
+	arg1Copy := fake.deepCopy1(arg1, map[interface{}]interface{}{})
+	fake.mutex.Lock()
+	ret, specificReturn := fake.returnsOnCall[len(fake.argsForCall)]
+	fake.argsForCall = append(fake.argsForCall, struct {
+		arg1 []fixtures.Order
+	}{arg1Copy})
+	stub := fake.Stub
+	returnsForArgs := fake.returnsForArgs
+	returns := fake.returns
+	fake.recordInvocation("DeepCopyFunction", []interface{}{arg1Copy})
+	fake.mutex.Unlock()
+	for i := len(returnsForArgs) - 1; i >= 0; i-- {
+		if fake.argsMatch(returnsForArgs[i].args, []interface{}{arg1}) {
+			return returnsForArgs[i].result1
+		}
+	}
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return returns.result1
+}
+
+func (fake *FakeDeepCopyFunction) CallCount() int {
+	fake.mutex.RLock()
+	defer fake.mutex.RUnlock()
+	return len(fake.argsForCall)
+}
+
+func (fake *FakeDeepCopyFunction) WaitForCalls(ctx context.Context, n int) error {
+	for {
+		fake.invocationsMutex.Lock()
+		if fake.invocationsChanged == nil {
+			fake.invocationsChanged = make(chan struct{})
+		}
+		changed := fake.invocationsChanged
+		fake.invocationsMutex.Unlock()
+		if fake.CallCount() >= n {
+			return nil
+		}
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (fake *FakeDeepCopyFunction) CallsChan(ctx context.Context) <-chan []interface{} {
+	ctx, cancel := context.WithCancel(ctx)
+	calls := make(chan []interface{})
+	ready := make(chan struct{}, 1)
+	var pendingMutex sync.Mutex
+	var pending [][]interface{}
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	fake.callsSubscribers = append(fake.callsSubscribers, struct {
+		publish func(args []interface{})
+		cancel  context.CancelFunc
+		done    <-chan struct{}
+	}{func(args []interface{}) {
+		pendingMutex.Lock()
+		pending = append(pending, args)
+		pendingMutex.Unlock()
+		select {
+		case ready <- struct{}{}:
+		default:
+		}
+	}, cancel, ctx.Done()})
+	go func() {
+		defer close(calls)
+		for {
+			select {
+			case <-ready:
+			case <-ctx.Done():
+				return
+			}
+			pendingMutex.Lock()
+			queued := pending
+			pending = nil
+			pendingMutex.Unlock()
+			for _, args := range queued {
+				select {
+				case calls <- args:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return calls
+}
+
+func (fake *FakeDeepCopyFunction) Calls(stub func([]fixtures.Order) error) {
+	fake.mutex.Lock()
+	defer fake.mutex.Unlock()
+	fake.Stub = stub
+}
+
+func (fake *FakeDeepCopyFunction) ArgsForCall(i int) []fixtures.Order {
+	fake.mutex.RLock()
+	defer fake.mutex.RUnlock()
+	return fake.argsForCall[i].arg1
+}
+
+func (fake *FakeDeepCopyFunction) CallHistory() []FakeDeepCopyFunctionCall {
+	fake.mutex.RLock()
+	defer fake.mutex.RUnlock()
+	history := make([]FakeDeepCopyFunctionCall, len(fake.argsForCall))
+	for i, argsForCall := range fake.argsForCall {
+		history[i] = FakeDeepCopyFunctionCall{argsForCall.arg1}
+	}
+	return history
+}
+
+func (fake *FakeDeepCopyFunction) Returns(result1 error) {
+	fake.mutex.Lock()
+	defer fake.mutex.Unlock()
+	fake.Stub = nil
+	fake.returns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDeepCopyFunction) ReturnsOnCall(i int, result1 error) {
+	fake.mutex.Lock()
+	defer fake.mutex.Unlock()
+	fake.Stub = nil
+	if fake.returnsOnCall == nil {
+		fake.returnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.returnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+// ReturnsForArgs returns the values for the calls with the given arguments.
+// The arguments are checked before the stub, and the arguments registered last
+// win.
+func (fake *FakeDeepCopyFunction) ReturnsForArgs(arg1 []fixtures.Order) func(error) {
+	args := []interface{}{arg1}
+	return func(result1 error) {
+		fake.mutex.Lock()
+		defer fake.mutex.Unlock()
+		fake.Stub = nil
+		fake.returnsForArgs = append(fake.returnsForArgs, struct {
+			args    []interface{}
+			result1 error
+		}{args, result1})
+	}
+}
+
+func (fake *FakeDeepCopyFunction) Reset() {
+	fake.ResetCalls()
+	fake.ResetStubs()
+}
+
+func (fake *FakeDeepCopyFunction) ResetCalls() {
+	fake.mutex.Lock()
+	fake.argsForCall = nil
+	fake.mutex.Unlock()
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	fake.invocations = nil
+	fake.orderedInvocations = nil
+	for _, subscriber := range fake.callsSubscribers {
+		subscriber.cancel()
+	}
+	fake.callsSubscribers = nil
+}
+
+func (fake *FakeDeepCopyFunction) ResetStubs() {
+	fake.mutex.Lock()
+	defer fake.mutex.Unlock()
+	fake.Stub = nil
+	fake.returns = struct {
+		result1 error
+	}{}
+	fake.returnsOnCall = nil
+	fake.returnsForArgs = nil
+}
+
+func (fake *FakeDeepCopyFunction) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.mutex.RLock()
+	defer fake.mutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeDeepCopyFunction) OrderedInvocations() []struct {
+	Seq    uint64
+	Method string
+	Args   []interface{}
+} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	return append([]struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}{}, fake.orderedInvocations...)
+}
+
+func (fake *FakeDeepCopyFunction) SetSequencer(sequencer *atomic.Uint64) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	fake.sequencer = sequencer
+}
+
+func (fake *FakeDeepCopyFunction) argsMatch(expected []interface{}, actual []interface{}) bool {
+	if len(expected) != len(actual) {
+		return false
+	}
+	for i := range expected {
+		if fake.ArgsComparer != nil && !fake.ArgsComparer(expected[i], actual[i]) || fake.ArgsComparer == nil && !reflect.DeepEqual(expected[i], actual[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (fake *FakeDeepCopyFunction) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+	if fake.sequencer == nil {
+		fake.sequencer = new(atomic.Uint64)
+	}
+	fake.orderedInvocations = append(fake.orderedInvocations, struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}{fake.sequencer.Add(1), key, args})
+	if fake.invocationsChanged != nil {
+		close(fake.invocationsChanged)
+		fake.invocationsChanged = nil
+	}
+	subscribers := fake.callsSubscribers[:0]
+	for _, subscriber := range fake.callsSubscribers {
+		select {
+		case <-subscriber.done:
+			continue
+		default:
+		}
+		subscriber.publish(args)
+		subscribers = append(subscribers, subscriber)
+	}
+	fake.callsSubscribers = subscribers
+}
+
+func (fake *FakeDeepCopyFunction) deepCopy1(v []fixtures.Order, copies map[interface{}]interface{}) []fixtures.Order {
+	if v == nil {
+		return nil
+	}
+	c := make([]fixtures.Order, len(v))
+	for i := range v {
+		c[i] = fake.deepCopy2(v[i], copies)
+	}
+	return c
+}
+
+func (fake *FakeDeepCopyFunction) deepCopy2(v fixtures.Order, copies map[interface{}]interface{}) fixtures.Order {
+	c := v
+	c.Lines = fake.deepCopy3(v.Lines, copies)
+	c.Tags = fake.deepCopy6(v.Tags, copies)
+	c.Next = fake.deepCopy8(v.Next, copies)
+	return c
+}
+
+func (fake *FakeDeepCopyFunction) deepCopy3(v []fixtures.OrderLine, copies map[interface{}]interface{}) []fixtures.OrderLine {
+	if v == nil {
+		return nil
+	}
+	c := make([]fixtures.OrderLine, len(v))
+	for i := range v {
+		c[i] = fake.deepCopy4(v[i], copies)
+	}
+	return c
+}
+
+func (fake *FakeDeepCopyFunction) deepCopy4(v fixtures.OrderLine, copies map[interface{}]interface{}) fixtures.OrderLine {
+	c := v
+	c.Quantity = fake.deepCopy5(v.Quantity, copies)
+	return c
+}
+
+func (fake *FakeDeepCopyFunction) deepCopy5(v *int, copies map[interface{}]interface{}) *int {
+	if v == nil {
+		return nil
+	}
+	if c, ok := copies[v]; ok {
+		return c.(*int)
+	}
+	c := new(int)
+	copies[v] = c
+	*c = *v
+	return c
+}
+
+func (fake *FakeDeepCopyFunction) deepCopy6(v map[string][]string, copies map[interface{}]interface{}) map[string][]string {
+	if v == nil {
+		return nil
+	}
+	c := make(map[string][]string, len(v))
+	for k, e := range v {
+		c[k] = fake.deepCopy7(e, copies)
+	}
+	return c
+}
+
+func (fake *FakeDeepCopyFunction) deepCopy7(v []string, copies map[interface{}]interface{}) []string {
+	if v == nil {
+		return nil
+	}
+	c := make([]string, len(v))
+	copy(c, v)
+	return c
+}
+
+func (fake *FakeDeepCopyFunction) deepCopy8(v *fixtures.Order, copies map[interface{}]interface{}) *fixtures.Order {
+	if v == nil {
+		return nil
+	}
+	if c, ok := copies[v]; ok {
+		return c.(*fixtures.Order)
+	}
+	c := new(fixtures.Order)
+	copies[v] = c
+	*c = fake.deepCopy2(*v, copies)
+	return c
+}
+
+var _ fixtures.DeepCopyFunction = new(FakeDeepCopyFunction).Spy
@@ -0,0 +1,1035 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package fixturesfakes
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"reflect"
+	"sync"
+	"sync/atomic"
+
+	"github.com/maxbrunsfeld/counterfeiter/v6/fixtures"
+)
+
+type FakeDeepCopySomething struct {
+	SaveStub        func(*fixtures.Order, []*fixtures.OrderLine, map[string]fixtures.Receipt) error
+	saveMutex       sync.RWMutex
+	saveArgsForCall []struct {
+		arg1 *fixtures.Order
+		arg2 []*fixtures.OrderLine
+		arg3 map[string]fixtures.Receipt
+	}
+	saveWhen []struct {
+		matcher func(*fixtures.Order, []*fixtures.OrderLine, map[string]fixtures.Receipt) bool
+		stub    func(*fixtures.Order, []*fixtures.OrderLine, map[string]fixtures.Receipt) error
+	}
+	saveSetsArgs map[int]interface{}
+	saveReturns  struct {
+		result1 error
+	}
+	saveReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SettleStub        func(*fixtures.Ledger, *sync.WaitGroup, []*fixtures.Ledger)
+	settleMutex       sync.RWMutex
+	settleArgsForCall []struct {
+		arg1 *fixtures.Ledger
+		arg2 *sync.WaitGroup
+		arg3 []*fixtures.Ledger
+	}
+	settleWhen []struct {
+		matcher func(*fixtures.Ledger, *sync.WaitGroup, []*fixtures.Ledger) bool
+		stub    func(*fixtures.Ledger, *sync.WaitGroup, []*fixtures.Ledger)
+	}
+	settleSetsArgs map[int]interface{}
+	TagStub        func(string, ...[]string)
+	tagMutex       sync.RWMutex
+	tagArgsForCall []struct {
+		arg1 string
+		arg2 [][]string
+	}
+	tagWhen []struct {
+		matcher func(string, ...[]string) bool
+		stub    func(string, ...[]string)
+	}
+	WriteStub        func(io.Writer, [2][]byte, func()) error
+	writeMutex       sync.RWMutex
+	writeArgsForCall []struct {
+		arg1 io.Writer
+		arg2 [2][]byte
+		arg3 func()
+	}
+	writeWhen []struct {
+		matcher func(io.Writer, [2][]byte, func()) bool
+		stub    func(io.Writer, [2][]byte, func()) error
+	}
+	writeReturns struct {
+		result1 error
+	}
+	writeReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeepCopyFallback   func(v interface{}) interface{}
+	ArgsComparer       func(expected interface{}, actual interface{}) bool
+	invocations        map[string][][]interface{}
+	orderedInvocations []struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}
+	sequencer          *atomic.Uint64
+	invocationsChanged chan struct{}
+	callsSubscribers   map[string][]struct {
+		publish func(args []interface{})
+		cancel  context.CancelFunc
+		done    <-chan struct{}
+	}
+	invocationsMutex sync.RWMutex
+}
+
+type FakeDeepCopySomethingSaveCall struct {
+	Arg1 *fixtures.Order
+	Arg2 []*fixtures.OrderLine
+	Arg3 map[string]fixtures.Receipt
+}
+
+func (fake *FakeDeepCopySomething) Save(arg1 *fixtures.Order, arg2 []*fixtures.OrderLine, arg3 map[string]fixtures.Receipt) error {
+	arg1Copy := fake.deepCopy1(arg1, map[interface{}]interface{}{})
+	arg2Copy := fake.deepCopy8(arg2, map[interface{}]interface{}{})
+	arg3Copy := fake.deepCopy10(arg3, map[interface{}]interface{}{})
+	fake.saveMutex.Lock()
+	ret, specificReturn := fake.saveReturnsOnCall[len(fake.saveArgsForCall)]
+	fake.saveArgsForCall = append(fake.saveArgsForCall, struct {
+		arg1 *fixtures.Order
+		arg2 []*fixtures.OrderLine
+		arg3 map[string]fixtures.Receipt
+	}{arg1Copy, arg2Copy, arg3Copy})
+	stub := fake.SaveStub
+	whens := fake.saveWhen
+	setsArgs := fake.saveSetsArgs
+	fakeReturns := fake.saveReturns
+	fake.recordInvocation("Save", []interface{}{arg1Copy, arg2Copy, arg3Copy})
+	fake.saveMutex.Unlock()
+	if len(setsArgs) > 0 {
+		args := []interface{}{arg1, arg2, arg3}
+		fake.setArgs("Save", setsArgs, args)
+	}
+	for i := len(whens) - 1; i >= 0; i-- {
+		if whens[i].matcher(arg1, arg2, arg3) {
+			return whens[i].stub(arg1, arg2, arg3)
+		}
+	}
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeDeepCopySomething) SaveCallCount() int {
+	fake.saveMutex.RLock()
+	defer fake.saveMutex.RUnlock()
+	return len(fake.saveArgsForCall)
+}
+
+func (fake *FakeDeepCopySomething) WaitForSaveCalls(ctx context.Context, n int) error {
+	return fake.waitForCalls(ctx, fake.SaveCallCount, n)
+}
+
+func (fake *FakeDeepCopySomething) SaveCallsChan(ctx context.Context) <-chan []interface{} {
+	return fake.callsChan(ctx, "Save")
+}
+
+func (fake *FakeDeepCopySomething) SaveCalls(stub func(*fixtures.Order, []*fixtures.OrderLine, map[string]fixtures.Receipt) error) {
+	fake.saveMutex.Lock()
+	defer fake.saveMutex.Unlock()
+	fake.SaveStub = stub
+}
+
+func (fake *FakeDeepCopySomething) SaveSetsArg(i int, value interface{}) {
+	fake.saveMutex.Lock()
+	defer fake.saveMutex.Unlock()
+	setsArgs := map[int]interface{}{i: value}
+	for j, v := range fake.saveSetsArgs {
+		if j != i {
+			setsArgs[j] = v
+		}
+	}
+	fake.saveSetsArgs = setsArgs
+}
+
+// SaveCallsWhen calls the stub for the calls whose arguments match.
+// Matchers are checked before the stub, and the matcher registered last wins.
+func (fake *FakeDeepCopySomething) SaveCallsWhen(matcher func(*fixtures.Order, []*fixtures.OrderLine, map[string]fixtures.Receipt) bool, stub func(*fixtures.Order, []*fixtures.OrderLine, map[string]fixtures.Receipt) error) {
+	fake.saveMutex.Lock()
+	defer fake.saveMutex.Unlock()
+	fake.saveWhen = append(fake.saveWhen, struct {
+		matcher func(*fixtures.Order, []*fixtures.OrderLine, map[string]fixtures.Receipt) bool
+		stub    func(*fixtures.Order, []*fixtures.OrderLine, map[string]fixtures.Receipt) error
+	}{matcher, stub})
+}
+
+func (fake *FakeDeepCopySomething) SaveArgsForCall(i int) (*fixtures.Order, []*fixtures.OrderLine, map[string]fixtures.Receipt) {
+	fake.saveMutex.RLock()
+	defer fake.saveMutex.RUnlock()
+	argsForCall := fake.saveArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDeepCopySomething) SaveCallHistory() []FakeDeepCopySomethingSaveCall {
+	fake.saveMutex.RLock()
+	defer fake.saveMutex.RUnlock()
+	history := make([]FakeDeepCopySomethingSaveCall, len(fake.saveArgsForCall))
+	for i, argsForCall := range fake.saveArgsForCall {
+		history[i] = FakeDeepCopySomethingSaveCall{argsForCall.arg1, argsForCall.arg2, argsForCall.arg3}
+	}
+	return history
+}
+
+func (fake *FakeDeepCopySomething) SaveReturns(result1 error) {
+	fake.saveMutex.Lock()
+	defer fake.saveMutex.Unlock()
+	fake.SaveStub = nil
+	fake.saveReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDeepCopySomething) SaveReturnsOnCall(i int, result1 error) {
+	fake.saveMutex.Lock()
+	defer fake.saveMutex.Unlock()
+	fake.SaveStub = nil
+	if fake.saveReturnsOnCall == nil {
+		fake.saveReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.saveReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+// SaveReturnsForArgs returns the values for the calls with the given
+// arguments. Like SaveCallsWhen, it is checked before the stub, and the
+// arguments or matcher registered last win.
+func (fake *FakeDeepCopySomething) SaveReturnsForArgs(arg1 *fixtures.Order, arg2 []*fixtures.OrderLine, arg3 map[string]fixtures.Receipt) func(error) {
+	args := []interface{}{arg1, arg2, arg3}
+	return func(result1 error) {
+		fake.saveMutex.Lock()
+		defer fake.saveMutex.Unlock()
+		fake.SaveStub = nil
+		fake.saveWhen = append(fake.saveWhen, struct {
+			matcher func(*fixtures.Order, []*fixtures.OrderLine, map[string]fixtures.Receipt) bool
+			stub    func(*fixtures.Order, []*fixtures.OrderLine, map[string]fixtures.Receipt) error
+		}{
+			func(arg1 *fixtures.Order, arg2 []*fixtures.OrderLine, arg3 map[string]fixtures.Receipt) bool {
+				return fake.argsMatch(args, []interface{}{arg1, arg2, arg3})
+			},
+			func(*fixtures.Order, []*fixtures.OrderLine, map[string]fixtures.Receipt) error {
+				return result1
+			},
+		})
+	}
+}
+
+// SaveReturnsWhen returns the values for the calls whose arguments
+// match. Like SaveCallsWhen, it is checked before the stub, and the
+// matcher registered last wins.
+func (fake *FakeDeepCopySomething) SaveReturnsWhen(matcher func(*fixtures.Order, []*fixtures.OrderLine, map[string]fixtures.Receipt) bool, result1 error) {
+	fake.SaveCallsWhen(matcher, func(*fixtures.Order, []*fixtures.OrderLine, map[string]fixtures.Receipt) error {
+		return result1
+	})
+}
+
+func (fake *FakeDeepCopySomething) ResetSave() {
+	fake.ResetSaveCalls()
+	fake.ResetSaveStubs()
+}
+
+func (fake *FakeDeepCopySomething) ResetSaveCalls() {
+	fake.saveMutex.Lock()
+	defer fake.saveMutex.Unlock()
+	fake.saveArgsForCall = nil
+	fake.forgetInvocations("Save")
+}
+
+func (fake *FakeDeepCopySomething) ResetSaveStubs() {
+	fake.saveMutex.Lock()
+	defer fake.saveMutex.Unlock()
+	fake.SaveStub = nil
+	fake.saveWhen = nil
+	fake.saveSetsArgs = nil
+	fake.saveReturns = struct {
+		result1 error
+	}{}
+	fake.saveReturnsOnCall = nil
+}
+
+type FakeDeepCopySomethingSettleCall struct {
+	Arg1 *fixtures.Ledger
+	Arg2 *sync.WaitGroup
+	Arg3 []*fixtures.Ledger
+}
+
+func (fake *FakeDeepCopySomething) Settle(arg1 *fixtures.Ledger, arg2 *sync.WaitGroup, arg3 []*fixtures.Ledger) {
+	arg1Copy := fake.deepCopy12(arg1, map[interface{}]interface{}{})
+	arg2Copy := fake.deepCopy13(arg2, map[interface{}]interface{}{})
+	arg3Copy := fake.deepCopy14(arg3, map[interface{}]interface{}{})
+	fake.settleMutex.Lock()
+	fake.settleArgsForCall = append(fake.settleArgsForCall, struct {
+		arg1 *fixtures.Ledger
+		arg2 *sync.WaitGroup
+		arg3 []*fixtures.Ledger
+	}{arg1Copy, arg2Copy, arg3Copy})
+	stub := fake.SettleStub
+	whens := fake.settleWhen
+	setsArgs := fake.settleSetsArgs
+	fake.recordInvocation("Settle", []interface{}{arg1Copy, arg2Copy, arg3Copy})
+	fake.settleMutex.Unlock()
+	if len(setsArgs) > 0 {
+		args := []interface{}{arg1, arg2, arg3}
+		fake.setArgs("Settle", setsArgs, args)
+	}
+	for i := len(whens) - 1; i >= 0; i-- {
+		if whens[i].matcher(arg1, arg2, arg3) {
+			whens[i].stub(arg1, arg2, arg3)
+			return
+		}
+	}
+	if stub != nil {
+		fake.SettleStub(arg1, arg2, arg3)
+	}
+}
+
+func (fake *FakeDeepCopySomething) SettleCallCount() int {
+	fake.settleMutex.RLock()
+	defer fake.settleMutex.RUnlock()
+	return len(fake.settleArgsForCall)
+}
+
+func (fake *FakeDeepCopySomething) WaitForSettleCalls(ctx context.Context, n int) error {
+	return fake.waitForCalls(ctx, fake.SettleCallCount, n)
+}
+
+func (fake *FakeDeepCopySomething) SettleCallsChan(ctx context.Context) <-chan []interface{} {
+	return fake.callsChan(ctx, "Settle")
+}
+
+func (fake *FakeDeepCopySomething) SettleCalls(stub func(*fixtures.Ledger, *sync.WaitGroup, []*fixtures.Ledger)) {
+	fake.settleMutex.Lock()
+	defer fake.settleMutex.Unlock()
+	fake.SettleStub = stub
+}
+
+func (fake *FakeDeepCopySomething) SettleSetsArg(i int, value interface{}) {
+	fake.settleMutex.Lock()
+	defer fake.settleMutex.Unlock()
+	setsArgs := map[int]interface{}{i: value}
+	for j, v := range fake.settleSetsArgs {
+		if j != i {
+			setsArgs[j] = v
+		}
+	}
+	fake.settleSetsArgs = setsArgs
+}
+
+// SettleCallsWhen calls the stub for the calls whose arguments match.
+// Matchers are checked before the stub, and the matcher registered last wins.
+func (fake *FakeDeepCopySomething) SettleCallsWhen(matcher func(*fixtures.Ledger, *sync.WaitGroup, []*fixtures.Ledger) bool, stub func(*fixtures.Ledger, *sync.WaitGroup, []*fixtures.Ledger)) {
+	fake.settleMutex.Lock()
+	defer fake.settleMutex.Unlock()
+	fake.settleWhen = append(fake.settleWhen, struct {
+		matcher func(*fixtures.Ledger, *sync.WaitGroup, []*fixtures.Ledger) bool
+		stub    func(*fixtures.Ledger, *sync.WaitGroup, []*fixtures.Ledger)
+	}{matcher, stub})
+}
+
+func (fake *FakeDeepCopySomething) SettleArgsForCall(i int) (*fixtures.Ledger, *sync.WaitGroup, []*fixtures.Ledger) {
+	fake.settleMutex.RLock()
+	defer fake.settleMutex.RUnlock()
+	argsForCall := fake.settleArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDeepCopySomething) SettleCallHistory() []FakeDeepCopySomethingSettleCall {
+	fake.settleMutex.RLock()
+	defer fake.settleMutex.RUnlock()
+	history := make([]FakeDeepCopySomethingSettleCall, len(fake.settleArgsForCall))
+	for i, argsForCall := range fake.settleArgsForCall {
+		history[i] = FakeDeepCopySomethingSettleCall{argsForCall.arg1, argsForCall.arg2, argsForCall.arg3}
+	}
+	return history
+}
+
+func (fake *FakeDeepCopySomething) ResetSettle() {
+	fake.ResetSettleCalls()
+	fake.ResetSettleStubs()
+}
+
+func (fake *FakeDeepCopySomething) ResetSettleCalls() {
+	fake.settleMutex.Lock()
+	defer fake.settleMutex.Unlock()
+	fake.settleArgsForCall = nil
+	fake.forgetInvocations("Settle")
+}
+
+func (fake *FakeDeepCopySomething) ResetSettleStubs() {
+	fake.settleMutex.Lock()
+	defer fake.settleMutex.Unlock()
+	fake.SettleStub = nil
+	fake.settleWhen = nil
+	fake.settleSetsArgs = nil
+}
+
+type FakeDeepCopySomethingTagCall struct {
+	Arg1 string
+	Arg2 [][]string
+}
+
+func (fake *FakeDeepCopySomething) Tag(arg1 string, arg2 ...[]string) {
+	arg2Copy := fake.deepCopy15(arg2, map[interface{}]interface{}{})
+	fake.tagMutex.Lock()
+	fake.tagArgsForCall = append(fake.tagArgsForCall, struct {
+		arg1 string
+		arg2 [][]string
+	}{arg1, arg2Copy})
+	stub := fake.TagStub
+	whens := fake.tagWhen
+	fake.recordInvocation("Tag", []interface{}{arg1, arg2Copy})
+	fake.tagMutex.Unlock()
+	for i := len(whens) - 1; i >= 0; i-- {
+		if whens[i].matcher(arg1, arg2...) {
+			whens[i].stub(arg1, arg2...)
+			return
+		}
+	}
+	if stub != nil {
+		fake.TagStub(arg1, arg2...)
+	}
+}
+
+func (fake *FakeDeepCopySomething) TagCallCount() int {
+	fake.tagMutex.RLock()
+	defer fake.tagMutex.RUnlock()
+	return len(fake.tagArgsForCall)
+}
+
+func (fake *FakeDeepCopySomething) WaitForTagCalls(ctx context.Context, n int) error {
+	return fake.waitForCalls(ctx, fake.TagCallCount, n)
+}
+
+func (fake *FakeDeepCopySomething) TagCallsChan(ctx context.Context) <-chan []interface{} {
+	return fake.callsChan(ctx, "Tag")
+}
+
+func (fake *FakeDeepCopySomething) TagCalls(stub func(string, ...[]string)) {
+	fake.tagMutex.Lock()
+	defer fake.tagMutex.Unlock()
+	fake.TagStub = stub
+}
+
+// TagCallsWhen calls the stub for the calls whose arguments match.
+// Matchers are checked before the stub, and the matcher registered last wins.
+func (fake *FakeDeepCopySomething) TagCallsWhen(matcher func(string, ...[]string) bool, stub func(string, ...[]string)) {
+	fake.tagMutex.Lock()
+	defer fake.tagMutex.Unlock()
+	fake.tagWhen = append(fake.tagWhen, struct {
+		matcher func(string, ...[]string) bool
+		stub    func(string, ...[]string)
+	}{matcher, stub})
+}
+
+func (fake *FakeDeepCopySomething) TagArgsForCall(i int) (string, [][]string) {
+	fake.tagMutex.RLock()
+	defer fake.tagMutex.RUnlock()
+	argsForCall := fake.tagArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeDeepCopySomething) TagCallHistory() []FakeDeepCopySomethingTagCall {
+	fake.tagMutex.RLock()
+	defer fake.tagMutex.RUnlock()
+	history := make([]FakeDeepCopySomethingTagCall, len(fake.tagArgsForCall))
+	for i, argsForCall := range fake.tagArgsForCall {
+		history[i] = FakeDeepCopySomethingTagCall{argsForCall.arg1, argsForCall.arg2}
+	}
+	return history
+}
+
+func (fake *FakeDeepCopySomething) ResetTag() {
+	fake.ResetTagCalls()
+	fake.ResetTagStubs()
+}
+
+func (fake *FakeDeepCopySomething) ResetTagCalls() {
+	fake.tagMutex.Lock()
+	defer fake.tagMutex.Unlock()
+	fake.tagArgsForCall = nil
+	fake.forgetInvocations("Tag")
+}
+
+func (fake *FakeDeepCopySomething) ResetTagStubs() {
+	fake.tagMutex.Lock()
+	defer fake.tagMutex.Unlock()
+	fake.TagStub = nil
+	fake.tagWhen = nil
+}
+
+type FakeDeepCopySomethingWriteCall struct {
+	Arg1 io.Writer
+	Arg2 [2][]byte
+	Arg3 func()
+}
+
+func (fake *FakeDeepCopySomething) Write(arg1 io.Writer, arg2 [2][]byte, arg3 func()) error {
+	arg1Copy := fake.deepCopy16(arg1, map[interface{}]interface{}{})
+	arg2Copy := fake.deepCopy17(arg2, map[interface{}]interface{}{})
+	fake.writeMutex.Lock()
+	ret, specificReturn := fake.writeReturnsOnCall[len(fake.writeArgsForCall)]
+	fake.writeArgsForCall = append(fake.writeArgsForCall, struct {
+		arg1 io.Writer
+		arg2 [2][]byte
+		arg3 func()
+	}{arg1Copy, arg2Copy, arg3})
+	stub := fake.WriteStub
+	whens := fake.writeWhen
+	fakeReturns := fake.writeReturns
+	fake.recordInvocation("Write", []interface{}{arg1Copy, arg2Copy, arg3})
+	fake.writeMutex.Unlock()
+	for i := len(whens) - 1; i >= 0; i-- {
+		if whens[i].matcher(arg1, arg2, arg3) {
+			return whens[i].stub(arg1, arg2, arg3)
+		}
+	}
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeDeepCopySomething) WriteCallCount() int {
+	fake.writeMutex.RLock()
+	defer fake.writeMutex.RUnlock()
+	return len(fake.writeArgsForCall)
+}
+
+func (fake *FakeDeepCopySomething) WaitForWriteCalls(ctx context.Context, n int) error {
+	return fake.waitForCalls(ctx, fake.WriteCallCount, n)
+}
+
+func (fake *FakeDeepCopySomething) WriteCallsChan(ctx context.Context) <-chan []interface{} {
+	return fake.callsChan(ctx, "Write")
+}
+
+func (fake *FakeDeepCopySomething) WriteCalls(stub func(io.Writer, [2][]byte, func()) error) {
+	fake.writeMutex.Lock()
+	defer fake.writeMutex.Unlock()
+	fake.WriteStub = stub
+}
+
+// WriteCallsWhen calls the stub for the calls whose arguments match.
+// Matchers are checked before the stub, and the matcher registered last wins.
+func (fake *FakeDeepCopySomething) WriteCallsWhen(matcher func(io.Writer, [2][]byte, func()) bool, stub func(io.Writer, [2][]byte, func()) error) {
+	fake.writeMutex.Lock()
+	defer fake.writeMutex.Unlock()
+	fake.writeWhen = append(fake.writeWhen, struct {
+		matcher func(io.Writer, [2][]byte, func()) bool
+		stub    func(io.Writer, [2][]byte, func()) error
+	}{matcher, stub})
+}
+
+func (fake *FakeDeepCopySomething) WriteArgsForCall(i int) (io.Writer, [2][]byte, func()) {
+	fake.writeMutex.RLock()
+	defer fake.writeMutex.RUnlock()
+	argsForCall := fake.writeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDeepCopySomething) WriteCallHistory() []FakeDeepCopySomethingWriteCall {
+	fake.writeMutex.RLock()
+	defer fake.writeMutex.RUnlock()
+	history := make([]FakeDeepCopySomethingWriteCall, len(fake.writeArgsForCall))
+	for i, argsForCall := range fake.writeArgsForCall {
+		history[i] = FakeDeepCopySomethingWriteCall{argsForCall.arg1, argsForCall.arg2, argsForCall.arg3}
+	}
+	return history
+}
+
+func (fake *FakeDeepCopySomething) WriteReturns(result1 error) {
+	fake.writeMutex.Lock()
+	defer fake.writeMutex.Unlock()
+	fake.WriteStub = nil
+	fake.writeReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDeepCopySomething) WriteReturnsOnCall(i int, result1 error) {
+	fake.writeMutex.Lock()
+	defer fake.writeMutex.Unlock()
+	fake.WriteStub = nil
+	if fake.writeReturnsOnCall == nil {
+		fake.writeReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.writeReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+// WriteReturnsForArgs returns the values for the calls with the given
+// arguments. Like WriteCallsWhen, it is checked before the stub, and the
+// arguments or matcher registered last win.
+func (fake *FakeDeepCopySomething) WriteReturnsForArgs(arg1 io.Writer, arg2 [2][]byte, arg3 func()) func(error) {
+	args := []interface{}{arg1, arg2, arg3}
+	return func(result1 error) {
+		fake.writeMutex.Lock()
+		defer fake.writeMutex.Unlock()
+		fake.WriteStub = nil
+		fake.writeWhen = append(fake.writeWhen, struct {
+			matcher func(io.Writer, [2][]byte, func()) bool
+			stub    func(io.Writer, [2][]byte, func()) error
+		}{
+			func(arg1 io.Writer, arg2 [2][]byte, arg3 func()) bool {
+				return fake.argsMatch(args, []interface{}{arg1, arg2, arg3})
+			},
+			func(io.Writer, [2][]byte, func()) error {
+				return result1
+			},
+		})
+	}
+}
+
+// WriteReturnsWhen returns the values for the calls whose arguments
+// match. Like WriteCallsWhen, it is checked before the stub, and the
+// matcher registered last wins.
+func (fake *FakeDeepCopySomething) WriteReturnsWhen(matcher func(io.Writer, [2][]byte, func()) bool, result1 error) {
+	fake.WriteCallsWhen(matcher, func(io.Writer, [2][]byte, func()) error {
+		return result1
+	})
+}
+
+func (fake *FakeDeepCopySomething) ResetWrite() {
+	fake.ResetWriteCalls()
+	fake.ResetWriteStubs()
+}
+
+func (fake *FakeDeepCopySomething) ResetWriteCalls() {
+	fake.writeMutex.Lock()
+	defer fake.writeMutex.Unlock()
+	fake.writeArgsForCall = nil
+	fake.forgetInvocations("Write")
+}
+
+func (fake *FakeDeepCopySomething) ResetWriteStubs() {
+	fake.writeMutex.Lock()
+	defer fake.writeMutex.Unlock()
+	fake.WriteStub = nil
+	fake.writeWhen = nil
+	fake.writeReturns = struct {
+		result1 error
+	}{}
+	fake.writeReturnsOnCall = nil
+}
+
+func (fake *FakeDeepCopySomething) Reset() {
+	fake.ResetCalls()
+	fake.ResetStubs()
+}
+
+func (fake *FakeDeepCopySomething) ResetCalls() {
+	fake.ResetSaveCalls()
+	fake.ResetSettleCalls()
+	fake.ResetTagCalls()
+	fake.ResetWriteCalls()
+}
+
+func (fake *FakeDeepCopySomething) ResetStubs() {
+	fake.ResetSaveStubs()
+	fake.ResetSettleStubs()
+	fake.ResetTagStubs()
+	fake.ResetWriteStubs()
+}
+
+func (fake *FakeDeepCopySomething) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeDeepCopySomething) setArgs(method string, values map[int]interface{}, args []interface{}) {
+	for i, value := range values {
+		if i < 0 || i >= len(args) {
+			panic(fmt.Sprintf("FakeDeepCopySomething.%s: cannot set argument %d, because it was called with %d arguments", method, i, len(args)))
+		}
+		target := reflect.ValueOf(args[i])
+		if target.Kind() != reflect.Ptr || target.IsNil() {
+			panic(fmt.Sprintf("FakeDeepCopySomething.%s: cannot set argument %d, because it is %T instead of a non-nil pointer", method, i, args[i]))
+		}
+		v := reflect.ValueOf(value)
+		if !v.IsValid() {
+			v = reflect.Zero(target.Elem().Type())
+		}
+		if !v.Type().AssignableTo(target.Elem().Type()) {
+			panic(fmt.Sprintf("FakeDeepCopySomething.%s: cannot set argument %d, because %T is not assignable to %s", method, i, value, target.Elem().Type()))
+		}
+		target.Elem().Set(v)
+	}
+}
+
+func (fake *FakeDeepCopySomething) argsMatch(expected []interface{}, actual []interface{}) bool {
+	if len(expected) != len(actual) {
+		return false
+	}
+	for i := range expected {
+		if fake.ArgsComparer != nil && !fake.ArgsComparer(expected[i], actual[i]) || fake.ArgsComparer == nil && !reflect.DeepEqual(expected[i], actual[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (fake *FakeDeepCopySomething) OrderedInvocations() []struct {
+	Seq    uint64
+	Method string
+	Args   []interface{}
+} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	return append([]struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}{}, fake.orderedInvocations...)
+}
+
+func (fake *FakeDeepCopySomething) SetSequencer(sequencer *atomic.Uint64) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	fake.sequencer = sequencer
+}
+
+func (fake *FakeDeepCopySomething) waitForCalls(ctx context.Context, callCount func() int, n int) error {
+	for {
+		fake.invocationsMutex.Lock()
+		if fake.invocationsChanged == nil {
+			fake.invocationsChanged = make(chan struct{})
+		}
+		changed := fake.invocationsChanged
+		fake.invocationsMutex.Unlock()
+		if callCount() >= n {
+			return nil
+		}
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (fake *FakeDeepCopySomething) callsChan(ctx context.Context, key string) <-chan []interface{} {
+	ctx, cancel := context.WithCancel(ctx)
+	calls := make(chan []interface{})
+	ready := make(chan struct{}, 1)
+	var pendingMutex sync.Mutex
+	var pending [][]interface{}
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.callsSubscribers == nil {
+		fake.callsSubscribers = map[string][]struct {
+			publish func(args []interface{})
+			cancel  context.CancelFunc
+			done    <-chan struct{}
+		}{}
+	}
+	fake.callsSubscribers[key] = append(fake.callsSubscribers[key], struct {
+		publish func(args []interface{})
+		cancel  context.CancelFunc
+		done    <-chan struct{}
+	}{func(args []interface{}) {
+		pendingMutex.Lock()
+		pending = append(pending, args)
+		pendingMutex.Unlock()
+		select {
+		case ready <- struct{}{}:
+		default:
+		}
+	}, cancel, ctx.Done()})
+	go func() {
+		defer close(calls)
+		for {
+			select {
+			case <-ready:
+			case <-ctx.Done():
+				return
+			}
+			pendingMutex.Lock()
+			queued := pending
+			pending = nil
+			pendingMutex.Unlock()
+			for _, args := range queued {
+				select {
+				case calls <- args:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return calls
+}
+
+func (fake *FakeDeepCopySomething) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+	if fake.sequencer == nil {
+		fake.sequencer = new(atomic.Uint64)
+	}
+	fake.orderedInvocations = append(fake.orderedInvocations, struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}{fake.sequencer.Add(1), key, args})
+	if fake.invocationsChanged != nil {
+		close(fake.invocationsChanged)
+		fake.invocationsChanged = nil
+	}
+	subscribers := fake.callsSubscribers[key][:0]
+	for _, subscriber := range fake.callsSubscribers[key] {
+		select {
+		case <-subscriber.done:
+			continue
+		default:
+		}
+		subscriber.publish(args)
+		subscribers = append(subscribers, subscriber)
+	}
+	if len(subscribers) > 0 {
+		fake.callsSubscribers[key] = subscribers
+	} else {
+		delete(fake.callsSubscribers, key)
+	}
+}
+
+func (fake *FakeDeepCopySomething) forgetInvocations(key string) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	delete(fake.invocations, key)
+	for _, subscriber := range fake.callsSubscribers[key] {
+		subscriber.cancel()
+	}
+	delete(fake.callsSubscribers, key)
+	var orderedInvocations []struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}
+	for _, invocation := range fake.orderedInvocations {
+		if invocation.Method != key {
+			orderedInvocations = append(orderedInvocations, invocation)
+		}
+	}
+	fake.orderedInvocations = orderedInvocations
+}
+
+func (fake *FakeDeepCopySomething) deepCopy1(v *fixtures.Order, copies map[interface{}]interface{}) *fixtures.Order {
+	if v == nil {
+		return nil
+	}
+	if c, ok := copies[v]; ok {
+		return c.(*fixtures.Order)
+	}
+	c := new(fixtures.Order)
+	copies[v] = c
+	*c = fake.deepCopy2(*v, copies)
+	return c
+}
+
+func (fake *FakeDeepCopySomething) deepCopy2(v fixtures.Order, copies map[interface{}]interface{}) fixtures.Order {
+	c := v
+	c.Lines = fake.deepCopy3(v.Lines, copies)
+	c.Tags = fake.deepCopy6(v.Tags, copies)
+	c.Next = fake.deepCopy1(v.Next, copies)
+	return c
+}
+
+func (fake *FakeDeepCopySomething) deepCopy3(v []fixtures.OrderLine, copies map[interface{}]interface{}) []fixtures.OrderLine {
+	if v == nil {
+		return nil
+	}
+	c := make([]fixtures.OrderLine, len(v))
+	for i := range v {
+		c[i] = fake.deepCopy4(v[i], copies)
+	}
+	return c
+}
+
+func (fake *FakeDeepCopySomething) deepCopy4(v fixtures.OrderLine, copies map[interface{}]interface{}) fixtures.OrderLine {
+	c := v
+	c.Quantity = fake.deepCopy5(v.Quantity, copies)
+	return c
+}
+
+func (fake *FakeDeepCopySomething) deepCopy5(v *int, copies map[interface{}]interface{}) *int {
+	if v == nil {
+		return nil
+	}
+	if c, ok := copies[v]; ok {
+		return c.(*int)
+	}
+	c := new(int)
+	copies[v] = c
+	*c = *v
+	return c
+}
+
+func (fake *FakeDeepCopySomething) deepCopy6(v map[string][]string, copies map[interface{}]interface{}) map[string][]string {
+	if v == nil {
+		return nil
+	}
+	c := make(map[string][]string, len(v))
+	for k, e := range v {
+		c[k] = fake.deepCopy7(e, copies)
+	}
+	return c
+}
+
+func (fake *FakeDeepCopySomething) deepCopy7(v []string, copies map[interface{}]interface{}) []string {
+	if v == nil {
+		return nil
+	}
+	c := make([]string, len(v))
+	copy(c, v)
+	return c
+}
+
+func (fake *FakeDeepCopySomething) deepCopy8(v []*fixtures.OrderLine, copies map[interface{}]interface{}) []*fixtures.OrderLine {
+	if v == nil {
+		return nil
+	}
+	c := make([]*fixtures.OrderLine, len(v))
+	for i := range v {
+		c[i] = fake.deepCopy9(v[i], copies)
+	}
+	return c
+}
+
+func (fake *FakeDeepCopySomething) deepCopy9(v *fixtures.OrderLine, copies map[interface{}]interface{}) *fixtures.OrderLine {
+	if v == nil {
+		return nil
+	}
+	if c, ok := copies[v]; ok {
+		return c.(*fixtures.OrderLine)
+	}
+	c := new(fixtures.OrderLine)
+	copies[v] = c
+	*c = fake.deepCopy4(*v, copies)
+	return c
+}
+
+func (fake *FakeDeepCopySomething) deepCopy10(v map[string]fixtures.Receipt, copies map[interface{}]interface{}) map[string]fixtures.Receipt {
+	if v == nil {
+		return nil
+	}
+	c := make(map[string]fixtures.Receipt, len(v))
+	for k, e := range v {
+		c[k] = fake.deepCopy11(e, copies)
+	}
+	return c
+}
+
+func (fake *FakeDeepCopySomething) deepCopy11(v fixtures.Receipt, copies map[interface{}]interface{}) fixtures.Receipt {
+	if fake.DeepCopyFallback != nil {
+		if c, ok := fake.DeepCopyFallback(v).(fixtures.Receipt); ok {
+			return c
+		}
+	}
+	return v
+}
+
+func (fake *FakeDeepCopySomething) deepCopy12(v *fixtures.Ledger, copies map[interface{}]interface{}) *fixtures.Ledger {
+	if fake.DeepCopyFallback != nil {
+		if c, ok := fake.DeepCopyFallback(v).(*fixtures.Ledger); ok {
+			return c
+		}
+	}
+	return v
+}
+
+func (fake *FakeDeepCopySomething) deepCopy13(v *sync.WaitGroup, copies map[interface{}]interface{}) *sync.WaitGroup {
+	if fake.DeepCopyFallback != nil {
+		if c, ok := fake.DeepCopyFallback(v).(*sync.WaitGroup); ok {
+			return c
+		}
+	}
+	return v
+}
+
+func (fake *FakeDeepCopySomething) deepCopy14(v []*fixtures.Ledger, copies map[interface{}]interface{}) []*fixtures.Ledger {
+	if v == nil {
+		return nil
+	}
+	c := make([]*fixtures.Ledger, len(v))
+	for i := range v {
+		c[i] = fake.deepCopy12(v[i], copies)
+	}
+	return c
+}
+
+func (fake *FakeDeepCopySomething) deepCopy15(v [][]string, copies map[interface{}]interface{}) [][]string {
+	if v == nil {
+		return nil
+	}
+	c := make([][]string, len(v))
+	for i := range v {
+		c[i] = fake.deepCopy7(v[i], copies)
+	}
+	return c
+}
+
+func (fake *FakeDeepCopySomething) deepCopy16(v io.Writer, copies map[interface{}]interface{}) io.Writer {
+	if fake.DeepCopyFallback != nil {
+		if c, ok := fake.DeepCopyFallback(v).(io.Writer); ok {
+			return c
+		}
+	}
+	return v
+}
+
+func (fake *FakeDeepCopySomething) deepCopy17(v [2][]byte, copies map[interface{}]interface{}) [2][]byte {
+	c := v
+	for i := range v {
+		c[i] = fake.deepCopy18(v[i], copies)
+	}
+	return c
+}
+
+func (fake *FakeDeepCopySomething) deepCopy18(v []byte, copies map[interface{}]interface{}) []byte {
+	if v == nil {
+		return nil
+	}
+	c := make([]byte, len(v))
+	copy(c, v)
+	return c
+}
+
+var _ fixtures.DeepCopySomething = new(FakeDeepCopySomething)
@@ -2,8 +2,12 @@
 package fixturesfakes
 
 import (
+	"context"
+	"fmt"
 	"net/http"
+	"reflect"
 	"sync"
+	"sync/atomic"
 
 	"github.com/maxbrunsfeld/counterfeiter/v6/fixtures"
 	"github.com/maxbrunsfeld/counterfeiter/v6/fixtures/another_package"
@@ -19,9 +23,14 @@ type FakeEmbedsInterfaces struct {
 		arg4 another_package.SomeType
 		arg5 chan another_package.SomeType
 	}
-	DoThingsStub        func()
-	doThingsMutex       sync.RWMutex
-	doThingsArgsForCall []struct {
+	anotherMethodWhen []struct {
+		matcher func([]another_package.SomeType, map[another_package.SomeType]another_package.SomeType, *another_package.SomeType, another_package.SomeType, chan another_package.SomeType) bool
+		stub    func([]another_package.SomeType, map[another_package.SomeType]another_package.SomeType, *another_package.SomeType, another_package.SomeType, chan another_package.SomeType)
+	}
+	anotherMethodSetsArgs map[int]interface{}
+	DoThingsStub          func()
+	doThingsMutex         sync.RWMutex
+	doThingsArgsForCall   []struct {
 	}
 	EmbeddedMethodStub        func() string
 	embeddedMethodMutex       sync.RWMutex
@@ -39,10 +48,35 @@ type FakeEmbedsInterfaces struct {
 		arg1 http.ResponseWriter
 		arg2 *http.Request
 	}
-	invocations      map[string][][]interface{}
+	serveHTTPWhen []struct {
+		matcher func(http.ResponseWriter, *http.Request) bool
+		stub    func(http.ResponseWriter, *http.Request)
+	}
+	serveHTTPSetsArgs  map[int]interface{}
+	invocations        map[string][][]interface{}
+	orderedInvocations []struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}
+	sequencer          *atomic.Uint64
+	invocationsChanged chan struct{}
+	callsSubscribers   map[string][]struct {
+		publish func(args []interface{})
+		cancel  context.CancelFunc
+		done    <-chan struct{}
+	}
 	invocationsMutex sync.RWMutex
 }
 
+type FakeEmbedsInterfacesAnotherMethodCall struct {
+	Arg1 []another_package.SomeType
+	Arg2 map[another_package.SomeType]another_package.SomeType
+	Arg3 *another_package.SomeType
+	Arg4 another_package.SomeType
+	Arg5 chan another_package.SomeType
+}
+
 func (fake *FakeEmbedsInterfaces) AnotherMethod(arg1 []another_package.SomeType, arg2 map[another_package.SomeType]another_package.SomeType, arg3 *another_package.SomeType, arg4 another_package.SomeType, arg5 chan another_package.SomeType) {
 	var arg1Copy []another_package.SomeType
 	if arg1 != nil {
@@ -58,8 +92,20 @@ func (fake *FakeEmbedsInterfaces) AnotherMethod(arg1 []another_package.SomeType,
 		arg5 chan another_package.SomeType
 	}{arg1Copy, arg2, arg3, arg4, arg5})
 	stub := fake.AnotherMethodStub
+	whens := fake.anotherMethodWhen
+	setsArgs := fake.anotherMethodSetsArgs
 	fake.recordInvocation("AnotherMethod", []interface{}{arg1Copy, arg2, arg3, arg4, arg5})
 	fake.anotherMethodMutex.Unlock()
+	if len(setsArgs) > 0 {
+		args := []interface{}{arg1, arg2, arg3, arg4, arg5}
+		fake.setArgs("AnotherMethod", setsArgs, args)
+	}
+	for i := len(whens) - 1; i >= 0; i-- {
+		if whens[i].matcher(arg1, arg2, arg3, arg4, arg5) {
+			whens[i].stub(arg1, arg2, arg3, arg4, arg5)
+			return
+		}
+	}
 	if stub != nil {
 		fake.AnotherMethodStub(arg1, arg2, arg3, arg4, arg5)
 	}
@@ -71,12 +117,43 @@ func (fake *FakeEmbedsInterfaces) AnotherMethodCallCount() int {
 	return len(fake.anotherMethodArgsForCall)
 }
 
+func (fake *FakeEmbedsInterfaces) WaitForAnotherMethodCalls(ctx context.Context, n int) error {
+	return fake.waitForCalls(ctx, fake.AnotherMethodCallCount, n)
+}
+
+func (fake *FakeEmbedsInterfaces) AnotherMethodCallsChan(ctx context.Context) <-chan []interface{} {
+	return fake.callsChan(ctx, "AnotherMethod")
+}
+
 func (fake *FakeEmbedsInterfaces) AnotherMethodCalls(stub func([]another_package.SomeType, map[another_package.SomeType]another_package.SomeType, *another_package.SomeType, another_package.SomeType, chan another_package.SomeType)) {
 	fake.anotherMethodMutex.Lock()
 	defer fake.anotherMethodMutex.Unlock()
 	fake.AnotherMethodStub = stub
 }
 
+func (fake *FakeEmbedsInterfaces) AnotherMethodSetsArg(i int, value interface{}) {
+	fake.anotherMethodMutex.Lock()
+	defer fake.anotherMethodMutex.Unlock()
+	setsArgs := map[int]interface{}{i: value}
+	for j, v := range fake.anotherMethodSetsArgs {
+		if j != i {
+			setsArgs[j] = v
+		}
+	}
+	fake.anotherMethodSetsArgs = setsArgs
+}
+
+// AnotherMethodCallsWhen calls the stub for the calls whose arguments match.
+// Matchers are checked before the stub, and the matcher registered last wins.
+func (fake *FakeEmbedsInterfaces) AnotherMethodCallsWhen(matcher func([]another_package.SomeType, map[another_package.SomeType]another_package.SomeType, *another_package.SomeType, another_package.SomeType, chan another_package.SomeType) bool, stub func([]another_package.SomeType, map[another_package.SomeType]another_package.SomeType, *another_package.SomeType, another_package.SomeType, chan another_package.SomeType)) {
+	fake.anotherMethodMutex.Lock()
+	defer fake.anotherMethodMutex.Unlock()
+	fake.anotherMethodWhen = append(fake.anotherMethodWhen, struct {
+		matcher func([]another_package.SomeType, map[another_package.SomeType]another_package.SomeType, *another_package.SomeType, another_package.SomeType, chan another_package.SomeType) bool
+		stub    func([]another_package.SomeType, map[another_package.SomeType]another_package.SomeType, *another_package.SomeType, another_package.SomeType, chan another_package.SomeType)
+	}{matcher, stub})
+}
+
 func (fake *FakeEmbedsInterfaces) AnotherMethodArgsForCall(i int) ([]another_package.SomeType, map[another_package.SomeType]another_package.SomeType, *another_package.SomeType, another_package.SomeType, chan another_package.SomeType) {
 	fake.anotherMethodMutex.RLock()
 	defer fake.anotherMethodMutex.RUnlock()
@@ -84,6 +161,36 @@ func (fake *FakeEmbedsInterfaces) AnotherMethodArgsForCall(i int) ([]another_pac
 	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
 }
 
+func (fake *FakeEmbedsInterfaces) AnotherMethodCallHistory() []FakeEmbedsInterfacesAnotherMethodCall {
+	fake.anotherMethodMutex.RLock()
+	defer fake.anotherMethodMutex.RUnlock()
+	history := make([]FakeEmbedsInterfacesAnotherMethodCall, len(fake.anotherMethodArgsForCall))
+	for i, argsForCall := range fake.anotherMethodArgsForCall {
+		history[i] = FakeEmbedsInterfacesAnotherMethodCall{argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5}
+	}
+	return history
+}
+
+func (fake *FakeEmbedsInterfaces) ResetAnotherMethod() {
+	fake.ResetAnotherMethodCalls()
+	fake.ResetAnotherMethodStubs()
+}
+
+func (fake *FakeEmbedsInterfaces) ResetAnotherMethodCalls() {
+	fake.anotherMethodMutex.Lock()
+	defer fake.anotherMethodMutex.Unlock()
+	fake.anotherMethodArgsForCall = nil
+	fake.forgetInvocations("AnotherMethod")
+}
+
+func (fake *FakeEmbedsInterfaces) ResetAnotherMethodStubs() {
+	fake.anotherMethodMutex.Lock()
+	defer fake.anotherMethodMutex.Unlock()
+	fake.AnotherMethodStub = nil
+	fake.anotherMethodWhen = nil
+	fake.anotherMethodSetsArgs = nil
+}
+
 func (fake *FakeEmbedsInterfaces) DoThings() {
 	fake.doThingsMutex.Lock()
 	fake.doThingsArgsForCall = append(fake.doThingsArgsForCall, struct {
@@ -102,12 +209,38 @@ func (fake *FakeEmbedsInterfaces) DoThingsCallCount() int {
 	return len(fake.doThingsArgsForCall)
 }
 
+func (fake *FakeEmbedsInterfaces) WaitForDoThingsCalls(ctx context.Context, n int) error {
+	return fake.waitForCalls(ctx, fake.DoThingsCallCount, n)
+}
+
+func (fake *FakeEmbedsInterfaces) DoThingsCallsChan(ctx context.Context) <-chan []interface{} {
+	return fake.callsChan(ctx, "DoThings")
+}
+
 func (fake *FakeEmbedsInterfaces) DoThingsCalls(stub func()) {
 	fake.doThingsMutex.Lock()
 	defer fake.doThingsMutex.Unlock()
 	fake.DoThingsStub = stub
 }
 
+func (fake *FakeEmbedsInterfaces) ResetDoThings() {
+	fake.ResetDoThingsCalls()
+	fake.ResetDoThingsStubs()
+}
+
+func (fake *FakeEmbedsInterfaces) ResetDoThingsCalls() {
+	fake.doThingsMutex.Lock()
+	defer fake.doThingsMutex.Unlock()
+	fake.doThingsArgsForCall = nil
+	fake.forgetInvocations("DoThings")
+}
+
+func (fake *FakeEmbedsInterfaces) ResetDoThingsStubs() {
+	fake.doThingsMutex.Lock()
+	defer fake.doThingsMutex.Unlock()
+	fake.DoThingsStub = nil
+}
+
 func (fake *FakeEmbedsInterfaces) EmbeddedMethod() string {
 	fake.embeddedMethodMutex.Lock()
 	ret, specificReturn := fake.embeddedMethodReturnsOnCall[len(fake.embeddedMethodArgsForCall)]
@@ -132,6 +265,14 @@ func (fake *FakeEmbedsInterfaces) EmbeddedMethodCallCount() int {
 	return len(fake.embeddedMethodArgsForCall)
 }
 
+func (fake *FakeEmbedsInterfaces) WaitForEmbeddedMethodCalls(ctx context.Context, n int) error {
+	return fake.waitForCalls(ctx, fake.EmbeddedMethodCallCount, n)
+}
+
+func (fake *FakeEmbedsInterfaces) EmbeddedMethodCallsChan(ctx context.Context) <-chan []interface{} {
+	return fake.callsChan(ctx, "EmbeddedMethod")
+}
+
 func (fake *FakeEmbedsInterfaces) EmbeddedMethodCalls(stub func() string) {
 	fake.embeddedMethodMutex.Lock()
 	defer fake.embeddedMethodMutex.Unlock()
@@ -161,6 +302,33 @@ func (fake *FakeEmbedsInterfaces) EmbeddedMethodReturnsOnCall(i int, result1 str
 	}{result1}
 }
 
+func (fake *FakeEmbedsInterfaces) ResetEmbeddedMethod() {
+	fake.ResetEmbeddedMethodCalls()
+	fake.ResetEmbeddedMethodStubs()
+}
+
+func (fake *FakeEmbedsInterfaces) ResetEmbeddedMethodCalls() {
+	fake.embeddedMethodMutex.Lock()
+	defer fake.embeddedMethodMutex.Unlock()
+	fake.embeddedMethodArgsForCall = nil
+	fake.forgetInvocations("EmbeddedMethod")
+}
+
+func (fake *FakeEmbedsInterfaces) ResetEmbeddedMethodStubs() {
+	fake.embeddedMethodMutex.Lock()
+	defer fake.embeddedMethodMutex.Unlock()
+	fake.EmbeddedMethodStub = nil
+	fake.embeddedMethodReturns = struct {
+		result1 string
+	}{}
+	fake.embeddedMethodReturnsOnCall = nil
+}
+
+type FakeEmbedsInterfacesServeHTTPCall struct {
+	Arg1 http.ResponseWriter
+	Arg2 *http.Request
+}
+
 func (fake *FakeEmbedsInterfaces) ServeHTTP(arg1 http.ResponseWriter, arg2 *http.Request) {
 	fake.serveHTTPMutex.Lock()
 	fake.serveHTTPArgsForCall = append(fake.serveHTTPArgsForCall, struct {
@@ -168,8 +336,20 @@ func (fake *FakeEmbedsInterfaces) ServeHTTP(arg1 http.ResponseWriter, arg2 *http
 		arg2 *http.Request
 	}{arg1, arg2})
 	stub := fake.ServeHTTPStub
+	whens := fake.serveHTTPWhen
+	setsArgs := fake.serveHTTPSetsArgs
 	fake.recordInvocation("ServeHTTP", []interface{}{arg1, arg2})
 	fake.serveHTTPMutex.Unlock()
+	if len(setsArgs) > 0 {
+		args := []interface{}{arg1, arg2}
+		fake.setArgs("ServeHTTP", setsArgs, args)
+	}
+	for i := len(whens) - 1; i >= 0; i-- {
+		if whens[i].matcher(arg1, arg2) {
+			whens[i].stub(arg1, arg2)
+			return
+		}
+	}
 	if stub != nil {
 		fake.ServeHTTPStub(arg1, arg2)
 	}
@@ -181,12 +361,43 @@ func (fake *FakeEmbedsInterfaces) ServeHTTPCallCount() int {
 	return len(fake.serveHTTPArgsForCall)
 }
 
+func (fake *FakeEmbedsInterfaces) WaitForServeHTTPCalls(ctx context.Context, n int) error {
+	return fake.waitForCalls(ctx, fake.ServeHTTPCallCount, n)
+}
+
+func (fake *FakeEmbedsInterfaces) ServeHTTPCallsChan(ctx context.Context) <-chan []interface{} {
+	return fake.callsChan(ctx, "ServeHTTP")
+}
+
 func (fake *FakeEmbedsInterfaces) ServeHTTPCalls(stub func(http.ResponseWriter, *http.Request)) {
 	fake.serveHTTPMutex.Lock()
 	defer fake.serveHTTPMutex.Unlock()
 	fake.ServeHTTPStub = stub
 }
 
+func (fake *FakeEmbedsInterfaces) ServeHTTPSetsArg(i int, value interface{}) {
+	fake.serveHTTPMutex.Lock()
+	defer fake.serveHTTPMutex.Unlock()
+	setsArgs := map[int]interface{}{i: value}
+	for j, v := range fake.serveHTTPSetsArgs {
+		if j != i {
+			setsArgs[j] = v
+		}
+	}
+	fake.serveHTTPSetsArgs = setsArgs
+}
+
+// ServeHTTPCallsWhen calls the stub for the calls whose arguments match.
+// Matchers are checked before the stub, and the matcher registered last wins.
+func (fake *FakeEmbedsInterfaces) ServeHTTPCallsWhen(matcher func(http.ResponseWriter, *http.Request) bool, stub func(http.ResponseWriter, *http.Request)) {
+	fake.serveHTTPMutex.Lock()
+	defer fake.serveHTTPMutex.Unlock()
+	fake.serveHTTPWhen = append(fake.serveHTTPWhen, struct {
+		matcher func(http.ResponseWriter, *http.Request) bool
+		stub    func(http.ResponseWriter, *http.Request)
+	}{matcher, stub})
+}
+
 func (fake *FakeEmbedsInterfaces) ServeHTTPArgsForCall(i int) (http.ResponseWriter, *http.Request) {
 	fake.serveHTTPMutex.RLock()
 	defer fake.serveHTTPMutex.RUnlock()
@@ -194,6 +405,55 @@ func (fake *FakeEmbedsInterfaces) ServeHTTPArgsForCall(i int) (http.ResponseWrit
 	return argsForCall.arg1, argsForCall.arg2
 }
 
+func (fake *FakeEmbedsInterfaces) ServeHTTPCallHistory() []FakeEmbedsInterfacesServeHTTPCall {
+	fake.serveHTTPMutex.RLock()
+	defer fake.serveHTTPMutex.RUnlock()
+	history := make([]FakeEmbedsInterfacesServeHTTPCall, len(fake.serveHTTPArgsForCall))
+	for i, argsForCall := range fake.serveHTTPArgsForCall {
+		history[i] = FakeEmbedsInterfacesServeHTTPCall{argsForCall.arg1, argsForCall.arg2}
+	}
+	return history
+}
+
+func (fake *FakeEmbedsInterfaces) ResetServeHTTP() {
+	fake.ResetServeHTTPCalls()
+	fake.ResetServeHTTPStubs()
+}
+
+func (fake *FakeEmbedsInterfaces) ResetServeHTTPCalls() {
+	fake.serveHTTPMutex.Lock()
+	defer fake.serveHTTPMutex.Unlock()
+	fake.serveHTTPArgsForCall = nil
+	fake.forgetInvocations("ServeHTTP")
+}
+
+func (fake *FakeEmbedsInterfaces) ResetServeHTTPStubs() {
+	fake.serveHTTPMutex.Lock()
+	defer fake.serveHTTPMutex.Unlock()
+	fake.ServeHTTPStub = nil
+	fake.serveHTTPWhen = nil
+	fake.serveHTTPSetsArgs = nil
+}
+
+func (fake *FakeEmbedsInterfaces) Reset() {
+	fake.ResetCalls()
+	fake.ResetStubs()
+}
+
+func (fake *FakeEmbedsInterfaces) ResetCalls() {
+	fake.ResetAnotherMethodCalls()
+	fake.ResetDoThingsCalls()
+	fake.ResetEmbeddedMethodCalls()
+	fake.ResetServeHTTPCalls()
+}
+
+func (fake *FakeEmbedsInterfaces) ResetStubs() {
+	fake.ResetAnotherMethodStubs()
+	fake.ResetDoThingsStubs()
+	fake.ResetEmbeddedMethodStubs()
+	fake.ResetServeHTTPStubs()
+}
+
 func (fake *FakeEmbedsInterfaces) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -204,6 +464,117 @@ func (fake *FakeEmbedsInterfaces) Invocations() map[string][][]interface{} {
 	return copiedInvocations
 }
 
+func (fake *FakeEmbedsInterfaces) setArgs(method string, values map[int]interface{}, args []interface{}) {
+	for i, value := range values {
+		if i < 0 || i >= len(args) {
+			panic(fmt.Sprintf("FakeEmbedsInterfaces.%s: cannot set argument %d, because it was called with %d arguments", method, i, len(args)))
+		}
+		target := reflect.ValueOf(args[i])
+		if target.Kind() != reflect.Ptr || target.IsNil() {
+			panic(fmt.Sprintf("FakeEmbedsInterfaces.%s: cannot set argument %d, because it is %T instead of a non-nil pointer", method, i, args[i]))
+		}
+		v := reflect.ValueOf(value)
+		if !v.IsValid() {
+			v = reflect.Zero(target.Elem().Type())
+		}
+		if !v.Type().AssignableTo(target.Elem().Type()) {
+			panic(fmt.Sprintf("FakeEmbedsInterfaces.%s: cannot set argument %d, because %T is not assignable to %s", method, i, value, target.Elem().Type()))
+		}
+		target.Elem().Set(v)
+	}
+}
+
+func (fake *FakeEmbedsInterfaces) OrderedInvocations() []struct {
+	Seq    uint64
+	Method string
+	Args   []interface{}
+} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	return append([]struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}{}, fake.orderedInvocations...)
+}
+
+func (fake *FakeEmbedsInterfaces) SetSequencer(sequencer *atomic.Uint64) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	fake.sequencer = sequencer
+}
+
+func (fake *FakeEmbedsInterfaces) waitForCalls(ctx context.Context, callCount func() int, n int) error {
+	for {
+		fake.invocationsMutex.Lock()
+		if fake.invocationsChanged == nil {
+			fake.invocationsChanged = make(chan struct{})
+		}
+		changed := fake.invocationsChanged
+		fake.invocationsMutex.Unlock()
+		if callCount() >= n {
+			return nil
+		}
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (fake *FakeEmbedsInterfaces) callsChan(ctx context.Context, key string) <-chan []interface{} {
+	ctx, cancel := context.WithCancel(ctx)
+	calls := make(chan []interface{})
+	ready := make(chan struct{}, 1)
+	var pendingMutex sync.Mutex
+	var pending [][]interface{}
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.callsSubscribers == nil {
+		fake.callsSubscribers = map[string][]struct {
+			publish func(args []interface{})
+			cancel  context.CancelFunc
+			done    <-chan struct{}
+		}{}
+	}
+	fake.callsSubscribers[key] = append(fake.callsSubscribers[key], struct {
+		publish func(args []interface{})
+		cancel  context.CancelFunc
+		done    <-chan struct{}
+	}{func(args []interface{}) {
+		pendingMutex.Lock()
+		pending = append(pending, args)
+		pendingMutex.Unlock()
+		select {
+		case ready <- struct{}{}:
+		default:
+		}
+	}, cancel, ctx.Done()})
+	go func() {
+		defer close(calls)
+		for {
+			select {
+			case <-ready:
+			case <-ctx.Done():
+				return
+			}
+			pendingMutex.Lock()
+			queued := pending
+			pending = nil
+			pendingMutex.Unlock()
+			for _, args := range queued {
+				select {
+				case calls <- args:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return calls
+}
+
 func (fake *FakeEmbedsInterfaces) recordInvocation(key string, args []interface{}) {
 	fake.invocationsMutex.Lock()
 	defer fake.invocationsMutex.Unlock()
@@ -214,6 +585,54 @@ func (fake *FakeEmbedsInterfaces) recordInvocation(key string, args []interface{
 		fake.invocations[key] = [][]interface{}{}
 	}
 	fake.invocations[key] = append(fake.invocations[key], args)
+	if fake.sequencer == nil {
+		fake.sequencer = new(atomic.Uint64)
+	}
+	fake.orderedInvocations = append(fake.orderedInvocations, struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}{fake.sequencer.Add(1), key, args})
+	if fake.invocationsChanged != nil {
+		close(fake.invocationsChanged)
+		fake.invocationsChanged = nil
+	}
+	subscribers := fake.callsSubscribers[key][:0]
+	for _, subscriber := range fake.callsSubscribers[key] {
+		select {
+		case <-subscriber.done:
+			continue
+		default:
+		}
+		subscriber.publish(args)
+		subscribers = append(subscribers, subscriber)
+	}
+	if len(subscribers) > 0 {
+		fake.callsSubscribers[key] = subscribers
+	} else {
+		delete(fake.callsSubscribers, key)
+	}
+}
+
+func (fake *FakeEmbedsInterfaces) forgetInvocations(key string) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	delete(fake.invocations, key)
+	for _, subscriber := range fake.callsSubscribers[key] {
+		subscriber.cancel()
+	}
+	delete(fake.callsSubscribers, key)
+	var orderedInvocations []struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}
+	for _, invocation := range fake.orderedInvocations {
+		if invocation.Method != key {
+			orderedInvocations = append(orderedInvocations, invocation)
+		}
+	}
+	fake.orderedInvocations = orderedInvocations
 }
 
 var _ fixtures.EmbedsInterfaces = new(FakeEmbedsInterfaces)
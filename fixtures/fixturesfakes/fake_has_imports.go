@@ -2,10 +2,14 @@
 package fixturesfakes
 
 import (
+	"context"
+	"fmt"
 	"io"
 	"net/http"
 	"os"
+	"reflect"
 	"sync"
+	"sync/atomic"
 
 	"github.com/maxbrunsfeld/counterfeiter/v6/fixtures"
 )
@@ -17,16 +21,39 @@ type FakeHasImports struct {
 		arg1 io.Writer
 		arg2 *os.File
 	}
-	doThingsReturns struct {
+	doThingsWhen []struct {
+		matcher func(io.Writer, *os.File) bool
+		stub    func(io.Writer, *os.File) *http.Client
+	}
+	doThingsSetsArgs map[int]interface{}
+	doThingsReturns  struct {
 		result1 *http.Client
 	}
 	doThingsReturnsOnCall map[int]struct {
 		result1 *http.Client
 	}
-	invocations      map[string][][]interface{}
+	ArgsComparer       func(expected interface{}, actual interface{}) bool
+	invocations        map[string][][]interface{}
+	orderedInvocations []struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}
+	sequencer          *atomic.Uint64
+	invocationsChanged chan struct{}
+	callsSubscribers   map[string][]struct {
+		publish func(args []interface{})
+		cancel  context.CancelFunc
+		done    <-chan struct{}
+	}
 	invocationsMutex sync.RWMutex
 }
 
+type FakeHasImportsDoThingsCall struct {
+	Arg1 io.Writer
+	Arg2 *os.File
+}
+
 func (fake *FakeHasImports) DoThings(arg1 io.Writer, arg2 *os.File) *http.Client {
 	fake.doThingsMutex.Lock()
 	ret, specificReturn := fake.doThingsReturnsOnCall[len(fake.doThingsArgsForCall)]
@@ -35,9 +62,20 @@ func (fake *FakeHasImports) DoThings(arg1 io.Writer, arg2 *os.File) *http.Client
 		arg2 *os.File
 	}{arg1, arg2})
 	stub := fake.DoThingsStub
+	whens := fake.doThingsWhen
+	setsArgs := fake.doThingsSetsArgs
 	fakeReturns := fake.doThingsReturns
 	fake.recordInvocation("DoThings", []interface{}{arg1, arg2})
 	fake.doThingsMutex.Unlock()
+	if len(setsArgs) > 0 {
+		args := []interface{}{arg1, arg2}
+		fake.setArgs("DoThings", setsArgs, args)
+	}
+	for i := len(whens) - 1; i >= 0; i-- {
+		if whens[i].matcher(arg1, arg2) {
+			return whens[i].stub(arg1, arg2)
+		}
+	}
 	if stub != nil {
 		return stub(arg1, arg2)
 	}
@@ -53,12 +91,43 @@ func (fake *FakeHasImports) DoThingsCallCount() int {
 	return len(fake.doThingsArgsForCall)
 }
 
+func (fake *FakeHasImports) WaitForDoThingsCalls(ctx context.Context, n int) error {
+	return fake.waitForCalls(ctx, fake.DoThingsCallCount, n)
+}
+
+func (fake *FakeHasImports) DoThingsCallsChan(ctx context.Context) <-chan []interface{} {
+	return fake.callsChan(ctx, "DoThings")
+}
+
 func (fake *FakeHasImports) DoThingsCalls(stub func(io.Writer, *os.File) *http.Client) {
 	fake.doThingsMutex.Lock()
 	defer fake.doThingsMutex.Unlock()
 	fake.DoThingsStub = stub
 }
 
+func (fake *FakeHasImports) DoThingsSetsArg(i int, value interface{}) {
+	fake.doThingsMutex.Lock()
+	defer fake.doThingsMutex.Unlock()
+	setsArgs := map[int]interface{}{i: value}
+	for j, v := range fake.doThingsSetsArgs {
+		if j != i {
+			setsArgs[j] = v
+		}
+	}
+	fake.doThingsSetsArgs = setsArgs
+}
+
+// DoThingsCallsWhen calls the stub for the calls whose arguments match.
+// Matchers are checked before the stub, and the matcher registered last wins.
+func (fake *FakeHasImports) DoThingsCallsWhen(matcher func(io.Writer, *os.File) bool, stub func(io.Writer, *os.File) *http.Client) {
+	fake.doThingsMutex.Lock()
+	defer fake.doThingsMutex.Unlock()
+	fake.doThingsWhen = append(fake.doThingsWhen, struct {
+		matcher func(io.Writer, *os.File) bool
+		stub    func(io.Writer, *os.File) *http.Client
+	}{matcher, stub})
+}
+
 func (fake *FakeHasImports) DoThingsArgsForCall(i int) (io.Writer, *os.File) {
 	fake.doThingsMutex.RLock()
 	defer fake.doThingsMutex.RUnlock()
@@ -66,6 +135,16 @@ func (fake *FakeHasImports) DoThingsArgsForCall(i int) (io.Writer, *os.File) {
 	return argsForCall.arg1, argsForCall.arg2
 }
 
+func (fake *FakeHasImports) DoThingsCallHistory() []FakeHasImportsDoThingsCall {
+	fake.doThingsMutex.RLock()
+	defer fake.doThingsMutex.RUnlock()
+	history := make([]FakeHasImportsDoThingsCall, len(fake.doThingsArgsForCall))
+	for i, argsForCall := range fake.doThingsArgsForCall {
+		history[i] = FakeHasImportsDoThingsCall{argsForCall.arg1, argsForCall.arg2}
+	}
+	return history
+}
+
 func (fake *FakeHasImports) DoThingsReturns(result1 *http.Client) {
 	fake.doThingsMutex.Lock()
 	defer fake.doThingsMutex.Unlock()
@@ -89,6 +168,75 @@ func (fake *FakeHasImports) DoThingsReturnsOnCall(i int, result1 *http.Client) {
 	}{result1}
 }
 
+// DoThingsReturnsForArgs returns the values for the calls with the given
+// arguments. Like DoThingsCallsWhen, it is checked before the stub, and the
+// arguments or matcher registered last win.
+func (fake *FakeHasImports) DoThingsReturnsForArgs(arg1 io.Writer, arg2 *os.File) func(*http.Client) {
+	args := []interface{}{arg1, arg2}
+	return func(result1 *http.Client) {
+		fake.doThingsMutex.Lock()
+		defer fake.doThingsMutex.Unlock()
+		fake.DoThingsStub = nil
+		fake.doThingsWhen = append(fake.doThingsWhen, struct {
+			matcher func(io.Writer, *os.File) bool
+			stub    func(io.Writer, *os.File) *http.Client
+		}{
+			func(arg1 io.Writer, arg2 *os.File) bool {
+				return fake.argsMatch(args, []interface{}{arg1, arg2})
+			},
+			func(io.Writer, *os.File) *http.Client {
+				return result1
+			},
+		})
+	}
+}
+
+// DoThingsReturnsWhen returns the values for the calls whose arguments
+// match. Like DoThingsCallsWhen, it is checked before the stub, and the
+// matcher registered last wins.
+func (fake *FakeHasImports) DoThingsReturnsWhen(matcher func(io.Writer, *os.File) bool, result1 *http.Client) {
+	fake.DoThingsCallsWhen(matcher, func(io.Writer, *os.File) *http.Client {
+		return result1
+	})
+}
+
+func (fake *FakeHasImports) ResetDoThings() {
+	fake.ResetDoThingsCalls()
+	fake.ResetDoThingsStubs()
+}
+
+func (fake *FakeHasImports) ResetDoThingsCalls() {
+	fake.doThingsMutex.Lock()
+	defer fake.doThingsMutex.Unlock()
+	fake.doThingsArgsForCall = nil
+	fake.forgetInvocations("DoThings")
+}
+
+func (fake *FakeHasImports) ResetDoThingsStubs() {
+	fake.doThingsMutex.Lock()
+	defer fake.doThingsMutex.Unlock()
+	fake.DoThingsStub = nil
+	fake.doThingsWhen = nil
+	fake.doThingsSetsArgs = nil
+	fake.doThingsReturns = struct {
+		result1 *http.Client
+	}{}
+	fake.doThingsReturnsOnCall = nil
+}
+
+func (fake *FakeHasImports) Reset() {
+	fake.ResetCalls()
+	fake.ResetStubs()
+}
+
+func (fake *FakeHasImports) ResetCalls() {
+	fake.ResetDoThingsCalls()
+}
+
+func (fake *FakeHasImports) ResetStubs() {
+	fake.ResetDoThingsStubs()
+}
+
 func (fake *FakeHasImports) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -99,6 +247,129 @@ func (fake *FakeHasImports) Invocations() map[string][][]interface{} {
 	return copiedInvocations
 }
 
+func (fake *FakeHasImports) setArgs(method string, values map[int]interface{}, args []interface{}) {
+	for i, value := range values {
+		if i < 0 || i >= len(args) {
+			panic(fmt.Sprintf("FakeHasImports.%s: cannot set argument %d, because it was called with %d arguments", method, i, len(args)))
+		}
+		target := reflect.ValueOf(args[i])
+		if target.Kind() != reflect.Ptr || target.IsNil() {
+			panic(fmt.Sprintf("FakeHasImports.%s: cannot set argument %d, because it is %T instead of a non-nil pointer", method, i, args[i]))
+		}
+		v := reflect.ValueOf(value)
+		if !v.IsValid() {
+			v = reflect.Zero(target.Elem().Type())
+		}
+		if !v.Type().AssignableTo(target.Elem().Type()) {
+			panic(fmt.Sprintf("FakeHasImports.%s: cannot set argument %d, because %T is not assignable to %s", method, i, value, target.Elem().Type()))
+		}
+		target.Elem().Set(v)
+	}
+}
+
+func (fake *FakeHasImports) argsMatch(expected []interface{}, actual []interface{}) bool {
+	if len(expected) != len(actual) {
+		return false
+	}
+	for i := range expected {
+		if fake.ArgsComparer != nil && !fake.ArgsComparer(expected[i], actual[i]) || fake.ArgsComparer == nil && !reflect.DeepEqual(expected[i], actual[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (fake *FakeHasImports) OrderedInvocations() []struct {
+	Seq    uint64
+	Method string
+	Args   []interface{}
+} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	return append([]struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}{}, fake.orderedInvocations...)
+}
+
+func (fake *FakeHasImports) SetSequencer(sequencer *atomic.Uint64) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	fake.sequencer = sequencer
+}
+
+func (fake *FakeHasImports) waitForCalls(ctx context.Context, callCount func() int, n int) error {
+	for {
+		fake.invocationsMutex.Lock()
+		if fake.invocationsChanged == nil {
+			fake.invocationsChanged = make(chan struct{})
+		}
+		changed := fake.invocationsChanged
+		fake.invocationsMutex.Unlock()
+		if callCount() >= n {
+			return nil
+		}
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (fake *FakeHasImports) callsChan(ctx context.Context, key string) <-chan []interface{} {
+	ctx, cancel := context.WithCancel(ctx)
+	calls := make(chan []interface{})
+	ready := make(chan struct{}, 1)
+	var pendingMutex sync.Mutex
+	var pending [][]interface{}
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.callsSubscribers == nil {
+		fake.callsSubscribers = map[string][]struct {
+			publish func(args []interface{})
+			cancel  context.CancelFunc
+			done    <-chan struct{}
+		}{}
+	}
+	fake.callsSubscribers[key] = append(fake.callsSubscribers[key], struct {
+		publish func(args []interface{})
+		cancel  context.CancelFunc
+		done    <-chan struct{}
+	}{func(args []interface{}) {
+		pendingMutex.Lock()
+		pending = append(pending, args)
+		pendingMutex.Unlock()
+		select {
+		case ready <- struct{}{}:
+		default:
+		}
+	}, cancel, ctx.Done()})
+	go func() {
+		defer close(calls)
+		for {
+			select {
+			case <-ready:
+			case <-ctx.Done():
+				return
+			}
+			pendingMutex.Lock()
+			queued := pending
+			pending = nil
+			pendingMutex.Unlock()
+			for _, args := range queued {
+				select {
+				case calls <- args:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return calls
+}
+
 func (fake *FakeHasImports) recordInvocation(key string, args []interface{}) {
 	fake.invocationsMutex.Lock()
 	defer fake.invocationsMutex.Unlock()
@@ -109,6 +380,54 @@ func (fake *FakeHasImports) recordInvocation(key string, args []interface{}) {
 		fake.invocations[key] = [][]interface{}{}
 	}
 	fake.invocations[key] = append(fake.invocations[key], args)
+	if fake.sequencer == nil {
+		fake.sequencer = new(atomic.Uint64)
+	}
+	fake.orderedInvocations = append(fake.orderedInvocations, struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}{fake.sequencer.Add(1), key, args})
+	if fake.invocationsChanged != nil {
+		close(fake.invocationsChanged)
+		fake.invocationsChanged = nil
+	}
+	subscribers := fake.callsSubscribers[key][:0]
+	for _, subscriber := range fake.callsSubscribers[key] {
+		select {
+		case <-subscriber.done:
+			continue
+		default:
+		}
+		subscriber.publish(args)
+		subscribers = append(subscribers, subscriber)
+	}
+	if len(subscribers) > 0 {
+		fake.callsSubscribers[key] = subscribers
+	} else {
+		delete(fake.callsSubscribers, key)
+	}
+}
+
+func (fake *FakeHasImports) forgetInvocations(key string) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	delete(fake.invocations, key)
+	for _, subscriber := range fake.callsSubscribers[key] {
+		subscriber.cancel()
+	}
+	delete(fake.callsSubscribers, key)
+	var orderedInvocations []struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}
+	for _, invocation := range fake.orderedInvocations {
+		if invocation.Method != key {
+			orderedInvocations = append(orderedInvocations, invocation)
+		}
+	}
+	fake.orderedInvocations = orderedInvocations
 }
 
 var _ fixtures.HasImports = new(FakeHasImports)
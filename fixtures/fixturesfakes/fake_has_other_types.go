@@ -2,7 +2,10 @@
 package fixturesfakes
 
 import (
+	"context"
+	"reflect"
 	"sync"
+	"sync/atomic"
 
 	"github.com/maxbrunsfeld/counterfeiter/v6/fixtures"
 )
@@ -13,16 +16,37 @@ type FakeHasOtherTypes struct {
 	getThingArgsForCall []struct {
 		arg1 fixtures.SomeString
 	}
+	getThingWhen []struct {
+		matcher func(fixtures.SomeString) bool
+		stub    func(fixtures.SomeString) fixtures.SomeFunc
+	}
 	getThingReturns struct {
 		result1 fixtures.SomeFunc
 	}
 	getThingReturnsOnCall map[int]struct {
 		result1 fixtures.SomeFunc
 	}
-	invocations      map[string][][]interface{}
+	ArgsComparer       func(expected interface{}, actual interface{}) bool
+	invocations        map[string][][]interface{}
+	orderedInvocations []struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}
+	sequencer          *atomic.Uint64
+	invocationsChanged chan struct{}
+	callsSubscribers   map[string][]struct {
+		publish func(args []interface{})
+		cancel  context.CancelFunc
+		done    <-chan struct{}
+	}
 	invocationsMutex sync.RWMutex
 }
 
+type FakeHasOtherTypesGetThingCall struct {
+	Arg1 fixtures.SomeString
+}
+
 func (fake *FakeHasOtherTypes) GetThing(arg1 fixtures.SomeString) fixtures.SomeFunc {
 	fake.getThingMutex.Lock()
 	ret, specificReturn := fake.getThingReturnsOnCall[len(fake.getThingArgsForCall)]
@@ -30,9 +54,15 @@ func (fake *FakeHasOtherTypes) GetThing(arg1 fixtures.SomeString) fixtures.SomeF
 		arg1 fixtures.SomeString
 	}{arg1})
 	stub := fake.GetThingStub
+	whens := fake.getThingWhen
 	fakeReturns := fake.getThingReturns
 	fake.recordInvocation("GetThing", []interface{}{arg1})
 	fake.getThingMutex.Unlock()
+	for i := len(whens) - 1; i >= 0; i-- {
+		if whens[i].matcher(arg1) {
+			return whens[i].stub(arg1)
+		}
+	}
 	if stub != nil {
 		return stub(arg1)
 	}
@@ -48,12 +78,31 @@ func (fake *FakeHasOtherTypes) GetThingCallCount() int {
 	return len(fake.getThingArgsForCall)
 }
 
+func (fake *FakeHasOtherTypes) WaitForGetThingCalls(ctx context.Context, n int) error {
+	return fake.waitForCalls(ctx, fake.GetThingCallCount, n)
+}
+
+func (fake *FakeHasOtherTypes) GetThingCallsChan(ctx context.Context) <-chan []interface{} {
+	return fake.callsChan(ctx, "GetThing")
+}
+
 func (fake *FakeHasOtherTypes) GetThingCalls(stub func(fixtures.SomeString) fixtures.SomeFunc) {
 	fake.getThingMutex.Lock()
 	defer fake.getThingMutex.Unlock()
 	fake.GetThingStub = stub
 }
 
+// GetThingCallsWhen calls the stub for the calls whose arguments match.
+// Matchers are checked before the stub, and the matcher registered last wins.
+func (fake *FakeHasOtherTypes) GetThingCallsWhen(matcher func(fixtures.SomeString) bool, stub func(fixtures.SomeString) fixtures.SomeFunc) {
+	fake.getThingMutex.Lock()
+	defer fake.getThingMutex.Unlock()
+	fake.getThingWhen = append(fake.getThingWhen, struct {
+		matcher func(fixtures.SomeString) bool
+		stub    func(fixtures.SomeString) fixtures.SomeFunc
+	}{matcher, stub})
+}
+
 func (fake *FakeHasOtherTypes) GetThingArgsForCall(i int) fixtures.SomeString {
 	fake.getThingMutex.RLock()
 	defer fake.getThingMutex.RUnlock()
@@ -61,6 +110,16 @@ func (fake *FakeHasOtherTypes) GetThingArgsForCall(i int) fixtures.SomeString {
 	return argsForCall.arg1
 }
 
+func (fake *FakeHasOtherTypes) GetThingCallHistory() []FakeHasOtherTypesGetThingCall {
+	fake.getThingMutex.RLock()
+	defer fake.getThingMutex.RUnlock()
+	history := make([]FakeHasOtherTypesGetThingCall, len(fake.getThingArgsForCall))
+	for i, argsForCall := range fake.getThingArgsForCall {
+		history[i] = FakeHasOtherTypesGetThingCall{argsForCall.arg1}
+	}
+	return history
+}
+
 func (fake *FakeHasOtherTypes) GetThingReturns(result1 fixtures.SomeFunc) {
 	fake.getThingMutex.Lock()
 	defer fake.getThingMutex.Unlock()
@@ -84,6 +143,74 @@ func (fake *FakeHasOtherTypes) GetThingReturnsOnCall(i int, result1 fixtures.Som
 	}{result1}
 }
 
+// GetThingReturnsForArgs returns the values for the calls with the given
+// arguments. Like GetThingCallsWhen, it is checked before the stub, and the
+// arguments or matcher registered last win.
+func (fake *FakeHasOtherTypes) GetThingReturnsForArgs(arg1 fixtures.SomeString) func(fixtures.SomeFunc) {
+	args := []interface{}{arg1}
+	return func(result1 fixtures.SomeFunc) {
+		fake.getThingMutex.Lock()
+		defer fake.getThingMutex.Unlock()
+		fake.GetThingStub = nil
+		fake.getThingWhen = append(fake.getThingWhen, struct {
+			matcher func(fixtures.SomeString) bool
+			stub    func(fixtures.SomeString) fixtures.SomeFunc
+		}{
+			func(arg1 fixtures.SomeString) bool {
+				return fake.argsMatch(args, []interface{}{arg1})
+			},
+			func(fixtures.SomeString) fixtures.SomeFunc {
+				return result1
+			},
+		})
+	}
+}
+
+// GetThingReturnsWhen returns the values for the calls whose arguments
+// match. Like GetThingCallsWhen, it is checked before the stub, and the
+// matcher registered last wins.
+func (fake *FakeHasOtherTypes) GetThingReturnsWhen(matcher func(fixtures.SomeString) bool, result1 fixtures.SomeFunc) {
+	fake.GetThingCallsWhen(matcher, func(fixtures.SomeString) fixtures.SomeFunc {
+		return result1
+	})
+}
+
+func (fake *FakeHasOtherTypes) ResetGetThing() {
+	fake.ResetGetThingCalls()
+	fake.ResetGetThingStubs()
+}
+
+func (fake *FakeHasOtherTypes) ResetGetThingCalls() {
+	fake.getThingMutex.Lock()
+	defer fake.getThingMutex.Unlock()
+	fake.getThingArgsForCall = nil
+	fake.forgetInvocations("GetThing")
+}
+
+func (fake *FakeHasOtherTypes) ResetGetThingStubs() {
+	fake.getThingMutex.Lock()
+	defer fake.getThingMutex.Unlock()
+	fake.GetThingStub = nil
+	fake.getThingWhen = nil
+	fake.getThingReturns = struct {
+		result1 fixtures.SomeFunc
+	}{}
+	fake.getThingReturnsOnCall = nil
+}
+
+func (fake *FakeHasOtherTypes) Reset() {
+	fake.ResetCalls()
+	fake.ResetStubs()
+}
+
+func (fake *FakeHasOtherTypes) ResetCalls() {
+	fake.ResetGetThingCalls()
+}
+
+func (fake *FakeHasOtherTypes) ResetStubs() {
+	fake.ResetGetThingStubs()
+}
+
 func (fake *FakeHasOtherTypes) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -94,6 +221,109 @@ func (fake *FakeHasOtherTypes) Invocations() map[string][][]interface{} {
 	return copiedInvocations
 }
 
+func (fake *FakeHasOtherTypes) argsMatch(expected []interface{}, actual []interface{}) bool {
+	if len(expected) != len(actual) {
+		return false
+	}
+	for i := range expected {
+		if fake.ArgsComparer != nil && !fake.ArgsComparer(expected[i], actual[i]) || fake.ArgsComparer == nil && !reflect.DeepEqual(expected[i], actual[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (fake *FakeHasOtherTypes) OrderedInvocations() []struct {
+	Seq    uint64
+	Method string
+	Args   []interface{}
+} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	return append([]struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}{}, fake.orderedInvocations...)
+}
+
+func (fake *FakeHasOtherTypes) SetSequencer(sequencer *atomic.Uint64) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	fake.sequencer = sequencer
+}
+
+func (fake *FakeHasOtherTypes) waitForCalls(ctx context.Context, callCount func() int, n int) error {
+	for {
+		fake.invocationsMutex.Lock()
+		if fake.invocationsChanged == nil {
+			fake.invocationsChanged = make(chan struct{})
+		}
+		changed := fake.invocationsChanged
+		fake.invocationsMutex.Unlock()
+		if callCount() >= n {
+			return nil
+		}
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (fake *FakeHasOtherTypes) callsChan(ctx context.Context, key string) <-chan []interface{} {
+	ctx, cancel := context.WithCancel(ctx)
+	calls := make(chan []interface{})
+	ready := make(chan struct{}, 1)
+	var pendingMutex sync.Mutex
+	var pending [][]interface{}
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.callsSubscribers == nil {
+		fake.callsSubscribers = map[string][]struct {
+			publish func(args []interface{})
+			cancel  context.CancelFunc
+			done    <-chan struct{}
+		}{}
+	}
+	fake.callsSubscribers[key] = append(fake.callsSubscribers[key], struct {
+		publish func(args []interface{})
+		cancel  context.CancelFunc
+		done    <-chan struct{}
+	}{func(args []interface{}) {
+		pendingMutex.Lock()
+		pending = append(pending, args)
+		pendingMutex.Unlock()
+		select {
+		case ready <- struct{}{}:
+		default:
+		}
+	}, cancel, ctx.Done()})
+	go func() {
+		defer close(calls)
+		for {
+			select {
+			case <-ready:
+			case <-ctx.Done():
+				return
+			}
+			pendingMutex.Lock()
+			queued := pending
+			pending = nil
+			pendingMutex.Unlock()
+			for _, args := range queued {
+				select {
+				case calls <- args:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return calls
+}
+
 func (fake *FakeHasOtherTypes) recordInvocation(key string, args []interface{}) {
 	fake.invocationsMutex.Lock()
 	defer fake.invocationsMutex.Unlock()
@@ -104,6 +334,54 @@ func (fake *FakeHasOtherTypes) recordInvocation(key string, args []interface{})
 		fake.invocations[key] = [][]interface{}{}
 	}
 	fake.invocations[key] = append(fake.invocations[key], args)
+	if fake.sequencer == nil {
+		fake.sequencer = new(atomic.Uint64)
+	}
+	fake.orderedInvocations = append(fake.orderedInvocations, struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}{fake.sequencer.Add(1), key, args})
+	if fake.invocationsChanged != nil {
+		close(fake.invocationsChanged)
+		fake.invocationsChanged = nil
+	}
+	subscribers := fake.callsSubscribers[key][:0]
+	for _, subscriber := range fake.callsSubscribers[key] {
+		select {
+		case <-subscriber.done:
+			continue
+		default:
+		}
+		subscriber.publish(args)
+		subscribers = append(subscribers, subscriber)
+	}
+	if len(subscribers) > 0 {
+		fake.callsSubscribers[key] = subscribers
+	} else {
+		delete(fake.callsSubscribers, key)
+	}
+}
+
+func (fake *FakeHasOtherTypes) forgetInvocations(key string) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	delete(fake.invocations, key)
+	for _, subscriber := range fake.callsSubscribers[key] {
+		subscriber.cancel()
+	}
+	delete(fake.callsSubscribers, key)
+	var orderedInvocations []struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}
+	for _, invocation := range fake.orderedInvocations {
+		if invocation.Method != key {
+			orderedInvocations = append(orderedInvocations, invocation)
+		}
+	}
+	fake.orderedInvocations = orderedInvocations
 }
 
 var _ fixtures.HasOtherTypes = new(FakeHasOtherTypes)
@@ -2,7 +2,10 @@
 package fixturesfakes
 
 import (
+	"context"
+	"reflect"
 	"sync"
+	"sync/atomic"
 
 	"github.com/maxbrunsfeld/counterfeiter/v6/fixtures"
 )
@@ -15,6 +18,10 @@ type FakeHasVarArgs struct {
 		arg2 int
 		arg3 []string
 	}
+	doMoreThingsWhen []struct {
+		matcher func(int, int, ...string) bool
+		stub    func(int, int, ...string) int
+	}
 	doMoreThingsReturns struct {
 		result1 int
 	}
@@ -27,16 +34,39 @@ type FakeHasVarArgs struct {
 		arg1 int
 		arg2 []string
 	}
+	doThingsWhen []struct {
+		matcher func(int, ...string) bool
+		stub    func(int, ...string) int
+	}
 	doThingsReturns struct {
 		result1 int
 	}
 	doThingsReturnsOnCall map[int]struct {
 		result1 int
 	}
-	invocations      map[string][][]interface{}
+	ArgsComparer       func(expected interface{}, actual interface{}) bool
+	invocations        map[string][][]interface{}
+	orderedInvocations []struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}
+	sequencer          *atomic.Uint64
+	invocationsChanged chan struct{}
+	callsSubscribers   map[string][]struct {
+		publish func(args []interface{})
+		cancel  context.CancelFunc
+		done    <-chan struct{}
+	}
 	invocationsMutex sync.RWMutex
 }
 
+type FakeHasVarArgsDoMoreThingsCall struct {
+	Arg1 int
+	Arg2 int
+	Arg3 []string
+}
+
 func (fake *FakeHasVarArgs) DoMoreThings(arg1 int, arg2 int, arg3 ...string) int {
 	fake.doMoreThingsMutex.Lock()
 	ret, specificReturn := fake.doMoreThingsReturnsOnCall[len(fake.doMoreThingsArgsForCall)]
@@ -46,9 +76,15 @@ func (fake *FakeHasVarArgs) DoMoreThings(arg1 int, arg2 int, arg3 ...string) int
 		arg3 []string
 	}{arg1, arg2, arg3})
 	stub := fake.DoMoreThingsStub
+	whens := fake.doMoreThingsWhen
 	fakeReturns := fake.doMoreThingsReturns
 	fake.recordInvocation("DoMoreThings", []interface{}{arg1, arg2, arg3})
 	fake.doMoreThingsMutex.Unlock()
+	for i := len(whens) - 1; i >= 0; i-- {
+		if whens[i].matcher(arg1, arg2, arg3...) {
+			return whens[i].stub(arg1, arg2, arg3...)
+		}
+	}
 	if stub != nil {
 		return stub(arg1, arg2, arg3...)
 	}
@@ -64,12 +100,31 @@ func (fake *FakeHasVarArgs) DoMoreThingsCallCount() int {
 	return len(fake.doMoreThingsArgsForCall)
 }
 
+func (fake *FakeHasVarArgs) WaitForDoMoreThingsCalls(ctx context.Context, n int) error {
+	return fake.waitForCalls(ctx, fake.DoMoreThingsCallCount, n)
+}
+
+func (fake *FakeHasVarArgs) DoMoreThingsCallsChan(ctx context.Context) <-chan []interface{} {
+	return fake.callsChan(ctx, "DoMoreThings")
+}
+
 func (fake *FakeHasVarArgs) DoMoreThingsCalls(stub func(int, int, ...string) int) {
 	fake.doMoreThingsMutex.Lock()
 	defer fake.doMoreThingsMutex.Unlock()
 	fake.DoMoreThingsStub = stub
 }
 
+// DoMoreThingsCallsWhen calls the stub for the calls whose arguments match.
+// Matchers are checked before the stub, and the matcher registered last wins.
+func (fake *FakeHasVarArgs) DoMoreThingsCallsWhen(matcher func(int, int, ...string) bool, stub func(int, int, ...string) int) {
+	fake.doMoreThingsMutex.Lock()
+	defer fake.doMoreThingsMutex.Unlock()
+	fake.doMoreThingsWhen = append(fake.doMoreThingsWhen, struct {
+		matcher func(int, int, ...string) bool
+		stub    func(int, int, ...string) int
+	}{matcher, stub})
+}
+
 func (fake *FakeHasVarArgs) DoMoreThingsArgsForCall(i int) (int, int, []string) {
 	fake.doMoreThingsMutex.RLock()
 	defer fake.doMoreThingsMutex.RUnlock()
@@ -77,6 +132,16 @@ func (fake *FakeHasVarArgs) DoMoreThingsArgsForCall(i int) (int, int, []string)
 	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
+func (fake *FakeHasVarArgs) DoMoreThingsCallHistory() []FakeHasVarArgsDoMoreThingsCall {
+	fake.doMoreThingsMutex.RLock()
+	defer fake.doMoreThingsMutex.RUnlock()
+	history := make([]FakeHasVarArgsDoMoreThingsCall, len(fake.doMoreThingsArgsForCall))
+	for i, argsForCall := range fake.doMoreThingsArgsForCall {
+		history[i] = FakeHasVarArgsDoMoreThingsCall{argsForCall.arg1, argsForCall.arg2, argsForCall.arg3}
+	}
+	return history
+}
+
 func (fake *FakeHasVarArgs) DoMoreThingsReturns(result1 int) {
 	fake.doMoreThingsMutex.Lock()
 	defer fake.doMoreThingsMutex.Unlock()
@@ -100,6 +165,66 @@ func (fake *FakeHasVarArgs) DoMoreThingsReturnsOnCall(i int, result1 int) {
 	}{result1}
 }
 
+// DoMoreThingsReturnsForArgs returns the values for the calls with the given
+// arguments. Like DoMoreThingsCallsWhen, it is checked before the stub, and the
+// arguments or matcher registered last win.
+func (fake *FakeHasVarArgs) DoMoreThingsReturnsForArgs(arg1 int, arg2 int, arg3 ...string) func(int) {
+	args := []interface{}{arg1, arg2, arg3}
+	return func(result1 int) {
+		fake.doMoreThingsMutex.Lock()
+		defer fake.doMoreThingsMutex.Unlock()
+		fake.DoMoreThingsStub = nil
+		fake.doMoreThingsWhen = append(fake.doMoreThingsWhen, struct {
+			matcher func(int, int, ...string) bool
+			stub    func(int, int, ...string) int
+		}{
+			func(arg1 int, arg2 int, arg3 ...string) bool {
+				return fake.argsMatch(args, []interface{}{arg1, arg2, arg3})
+			},
+			func(int, int, ...string) int {
+				return result1
+			},
+		})
+	}
+}
+
+// DoMoreThingsReturnsWhen returns the values for the calls whose arguments
+// match. Like DoMoreThingsCallsWhen, it is checked before the stub, and the
+// matcher registered last wins.
+func (fake *FakeHasVarArgs) DoMoreThingsReturnsWhen(matcher func(int, int, ...string) bool, result1 int) {
+	fake.DoMoreThingsCallsWhen(matcher, func(int, int, ...string) int {
+		return result1
+	})
+}
+
+func (fake *FakeHasVarArgs) ResetDoMoreThings() {
+	fake.ResetDoMoreThingsCalls()
+	fake.ResetDoMoreThingsStubs()
+}
+
+func (fake *FakeHasVarArgs) ResetDoMoreThingsCalls() {
+	fake.doMoreThingsMutex.Lock()
+	defer fake.doMoreThingsMutex.Unlock()
+	fake.doMoreThingsArgsForCall = nil
+	fake.forgetInvocations("DoMoreThings")
+}
+
+func (fake *FakeHasVarArgs) ResetDoMoreThingsStubs() {
+	fake.doMoreThingsMutex.Lock()
+	defer fake.doMoreThingsMutex.Unlock()
+	fake.DoMoreThingsStub = nil
+	fake.doMoreThingsWhen = nil
+	fake.doMoreThingsReturns = struct {
+		result1 int
+	}{}
+	fake.doMoreThingsReturnsOnCall = nil
+}
+
+type FakeHasVarArgsDoThingsCall struct {
+	Arg1 int
+	Arg2 []string
+}
+
 func (fake *FakeHasVarArgs) DoThings(arg1 int, arg2 ...string) int {
 	fake.doThingsMutex.Lock()
 	ret, specificReturn := fake.doThingsReturnsOnCall[len(fake.doThingsArgsForCall)]
@@ -108,9 +233,15 @@ func (fake *FakeHasVarArgs) DoThings(arg1 int, arg2 ...string) int {
 		arg2 []string
 	}{arg1, arg2})
 	stub := fake.DoThingsStub
+	whens := fake.doThingsWhen
 	fakeReturns := fake.doThingsReturns
 	fake.recordInvocation("DoThings", []interface{}{arg1, arg2})
 	fake.doThingsMutex.Unlock()
+	for i := len(whens) - 1; i >= 0; i-- {
+		if whens[i].matcher(arg1, arg2...) {
+			return whens[i].stub(arg1, arg2...)
+		}
+	}
 	if stub != nil {
 		return stub(arg1, arg2...)
 	}
@@ -126,12 +257,31 @@ func (fake *FakeHasVarArgs) DoThingsCallCount() int {
 	return len(fake.doThingsArgsForCall)
 }
 
+func (fake *FakeHasVarArgs) WaitForDoThingsCalls(ctx context.Context, n int) error {
+	return fake.waitForCalls(ctx, fake.DoThingsCallCount, n)
+}
+
+func (fake *FakeHasVarArgs) DoThingsCallsChan(ctx context.Context) <-chan []interface{} {
+	return fake.callsChan(ctx, "DoThings")
+}
+
 func (fake *FakeHasVarArgs) DoThingsCalls(stub func(int, ...string) int) {
 	fake.doThingsMutex.Lock()
 	defer fake.doThingsMutex.Unlock()
 	fake.DoThingsStub = stub
 }
 
+// DoThingsCallsWhen calls the stub for the calls whose arguments match.
+// Matchers are checked before the stub, and the matcher registered last wins.
+func (fake *FakeHasVarArgs) DoThingsCallsWhen(matcher func(int, ...string) bool, stub func(int, ...string) int) {
+	fake.doThingsMutex.Lock()
+	defer fake.doThingsMutex.Unlock()
+	fake.doThingsWhen = append(fake.doThingsWhen, struct {
+		matcher func(int, ...string) bool
+		stub    func(int, ...string) int
+	}{matcher, stub})
+}
+
 func (fake *FakeHasVarArgs) DoThingsArgsForCall(i int) (int, []string) {
 	fake.doThingsMutex.RLock()
 	defer fake.doThingsMutex.RUnlock()
@@ -139,6 +289,16 @@ func (fake *FakeHasVarArgs) DoThingsArgsForCall(i int) (int, []string) {
 	return argsForCall.arg1, argsForCall.arg2
 }
 
+func (fake *FakeHasVarArgs) DoThingsCallHistory() []FakeHasVarArgsDoThingsCall {
+	fake.doThingsMutex.RLock()
+	defer fake.doThingsMutex.RUnlock()
+	history := make([]FakeHasVarArgsDoThingsCall, len(fake.doThingsArgsForCall))
+	for i, argsForCall := range fake.doThingsArgsForCall {
+		history[i] = FakeHasVarArgsDoThingsCall{argsForCall.arg1, argsForCall.arg2}
+	}
+	return history
+}
+
 func (fake *FakeHasVarArgs) DoThingsReturns(result1 int) {
 	fake.doThingsMutex.Lock()
 	defer fake.doThingsMutex.Unlock()
@@ -162,6 +322,76 @@ func (fake *FakeHasVarArgs) DoThingsReturnsOnCall(i int, result1 int) {
 	}{result1}
 }
 
+// DoThingsReturnsForArgs returns the values for the calls with the given
+// arguments. Like DoThingsCallsWhen, it is checked before the stub, and the
+// arguments or matcher registered last win.
+func (fake *FakeHasVarArgs) DoThingsReturnsForArgs(arg1 int, arg2 ...string) func(int) {
+	args := []interface{}{arg1, arg2}
+	return func(result1 int) {
+		fake.doThingsMutex.Lock()
+		defer fake.doThingsMutex.Unlock()
+		fake.DoThingsStub = nil
+		fake.doThingsWhen = append(fake.doThingsWhen, struct {
+			matcher func(int, ...string) bool
+			stub    func(int, ...string) int
+		}{
+			func(arg1 int, arg2 ...string) bool {
+				return fake.argsMatch(args, []interface{}{arg1, arg2})
+			},
+			func(int, ...string) int {
+				return result1
+			},
+		})
+	}
+}
+
+// DoThingsReturnsWhen returns the values for the calls whose arguments
+// match. Like DoThingsCallsWhen, it is checked before the stub, and the
+// matcher registered last wins.
+func (fake *FakeHasVarArgs) DoThingsReturnsWhen(matcher func(int, ...string) bool, result1 int) {
+	fake.DoThingsCallsWhen(matcher, func(int, ...string) int {
+		return result1
+	})
+}
+
+func (fake *FakeHasVarArgs) ResetDoThings() {
+	fake.ResetDoThingsCalls()
+	fake.ResetDoThingsStubs()
+}
+
+func (fake *FakeHasVarArgs) ResetDoThingsCalls() {
+	fake.doThingsMutex.Lock()
+	defer fake.doThingsMutex.Unlock()
+	fake.doThingsArgsForCall = nil
+	fake.forgetInvocations("DoThings")
+}
+
+func (fake *FakeHasVarArgs) ResetDoThingsStubs() {
+	fake.doThingsMutex.Lock()
+	defer fake.doThingsMutex.Unlock()
+	fake.DoThingsStub = nil
+	fake.doThingsWhen = nil
+	fake.doThingsReturns = struct {
+		result1 int
+	}{}
+	fake.doThingsReturnsOnCall = nil
+}
+
+func (fake *FakeHasVarArgs) Reset() {
+	fake.ResetCalls()
+	fake.ResetStubs()
+}
+
+func (fake *FakeHasVarArgs) ResetCalls() {
+	fake.ResetDoMoreThingsCalls()
+	fake.ResetDoThingsCalls()
+}
+
+func (fake *FakeHasVarArgs) ResetStubs() {
+	fake.ResetDoMoreThingsStubs()
+	fake.ResetDoThingsStubs()
+}
+
 func (fake *FakeHasVarArgs) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -172,6 +402,109 @@ func (fake *FakeHasVarArgs) Invocations() map[string][][]interface{} {
 	return copiedInvocations
 }
 
+func (fake *FakeHasVarArgs) argsMatch(expected []interface{}, actual []interface{}) bool {
+	if len(expected) != len(actual) {
+		return false
+	}
+	for i := range expected {
+		if fake.ArgsComparer != nil && !fake.ArgsComparer(expected[i], actual[i]) || fake.ArgsComparer == nil && !reflect.DeepEqual(expected[i], actual[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (fake *FakeHasVarArgs) OrderedInvocations() []struct {
+	Seq    uint64
+	Method string
+	Args   []interface{}
+} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	return append([]struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}{}, fake.orderedInvocations...)
+}
+
+func (fake *FakeHasVarArgs) SetSequencer(sequencer *atomic.Uint64) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	fake.sequencer = sequencer
+}
+
+func (fake *FakeHasVarArgs) waitForCalls(ctx context.Context, callCount func() int, n int) error {
+	for {
+		fake.invocationsMutex.Lock()
+		if fake.invocationsChanged == nil {
+			fake.invocationsChanged = make(chan struct{})
+		}
+		changed := fake.invocationsChanged
+		fake.invocationsMutex.Unlock()
+		if callCount() >= n {
+			return nil
+		}
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (fake *FakeHasVarArgs) callsChan(ctx context.Context, key string) <-chan []interface{} {
+	ctx, cancel := context.WithCancel(ctx)
+	calls := make(chan []interface{})
+	ready := make(chan struct{}, 1)
+	var pendingMutex sync.Mutex
+	var pending [][]interface{}
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.callsSubscribers == nil {
+		fake.callsSubscribers = map[string][]struct {
+			publish func(args []interface{})
+			cancel  context.CancelFunc
+			done    <-chan struct{}
+		}{}
+	}
+	fake.callsSubscribers[key] = append(fake.callsSubscribers[key], struct {
+		publish func(args []interface{})
+		cancel  context.CancelFunc
+		done    <-chan struct{}
+	}{func(args []interface{}) {
+		pendingMutex.Lock()
+		pending = append(pending, args)
+		pendingMutex.Unlock()
+		select {
+		case ready <- struct{}{}:
+		default:
+		}
+	}, cancel, ctx.Done()})
+	go func() {
+		defer close(calls)
+		for {
+			select {
+			case <-ready:
+			case <-ctx.Done():
+				return
+			}
+			pendingMutex.Lock()
+			queued := pending
+			pending = nil
+			pendingMutex.Unlock()
+			for _, args := range queued {
+				select {
+				case calls <- args:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return calls
+}
+
 func (fake *FakeHasVarArgs) recordInvocation(key string, args []interface{}) {
 	fake.invocationsMutex.Lock()
 	defer fake.invocationsMutex.Unlock()
@@ -182,6 +515,54 @@ func (fake *FakeHasVarArgs) recordInvocation(key string, args []interface{}) {
 		fake.invocations[key] = [][]interface{}{}
 	}
 	fake.invocations[key] = append(fake.invocations[key], args)
+	if fake.sequencer == nil {
+		fake.sequencer = new(atomic.Uint64)
+	}
+	fake.orderedInvocations = append(fake.orderedInvocations, struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}{fake.sequencer.Add(1), key, args})
+	if fake.invocationsChanged != nil {
+		close(fake.invocationsChanged)
+		fake.invocationsChanged = nil
+	}
+	subscribers := fake.callsSubscribers[key][:0]
+	for _, subscriber := range fake.callsSubscribers[key] {
+		select {
+		case <-subscriber.done:
+			continue
+		default:
+		}
+		subscriber.publish(args)
+		subscribers = append(subscribers, subscriber)
+	}
+	if len(subscribers) > 0 {
+		fake.callsSubscribers[key] = subscribers
+	} else {
+		delete(fake.callsSubscribers, key)
+	}
+}
+
+func (fake *FakeHasVarArgs) forgetInvocations(key string) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	delete(fake.invocations, key)
+	for _, subscriber := range fake.callsSubscribers[key] {
+		subscriber.cancel()
+	}
+	delete(fake.callsSubscribers, key)
+	var orderedInvocations []struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}
+	for _, invocation := range fake.orderedInvocations {
+		if invocation.Method != key {
+			orderedInvocations = append(orderedInvocations, invocation)
+		}
+	}
+	fake.orderedInvocations = orderedInvocations
 }
 
 var _ fixtures.HasVarArgs = new(FakeHasVarArgs)
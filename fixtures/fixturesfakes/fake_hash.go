@@ -0,0 +1,808 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package fixturesfakes
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"sync/atomic"
+
+	"github.com/maxbrunsfeld/counterfeiter/v6/fixtures"
+)
+
+type FakeHash struct {
+	BlockSizeStub        func() int
+	blockSizeMutex       sync.RWMutex
+	blockSizeArgsForCall []struct {
+	}
+	blockSizeReturns struct {
+		result1 int
+	}
+	blockSizeReturnsOnCall map[int]struct {
+		result1 int
+	}
+	ResetStub        func()
+	resetMutex       sync.RWMutex
+	resetArgsForCall []struct {
+	}
+	SizeStub        func() int
+	sizeMutex       sync.RWMutex
+	sizeArgsForCall []struct {
+	}
+	sizeReturns struct {
+		result1 int
+	}
+	sizeReturnsOnCall map[int]struct {
+		result1 int
+	}
+	SumStub        func([]byte) []byte
+	sumMutex       sync.RWMutex
+	sumArgsForCall []struct {
+		arg1 []byte
+	}
+	sumWhen []struct {
+		matcher func([]byte) bool
+		stub    func([]byte) []byte
+	}
+	sumReturns struct {
+		result1 []byte
+	}
+	sumReturnsOnCall map[int]struct {
+		result1 []byte
+	}
+	WriteStub        func([]byte) (int, error)
+	writeMutex       sync.RWMutex
+	writeArgsForCall []struct {
+		arg1 []byte
+	}
+	writeWhen []struct {
+		matcher func([]byte) bool
+		stub    func([]byte) (int, error)
+	}
+	writeReturns struct {
+		result1 int
+		result2 error
+	}
+	writeReturnsOnCall map[int]struct {
+		result1 int
+		result2 error
+	}
+	ArgsComparer       func(expected interface{}, actual interface{}) bool
+	invocations        map[string][][]interface{}
+	orderedInvocations []struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}
+	sequencer          *atomic.Uint64
+	invocationsChanged chan struct{}
+	callsSubscribers   map[string][]struct {
+		publish func(args []interface{})
+		cancel  context.CancelFunc
+		done    <-chan struct{}
+	}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeHash) BlockSize() int {
+	fake.blockSizeMutex.Lock()
+	ret, specificReturn := fake.blockSizeReturnsOnCall[len(fake.blockSizeArgsForCall)]
+	fake.blockSizeArgsForCall = append(fake.blockSizeArgsForCall, struct {
+	}{})
+	stub := fake.BlockSizeStub
+	fakeReturns := fake.blockSizeReturns
+	fake.recordInvocation("BlockSize", []interface{}{})
+	fake.blockSizeMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeHash) BlockSizeCallCount() int {
+	fake.blockSizeMutex.RLock()
+	defer fake.blockSizeMutex.RUnlock()
+	return len(fake.blockSizeArgsForCall)
+}
+
+func (fake *FakeHash) WaitForBlockSizeCalls(ctx context.Context, n int) error {
+	return fake.waitForCalls(ctx, fake.BlockSizeCallCount, n)
+}
+
+func (fake *FakeHash) BlockSizeCallsChan(ctx context.Context) <-chan []interface{} {
+	return fake.callsChan(ctx, "BlockSize")
+}
+
+func (fake *FakeHash) BlockSizeCalls(stub func() int) {
+	fake.blockSizeMutex.Lock()
+	defer fake.blockSizeMutex.Unlock()
+	fake.BlockSizeStub = stub
+}
+
+func (fake *FakeHash) BlockSizeReturns(result1 int) {
+	fake.blockSizeMutex.Lock()
+	defer fake.blockSizeMutex.Unlock()
+	fake.BlockSizeStub = nil
+	fake.blockSizeReturns = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeHash) BlockSizeReturnsOnCall(i int, result1 int) {
+	fake.blockSizeMutex.Lock()
+	defer fake.blockSizeMutex.Unlock()
+	fake.BlockSizeStub = nil
+	if fake.blockSizeReturnsOnCall == nil {
+		fake.blockSizeReturnsOnCall = make(map[int]struct {
+			result1 int
+		})
+	}
+	fake.blockSizeReturnsOnCall[i] = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeHash) ResetBlockSize() {
+	fake.ResetBlockSizeCalls()
+	fake.ResetBlockSizeStubs()
+}
+
+func (fake *FakeHash) ResetBlockSizeCalls() {
+	fake.blockSizeMutex.Lock()
+	defer fake.blockSizeMutex.Unlock()
+	fake.blockSizeArgsForCall = nil
+	fake.forgetInvocations("BlockSize")
+}
+
+func (fake *FakeHash) ResetBlockSizeStubs() {
+	fake.blockSizeMutex.Lock()
+	defer fake.blockSizeMutex.Unlock()
+	fake.BlockSizeStub = nil
+	fake.blockSizeReturns = struct {
+		result1 int
+	}{}
+	fake.blockSizeReturnsOnCall = nil
+}
+
+func (fake *FakeHash) Reset() {
+	fake.resetMutex.Lock()
+	fake.resetArgsForCall = append(fake.resetArgsForCall, struct {
+	}{})
+	stub := fake.ResetStub
+	fake.recordInvocation("Reset", []interface{}{})
+	fake.resetMutex.Unlock()
+	if stub != nil {
+		fake.ResetStub()
+	}
+}
+
+func (fake *FakeHash) ResetCallCount() int {
+	fake.resetMutex.RLock()
+	defer fake.resetMutex.RUnlock()
+	return len(fake.resetArgsForCall)
+}
+
+func (fake *FakeHash) WaitForResetCalls(ctx context.Context, n int) error {
+	return fake.waitForCalls(ctx, fake.ResetCallCount, n)
+}
+
+func (fake *FakeHash) ResetCallsChan(ctx context.Context) <-chan []interface{} {
+	return fake.callsChan(ctx, "Reset")
+}
+
+func (fake *FakeHash) ResetCalls(stub func()) {
+	fake.resetMutex.Lock()
+	defer fake.resetMutex.Unlock()
+	fake.ResetStub = stub
+}
+
+func (fake *FakeHash) ResetReset() {
+	fake.ResetResetCalls()
+	fake.ResetResetStubs()
+}
+
+func (fake *FakeHash) ResetResetCalls() {
+	fake.resetMutex.Lock()
+	defer fake.resetMutex.Unlock()
+	fake.resetArgsForCall = nil
+	fake.forgetInvocations("Reset")
+}
+
+func (fake *FakeHash) ResetResetStubs() {
+	fake.resetMutex.Lock()
+	defer fake.resetMutex.Unlock()
+	fake.ResetStub = nil
+}
+
+func (fake *FakeHash) Size() int {
+	fake.sizeMutex.Lock()
+	ret, specificReturn := fake.sizeReturnsOnCall[len(fake.sizeArgsForCall)]
+	fake.sizeArgsForCall = append(fake.sizeArgsForCall, struct {
+	}{})
+	stub := fake.SizeStub
+	fakeReturns := fake.sizeReturns
+	fake.recordInvocation("Size", []interface{}{})
+	fake.sizeMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeHash) SizeCallCount() int {
+	fake.sizeMutex.RLock()
+	defer fake.sizeMutex.RUnlock()
+	return len(fake.sizeArgsForCall)
+}
+
+func (fake *FakeHash) WaitForSizeCalls(ctx context.Context, n int) error {
+	return fake.waitForCalls(ctx, fake.SizeCallCount, n)
+}
+
+func (fake *FakeHash) SizeCallsChan(ctx context.Context) <-chan []interface{} {
+	return fake.callsChan(ctx, "Size")
+}
+
+func (fake *FakeHash) SizeCalls(stub func() int) {
+	fake.sizeMutex.Lock()
+	defer fake.sizeMutex.Unlock()
+	fake.SizeStub = stub
+}
+
+func (fake *FakeHash) SizeReturns(result1 int) {
+	fake.sizeMutex.Lock()
+	defer fake.sizeMutex.Unlock()
+	fake.SizeStub = nil
+	fake.sizeReturns = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeHash) SizeReturnsOnCall(i int, result1 int) {
+	fake.sizeMutex.Lock()
+	defer fake.sizeMutex.Unlock()
+	fake.SizeStub = nil
+	if fake.sizeReturnsOnCall == nil {
+		fake.sizeReturnsOnCall = make(map[int]struct {
+			result1 int
+		})
+	}
+	fake.sizeReturnsOnCall[i] = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeHash) ResetSize() {
+	fake.ResetSizeCalls()
+	fake.ResetSizeStubs()
+}
+
+func (fake *FakeHash) ResetSizeCalls() {
+	fake.sizeMutex.Lock()
+	defer fake.sizeMutex.Unlock()
+	fake.sizeArgsForCall = nil
+	fake.forgetInvocations("Size")
+}
+
+func (fake *FakeHash) ResetSizeStubs() {
+	fake.sizeMutex.Lock()
+	defer fake.sizeMutex.Unlock()
+	fake.SizeStub = nil
+	fake.sizeReturns = struct {
+		result1 int
+	}{}
+	fake.sizeReturnsOnCall = nil
+}
+
+type FakeHashSumCall struct {
+	B []byte
+}
+
+func (fake *FakeHash) Sum(arg1 []byte) []byte {
+	var arg1Copy []byte
+	if arg1 != nil {
+		arg1Copy = make([]byte, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.sumMutex.Lock()
+	ret, specificReturn := fake.sumReturnsOnCall[len(fake.sumArgsForCall)]
+	fake.sumArgsForCall = append(fake.sumArgsForCall, struct {
+		arg1 []byte
+	}{arg1Copy})
+	stub := fake.SumStub
+	whens := fake.sumWhen
+	fakeReturns := fake.sumReturns
+	fake.recordInvocation("Sum", []interface{}{arg1Copy})
+	fake.sumMutex.Unlock()
+	for i := len(whens) - 1; i >= 0; i-- {
+		if whens[i].matcher(arg1) {
+			return whens[i].stub(arg1)
+		}
+	}
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeHash) SumCallCount() int {
+	fake.sumMutex.RLock()
+	defer fake.sumMutex.RUnlock()
+	return len(fake.sumArgsForCall)
+}
+
+func (fake *FakeHash) WaitForSumCalls(ctx context.Context, n int) error {
+	return fake.waitForCalls(ctx, fake.SumCallCount, n)
+}
+
+func (fake *FakeHash) SumCallsChan(ctx context.Context) <-chan []interface{} {
+	return fake.callsChan(ctx, "Sum")
+}
+
+func (fake *FakeHash) SumCalls(stub func([]byte) []byte) {
+	fake.sumMutex.Lock()
+	defer fake.sumMutex.Unlock()
+	fake.SumStub = stub
+}
+
+// SumCallsWhen calls the stub for the calls whose arguments match.
+// Matchers are checked before the stub, and the matcher registered last wins.
+func (fake *FakeHash) SumCallsWhen(matcher func([]byte) bool, stub func([]byte) []byte) {
+	fake.sumMutex.Lock()
+	defer fake.sumMutex.Unlock()
+	fake.sumWhen = append(fake.sumWhen, struct {
+		matcher func([]byte) bool
+		stub    func([]byte) []byte
+	}{matcher, stub})
+}
+
+func (fake *FakeHash) SumArgsForCall(i int) []byte {
+	fake.sumMutex.RLock()
+	defer fake.sumMutex.RUnlock()
+	argsForCall := fake.sumArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeHash) SumCallHistory() []FakeHashSumCall {
+	fake.sumMutex.RLock()
+	defer fake.sumMutex.RUnlock()
+	history := make([]FakeHashSumCall, len(fake.sumArgsForCall))
+	for i, argsForCall := range fake.sumArgsForCall {
+		history[i] = FakeHashSumCall{argsForCall.arg1}
+	}
+	return history
+}
+
+func (fake *FakeHash) SumReturns(result1 []byte) {
+	fake.sumMutex.Lock()
+	defer fake.sumMutex.Unlock()
+	fake.SumStub = nil
+	fake.sumReturns = struct {
+		result1 []byte
+	}{result1}
+}
+
+func (fake *FakeHash) SumReturnsOnCall(i int, result1 []byte) {
+	fake.sumMutex.Lock()
+	defer fake.sumMutex.Unlock()
+	fake.SumStub = nil
+	if fake.sumReturnsOnCall == nil {
+		fake.sumReturnsOnCall = make(map[int]struct {
+			result1 []byte
+		})
+	}
+	fake.sumReturnsOnCall[i] = struct {
+		result1 []byte
+	}{result1}
+}
+
+// SumReturnsForArgs returns the values for the calls with the given
+// arguments. Like SumCallsWhen, it is checked before the stub, and the
+// arguments or matcher registered last win.
+func (fake *FakeHash) SumReturnsForArgs(arg1 []byte) func([]byte) {
+	args := []interface{}{arg1}
+	return func(result1 []byte) {
+		fake.sumMutex.Lock()
+		defer fake.sumMutex.Unlock()
+		fake.SumStub = nil
+		fake.sumWhen = append(fake.sumWhen, struct {
+			matcher func([]byte) bool
+			stub    func([]byte) []byte
+		}{
+			func(arg1 []byte) bool {
+				return fake.argsMatch(args, []interface{}{arg1})
+			},
+			func([]byte) []byte {
+				return result1
+			},
+		})
+	}
+}
+
+// SumReturnsWhen returns the values for the calls whose arguments
+// match. Like SumCallsWhen, it is checked before the stub, and the
+// matcher registered last wins.
+func (fake *FakeHash) SumReturnsWhen(matcher func([]byte) bool, result1 []byte) {
+	fake.SumCallsWhen(matcher, func([]byte) []byte {
+		return result1
+	})
+}
+
+func (fake *FakeHash) ResetSum() {
+	fake.ResetSumCalls()
+	fake.ResetSumStubs()
+}
+
+func (fake *FakeHash) ResetSumCalls() {
+	fake.sumMutex.Lock()
+	defer fake.sumMutex.Unlock()
+	fake.sumArgsForCall = nil
+	fake.forgetInvocations("Sum")
+}
+
+func (fake *FakeHash) ResetSumStubs() {
+	fake.sumMutex.Lock()
+	defer fake.sumMutex.Unlock()
+	fake.SumStub = nil
+	fake.sumWhen = nil
+	fake.sumReturns = struct {
+		result1 []byte
+	}{}
+	fake.sumReturnsOnCall = nil
+}
+
+type FakeHashWriteCall struct {
+	P []byte
+}
+
+func (fake *FakeHash) Write(arg1 []byte) (int, error) {
+	var arg1Copy []byte
+	if arg1 != nil {
+		arg1Copy = make([]byte, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.writeMutex.Lock()
+	ret, specificReturn := fake.writeReturnsOnCall[len(fake.writeArgsForCall)]
+	fake.writeArgsForCall = append(fake.writeArgsForCall, struct {
+		arg1 []byte
+	}{arg1Copy})
+	stub := fake.WriteStub
+	whens := fake.writeWhen
+	fakeReturns := fake.writeReturns
+	fake.recordInvocation("Write", []interface{}{arg1Copy})
+	fake.writeMutex.Unlock()
+	for i := len(whens) - 1; i >= 0; i-- {
+		if whens[i].matcher(arg1) {
+			return whens[i].stub(arg1)
+		}
+	}
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeHash) WriteCallCount() int {
+	fake.writeMutex.RLock()
+	defer fake.writeMutex.RUnlock()
+	return len(fake.writeArgsForCall)
+}
+
+func (fake *FakeHash) WaitForWriteCalls(ctx context.Context, n int) error {
+	return fake.waitForCalls(ctx, fake.WriteCallCount, n)
+}
+
+func (fake *FakeHash) WriteCallsChan(ctx context.Context) <-chan []interface{} {
+	return fake.callsChan(ctx, "Write")
+}
+
+func (fake *FakeHash) WriteCalls(stub func([]byte) (int, error)) {
+	fake.writeMutex.Lock()
+	defer fake.writeMutex.Unlock()
+	fake.WriteStub = stub
+}
+
+// WriteCallsWhen calls the stub for the calls whose arguments match.
+// Matchers are checked before the stub, and the matcher registered last wins.
+func (fake *FakeHash) WriteCallsWhen(matcher func([]byte) bool, stub func([]byte) (int, error)) {
+	fake.writeMutex.Lock()
+	defer fake.writeMutex.Unlock()
+	fake.writeWhen = append(fake.writeWhen, struct {
+		matcher func([]byte) bool
+		stub    func([]byte) (int, error)
+	}{matcher, stub})
+}
+
+func (fake *FakeHash) WriteArgsForCall(i int) []byte {
+	fake.writeMutex.RLock()
+	defer fake.writeMutex.RUnlock()
+	argsForCall := fake.writeArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeHash) WriteCallHistory() []FakeHashWriteCall {
+	fake.writeMutex.RLock()
+	defer fake.writeMutex.RUnlock()
+	history := make([]FakeHashWriteCall, len(fake.writeArgsForCall))
+	for i, argsForCall := range fake.writeArgsForCall {
+		history[i] = FakeHashWriteCall{argsForCall.arg1}
+	}
+	return history
+}
+
+func (fake *FakeHash) WriteReturns(result1 int, result2 error) {
+	fake.writeMutex.Lock()
+	defer fake.writeMutex.Unlock()
+	fake.WriteStub = nil
+	fake.writeReturns = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeHash) WriteReturnsOnCall(i int, result1 int, result2 error) {
+	fake.writeMutex.Lock()
+	defer fake.writeMutex.Unlock()
+	fake.WriteStub = nil
+	if fake.writeReturnsOnCall == nil {
+		fake.writeReturnsOnCall = make(map[int]struct {
+			result1 int
+			result2 error
+		})
+	}
+	fake.writeReturnsOnCall[i] = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+// WriteReturnsForArgs returns the values for the calls with the given
+// arguments. Like WriteCallsWhen, it is checked before the stub, and the
+// arguments or matcher registered last win.
+func (fake *FakeHash) WriteReturnsForArgs(arg1 []byte) func(int, error) {
+	args := []interface{}{arg1}
+	return func(result1 int, result2 error) {
+		fake.writeMutex.Lock()
+		defer fake.writeMutex.Unlock()
+		fake.WriteStub = nil
+		fake.writeWhen = append(fake.writeWhen, struct {
+			matcher func([]byte) bool
+			stub    func([]byte) (int, error)
+		}{
+			func(arg1 []byte) bool {
+				return fake.argsMatch(args, []interface{}{arg1})
+			},
+			func([]byte) (int, error) {
+				return result1, result2
+			},
+		})
+	}
+}
+
+// WriteReturnsWhen returns the values for the calls whose arguments
+// match. Like WriteCallsWhen, it is checked before the stub, and the
+// matcher registered last wins.
+func (fake *FakeHash) WriteReturnsWhen(matcher func([]byte) bool, result1 int, result2 error) {
+	fake.WriteCallsWhen(matcher, func([]byte) (int, error) {
+		return result1, result2
+	})
+}
+
+func (fake *FakeHash) ResetWrite() {
+	fake.ResetWriteCalls()
+	fake.ResetWriteStubs()
+}
+
+func (fake *FakeHash) ResetWriteCalls() {
+	fake.writeMutex.Lock()
+	defer fake.writeMutex.Unlock()
+	fake.writeArgsForCall = nil
+	fake.forgetInvocations("Write")
+}
+
+func (fake *FakeHash) ResetWriteStubs() {
+	fake.writeMutex.Lock()
+	defer fake.writeMutex.Unlock()
+	fake.WriteStub = nil
+	fake.writeWhen = nil
+	fake.writeReturns = struct {
+		result1 int
+		result2 error
+	}{}
+	fake.writeReturnsOnCall = nil
+}
+
+func (fake *FakeHash) ResetStubs() {
+	fake.ResetBlockSizeStubs()
+	fake.ResetResetStubs()
+	fake.ResetSizeStubs()
+	fake.ResetSumStubs()
+	fake.ResetWriteStubs()
+}
+
+func (fake *FakeHash) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeHash) argsMatch(expected []interface{}, actual []interface{}) bool {
+	if len(expected) != len(actual) {
+		return false
+	}
+	for i := range expected {
+		if fake.ArgsComparer != nil && !fake.ArgsComparer(expected[i], actual[i]) || fake.ArgsComparer == nil && !reflect.DeepEqual(expected[i], actual[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (fake *FakeHash) OrderedInvocations() []struct {
+	Seq    uint64
+	Method string
+	Args   []interface{}
+} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	return append([]struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}{}, fake.orderedInvocations...)
+}
+
+func (fake *FakeHash) SetSequencer(sequencer *atomic.Uint64) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	fake.sequencer = sequencer
+}
+
+func (fake *FakeHash) waitForCalls(ctx context.Context, callCount func() int, n int) error {
+	for {
+		fake.invocationsMutex.Lock()
+		if fake.invocationsChanged == nil {
+			fake.invocationsChanged = make(chan struct{})
+		}
+		changed := fake.invocationsChanged
+		fake.invocationsMutex.Unlock()
+		if callCount() >= n {
+			return nil
+		}
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (fake *FakeHash) callsChan(ctx context.Context, key string) <-chan []interface{} {
+	ctx, cancel := context.WithCancel(ctx)
+	calls := make(chan []interface{})
+	ready := make(chan struct{}, 1)
+	var pendingMutex sync.Mutex
+	var pending [][]interface{}
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.callsSubscribers == nil {
+		fake.callsSubscribers = map[string][]struct {
+			publish func(args []interface{})
+			cancel  context.CancelFunc
+			done    <-chan struct{}
+		}{}
+	}
+	fake.callsSubscribers[key] = append(fake.callsSubscribers[key], struct {
+		publish func(args []interface{})
+		cancel  context.CancelFunc
+		done    <-chan struct{}
+	}{func(args []interface{}) {
+		pendingMutex.Lock()
+		pending = append(pending, args)
+		pendingMutex.Unlock()
+		select {
+		case ready <- struct{}{}:
+		default:
+		}
+	}, cancel, ctx.Done()})
+	go func() {
+		defer close(calls)
+		for {
+			select {
+			case <-ready:
+			case <-ctx.Done():
+				return
+			}
+			pendingMutex.Lock()
+			queued := pending
+			pending = nil
+			pendingMutex.Unlock()
+			for _, args := range queued {
+				select {
+				case calls <- args:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return calls
+}
+
+func (fake *FakeHash) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+	if fake.sequencer == nil {
+		fake.sequencer = new(atomic.Uint64)
+	}
+	fake.orderedInvocations = append(fake.orderedInvocations, struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}{fake.sequencer.Add(1), key, args})
+	if fake.invocationsChanged != nil {
+		close(fake.invocationsChanged)
+		fake.invocationsChanged = nil
+	}
+	subscribers := fake.callsSubscribers[key][:0]
+	for _, subscriber := range fake.callsSubscribers[key] {
+		select {
+		case <-subscriber.done:
+			continue
+		default:
+		}
+		subscriber.publish(args)
+		subscribers = append(subscribers, subscriber)
+	}
+	if len(subscribers) > 0 {
+		fake.callsSubscribers[key] = subscribers
+	} else {
+		delete(fake.callsSubscribers, key)
+	}
+}
+
+func (fake *FakeHash) forgetInvocations(key string) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	delete(fake.invocations, key)
+	for _, subscriber := range fake.callsSubscribers[key] {
+		subscriber.cancel()
+	}
+	delete(fake.callsSubscribers, key)
+	var orderedInvocations []struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}
+	for _, invocation := range fake.orderedInvocations {
+		if invocation.Method != key {
+			orderedInvocations = append(orderedInvocations, invocation)
+		}
+	}
+	fake.orderedInvocations = orderedInvocations
+}
+
+var _ fixtures.Hash = new(FakeHash)
@@ -2,7 +2,9 @@
 package fixturesfakes
 
 import (
+	"context"
 	"sync"
+	"sync/atomic"
 
 	"github.com/maxbrunsfeld/counterfeiter/v6/fixtures"
 	hyphenpackage "github.com/maxbrunsfeld/counterfeiter/v6/fixtures/go-hyphenpackage"
@@ -14,18 +16,45 @@ type FakeImportsGoHyphenPackage struct {
 	useHyphenTypeArgsForCall []struct {
 		arg1 hyphenpackage.HyphenType
 	}
-	invocations      map[string][][]interface{}
+	useHyphenTypeWhen []struct {
+		matcher func(hyphenpackage.HyphenType) bool
+		stub    func(hyphenpackage.HyphenType)
+	}
+	invocations        map[string][][]interface{}
+	orderedInvocations []struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}
+	sequencer          *atomic.Uint64
+	invocationsChanged chan struct{}
+	callsSubscribers   map[string][]struct {
+		publish func(args []interface{})
+		cancel  context.CancelFunc
+		done    <-chan struct{}
+	}
 	invocationsMutex sync.RWMutex
 }
 
+type FakeImportsGoHyphenPackageUseHyphenTypeCall struct {
+	Arg1 hyphenpackage.HyphenType
+}
+
 func (fake *FakeImportsGoHyphenPackage) UseHyphenType(arg1 hyphenpackage.HyphenType) {
 	fake.useHyphenTypeMutex.Lock()
 	fake.useHyphenTypeArgsForCall = append(fake.useHyphenTypeArgsForCall, struct {
 		arg1 hyphenpackage.HyphenType
 	}{arg1})
 	stub := fake.UseHyphenTypeStub
+	whens := fake.useHyphenTypeWhen
 	fake.recordInvocation("UseHyphenType", []interface{}{arg1})
 	fake.useHyphenTypeMutex.Unlock()
+	for i := len(whens) - 1; i >= 0; i-- {
+		if whens[i].matcher(arg1) {
+			whens[i].stub(arg1)
+			return
+		}
+	}
 	if stub != nil {
 		fake.UseHyphenTypeStub(arg1)
 	}
@@ -37,12 +66,31 @@ func (fake *FakeImportsGoHyphenPackage) UseHyphenTypeCallCount() int {
 	return len(fake.useHyphenTypeArgsForCall)
 }
 
+func (fake *FakeImportsGoHyphenPackage) WaitForUseHyphenTypeCalls(ctx context.Context, n int) error {
+	return fake.waitForCalls(ctx, fake.UseHyphenTypeCallCount, n)
+}
+
+func (fake *FakeImportsGoHyphenPackage) UseHyphenTypeCallsChan(ctx context.Context) <-chan []interface{} {
+	return fake.callsChan(ctx, "UseHyphenType")
+}
+
 func (fake *FakeImportsGoHyphenPackage) UseHyphenTypeCalls(stub func(hyphenpackage.HyphenType)) {
 	fake.useHyphenTypeMutex.Lock()
 	defer fake.useHyphenTypeMutex.Unlock()
 	fake.UseHyphenTypeStub = stub
 }
 
+// UseHyphenTypeCallsWhen calls the stub for the calls whose arguments match.
+// Matchers are checked before the stub, and the matcher registered last wins.
+func (fake *FakeImportsGoHyphenPackage) UseHyphenTypeCallsWhen(matcher func(hyphenpackage.HyphenType) bool, stub func(hyphenpackage.HyphenType)) {
+	fake.useHyphenTypeMutex.Lock()
+	defer fake.useHyphenTypeMutex.Unlock()
+	fake.useHyphenTypeWhen = append(fake.useHyphenTypeWhen, struct {
+		matcher func(hyphenpackage.HyphenType) bool
+		stub    func(hyphenpackage.HyphenType)
+	}{matcher, stub})
+}
+
 func (fake *FakeImportsGoHyphenPackage) UseHyphenTypeArgsForCall(i int) hyphenpackage.HyphenType {
 	fake.useHyphenTypeMutex.RLock()
 	defer fake.useHyphenTypeMutex.RUnlock()
@@ -50,6 +98,48 @@ func (fake *FakeImportsGoHyphenPackage) UseHyphenTypeArgsForCall(i int) hyphenpa
 	return argsForCall.arg1
 }
 
+func (fake *FakeImportsGoHyphenPackage) UseHyphenTypeCallHistory() []FakeImportsGoHyphenPackageUseHyphenTypeCall {
+	fake.useHyphenTypeMutex.RLock()
+	defer fake.useHyphenTypeMutex.RUnlock()
+	history := make([]FakeImportsGoHyphenPackageUseHyphenTypeCall, len(fake.useHyphenTypeArgsForCall))
+	for i, argsForCall := range fake.useHyphenTypeArgsForCall {
+		history[i] = FakeImportsGoHyphenPackageUseHyphenTypeCall{argsForCall.arg1}
+	}
+	return history
+}
+
+func (fake *FakeImportsGoHyphenPackage) ResetUseHyphenType() {
+	fake.ResetUseHyphenTypeCalls()
+	fake.ResetUseHyphenTypeStubs()
+}
+
+func (fake *FakeImportsGoHyphenPackage) ResetUseHyphenTypeCalls() {
+	fake.useHyphenTypeMutex.Lock()
+	defer fake.useHyphenTypeMutex.Unlock()
+	fake.useHyphenTypeArgsForCall = nil
+	fake.forgetInvocations("UseHyphenType")
+}
+
+func (fake *FakeImportsGoHyphenPackage) ResetUseHyphenTypeStubs() {
+	fake.useHyphenTypeMutex.Lock()
+	defer fake.useHyphenTypeMutex.Unlock()
+	fake.UseHyphenTypeStub = nil
+	fake.useHyphenTypeWhen = nil
+}
+
+func (fake *FakeImportsGoHyphenPackage) Reset() {
+	fake.ResetCalls()
+	fake.ResetStubs()
+}
+
+func (fake *FakeImportsGoHyphenPackage) ResetCalls() {
+	fake.ResetUseHyphenTypeCalls()
+}
+
+func (fake *FakeImportsGoHyphenPackage) ResetStubs() {
+	fake.ResetUseHyphenTypeStubs()
+}
+
 func (fake *FakeImportsGoHyphenPackage) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -60,6 +150,97 @@ func (fake *FakeImportsGoHyphenPackage) Invocations() map[string][][]interface{}
 	return copiedInvocations
 }
 
+func (fake *FakeImportsGoHyphenPackage) OrderedInvocations() []struct {
+	Seq    uint64
+	Method string
+	Args   []interface{}
+} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	return append([]struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}{}, fake.orderedInvocations...)
+}
+
+func (fake *FakeImportsGoHyphenPackage) SetSequencer(sequencer *atomic.Uint64) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	fake.sequencer = sequencer
+}
+
+func (fake *FakeImportsGoHyphenPackage) waitForCalls(ctx context.Context, callCount func() int, n int) error {
+	for {
+		fake.invocationsMutex.Lock()
+		if fake.invocationsChanged == nil {
+			fake.invocationsChanged = make(chan struct{})
+		}
+		changed := fake.invocationsChanged
+		fake.invocationsMutex.Unlock()
+		if callCount() >= n {
+			return nil
+		}
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (fake *FakeImportsGoHyphenPackage) callsChan(ctx context.Context, key string) <-chan []interface{} {
+	ctx, cancel := context.WithCancel(ctx)
+	calls := make(chan []interface{})
+	ready := make(chan struct{}, 1)
+	var pendingMutex sync.Mutex
+	var pending [][]interface{}
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.callsSubscribers == nil {
+		fake.callsSubscribers = map[string][]struct {
+			publish func(args []interface{})
+			cancel  context.CancelFunc
+			done    <-chan struct{}
+		}{}
+	}
+	fake.callsSubscribers[key] = append(fake.callsSubscribers[key], struct {
+		publish func(args []interface{})
+		cancel  context.CancelFunc
+		done    <-chan struct{}
+	}{func(args []interface{}) {
+		pendingMutex.Lock()
+		pending = append(pending, args)
+		pendingMutex.Unlock()
+		select {
+		case ready <- struct{}{}:
+		default:
+		}
+	}, cancel, ctx.Done()})
+	go func() {
+		defer close(calls)
+		for {
+			select {
+			case <-ready:
+			case <-ctx.Done():
+				return
+			}
+			pendingMutex.Lock()
+			queued := pending
+			pending = nil
+			pendingMutex.Unlock()
+			for _, args := range queued {
+				select {
+				case calls <- args:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return calls
+}
+
 func (fake *FakeImportsGoHyphenPackage) recordInvocation(key string, args []interface{}) {
 	fake.invocationsMutex.Lock()
 	defer fake.invocationsMutex.Unlock()
@@ -70,6 +251,54 @@ func (fake *FakeImportsGoHyphenPackage) recordInvocation(key string, args []inte
 		fake.invocations[key] = [][]interface{}{}
 	}
 	fake.invocations[key] = append(fake.invocations[key], args)
+	if fake.sequencer == nil {
+		fake.sequencer = new(atomic.Uint64)
+	}
+	fake.orderedInvocations = append(fake.orderedInvocations, struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}{fake.sequencer.Add(1), key, args})
+	if fake.invocationsChanged != nil {
+		close(fake.invocationsChanged)
+		fake.invocationsChanged = nil
+	}
+	subscribers := fake.callsSubscribers[key][:0]
+	for _, subscriber := range fake.callsSubscribers[key] {
+		select {
+		case <-subscriber.done:
+			continue
+		default:
+		}
+		subscriber.publish(args)
+		subscribers = append(subscribers, subscriber)
+	}
+	if len(subscribers) > 0 {
+		fake.callsSubscribers[key] = subscribers
+	} else {
+		delete(fake.callsSubscribers, key)
+	}
+}
+
+func (fake *FakeImportsGoHyphenPackage) forgetInvocations(key string) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	delete(fake.invocations, key)
+	for _, subscriber := range fake.callsSubscribers[key] {
+		subscriber.cancel()
+	}
+	delete(fake.callsSubscribers, key)
+	var orderedInvocations []struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}
+	for _, invocation := range fake.orderedInvocations {
+		if invocation.Method != key {
+			orderedInvocations = append(orderedInvocations, invocation)
+		}
+	}
+	fake.orderedInvocations = orderedInvocations
 }
 
 var _ fixtures.ImportsGoHyphenPackage = new(FakeImportsGoHyphenPackage)
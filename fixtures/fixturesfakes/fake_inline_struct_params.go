@@ -4,7 +4,9 @@ package fixturesfakes
 import (
 	"context"
 	"net/http"
+	"reflect"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/maxbrunsfeld/counterfeiter/v6/fixtures"
@@ -29,16 +31,56 @@ type FakeInlineStructParams struct {
 			HTTPRequest       http.Request
 		}
 	}
+	doSomethingWhen []struct {
+		matcher func(context.Context, struct {
+			SomeString        string
+			SomeStringPointer *string
+			SomeTime          time.Time
+			SomeTimePointer   *time.Time
+			HTTPRequest       http.Request
+		}) bool
+		stub func(context.Context, struct {
+			SomeString        string
+			SomeStringPointer *string
+			SomeTime          time.Time
+			SomeTimePointer   *time.Time
+			HTTPRequest       http.Request
+		}) error
+	}
 	doSomethingReturns struct {
 		result1 error
 	}
 	doSomethingReturnsOnCall map[int]struct {
 		result1 error
 	}
-	invocations      map[string][][]interface{}
+	ArgsComparer       func(expected interface{}, actual interface{}) bool
+	invocations        map[string][][]interface{}
+	orderedInvocations []struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}
+	sequencer          *atomic.Uint64
+	invocationsChanged chan struct{}
+	callsSubscribers   map[string][]struct {
+		publish func(args []interface{})
+		cancel  context.CancelFunc
+		done    <-chan struct{}
+	}
 	invocationsMutex sync.RWMutex
 }
 
+type FakeInlineStructParamsDoSomethingCall struct {
+	Ctx  context.Context
+	Body struct {
+		SomeString        string
+		SomeStringPointer *string
+		SomeTime          time.Time
+		SomeTimePointer   *time.Time
+		HTTPRequest       http.Request
+	}
+}
+
 func (fake *FakeInlineStructParams) DoSomething(arg1 context.Context, arg2 struct {
 	SomeString        string
 	SomeStringPointer *string
@@ -59,9 +101,15 @@ func (fake *FakeInlineStructParams) DoSomething(arg1 context.Context, arg2 struc
 		}
 	}{arg1, arg2})
 	stub := fake.DoSomethingStub
+	whens := fake.doSomethingWhen
 	fakeReturns := fake.doSomethingReturns
 	fake.recordInvocation("DoSomething", []interface{}{arg1, arg2})
 	fake.doSomethingMutex.Unlock()
+	for i := len(whens) - 1; i >= 0; i-- {
+		if whens[i].matcher(arg1, arg2) {
+			return whens[i].stub(arg1, arg2)
+		}
+	}
 	if stub != nil {
 		return stub(arg1, arg2)
 	}
@@ -77,6 +125,14 @@ func (fake *FakeInlineStructParams) DoSomethingCallCount() int {
 	return len(fake.doSomethingArgsForCall)
 }
 
+func (fake *FakeInlineStructParams) WaitForDoSomethingCalls(ctx context.Context, n int) error {
+	return fake.waitForCalls(ctx, fake.DoSomethingCallCount, n)
+}
+
+func (fake *FakeInlineStructParams) DoSomethingCallsChan(ctx context.Context) <-chan []interface{} {
+	return fake.callsChan(ctx, "DoSomething")
+}
+
 func (fake *FakeInlineStructParams) DoSomethingCalls(stub func(context.Context, struct {
 	SomeString        string
 	SomeStringPointer *string
@@ -89,6 +145,41 @@ func (fake *FakeInlineStructParams) DoSomethingCalls(stub func(context.Context,
 	fake.DoSomethingStub = stub
 }
 
+// DoSomethingCallsWhen calls the stub for the calls whose arguments match.
+// Matchers are checked before the stub, and the matcher registered last wins.
+func (fake *FakeInlineStructParams) DoSomethingCallsWhen(matcher func(context.Context, struct {
+	SomeString        string
+	SomeStringPointer *string
+	SomeTime          time.Time
+	SomeTimePointer   *time.Time
+	HTTPRequest       http.Request
+}) bool, stub func(context.Context, struct {
+	SomeString        string
+	SomeStringPointer *string
+	SomeTime          time.Time
+	SomeTimePointer   *time.Time
+	HTTPRequest       http.Request
+}) error) {
+	fake.doSomethingMutex.Lock()
+	defer fake.doSomethingMutex.Unlock()
+	fake.doSomethingWhen = append(fake.doSomethingWhen, struct {
+		matcher func(context.Context, struct {
+			SomeString        string
+			SomeStringPointer *string
+			SomeTime          time.Time
+			SomeTimePointer   *time.Time
+			HTTPRequest       http.Request
+		}) bool
+		stub func(context.Context, struct {
+			SomeString        string
+			SomeStringPointer *string
+			SomeTime          time.Time
+			SomeTimePointer   *time.Time
+			HTTPRequest       http.Request
+		}) error
+	}{matcher, stub})
+}
+
 func (fake *FakeInlineStructParams) DoSomethingArgsForCall(i int) (context.Context, struct {
 	SomeString        string
 	SomeStringPointer *string
@@ -102,6 +193,16 @@ func (fake *FakeInlineStructParams) DoSomethingArgsForCall(i int) (context.Conte
 	return argsForCall.arg1, argsForCall.arg2
 }
 
+func (fake *FakeInlineStructParams) DoSomethingCallHistory() []FakeInlineStructParamsDoSomethingCall {
+	fake.doSomethingMutex.RLock()
+	defer fake.doSomethingMutex.RUnlock()
+	history := make([]FakeInlineStructParamsDoSomethingCall, len(fake.doSomethingArgsForCall))
+	for i, argsForCall := range fake.doSomethingArgsForCall {
+		history[i] = FakeInlineStructParamsDoSomethingCall{argsForCall.arg1, argsForCall.arg2}
+	}
+	return history
+}
+
 func (fake *FakeInlineStructParams) DoSomethingReturns(result1 error) {
 	fake.doSomethingMutex.Lock()
 	defer fake.doSomethingMutex.Unlock()
@@ -125,6 +226,116 @@ func (fake *FakeInlineStructParams) DoSomethingReturnsOnCall(i int, result1 erro
 	}{result1}
 }
 
+// DoSomethingReturnsForArgs returns the values for the calls with the given
+// arguments. Like DoSomethingCallsWhen, it is checked before the stub, and the
+// arguments or matcher registered last win.
+func (fake *FakeInlineStructParams) DoSomethingReturnsForArgs(arg1 context.Context, arg2 struct {
+	SomeString        string
+	SomeStringPointer *string
+	SomeTime          time.Time
+	SomeTimePointer   *time.Time
+	HTTPRequest       http.Request
+}) func(error) {
+	args := []interface{}{arg1, arg2}
+	return func(result1 error) {
+		fake.doSomethingMutex.Lock()
+		defer fake.doSomethingMutex.Unlock()
+		fake.DoSomethingStub = nil
+		fake.doSomethingWhen = append(fake.doSomethingWhen, struct {
+			matcher func(context.Context, struct {
+				SomeString        string
+				SomeStringPointer *string
+				SomeTime          time.Time
+				SomeTimePointer   *time.Time
+				HTTPRequest       http.Request
+			}) bool
+			stub func(context.Context, struct {
+				SomeString        string
+				SomeStringPointer *string
+				SomeTime          time.Time
+				SomeTimePointer   *time.Time
+				HTTPRequest       http.Request
+			}) error
+		}{
+			func(arg1 context.Context, arg2 struct {
+				SomeString        string
+				SomeStringPointer *string
+				SomeTime          time.Time
+				SomeTimePointer   *time.Time
+				HTTPRequest       http.Request
+			}) bool {
+				return fake.argsMatch(args, []interface{}{arg1, arg2})
+			},
+			func(context.Context, struct {
+				SomeString        string
+				SomeStringPointer *string
+				SomeTime          time.Time
+				SomeTimePointer   *time.Time
+				HTTPRequest       http.Request
+			}) error {
+				return result1
+			},
+		})
+	}
+}
+
+// DoSomethingReturnsWhen returns the values for the calls whose arguments
+// match. Like DoSomethingCallsWhen, it is checked before the stub, and the
+// matcher registered last wins.
+func (fake *FakeInlineStructParams) DoSomethingReturnsWhen(matcher func(context.Context, struct {
+	SomeString        string
+	SomeStringPointer *string
+	SomeTime          time.Time
+	SomeTimePointer   *time.Time
+	HTTPRequest       http.Request
+}) bool, result1 error) {
+	fake.DoSomethingCallsWhen(matcher, func(context.Context, struct {
+		SomeString        string
+		SomeStringPointer *string
+		SomeTime          time.Time
+		SomeTimePointer   *time.Time
+		HTTPRequest       http.Request
+	}) error {
+		return result1
+	})
+}
+
+func (fake *FakeInlineStructParams) ResetDoSomething() {
+	fake.ResetDoSomethingCalls()
+	fake.ResetDoSomethingStubs()
+}
+
+func (fake *FakeInlineStructParams) ResetDoSomethingCalls() {
+	fake.doSomethingMutex.Lock()
+	defer fake.doSomethingMutex.Unlock()
+	fake.doSomethingArgsForCall = nil
+	fake.forgetInvocations("DoSomething")
+}
+
+func (fake *FakeInlineStructParams) ResetDoSomethingStubs() {
+	fake.doSomethingMutex.Lock()
+	defer fake.doSomethingMutex.Unlock()
+	fake.DoSomethingStub = nil
+	fake.doSomethingWhen = nil
+	fake.doSomethingReturns = struct {
+		result1 error
+	}{}
+	fake.doSomethingReturnsOnCall = nil
+}
+
+func (fake *FakeInlineStructParams) Reset() {
+	fake.ResetCalls()
+	fake.ResetStubs()
+}
+
+func (fake *FakeInlineStructParams) ResetCalls() {
+	fake.ResetDoSomethingCalls()
+}
+
+func (fake *FakeInlineStructParams) ResetStubs() {
+	fake.ResetDoSomethingStubs()
+}
+
 func (fake *FakeInlineStructParams) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -135,6 +346,109 @@ func (fake *FakeInlineStructParams) Invocations() map[string][][]interface{} {
 	return copiedInvocations
 }
 
+func (fake *FakeInlineStructParams) argsMatch(expected []interface{}, actual []interface{}) bool {
+	if len(expected) != len(actual) {
+		return false
+	}
+	for i := range expected {
+		if fake.ArgsComparer != nil && !fake.ArgsComparer(expected[i], actual[i]) || fake.ArgsComparer == nil && !reflect.DeepEqual(expected[i], actual[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (fake *FakeInlineStructParams) OrderedInvocations() []struct {
+	Seq    uint64
+	Method string
+	Args   []interface{}
+} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	return append([]struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}{}, fake.orderedInvocations...)
+}
+
+func (fake *FakeInlineStructParams) SetSequencer(sequencer *atomic.Uint64) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	fake.sequencer = sequencer
+}
+
+func (fake *FakeInlineStructParams) waitForCalls(ctx context.Context, callCount func() int, n int) error {
+	for {
+		fake.invocationsMutex.Lock()
+		if fake.invocationsChanged == nil {
+			fake.invocationsChanged = make(chan struct{})
+		}
+		changed := fake.invocationsChanged
+		fake.invocationsMutex.Unlock()
+		if callCount() >= n {
+			return nil
+		}
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (fake *FakeInlineStructParams) callsChan(ctx context.Context, key string) <-chan []interface{} {
+	ctx, cancel := context.WithCancel(ctx)
+	calls := make(chan []interface{})
+	ready := make(chan struct{}, 1)
+	var pendingMutex sync.Mutex
+	var pending [][]interface{}
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.callsSubscribers == nil {
+		fake.callsSubscribers = map[string][]struct {
+			publish func(args []interface{})
+			cancel  context.CancelFunc
+			done    <-chan struct{}
+		}{}
+	}
+	fake.callsSubscribers[key] = append(fake.callsSubscribers[key], struct {
+		publish func(args []interface{})
+		cancel  context.CancelFunc
+		done    <-chan struct{}
+	}{func(args []interface{}) {
+		pendingMutex.Lock()
+		pending = append(pending, args)
+		pendingMutex.Unlock()
+		select {
+		case ready <- struct{}{}:
+		default:
+		}
+	}, cancel, ctx.Done()})
+	go func() {
+		defer close(calls)
+		for {
+			select {
+			case <-ready:
+			case <-ctx.Done():
+				return
+			}
+			pendingMutex.Lock()
+			queued := pending
+			pending = nil
+			pendingMutex.Unlock()
+			for _, args := range queued {
+				select {
+				case calls <- args:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return calls
+}
+
 func (fake *FakeInlineStructParams) recordInvocation(key string, args []interface{}) {
 	fake.invocationsMutex.Lock()
 	defer fake.invocationsMutex.Unlock()
@@ -145,6 +459,54 @@ func (fake *FakeInlineStructParams) recordInvocation(key string, args []interfac
 		fake.invocations[key] = [][]interface{}{}
 	}
 	fake.invocations[key] = append(fake.invocations[key], args)
+	if fake.sequencer == nil {
+		fake.sequencer = new(atomic.Uint64)
+	}
+	fake.orderedInvocations = append(fake.orderedInvocations, struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}{fake.sequencer.Add(1), key, args})
+	if fake.invocationsChanged != nil {
+		close(fake.invocationsChanged)
+		fake.invocationsChanged = nil
+	}
+	subscribers := fake.callsSubscribers[key][:0]
+	for _, subscriber := range fake.callsSubscribers[key] {
+		select {
+		case <-subscriber.done:
+			continue
+		default:
+		}
+		subscriber.publish(args)
+		subscribers = append(subscribers, subscriber)
+	}
+	if len(subscribers) > 0 {
+		fake.callsSubscribers[key] = subscribers
+	} else {
+		delete(fake.callsSubscribers, key)
+	}
+}
+
+func (fake *FakeInlineStructParams) forgetInvocations(key string) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	delete(fake.invocations, key)
+	for _, subscriber := range fake.callsSubscribers[key] {
+		subscriber.cancel()
+	}
+	delete(fake.callsSubscribers, key)
+	var orderedInvocations []struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}
+	for _, invocation := range fake.orderedInvocations {
+		if invocation.Method != key {
+			orderedInvocations = append(orderedInvocations, invocation)
+		}
+	}
+	fake.orderedInvocations = orderedInvocations
 }
 
 var _ fixtures.InlineStructParams = new(FakeInlineStructParams)
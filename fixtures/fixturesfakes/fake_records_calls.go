@@ -0,0 +1,474 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package fixturesfakes
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"sync/atomic"
+
+	"github.com/maxbrunsfeld/counterfeiter/v6/fixtures"
+)
+
+type FakeRecordsCalls struct {
+	CallsStub        func() []string
+	callsMutex       sync.RWMutex
+	callsArgsForCall []struct {
+	}
+	callsReturns struct {
+		result1 []string
+	}
+	callsReturnsOnCall map[int]struct {
+		result1 []string
+	}
+	StubsStub        func(string) error
+	stubsMutex       sync.RWMutex
+	stubsArgsForCall []struct {
+		arg1 string
+	}
+	stubsWhen []struct {
+		matcher func(string) bool
+		stub    func(string) error
+	}
+	stubsReturns struct {
+		result1 error
+	}
+	stubsReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ArgsComparer       func(expected interface{}, actual interface{}) bool
+	invocations        map[string][][]interface{}
+	orderedInvocations []struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}
+	sequencer          *atomic.Uint64
+	invocationsChanged chan struct{}
+	callsSubscribers   map[string][]struct {
+		publish func(args []interface{})
+		cancel  context.CancelFunc
+		done    <-chan struct{}
+	}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeRecordsCalls) Calls() []string {
+	fake.callsMutex.Lock()
+	ret, specificReturn := fake.callsReturnsOnCall[len(fake.callsArgsForCall)]
+	fake.callsArgsForCall = append(fake.callsArgsForCall, struct {
+	}{})
+	stub := fake.CallsStub
+	fakeReturns := fake.callsReturns
+	fake.recordInvocation("Calls", []interface{}{})
+	fake.callsMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRecordsCalls) CallsCallCount() int {
+	fake.callsMutex.RLock()
+	defer fake.callsMutex.RUnlock()
+	return len(fake.callsArgsForCall)
+}
+
+func (fake *FakeRecordsCalls) WaitForCallsCalls(ctx context.Context, n int) error {
+	return fake.waitForCalls(ctx, fake.CallsCallCount, n)
+}
+
+func (fake *FakeRecordsCalls) CallsCallsChan(ctx context.Context) <-chan []interface{} {
+	return fake.callsChan(ctx, "Calls")
+}
+
+func (fake *FakeRecordsCalls) CallsCalls(stub func() []string) {
+	fake.callsMutex.Lock()
+	defer fake.callsMutex.Unlock()
+	fake.CallsStub = stub
+}
+
+func (fake *FakeRecordsCalls) CallsReturns(result1 []string) {
+	fake.callsMutex.Lock()
+	defer fake.callsMutex.Unlock()
+	fake.CallsStub = nil
+	fake.callsReturns = struct {
+		result1 []string
+	}{result1}
+}
+
+func (fake *FakeRecordsCalls) CallsReturnsOnCall(i int, result1 []string) {
+	fake.callsMutex.Lock()
+	defer fake.callsMutex.Unlock()
+	fake.CallsStub = nil
+	if fake.callsReturnsOnCall == nil {
+		fake.callsReturnsOnCall = make(map[int]struct {
+			result1 []string
+		})
+	}
+	fake.callsReturnsOnCall[i] = struct {
+		result1 []string
+	}{result1}
+}
+
+func (fake *FakeRecordsCalls) ResetCalls() {
+	fake.ResetCallsCalls()
+	fake.ResetCallsStubs()
+}
+
+func (fake *FakeRecordsCalls) ResetCallsCalls() {
+	fake.callsMutex.Lock()
+	defer fake.callsMutex.Unlock()
+	fake.callsArgsForCall = nil
+	fake.forgetInvocations("Calls")
+}
+
+func (fake *FakeRecordsCalls) ResetCallsStubs() {
+	fake.callsMutex.Lock()
+	defer fake.callsMutex.Unlock()
+	fake.CallsStub = nil
+	fake.callsReturns = struct {
+		result1 []string
+	}{}
+	fake.callsReturnsOnCall = nil
+}
+
+type FakeRecordsCallsStubsCall struct {
+	Name string
+}
+
+func (fake *FakeRecordsCalls) Stubs(arg1 string) error {
+	fake.stubsMutex.Lock()
+	ret, specificReturn := fake.stubsReturnsOnCall[len(fake.stubsArgsForCall)]
+	fake.stubsArgsForCall = append(fake.stubsArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.StubsStub
+	whens := fake.stubsWhen
+	fakeReturns := fake.stubsReturns
+	fake.recordInvocation("Stubs", []interface{}{arg1})
+	fake.stubsMutex.Unlock()
+	for i := len(whens) - 1; i >= 0; i-- {
+		if whens[i].matcher(arg1) {
+			return whens[i].stub(arg1)
+		}
+	}
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRecordsCalls) StubsCallCount() int {
+	fake.stubsMutex.RLock()
+	defer fake.stubsMutex.RUnlock()
+	return len(fake.stubsArgsForCall)
+}
+
+func (fake *FakeRecordsCalls) WaitForStubsCalls(ctx context.Context, n int) error {
+	return fake.waitForCalls(ctx, fake.StubsCallCount, n)
+}
+
+func (fake *FakeRecordsCalls) StubsCallsChan(ctx context.Context) <-chan []interface{} {
+	return fake.callsChan(ctx, "Stubs")
+}
+
+func (fake *FakeRecordsCalls) StubsCalls(stub func(string) error) {
+	fake.stubsMutex.Lock()
+	defer fake.stubsMutex.Unlock()
+	fake.StubsStub = stub
+}
+
+// StubsCallsWhen calls the stub for the calls whose arguments match.
+// Matchers are checked before the stub, and the matcher registered last wins.
+func (fake *FakeRecordsCalls) StubsCallsWhen(matcher func(string) bool, stub func(string) error) {
+	fake.stubsMutex.Lock()
+	defer fake.stubsMutex.Unlock()
+	fake.stubsWhen = append(fake.stubsWhen, struct {
+		matcher func(string) bool
+		stub    func(string) error
+	}{matcher, stub})
+}
+
+func (fake *FakeRecordsCalls) StubsArgsForCall(i int) string {
+	fake.stubsMutex.RLock()
+	defer fake.stubsMutex.RUnlock()
+	argsForCall := fake.stubsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeRecordsCalls) StubsCallHistory() []FakeRecordsCallsStubsCall {
+	fake.stubsMutex.RLock()
+	defer fake.stubsMutex.RUnlock()
+	history := make([]FakeRecordsCallsStubsCall, len(fake.stubsArgsForCall))
+	for i, argsForCall := range fake.stubsArgsForCall {
+		history[i] = FakeRecordsCallsStubsCall{argsForCall.arg1}
+	}
+	return history
+}
+
+func (fake *FakeRecordsCalls) StubsReturns(result1 error) {
+	fake.stubsMutex.Lock()
+	defer fake.stubsMutex.Unlock()
+	fake.StubsStub = nil
+	fake.stubsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRecordsCalls) StubsReturnsOnCall(i int, result1 error) {
+	fake.stubsMutex.Lock()
+	defer fake.stubsMutex.Unlock()
+	fake.StubsStub = nil
+	if fake.stubsReturnsOnCall == nil {
+		fake.stubsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.stubsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+// StubsReturnsForArgs returns the values for the calls with the given
+// arguments. Like StubsCallsWhen, it is checked before the stub, and the
+// arguments or matcher registered last win.
+func (fake *FakeRecordsCalls) StubsReturnsForArgs(arg1 string) func(error) {
+	args := []interface{}{arg1}
+	return func(result1 error) {
+		fake.stubsMutex.Lock()
+		defer fake.stubsMutex.Unlock()
+		fake.StubsStub = nil
+		fake.stubsWhen = append(fake.stubsWhen, struct {
+			matcher func(string) bool
+			stub    func(string) error
+		}{
+			func(arg1 string) bool {
+				return fake.argsMatch(args, []interface{}{arg1})
+			},
+			func(string) error {
+				return result1
+			},
+		})
+	}
+}
+
+// StubsReturnsWhen returns the values for the calls whose arguments
+// match. Like StubsCallsWhen, it is checked before the stub, and the
+// matcher registered last wins.
+func (fake *FakeRecordsCalls) StubsReturnsWhen(matcher func(string) bool, result1 error) {
+	fake.StubsCallsWhen(matcher, func(string) error {
+		return result1
+	})
+}
+
+func (fake *FakeRecordsCalls) ResetStubs() {
+	fake.ResetStubsCalls()
+	fake.ResetStubsStubs()
+}
+
+func (fake *FakeRecordsCalls) ResetStubsCalls() {
+	fake.stubsMutex.Lock()
+	defer fake.stubsMutex.Unlock()
+	fake.stubsArgsForCall = nil
+	fake.forgetInvocations("Stubs")
+}
+
+func (fake *FakeRecordsCalls) ResetStubsStubs() {
+	fake.stubsMutex.Lock()
+	defer fake.stubsMutex.Unlock()
+	fake.StubsStub = nil
+	fake.stubsWhen = nil
+	fake.stubsReturns = struct {
+		result1 error
+	}{}
+	fake.stubsReturnsOnCall = nil
+}
+
+func (fake *FakeRecordsCalls) Reset() {
+	fake.ResetCallsCalls()
+	fake.ResetCallsStubs()
+	fake.ResetStubsCalls()
+	fake.ResetStubsStubs()
+}
+
+func (fake *FakeRecordsCalls) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeRecordsCalls) argsMatch(expected []interface{}, actual []interface{}) bool {
+	if len(expected) != len(actual) {
+		return false
+	}
+	for i := range expected {
+		if fake.ArgsComparer != nil && !fake.ArgsComparer(expected[i], actual[i]) || fake.ArgsComparer == nil && !reflect.DeepEqual(expected[i], actual[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (fake *FakeRecordsCalls) OrderedInvocations() []struct {
+	Seq    uint64
+	Method string
+	Args   []interface{}
+} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	return append([]struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}{}, fake.orderedInvocations...)
+}
+
+func (fake *FakeRecordsCalls) SetSequencer(sequencer *atomic.Uint64) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	fake.sequencer = sequencer
+}
+
+func (fake *FakeRecordsCalls) waitForCalls(ctx context.Context, callCount func() int, n int) error {
+	for {
+		fake.invocationsMutex.Lock()
+		if fake.invocationsChanged == nil {
+			fake.invocationsChanged = make(chan struct{})
+		}
+		changed := fake.invocationsChanged
+		fake.invocationsMutex.Unlock()
+		if callCount() >= n {
+			return nil
+		}
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (fake *FakeRecordsCalls) callsChan(ctx context.Context, key string) <-chan []interface{} {
+	ctx, cancel := context.WithCancel(ctx)
+	calls := make(chan []interface{})
+	ready := make(chan struct{}, 1)
+	var pendingMutex sync.Mutex
+	var pending [][]interface{}
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.callsSubscribers == nil {
+		fake.callsSubscribers = map[string][]struct {
+			publish func(args []interface{})
+			cancel  context.CancelFunc
+			done    <-chan struct{}
+		}{}
+	}
+	fake.callsSubscribers[key] = append(fake.callsSubscribers[key], struct {
+		publish func(args []interface{})
+		cancel  context.CancelFunc
+		done    <-chan struct{}
+	}{func(args []interface{}) {
+		pendingMutex.Lock()
+		pending = append(pending, args)
+		pendingMutex.Unlock()
+		select {
+		case ready <- struct{}{}:
+		default:
+		}
+	}, cancel, ctx.Done()})
+	go func() {
+		defer close(calls)
+		for {
+			select {
+			case <-ready:
+			case <-ctx.Done():
+				return
+			}
+			pendingMutex.Lock()
+			queued := pending
+			pending = nil
+			pendingMutex.Unlock()
+			for _, args := range queued {
+				select {
+				case calls <- args:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return calls
+}
+
+func (fake *FakeRecordsCalls) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+	if fake.sequencer == nil {
+		fake.sequencer = new(atomic.Uint64)
+	}
+	fake.orderedInvocations = append(fake.orderedInvocations, struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}{fake.sequencer.Add(1), key, args})
+	if fake.invocationsChanged != nil {
+		close(fake.invocationsChanged)
+		fake.invocationsChanged = nil
+	}
+	subscribers := fake.callsSubscribers[key][:0]
+	for _, subscriber := range fake.callsSubscribers[key] {
+		select {
+		case <-subscriber.done:
+			continue
+		default:
+		}
+		subscriber.publish(args)
+		subscribers = append(subscribers, subscriber)
+	}
+	if len(subscribers) > 0 {
+		fake.callsSubscribers[key] = subscribers
+	} else {
+		delete(fake.callsSubscribers, key)
+	}
+}
+
+func (fake *FakeRecordsCalls) forgetInvocations(key string) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	delete(fake.invocations, key)
+	for _, subscriber := range fake.callsSubscribers[key] {
+		subscriber.cancel()
+	}
+	delete(fake.callsSubscribers, key)
+	var orderedInvocations []struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}
+	for _, invocation := range fake.orderedInvocations {
+		if invocation.Method != key {
+			orderedInvocations = append(orderedInvocations, invocation)
+		}
+	}
+	fake.orderedInvocations = orderedInvocations
+}
+
+var _ fixtures.RecordsCalls = new(FakeRecordsCalls)
@@ -0,0 +1,821 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package fixturesfakes
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+	"sync/atomic"
+
+	"github.com/maxbrunsfeld/counterfeiter/v6/fixtures"
+)
+
+type FakeScanner struct {
+	DecodeStub        func(any) error
+	decodeMutex       sync.RWMutex
+	decodeArgsForCall []struct {
+		arg1 any
+	}
+	decodeWhen []struct {
+		matcher func(any) bool
+		stub    func(any) error
+	}
+	decodeSetsArgs map[int]interface{}
+	decodeReturns  struct {
+		result1 error
+	}
+	decodeReturnsOnCall map[int]struct {
+		result1 error
+	}
+	LoadStub        func(string, *fixtures.Order) (bool, error)
+	loadMutex       sync.RWMutex
+	loadArgsForCall []struct {
+		arg1 string
+		arg2 *fixtures.Order
+	}
+	loadWhen []struct {
+		matcher func(string, *fixtures.Order) bool
+		stub    func(string, *fixtures.Order) (bool, error)
+	}
+	loadSetsArgs map[int]interface{}
+	loadReturns  struct {
+		result1 bool
+		result2 error
+	}
+	loadReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	ScanStub        func(...interface{}) error
+	scanMutex       sync.RWMutex
+	scanArgsForCall []struct {
+		arg1 []interface{}
+	}
+	scanWhen []struct {
+		matcher func(...interface{}) bool
+		stub    func(...interface{}) error
+	}
+	scanSetsArgs map[int]interface{}
+	scanReturns  struct {
+		result1 error
+	}
+	scanReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ArgsComparer       func(expected interface{}, actual interface{}) bool
+	invocations        map[string][][]interface{}
+	orderedInvocations []struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}
+	sequencer          *atomic.Uint64
+	invocationsChanged chan struct{}
+	callsSubscribers   map[string][]struct {
+		publish func(args []interface{})
+		cancel  context.CancelFunc
+		done    <-chan struct{}
+	}
+	invocationsMutex sync.RWMutex
+}
+
+type FakeScannerDecodeCall struct {
+	V any
+}
+
+func (fake *FakeScanner) Decode(arg1 any) error {
+	fake.decodeMutex.Lock()
+	ret, specificReturn := fake.decodeReturnsOnCall[len(fake.decodeArgsForCall)]
+	fake.decodeArgsForCall = append(fake.decodeArgsForCall, struct {
+		arg1 any
+	}{arg1})
+	stub := fake.DecodeStub
+	whens := fake.decodeWhen
+	setsArgs := fake.decodeSetsArgs
+	fakeReturns := fake.decodeReturns
+	fake.recordInvocation("Decode", []interface{}{arg1})
+	fake.decodeMutex.Unlock()
+	if len(setsArgs) > 0 {
+		args := []interface{}{arg1}
+		fake.setArgs("Decode", setsArgs, args)
+	}
+	for i := len(whens) - 1; i >= 0; i-- {
+		if whens[i].matcher(arg1) {
+			return whens[i].stub(arg1)
+		}
+	}
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeScanner) DecodeCallCount() int {
+	fake.decodeMutex.RLock()
+	defer fake.decodeMutex.RUnlock()
+	return len(fake.decodeArgsForCall)
+}
+
+func (fake *FakeScanner) WaitForDecodeCalls(ctx context.Context, n int) error {
+	return fake.waitForCalls(ctx, fake.DecodeCallCount, n)
+}
+
+func (fake *FakeScanner) DecodeCallsChan(ctx context.Context) <-chan []interface{} {
+	return fake.callsChan(ctx, "Decode")
+}
+
+func (fake *FakeScanner) DecodeCalls(stub func(any) error) {
+	fake.decodeMutex.Lock()
+	defer fake.decodeMutex.Unlock()
+	fake.DecodeStub = stub
+}
+
+func (fake *FakeScanner) DecodeSetsArg(i int, value interface{}) {
+	fake.decodeMutex.Lock()
+	defer fake.decodeMutex.Unlock()
+	setsArgs := map[int]interface{}{i: value}
+	for j, v := range fake.decodeSetsArgs {
+		if j != i {
+			setsArgs[j] = v
+		}
+	}
+	fake.decodeSetsArgs = setsArgs
+}
+
+// DecodeCallsWhen calls the stub for the calls whose arguments match.
+// Matchers are checked before the stub, and the matcher registered last wins.
+func (fake *FakeScanner) DecodeCallsWhen(matcher func(any) bool, stub func(any) error) {
+	fake.decodeMutex.Lock()
+	defer fake.decodeMutex.Unlock()
+	fake.decodeWhen = append(fake.decodeWhen, struct {
+		matcher func(any) bool
+		stub    func(any) error
+	}{matcher, stub})
+}
+
+func (fake *FakeScanner) DecodeArgsForCall(i int) any {
+	fake.decodeMutex.RLock()
+	defer fake.decodeMutex.RUnlock()
+	argsForCall := fake.decodeArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeScanner) DecodeCallHistory() []FakeScannerDecodeCall {
+	fake.decodeMutex.RLock()
+	defer fake.decodeMutex.RUnlock()
+	history := make([]FakeScannerDecodeCall, len(fake.decodeArgsForCall))
+	for i, argsForCall := range fake.decodeArgsForCall {
+		history[i] = FakeScannerDecodeCall{argsForCall.arg1}
+	}
+	return history
+}
+
+func (fake *FakeScanner) DecodeReturns(result1 error) {
+	fake.decodeMutex.Lock()
+	defer fake.decodeMutex.Unlock()
+	fake.DecodeStub = nil
+	fake.decodeReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeScanner) DecodeReturnsOnCall(i int, result1 error) {
+	fake.decodeMutex.Lock()
+	defer fake.decodeMutex.Unlock()
+	fake.DecodeStub = nil
+	if fake.decodeReturnsOnCall == nil {
+		fake.decodeReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.decodeReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+// DecodeReturnsForArgs returns the values for the calls with the given
+// arguments. Like DecodeCallsWhen, it is checked before the stub, and the
+// arguments or matcher registered last win.
+func (fake *FakeScanner) DecodeReturnsForArgs(arg1 any) func(error) {
+	args := []interface{}{arg1}
+	return func(result1 error) {
+		fake.decodeMutex.Lock()
+		defer fake.decodeMutex.Unlock()
+		fake.DecodeStub = nil
+		fake.decodeWhen = append(fake.decodeWhen, struct {
+			matcher func(any) bool
+			stub    func(any) error
+		}{
+			func(arg1 any) bool {
+				return fake.argsMatch(args, []interface{}{arg1})
+			},
+			func(any) error {
+				return result1
+			},
+		})
+	}
+}
+
+// DecodeReturnsWhen returns the values for the calls whose arguments
+// match. Like DecodeCallsWhen, it is checked before the stub, and the
+// matcher registered last wins.
+func (fake *FakeScanner) DecodeReturnsWhen(matcher func(any) bool, result1 error) {
+	fake.DecodeCallsWhen(matcher, func(any) error {
+		return result1
+	})
+}
+
+func (fake *FakeScanner) ResetDecode() {
+	fake.ResetDecodeCalls()
+	fake.ResetDecodeStubs()
+}
+
+func (fake *FakeScanner) ResetDecodeCalls() {
+	fake.decodeMutex.Lock()
+	defer fake.decodeMutex.Unlock()
+	fake.decodeArgsForCall = nil
+	fake.forgetInvocations("Decode")
+}
+
+func (fake *FakeScanner) ResetDecodeStubs() {
+	fake.decodeMutex.Lock()
+	defer fake.decodeMutex.Unlock()
+	fake.DecodeStub = nil
+	fake.decodeWhen = nil
+	fake.decodeSetsArgs = nil
+	fake.decodeReturns = struct {
+		result1 error
+	}{}
+	fake.decodeReturnsOnCall = nil
+}
+
+type FakeScannerLoadCall struct {
+	Key  string
+	Into *fixtures.Order
+}
+
+func (fake *FakeScanner) Load(arg1 string, arg2 *fixtures.Order) (bool, error) {
+	fake.loadMutex.Lock()
+	ret, specificReturn := fake.loadReturnsOnCall[len(fake.loadArgsForCall)]
+	fake.loadArgsForCall = append(fake.loadArgsForCall, struct {
+		arg1 string
+		arg2 *fixtures.Order
+	}{arg1, arg2})
+	stub := fake.LoadStub
+	whens := fake.loadWhen
+	setsArgs := fake.loadSetsArgs
+	fakeReturns := fake.loadReturns
+	fake.recordInvocation("Load", []interface{}{arg1, arg2})
+	fake.loadMutex.Unlock()
+	if len(setsArgs) > 0 {
+		args := []interface{}{arg1, arg2}
+		fake.setArgs("Load", setsArgs, args)
+	}
+	for i := len(whens) - 1; i >= 0; i-- {
+		if whens[i].matcher(arg1, arg2) {
+			return whens[i].stub(arg1, arg2)
+		}
+	}
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeScanner) LoadCallCount() int {
+	fake.loadMutex.RLock()
+	defer fake.loadMutex.RUnlock()
+	return len(fake.loadArgsForCall)
+}
+
+func (fake *FakeScanner) WaitForLoadCalls(ctx context.Context, n int) error {
+	return fake.waitForCalls(ctx, fake.LoadCallCount, n)
+}
+
+func (fake *FakeScanner) LoadCallsChan(ctx context.Context) <-chan []interface{} {
+	return fake.callsChan(ctx, "Load")
+}
+
+func (fake *FakeScanner) LoadCalls(stub func(string, *fixtures.Order) (bool, error)) {
+	fake.loadMutex.Lock()
+	defer fake.loadMutex.Unlock()
+	fake.LoadStub = stub
+}
+
+func (fake *FakeScanner) LoadSetsArg(i int, value interface{}) {
+	fake.loadMutex.Lock()
+	defer fake.loadMutex.Unlock()
+	setsArgs := map[int]interface{}{i: value}
+	for j, v := range fake.loadSetsArgs {
+		if j != i {
+			setsArgs[j] = v
+		}
+	}
+	fake.loadSetsArgs = setsArgs
+}
+
+// LoadCallsWhen calls the stub for the calls whose arguments match.
+// Matchers are checked before the stub, and the matcher registered last wins.
+func (fake *FakeScanner) LoadCallsWhen(matcher func(string, *fixtures.Order) bool, stub func(string, *fixtures.Order) (bool, error)) {
+	fake.loadMutex.Lock()
+	defer fake.loadMutex.Unlock()
+	fake.loadWhen = append(fake.loadWhen, struct {
+		matcher func(string, *fixtures.Order) bool
+		stub    func(string, *fixtures.Order) (bool, error)
+	}{matcher, stub})
+}
+
+func (fake *FakeScanner) LoadArgsForCall(i int) (string, *fixtures.Order) {
+	fake.loadMutex.RLock()
+	defer fake.loadMutex.RUnlock()
+	argsForCall := fake.loadArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeScanner) LoadCallHistory() []FakeScannerLoadCall {
+	fake.loadMutex.RLock()
+	defer fake.loadMutex.RUnlock()
+	history := make([]FakeScannerLoadCall, len(fake.loadArgsForCall))
+	for i, argsForCall := range fake.loadArgsForCall {
+		history[i] = FakeScannerLoadCall{argsForCall.arg1, argsForCall.arg2}
+	}
+	return history
+}
+
+func (fake *FakeScanner) LoadReturns(result1 bool, result2 error) {
+	fake.loadMutex.Lock()
+	defer fake.loadMutex.Unlock()
+	fake.LoadStub = nil
+	fake.loadReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeScanner) LoadReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.loadMutex.Lock()
+	defer fake.loadMutex.Unlock()
+	fake.LoadStub = nil
+	if fake.loadReturnsOnCall == nil {
+		fake.loadReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.loadReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+// LoadReturnsForArgs returns the values for the calls with the given
+// arguments. Like LoadCallsWhen, it is checked before the stub, and the
+// arguments or matcher registered last win.
+func (fake *FakeScanner) LoadReturnsForArgs(arg1 string, arg2 *fixtures.Order) func(bool, error) {
+	args := []interface{}{arg1, arg2}
+	return func(result1 bool, result2 error) {
+		fake.loadMutex.Lock()
+		defer fake.loadMutex.Unlock()
+		fake.LoadStub = nil
+		fake.loadWhen = append(fake.loadWhen, struct {
+			matcher func(string, *fixtures.Order) bool
+			stub    func(string, *fixtures.Order) (bool, error)
+		}{
+			func(arg1 string, arg2 *fixtures.Order) bool {
+				return fake.argsMatch(args, []interface{}{arg1, arg2})
+			},
+			func(string, *fixtures.Order) (bool, error) {
+				return result1, result2
+			},
+		})
+	}
+}
+
+// LoadReturnsWhen returns the values for the calls whose arguments
+// match. Like LoadCallsWhen, it is checked before the stub, and the
+// matcher registered last wins.
+func (fake *FakeScanner) LoadReturnsWhen(matcher func(string, *fixtures.Order) bool, result1 bool, result2 error) {
+	fake.LoadCallsWhen(matcher, func(string, *fixtures.Order) (bool, error) {
+		return result1, result2
+	})
+}
+
+func (fake *FakeScanner) ResetLoad() {
+	fake.ResetLoadCalls()
+	fake.ResetLoadStubs()
+}
+
+func (fake *FakeScanner) ResetLoadCalls() {
+	fake.loadMutex.Lock()
+	defer fake.loadMutex.Unlock()
+	fake.loadArgsForCall = nil
+	fake.forgetInvocations("Load")
+}
+
+func (fake *FakeScanner) ResetLoadStubs() {
+	fake.loadMutex.Lock()
+	defer fake.loadMutex.Unlock()
+	fake.LoadStub = nil
+	fake.loadWhen = nil
+	fake.loadSetsArgs = nil
+	fake.loadReturns = struct {
+		result1 bool
+		result2 error
+	}{}
+	fake.loadReturnsOnCall = nil
+}
+
+type FakeScannerScanCall struct {
+	Dest []interface{}
+}
+
+func (fake *FakeScanner) Scan(arg1 ...interface{}) error {
+	fake.scanMutex.Lock()
+	ret, specificReturn := fake.scanReturnsOnCall[len(fake.scanArgsForCall)]
+	fake.scanArgsForCall = append(fake.scanArgsForCall, struct {
+		arg1 []interface{}
+	}{arg1})
+	stub := fake.ScanStub
+	whens := fake.scanWhen
+	setsArgs := fake.scanSetsArgs
+	fakeReturns := fake.scanReturns
+	fake.recordInvocation("Scan", []interface{}{arg1})
+	fake.scanMutex.Unlock()
+	if len(setsArgs) > 0 {
+		args := []interface{}{}
+		for _, arg := range arg1 {
+			args = append(args, arg)
+		}
+		fake.setArgs("Scan", setsArgs, args)
+	}
+	for i := len(whens) - 1; i >= 0; i-- {
+		if whens[i].matcher(arg1...) {
+			return whens[i].stub(arg1...)
+		}
+	}
+	if stub != nil {
+		return stub(arg1...)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeScanner) ScanCallCount() int {
+	fake.scanMutex.RLock()
+	defer fake.scanMutex.RUnlock()
+	return len(fake.scanArgsForCall)
+}
+
+func (fake *FakeScanner) WaitForScanCalls(ctx context.Context, n int) error {
+	return fake.waitForCalls(ctx, fake.ScanCallCount, n)
+}
+
+func (fake *FakeScanner) ScanCallsChan(ctx context.Context) <-chan []interface{} {
+	return fake.callsChan(ctx, "Scan")
+}
+
+func (fake *FakeScanner) ScanCalls(stub func(...interface{}) error) {
+	fake.scanMutex.Lock()
+	defer fake.scanMutex.Unlock()
+	fake.ScanStub = stub
+}
+
+func (fake *FakeScanner) ScanSetsArg(i int, value interface{}) {
+	fake.scanMutex.Lock()
+	defer fake.scanMutex.Unlock()
+	setsArgs := map[int]interface{}{i: value}
+	for j, v := range fake.scanSetsArgs {
+		if j != i {
+			setsArgs[j] = v
+		}
+	}
+	fake.scanSetsArgs = setsArgs
+}
+
+// ScanCallsWhen calls the stub for the calls whose arguments match.
+// Matchers are checked before the stub, and the matcher registered last wins.
+func (fake *FakeScanner) ScanCallsWhen(matcher func(...interface{}) bool, stub func(...interface{}) error) {
+	fake.scanMutex.Lock()
+	defer fake.scanMutex.Unlock()
+	fake.scanWhen = append(fake.scanWhen, struct {
+		matcher func(...interface{}) bool
+		stub    func(...interface{}) error
+	}{matcher, stub})
+}
+
+func (fake *FakeScanner) ScanArgsForCall(i int) []interface{} {
+	fake.scanMutex.RLock()
+	defer fake.scanMutex.RUnlock()
+	argsForCall := fake.scanArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeScanner) ScanCallHistory() []FakeScannerScanCall {
+	fake.scanMutex.RLock()
+	defer fake.scanMutex.RUnlock()
+	history := make([]FakeScannerScanCall, len(fake.scanArgsForCall))
+	for i, argsForCall := range fake.scanArgsForCall {
+		history[i] = FakeScannerScanCall{argsForCall.arg1}
+	}
+	return history
+}
+
+func (fake *FakeScanner) ScanReturns(result1 error) {
+	fake.scanMutex.Lock()
+	defer fake.scanMutex.Unlock()
+	fake.ScanStub = nil
+	fake.scanReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeScanner) ScanReturnsOnCall(i int, result1 error) {
+	fake.scanMutex.Lock()
+	defer fake.scanMutex.Unlock()
+	fake.ScanStub = nil
+	if fake.scanReturnsOnCall == nil {
+		fake.scanReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.scanReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+// ScanReturnsForArgs returns the values for the calls with the given
+// arguments. Like ScanCallsWhen, it is checked before the stub, and the
+// arguments or matcher registered last win.
+func (fake *FakeScanner) ScanReturnsForArgs(arg1 ...interface{}) func(error) {
+	args := []interface{}{arg1}
+	return func(result1 error) {
+		fake.scanMutex.Lock()
+		defer fake.scanMutex.Unlock()
+		fake.ScanStub = nil
+		fake.scanWhen = append(fake.scanWhen, struct {
+			matcher func(...interface{}) bool
+			stub    func(...interface{}) error
+		}{
+			func(arg1 ...interface{}) bool {
+				return fake.argsMatch(args, []interface{}{arg1})
+			},
+			func(...interface{}) error {
+				return result1
+			},
+		})
+	}
+}
+
+// ScanReturnsWhen returns the values for the calls whose arguments
+// match. Like ScanCallsWhen, it is checked before the stub, and the
+// matcher registered last wins.
+func (fake *FakeScanner) ScanReturnsWhen(matcher func(...interface{}) bool, result1 error) {
+	fake.ScanCallsWhen(matcher, func(...interface{}) error {
+		return result1
+	})
+}
+
+func (fake *FakeScanner) ResetScan() {
+	fake.ResetScanCalls()
+	fake.ResetScanStubs()
+}
+
+func (fake *FakeScanner) ResetScanCalls() {
+	fake.scanMutex.Lock()
+	defer fake.scanMutex.Unlock()
+	fake.scanArgsForCall = nil
+	fake.forgetInvocations("Scan")
+}
+
+func (fake *FakeScanner) ResetScanStubs() {
+	fake.scanMutex.Lock()
+	defer fake.scanMutex.Unlock()
+	fake.ScanStub = nil
+	fake.scanWhen = nil
+	fake.scanSetsArgs = nil
+	fake.scanReturns = struct {
+		result1 error
+	}{}
+	fake.scanReturnsOnCall = nil
+}
+
+func (fake *FakeScanner) Reset() {
+	fake.ResetCalls()
+	fake.ResetStubs()
+}
+
+func (fake *FakeScanner) ResetCalls() {
+	fake.ResetDecodeCalls()
+	fake.ResetLoadCalls()
+	fake.ResetScanCalls()
+}
+
+func (fake *FakeScanner) ResetStubs() {
+	fake.ResetDecodeStubs()
+	fake.ResetLoadStubs()
+	fake.ResetScanStubs()
+}
+
+func (fake *FakeScanner) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeScanner) setArgs(method string, values map[int]interface{}, args []interface{}) {
+	for i, value := range values {
+		if i < 0 || i >= len(args) {
+			panic(fmt.Sprintf("FakeScanner.%s: cannot set argument %d, because it was called with %d arguments", method, i, len(args)))
+		}
+		target := reflect.ValueOf(args[i])
+		if target.Kind() != reflect.Ptr || target.IsNil() {
+			panic(fmt.Sprintf("FakeScanner.%s: cannot set argument %d, because it is %T instead of a non-nil pointer", method, i, args[i]))
+		}
+		v := reflect.ValueOf(value)
+		if !v.IsValid() {
+			v = reflect.Zero(target.Elem().Type())
+		}
+		if !v.Type().AssignableTo(target.Elem().Type()) {
+			panic(fmt.Sprintf("FakeScanner.%s: cannot set argument %d, because %T is not assignable to %s", method, i, value, target.Elem().Type()))
+		}
+		target.Elem().Set(v)
+	}
+}
+
+func (fake *FakeScanner) argsMatch(expected []interface{}, actual []interface{}) bool {
+	if len(expected) != len(actual) {
+		return false
+	}
+	for i := range expected {
+		if fake.ArgsComparer != nil && !fake.ArgsComparer(expected[i], actual[i]) || fake.ArgsComparer == nil && !reflect.DeepEqual(expected[i], actual[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (fake *FakeScanner) OrderedInvocations() []struct {
+	Seq    uint64
+	Method string
+	Args   []interface{}
+} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	return append([]struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}{}, fake.orderedInvocations...)
+}
+
+func (fake *FakeScanner) SetSequencer(sequencer *atomic.Uint64) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	fake.sequencer = sequencer
+}
+
+func (fake *FakeScanner) waitForCalls(ctx context.Context, callCount func() int, n int) error {
+	for {
+		fake.invocationsMutex.Lock()
+		if fake.invocationsChanged == nil {
+			fake.invocationsChanged = make(chan struct{})
+		}
+		changed := fake.invocationsChanged
+		fake.invocationsMutex.Unlock()
+		if callCount() >= n {
+			return nil
+		}
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (fake *FakeScanner) callsChan(ctx context.Context, key string) <-chan []interface{} {
+	ctx, cancel := context.WithCancel(ctx)
+	calls := make(chan []interface{})
+	ready := make(chan struct{}, 1)
+	var pendingMutex sync.Mutex
+	var pending [][]interface{}
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.callsSubscribers == nil {
+		fake.callsSubscribers = map[string][]struct {
+			publish func(args []interface{})
+			cancel  context.CancelFunc
+			done    <-chan struct{}
+		}{}
+	}
+	fake.callsSubscribers[key] = append(fake.callsSubscribers[key], struct {
+		publish func(args []interface{})
+		cancel  context.CancelFunc
+		done    <-chan struct{}
+	}{func(args []interface{}) {
+		pendingMutex.Lock()
+		pending = append(pending, args)
+		pendingMutex.Unlock()
+		select {
+		case ready <- struct{}{}:
+		default:
+		}
+	}, cancel, ctx.Done()})
+	go func() {
+		defer close(calls)
+		for {
+			select {
+			case <-ready:
+			case <-ctx.Done():
+				return
+			}
+			pendingMutex.Lock()
+			queued := pending
+			pending = nil
+			pendingMutex.Unlock()
+			for _, args := range queued {
+				select {
+				case calls <- args:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return calls
+}
+
+func (fake *FakeScanner) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+	if fake.sequencer == nil {
+		fake.sequencer = new(atomic.Uint64)
+	}
+	fake.orderedInvocations = append(fake.orderedInvocations, struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}{fake.sequencer.Add(1), key, args})
+	if fake.invocationsChanged != nil {
+		close(fake.invocationsChanged)
+		fake.invocationsChanged = nil
+	}
+	subscribers := fake.callsSubscribers[key][:0]
+	for _, subscriber := range fake.callsSubscribers[key] {
+		select {
+		case <-subscriber.done:
+			continue
+		default:
+		}
+		subscriber.publish(args)
+		subscribers = append(subscribers, subscriber)
+	}
+	if len(subscribers) > 0 {
+		fake.callsSubscribers[key] = subscribers
+	} else {
+		delete(fake.callsSubscribers, key)
+	}
+}
+
+func (fake *FakeScanner) forgetInvocations(key string) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	delete(fake.invocations, key)
+	for _, subscriber := range fake.callsSubscribers[key] {
+		subscriber.cancel()
+	}
+	delete(fake.callsSubscribers, key)
+	var orderedInvocations []struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}
+	for _, invocation := range fake.orderedInvocations {
+		if invocation.Method != key {
+			orderedInvocations = append(orderedInvocations, invocation)
+		}
+	}
+	fake.orderedInvocations = orderedInvocations
+}
+
+var _ fixtures.Scanner = new(FakeScanner)
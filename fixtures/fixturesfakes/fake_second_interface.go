@@ -2,7 +2,9 @@
 package fixturesfakes
 
 import (
+	"context"
 	"sync"
+	"sync/atomic"
 
 	"github.com/maxbrunsfeld/counterfeiter/v6/fixtures"
 )
@@ -18,7 +20,19 @@ type FakeSecondInterface struct {
 	embeddedMethodReturnsOnCall map[int]struct {
 		result1 string
 	}
-	invocations      map[string][][]interface{}
+	invocations        map[string][][]interface{}
+	orderedInvocations []struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}
+	sequencer          *atomic.Uint64
+	invocationsChanged chan struct{}
+	callsSubscribers   map[string][]struct {
+		publish func(args []interface{})
+		cancel  context.CancelFunc
+		done    <-chan struct{}
+	}
 	invocationsMutex sync.RWMutex
 }
 
@@ -46,6 +60,14 @@ func (fake *FakeSecondInterface) EmbeddedMethodCallCount() int {
 	return len(fake.embeddedMethodArgsForCall)
 }
 
+func (fake *FakeSecondInterface) WaitForEmbeddedMethodCalls(ctx context.Context, n int) error {
+	return fake.waitForCalls(ctx, fake.EmbeddedMethodCallCount, n)
+}
+
+func (fake *FakeSecondInterface) EmbeddedMethodCallsChan(ctx context.Context) <-chan []interface{} {
+	return fake.callsChan(ctx, "EmbeddedMethod")
+}
+
 func (fake *FakeSecondInterface) EmbeddedMethodCalls(stub func() string) {
 	fake.embeddedMethodMutex.Lock()
 	defer fake.embeddedMethodMutex.Unlock()
@@ -75,6 +97,41 @@ func (fake *FakeSecondInterface) EmbeddedMethodReturnsOnCall(i int, result1 stri
 	}{result1}
 }
 
+func (fake *FakeSecondInterface) ResetEmbeddedMethod() {
+	fake.ResetEmbeddedMethodCalls()
+	fake.ResetEmbeddedMethodStubs()
+}
+
+func (fake *FakeSecondInterface) ResetEmbeddedMethodCalls() {
+	fake.embeddedMethodMutex.Lock()
+	defer fake.embeddedMethodMutex.Unlock()
+	fake.embeddedMethodArgsForCall = nil
+	fake.forgetInvocations("EmbeddedMethod")
+}
+
+func (fake *FakeSecondInterface) ResetEmbeddedMethodStubs() {
+	fake.embeddedMethodMutex.Lock()
+	defer fake.embeddedMethodMutex.Unlock()
+	fake.EmbeddedMethodStub = nil
+	fake.embeddedMethodReturns = struct {
+		result1 string
+	}{}
+	fake.embeddedMethodReturnsOnCall = nil
+}
+
+func (fake *FakeSecondInterface) Reset() {
+	fake.ResetCalls()
+	fake.ResetStubs()
+}
+
+func (fake *FakeSecondInterface) ResetCalls() {
+	fake.ResetEmbeddedMethodCalls()
+}
+
+func (fake *FakeSecondInterface) ResetStubs() {
+	fake.ResetEmbeddedMethodStubs()
+}
+
 func (fake *FakeSecondInterface) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -85,6 +142,97 @@ func (fake *FakeSecondInterface) Invocations() map[string][][]interface{} {
 	return copiedInvocations
 }
 
+func (fake *FakeSecondInterface) OrderedInvocations() []struct {
+	Seq    uint64
+	Method string
+	Args   []interface{}
+} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	return append([]struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}{}, fake.orderedInvocations...)
+}
+
+func (fake *FakeSecondInterface) SetSequencer(sequencer *atomic.Uint64) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	fake.sequencer = sequencer
+}
+
+func (fake *FakeSecondInterface) waitForCalls(ctx context.Context, callCount func() int, n int) error {
+	for {
+		fake.invocationsMutex.Lock()
+		if fake.invocationsChanged == nil {
+			fake.invocationsChanged = make(chan struct{})
+		}
+		changed := fake.invocationsChanged
+		fake.invocationsMutex.Unlock()
+		if callCount() >= n {
+			return nil
+		}
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (fake *FakeSecondInterface) callsChan(ctx context.Context, key string) <-chan []interface{} {
+	ctx, cancel := context.WithCancel(ctx)
+	calls := make(chan []interface{})
+	ready := make(chan struct{}, 1)
+	var pendingMutex sync.Mutex
+	var pending [][]interface{}
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.callsSubscribers == nil {
+		fake.callsSubscribers = map[string][]struct {
+			publish func(args []interface{})
+			cancel  context.CancelFunc
+			done    <-chan struct{}
+		}{}
+	}
+	fake.callsSubscribers[key] = append(fake.callsSubscribers[key], struct {
+		publish func(args []interface{})
+		cancel  context.CancelFunc
+		done    <-chan struct{}
+	}{func(args []interface{}) {
+		pendingMutex.Lock()
+		pending = append(pending, args)
+		pendingMutex.Unlock()
+		select {
+		case ready <- struct{}{}:
+		default:
+		}
+	}, cancel, ctx.Done()})
+	go func() {
+		defer close(calls)
+		for {
+			select {
+			case <-ready:
+			case <-ctx.Done():
+				return
+			}
+			pendingMutex.Lock()
+			queued := pending
+			pending = nil
+			pendingMutex.Unlock()
+			for _, args := range queued {
+				select {
+				case calls <- args:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return calls
+}
+
 func (fake *FakeSecondInterface) recordInvocation(key string, args []interface{}) {
 	fake.invocationsMutex.Lock()
 	defer fake.invocationsMutex.Unlock()
@@ -95,6 +243,54 @@ func (fake *FakeSecondInterface) recordInvocation(key string, args []interface{}
 		fake.invocations[key] = [][]interface{}{}
 	}
 	fake.invocations[key] = append(fake.invocations[key], args)
+	if fake.sequencer == nil {
+		fake.sequencer = new(atomic.Uint64)
+	}
+	fake.orderedInvocations = append(fake.orderedInvocations, struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}{fake.sequencer.Add(1), key, args})
+	if fake.invocationsChanged != nil {
+		close(fake.invocationsChanged)
+		fake.invocationsChanged = nil
+	}
+	subscribers := fake.callsSubscribers[key][:0]
+	for _, subscriber := range fake.callsSubscribers[key] {
+		select {
+		case <-subscriber.done:
+			continue
+		default:
+		}
+		subscriber.publish(args)
+		subscribers = append(subscribers, subscriber)
+	}
+	if len(subscribers) > 0 {
+		fake.callsSubscribers[key] = subscribers
+	} else {
+		delete(fake.callsSubscribers, key)
+	}
+}
+
+func (fake *FakeSecondInterface) forgetInvocations(key string) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	delete(fake.invocations, key)
+	for _, subscriber := range fake.callsSubscribers[key] {
+		subscriber.cancel()
+	}
+	delete(fake.callsSubscribers, key)
+	var orderedInvocations []struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}
+	for _, invocation := range fake.orderedInvocations {
+		if invocation.Method != key {
+			orderedInvocations = append(orderedInvocations, invocation)
+		}
+	}
+	fake.orderedInvocations = orderedInvocations
 }
 
 var _ fixtures.SecondInterface = new(FakeSecondInterface)
@@ -2,7 +2,9 @@
 package fixturesfakes
 
 import (
+	"context"
 	"sync"
+	"sync/atomic"
 
 	"github.com/maxbrunsfeld/counterfeiter/v6/fixtures"
 )
@@ -20,7 +22,19 @@ type FakeSomethingElse struct {
 		result1 int
 		result2 int
 	}
-	invocations      map[string][][]interface{}
+	invocations        map[string][][]interface{}
+	orderedInvocations []struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}
+	sequencer          *atomic.Uint64
+	invocationsChanged chan struct{}
+	callsSubscribers   map[string][]struct {
+		publish func(args []interface{})
+		cancel  context.CancelFunc
+		done    <-chan struct{}
+	}
 	invocationsMutex sync.RWMutex
 }
 
@@ -48,6 +62,14 @@ func (fake *FakeSomethingElse) ReturnStuffCallCount() int {
 	return len(fake.returnStuffArgsForCall)
 }
 
+func (fake *FakeSomethingElse) WaitForReturnStuffCalls(ctx context.Context, n int) error {
+	return fake.waitForCalls(ctx, fake.ReturnStuffCallCount, n)
+}
+
+func (fake *FakeSomethingElse) ReturnStuffCallsChan(ctx context.Context) <-chan []interface{} {
+	return fake.callsChan(ctx, "ReturnStuff")
+}
+
 func (fake *FakeSomethingElse) ReturnStuffCalls(stub func() (int, int)) {
 	fake.returnStuffMutex.Lock()
 	defer fake.returnStuffMutex.Unlock()
@@ -80,6 +102,42 @@ func (fake *FakeSomethingElse) ReturnStuffReturnsOnCall(i int, result1 int, resu
 	}{result1, result2}
 }
 
+func (fake *FakeSomethingElse) ResetReturnStuff() {
+	fake.ResetReturnStuffCalls()
+	fake.ResetReturnStuffStubs()
+}
+
+func (fake *FakeSomethingElse) ResetReturnStuffCalls() {
+	fake.returnStuffMutex.Lock()
+	defer fake.returnStuffMutex.Unlock()
+	fake.returnStuffArgsForCall = nil
+	fake.forgetInvocations("ReturnStuff")
+}
+
+func (fake *FakeSomethingElse) ResetReturnStuffStubs() {
+	fake.returnStuffMutex.Lock()
+	defer fake.returnStuffMutex.Unlock()
+	fake.ReturnStuffStub = nil
+	fake.returnStuffReturns = struct {
+		result1 int
+		result2 int
+	}{}
+	fake.returnStuffReturnsOnCall = nil
+}
+
+func (fake *FakeSomethingElse) Reset() {
+	fake.ResetCalls()
+	fake.ResetStubs()
+}
+
+func (fake *FakeSomethingElse) ResetCalls() {
+	fake.ResetReturnStuffCalls()
+}
+
+func (fake *FakeSomethingElse) ResetStubs() {
+	fake.ResetReturnStuffStubs()
+}
+
 func (fake *FakeSomethingElse) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -90,6 +148,97 @@ func (fake *FakeSomethingElse) Invocations() map[string][][]interface{} {
 	return copiedInvocations
 }
 
+func (fake *FakeSomethingElse) OrderedInvocations() []struct {
+	Seq    uint64
+	Method string
+	Args   []interface{}
+} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	return append([]struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}{}, fake.orderedInvocations...)
+}
+
+func (fake *FakeSomethingElse) SetSequencer(sequencer *atomic.Uint64) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	fake.sequencer = sequencer
+}
+
+func (fake *FakeSomethingElse) waitForCalls(ctx context.Context, callCount func() int, n int) error {
+	for {
+		fake.invocationsMutex.Lock()
+		if fake.invocationsChanged == nil {
+			fake.invocationsChanged = make(chan struct{})
+		}
+		changed := fake.invocationsChanged
+		fake.invocationsMutex.Unlock()
+		if callCount() >= n {
+			return nil
+		}
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (fake *FakeSomethingElse) callsChan(ctx context.Context, key string) <-chan []interface{} {
+	ctx, cancel := context.WithCancel(ctx)
+	calls := make(chan []interface{})
+	ready := make(chan struct{}, 1)
+	var pendingMutex sync.Mutex
+	var pending [][]interface{}
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.callsSubscribers == nil {
+		fake.callsSubscribers = map[string][]struct {
+			publish func(args []interface{})
+			cancel  context.CancelFunc
+			done    <-chan struct{}
+		}{}
+	}
+	fake.callsSubscribers[key] = append(fake.callsSubscribers[key], struct {
+		publish func(args []interface{})
+		cancel  context.CancelFunc
+		done    <-chan struct{}
+	}{func(args []interface{}) {
+		pendingMutex.Lock()
+		pending = append(pending, args)
+		pendingMutex.Unlock()
+		select {
+		case ready <- struct{}{}:
+		default:
+		}
+	}, cancel, ctx.Done()})
+	go func() {
+		defer close(calls)
+		for {
+			select {
+			case <-ready:
+			case <-ctx.Done():
+				return
+			}
+			pendingMutex.Lock()
+			queued := pending
+			pending = nil
+			pendingMutex.Unlock()
+			for _, args := range queued {
+				select {
+				case calls <- args:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return calls
+}
+
 func (fake *FakeSomethingElse) recordInvocation(key string, args []interface{}) {
 	fake.invocationsMutex.Lock()
 	defer fake.invocationsMutex.Unlock()
@@ -100,6 +249,54 @@ func (fake *FakeSomethingElse) recordInvocation(key string, args []interface{})
 		fake.invocations[key] = [][]interface{}{}
 	}
 	fake.invocations[key] = append(fake.invocations[key], args)
+	if fake.sequencer == nil {
+		fake.sequencer = new(atomic.Uint64)
+	}
+	fake.orderedInvocations = append(fake.orderedInvocations, struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}{fake.sequencer.Add(1), key, args})
+	if fake.invocationsChanged != nil {
+		close(fake.invocationsChanged)
+		fake.invocationsChanged = nil
+	}
+	subscribers := fake.callsSubscribers[key][:0]
+	for _, subscriber := range fake.callsSubscribers[key] {
+		select {
+		case <-subscriber.done:
+			continue
+		default:
+		}
+		subscriber.publish(args)
+		subscribers = append(subscribers, subscriber)
+	}
+	if len(subscribers) > 0 {
+		fake.callsSubscribers[key] = subscribers
+	} else {
+		delete(fake.callsSubscribers, key)
+	}
+}
+
+func (fake *FakeSomethingElse) forgetInvocations(key string) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	delete(fake.invocations, key)
+	for _, subscriber := range fake.callsSubscribers[key] {
+		subscriber.cancel()
+	}
+	delete(fake.callsSubscribers, key)
+	var orderedInvocations []struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}
+	for _, invocation := range fake.orderedInvocations {
+		if invocation.Method != key {
+			orderedInvocations = append(orderedInvocations, invocation)
+		}
+	}
+	fake.orderedInvocations = orderedInvocations
 }
 
 var _ fixtures.SomethingElse = new(FakeSomethingElse)
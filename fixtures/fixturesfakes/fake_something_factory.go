@@ -2,7 +2,10 @@
 package fixturesfakes
 
 import (
+	"context"
+	"reflect"
 	"sync"
+	"sync/atomic"
 
 	"github.com/maxbrunsfeld/counterfeiter/v6/fixtures"
 )
@@ -20,10 +23,32 @@ type FakeSomethingFactory struct {
 	returnsOnCall map[int]struct {
 		result1 string
 	}
-	invocations      map[string][][]interface{}
+	returnsForArgs []struct {
+		args    []interface{}
+		result1 string
+	}
+	ArgsComparer       func(expected interface{}, actual interface{}) bool
+	invocations        map[string][][]interface{}
+	orderedInvocations []struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}
+	sequencer          *atomic.Uint64
+	invocationsChanged chan struct{}
+	callsSubscribers   []struct {
+		publish func(args []interface{})
+		cancel  context.CancelFunc
+		done    <-chan struct{}
+	}
 	invocationsMutex sync.RWMutex
 }
 
+type FakeSomethingFactoryCall struct {
+	Arg1 string
+	Arg2 map[string]interface{}
+}
+
 func (fake *FakeSomethingFactory) Spy(arg1 string, arg2 map[string]interface{}) string {
 	fake.mutex.Lock()
 	ret, specificReturn := fake.returnsOnCall[len(fake.argsForCall)]
@@ -32,9 +57,15 @@ func (fake *FakeSomethingFactory) Spy(arg1 string, arg2 map[string]interface{})
 		arg2 map[string]interface{}
 	}{arg1, arg2})
 	stub := fake.Stub
+	returnsForArgs := fake.returnsForArgs
 	returns := fake.returns
 	fake.recordInvocation("SomethingFactory", []interface{}{arg1, arg2})
 	fake.mutex.Unlock()
+	for i := len(returnsForArgs) - 1; i >= 0; i-- {
+		if fake.argsMatch(returnsForArgs[i].args, []interface{}{arg1, arg2}) {
+			return returnsForArgs[i].result1
+		}
+	}
 	if stub != nil {
 		return stub(arg1, arg2)
 	}
@@ -50,6 +81,70 @@ func (fake *FakeSomethingFactory) CallCount() int {
 	return len(fake.argsForCall)
 }
 
+func (fake *FakeSomethingFactory) WaitForCalls(ctx context.Context, n int) error {
+	for {
+		fake.invocationsMutex.Lock()
+		if fake.invocationsChanged == nil {
+			fake.invocationsChanged = make(chan struct{})
+		}
+		changed := fake.invocationsChanged
+		fake.invocationsMutex.Unlock()
+		if fake.CallCount() >= n {
+			return nil
+		}
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (fake *FakeSomethingFactory) CallsChan(ctx context.Context) <-chan []interface{} {
+	ctx, cancel := context.WithCancel(ctx)
+	calls := make(chan []interface{})
+	ready := make(chan struct{}, 1)
+	var pendingMutex sync.Mutex
+	var pending [][]interface{}
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	fake.callsSubscribers = append(fake.callsSubscribers, struct {
+		publish func(args []interface{})
+		cancel  context.CancelFunc
+		done    <-chan struct{}
+	}{func(args []interface{}) {
+		pendingMutex.Lock()
+		pending = append(pending, args)
+		pendingMutex.Unlock()
+		select {
+		case ready <- struct{}{}:
+		default:
+		}
+	}, cancel, ctx.Done()})
+	go func() {
+		defer close(calls)
+		for {
+			select {
+			case <-ready:
+			case <-ctx.Done():
+				return
+			}
+			pendingMutex.Lock()
+			queued := pending
+			pending = nil
+			pendingMutex.Unlock()
+			for _, args := range queued {
+				select {
+				case calls <- args:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return calls
+}
+
 func (fake *FakeSomethingFactory) Calls(stub func(string, map[string]interface{}) string) {
 	fake.mutex.Lock()
 	defer fake.mutex.Unlock()
@@ -62,6 +157,16 @@ func (fake *FakeSomethingFactory) ArgsForCall(i int) (string, map[string]interfa
 	return fake.argsForCall[i].arg1, fake.argsForCall[i].arg2
 }
 
+func (fake *FakeSomethingFactory) CallHistory() []FakeSomethingFactoryCall {
+	fake.mutex.RLock()
+	defer fake.mutex.RUnlock()
+	history := make([]FakeSomethingFactoryCall, len(fake.argsForCall))
+	for i, argsForCall := range fake.argsForCall {
+		history[i] = FakeSomethingFactoryCall{argsForCall.arg1, argsForCall.arg2}
+	}
+	return history
+}
+
 func (fake *FakeSomethingFactory) Returns(result1 string) {
 	fake.mutex.Lock()
 	defer fake.mutex.Unlock()
@@ -85,6 +190,52 @@ func (fake *FakeSomethingFactory) ReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+// ReturnsForArgs returns the values for the calls with the given arguments.
+// The arguments are checked before the stub, and the arguments registered last
+// win.
+func (fake *FakeSomethingFactory) ReturnsForArgs(arg1 string, arg2 map[string]interface{}) func(string) {
+	args := []interface{}{arg1, arg2}
+	return func(result1 string) {
+		fake.mutex.Lock()
+		defer fake.mutex.Unlock()
+		fake.Stub = nil
+		fake.returnsForArgs = append(fake.returnsForArgs, struct {
+			args    []interface{}
+			result1 string
+		}{args, result1})
+	}
+}
+
+func (fake *FakeSomethingFactory) Reset() {
+	fake.ResetCalls()
+	fake.ResetStubs()
+}
+
+func (fake *FakeSomethingFactory) ResetCalls() {
+	fake.mutex.Lock()
+	fake.argsForCall = nil
+	fake.mutex.Unlock()
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	fake.invocations = nil
+	fake.orderedInvocations = nil
+	for _, subscriber := range fake.callsSubscribers {
+		subscriber.cancel()
+	}
+	fake.callsSubscribers = nil
+}
+
+func (fake *FakeSomethingFactory) ResetStubs() {
+	fake.mutex.Lock()
+	defer fake.mutex.Unlock()
+	fake.Stub = nil
+	fake.returns = struct {
+		result1 string
+	}{}
+	fake.returnsOnCall = nil
+	fake.returnsForArgs = nil
+}
+
 func (fake *FakeSomethingFactory) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -97,6 +248,38 @@ func (fake *FakeSomethingFactory) Invocations() map[string][][]interface{} {
 	return copiedInvocations
 }
 
+func (fake *FakeSomethingFactory) OrderedInvocations() []struct {
+	Seq    uint64
+	Method string
+	Args   []interface{}
+} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	return append([]struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}{}, fake.orderedInvocations...)
+}
+
+func (fake *FakeSomethingFactory) SetSequencer(sequencer *atomic.Uint64) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	fake.sequencer = sequencer
+}
+
+func (fake *FakeSomethingFactory) argsMatch(expected []interface{}, actual []interface{}) bool {
+	if len(expected) != len(actual) {
+		return false
+	}
+	for i := range expected {
+		if fake.ArgsComparer != nil && !fake.ArgsComparer(expected[i], actual[i]) || fake.ArgsComparer == nil && !reflect.DeepEqual(expected[i], actual[i]) {
+			return false
+		}
+	}
+	return true
+}
+
 func (fake *FakeSomethingFactory) recordInvocation(key string, args []interface{}) {
 	fake.invocationsMutex.Lock()
 	defer fake.invocationsMutex.Unlock()
@@ -107,6 +290,29 @@ func (fake *FakeSomethingFactory) recordInvocation(key string, args []interface{
 		fake.invocations[key] = [][]interface{}{}
 	}
 	fake.invocations[key] = append(fake.invocations[key], args)
+	if fake.sequencer == nil {
+		fake.sequencer = new(atomic.Uint64)
+	}
+	fake.orderedInvocations = append(fake.orderedInvocations, struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}{fake.sequencer.Add(1), key, args})
+	if fake.invocationsChanged != nil {
+		close(fake.invocationsChanged)
+		fake.invocationsChanged = nil
+	}
+	subscribers := fake.callsSubscribers[:0]
+	for _, subscriber := range fake.callsSubscribers {
+		select {
+		case <-subscriber.done:
+			continue
+		default:
+		}
+		subscriber.publish(args)
+		subscribers = append(subscribers, subscriber)
+	}
+	fake.callsSubscribers = subscribers
 }
 
 var _ fixtures.SomethingFactory = new(FakeSomethingFactory).Spy
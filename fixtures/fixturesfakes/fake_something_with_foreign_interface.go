@@ -2,7 +2,10 @@
 package fixturesfakes
 
 import (
+	"context"
+	"reflect"
 	"sync"
+	"sync/atomic"
 
 	"github.com/maxbrunsfeld/counterfeiter/v6/fixtures"
 )
@@ -13,16 +16,37 @@ type FakeSomethingWithForeignInterface struct {
 	stuffArgsForCall []struct {
 		arg1 int
 	}
+	stuffWhen []struct {
+		matcher func(int) bool
+		stub    func(int) string
+	}
 	stuffReturns struct {
 		result1 string
 	}
 	stuffReturnsOnCall map[int]struct {
 		result1 string
 	}
-	invocations      map[string][][]interface{}
+	ArgsComparer       func(expected interface{}, actual interface{}) bool
+	invocations        map[string][][]interface{}
+	orderedInvocations []struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}
+	sequencer          *atomic.Uint64
+	invocationsChanged chan struct{}
+	callsSubscribers   map[string][]struct {
+		publish func(args []interface{})
+		cancel  context.CancelFunc
+		done    <-chan struct{}
+	}
 	invocationsMutex sync.RWMutex
 }
 
+type FakeSomethingWithForeignInterfaceStuffCall struct {
+	Arg1 int
+}
+
 func (fake *FakeSomethingWithForeignInterface) Stuff(arg1 int) string {
 	fake.stuffMutex.Lock()
 	ret, specificReturn := fake.stuffReturnsOnCall[len(fake.stuffArgsForCall)]
@@ -30,9 +54,15 @@ func (fake *FakeSomethingWithForeignInterface) Stuff(arg1 int) string {
 		arg1 int
 	}{arg1})
 	stub := fake.StuffStub
+	whens := fake.stuffWhen
 	fakeReturns := fake.stuffReturns
 	fake.recordInvocation("Stuff", []interface{}{arg1})
 	fake.stuffMutex.Unlock()
+	for i := len(whens) - 1; i >= 0; i-- {
+		if whens[i].matcher(arg1) {
+			return whens[i].stub(arg1)
+		}
+	}
 	if stub != nil {
 		return stub(arg1)
 	}
@@ -48,12 +78,31 @@ func (fake *FakeSomethingWithForeignInterface) StuffCallCount() int {
 	return len(fake.stuffArgsForCall)
 }
 
+func (fake *FakeSomethingWithForeignInterface) WaitForStuffCalls(ctx context.Context, n int) error {
+	return fake.waitForCalls(ctx, fake.StuffCallCount, n)
+}
+
+func (fake *FakeSomethingWithForeignInterface) StuffCallsChan(ctx context.Context) <-chan []interface{} {
+	return fake.callsChan(ctx, "Stuff")
+}
+
 func (fake *FakeSomethingWithForeignInterface) StuffCalls(stub func(int) string) {
 	fake.stuffMutex.Lock()
 	defer fake.stuffMutex.Unlock()
 	fake.StuffStub = stub
 }
 
+// StuffCallsWhen calls the stub for the calls whose arguments match.
+// Matchers are checked before the stub, and the matcher registered last wins.
+func (fake *FakeSomethingWithForeignInterface) StuffCallsWhen(matcher func(int) bool, stub func(int) string) {
+	fake.stuffMutex.Lock()
+	defer fake.stuffMutex.Unlock()
+	fake.stuffWhen = append(fake.stuffWhen, struct {
+		matcher func(int) bool
+		stub    func(int) string
+	}{matcher, stub})
+}
+
 func (fake *FakeSomethingWithForeignInterface) StuffArgsForCall(i int) int {
 	fake.stuffMutex.RLock()
 	defer fake.stuffMutex.RUnlock()
@@ -61,6 +110,16 @@ func (fake *FakeSomethingWithForeignInterface) StuffArgsForCall(i int) int {
 	return argsForCall.arg1
 }
 
+func (fake *FakeSomethingWithForeignInterface) StuffCallHistory() []FakeSomethingWithForeignInterfaceStuffCall {
+	fake.stuffMutex.RLock()
+	defer fake.stuffMutex.RUnlock()
+	history := make([]FakeSomethingWithForeignInterfaceStuffCall, len(fake.stuffArgsForCall))
+	for i, argsForCall := range fake.stuffArgsForCall {
+		history[i] = FakeSomethingWithForeignInterfaceStuffCall{argsForCall.arg1}
+	}
+	return history
+}
+
 func (fake *FakeSomethingWithForeignInterface) StuffReturns(result1 string) {
 	fake.stuffMutex.Lock()
 	defer fake.stuffMutex.Unlock()
@@ -84,6 +143,74 @@ func (fake *FakeSomethingWithForeignInterface) StuffReturnsOnCall(i int, result1
 	}{result1}
 }
 
+// StuffReturnsForArgs returns the values for the calls with the given
+// arguments. Like StuffCallsWhen, it is checked before the stub, and the
+// arguments or matcher registered last win.
+func (fake *FakeSomethingWithForeignInterface) StuffReturnsForArgs(arg1 int) func(string) {
+	args := []interface{}{arg1}
+	return func(result1 string) {
+		fake.stuffMutex.Lock()
+		defer fake.stuffMutex.Unlock()
+		fake.StuffStub = nil
+		fake.stuffWhen = append(fake.stuffWhen, struct {
+			matcher func(int) bool
+			stub    func(int) string
+		}{
+			func(arg1 int) bool {
+				return fake.argsMatch(args, []interface{}{arg1})
+			},
+			func(int) string {
+				return result1
+			},
+		})
+	}
+}
+
+// StuffReturnsWhen returns the values for the calls whose arguments
+// match. Like StuffCallsWhen, it is checked before the stub, and the
+// matcher registered last wins.
+func (fake *FakeSomethingWithForeignInterface) StuffReturnsWhen(matcher func(int) bool, result1 string) {
+	fake.StuffCallsWhen(matcher, func(int) string {
+		return result1
+	})
+}
+
+func (fake *FakeSomethingWithForeignInterface) ResetStuff() {
+	fake.ResetStuffCalls()
+	fake.ResetStuffStubs()
+}
+
+func (fake *FakeSomethingWithForeignInterface) ResetStuffCalls() {
+	fake.stuffMutex.Lock()
+	defer fake.stuffMutex.Unlock()
+	fake.stuffArgsForCall = nil
+	fake.forgetInvocations("Stuff")
+}
+
+func (fake *FakeSomethingWithForeignInterface) ResetStuffStubs() {
+	fake.stuffMutex.Lock()
+	defer fake.stuffMutex.Unlock()
+	fake.StuffStub = nil
+	fake.stuffWhen = nil
+	fake.stuffReturns = struct {
+		result1 string
+	}{}
+	fake.stuffReturnsOnCall = nil
+}
+
+func (fake *FakeSomethingWithForeignInterface) Reset() {
+	fake.ResetCalls()
+	fake.ResetStubs()
+}
+
+func (fake *FakeSomethingWithForeignInterface) ResetCalls() {
+	fake.ResetStuffCalls()
+}
+
+func (fake *FakeSomethingWithForeignInterface) ResetStubs() {
+	fake.ResetStuffStubs()
+}
+
 func (fake *FakeSomethingWithForeignInterface) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -94,6 +221,109 @@ func (fake *FakeSomethingWithForeignInterface) Invocations() map[string][][]inte
 	return copiedInvocations
 }
 
+func (fake *FakeSomethingWithForeignInterface) argsMatch(expected []interface{}, actual []interface{}) bool {
+	if len(expected) != len(actual) {
+		return false
+	}
+	for i := range expected {
+		if fake.ArgsComparer != nil && !fake.ArgsComparer(expected[i], actual[i]) || fake.ArgsComparer == nil && !reflect.DeepEqual(expected[i], actual[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (fake *FakeSomethingWithForeignInterface) OrderedInvocations() []struct {
+	Seq    uint64
+	Method string
+	Args   []interface{}
+} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	return append([]struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}{}, fake.orderedInvocations...)
+}
+
+func (fake *FakeSomethingWithForeignInterface) SetSequencer(sequencer *atomic.Uint64) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	fake.sequencer = sequencer
+}
+
+func (fake *FakeSomethingWithForeignInterface) waitForCalls(ctx context.Context, callCount func() int, n int) error {
+	for {
+		fake.invocationsMutex.Lock()
+		if fake.invocationsChanged == nil {
+			fake.invocationsChanged = make(chan struct{})
+		}
+		changed := fake.invocationsChanged
+		fake.invocationsMutex.Unlock()
+		if callCount() >= n {
+			return nil
+		}
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (fake *FakeSomethingWithForeignInterface) callsChan(ctx context.Context, key string) <-chan []interface{} {
+	ctx, cancel := context.WithCancel(ctx)
+	calls := make(chan []interface{})
+	ready := make(chan struct{}, 1)
+	var pendingMutex sync.Mutex
+	var pending [][]interface{}
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.callsSubscribers == nil {
+		fake.callsSubscribers = map[string][]struct {
+			publish func(args []interface{})
+			cancel  context.CancelFunc
+			done    <-chan struct{}
+		}{}
+	}
+	fake.callsSubscribers[key] = append(fake.callsSubscribers[key], struct {
+		publish func(args []interface{})
+		cancel  context.CancelFunc
+		done    <-chan struct{}
+	}{func(args []interface{}) {
+		pendingMutex.Lock()
+		pending = append(pending, args)
+		pendingMutex.Unlock()
+		select {
+		case ready <- struct{}{}:
+		default:
+		}
+	}, cancel, ctx.Done()})
+	go func() {
+		defer close(calls)
+		for {
+			select {
+			case <-ready:
+			case <-ctx.Done():
+				return
+			}
+			pendingMutex.Lock()
+			queued := pending
+			pending = nil
+			pendingMutex.Unlock()
+			for _, args := range queued {
+				select {
+				case calls <- args:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return calls
+}
+
 func (fake *FakeSomethingWithForeignInterface) recordInvocation(key string, args []interface{}) {
 	fake.invocationsMutex.Lock()
 	defer fake.invocationsMutex.Unlock()
@@ -104,6 +334,54 @@ func (fake *FakeSomethingWithForeignInterface) recordInvocation(key string, args
 		fake.invocations[key] = [][]interface{}{}
 	}
 	fake.invocations[key] = append(fake.invocations[key], args)
+	if fake.sequencer == nil {
+		fake.sequencer = new(atomic.Uint64)
+	}
+	fake.orderedInvocations = append(fake.orderedInvocations, struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}{fake.sequencer.Add(1), key, args})
+	if fake.invocationsChanged != nil {
+		close(fake.invocationsChanged)
+		fake.invocationsChanged = nil
+	}
+	subscribers := fake.callsSubscribers[key][:0]
+	for _, subscriber := range fake.callsSubscribers[key] {
+		select {
+		case <-subscriber.done:
+			continue
+		default:
+		}
+		subscriber.publish(args)
+		subscribers = append(subscribers, subscriber)
+	}
+	if len(subscribers) > 0 {
+		fake.callsSubscribers[key] = subscribers
+	} else {
+		delete(fake.callsSubscribers, key)
+	}
+}
+
+func (fake *FakeSomethingWithForeignInterface) forgetInvocations(key string) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	delete(fake.invocations, key)
+	for _, subscriber := range fake.callsSubscribers[key] {
+		subscriber.cancel()
+	}
+	delete(fake.callsSubscribers, key)
+	var orderedInvocations []struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}
+	for _, invocation := range fake.orderedInvocations {
+		if invocation.Method != key {
+			orderedInvocations = append(orderedInvocations, invocation)
+		}
+	}
+	fake.orderedInvocations = orderedInvocations
 }
 
 var _ fixtures.SomethingWithForeignInterface = new(FakeSomethingWithForeignInterface)
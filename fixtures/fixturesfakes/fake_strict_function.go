@@ -0,0 +1,379 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package fixturesfakes
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/maxbrunsfeld/counterfeiter/v6/fixtures"
+)
+
+type FakeStrictFunction struct {
+	Stub        func(string) error
+	mutex       sync.RWMutex
+	argsForCall []struct {
+		arg1 string
+	}
+	returns struct {
+		result1 error
+	}
+	returnsOnCall map[int]struct {
+		result1 error
+	}
+	returnsForArgs []struct {
+		args    []interface{}
+		result1 error
+	}
+	returnsConfigured  bool
+	StrictHandler      func(method string, call int, args []interface{})
+	ArgsComparer       func(expected interface{}, actual interface{}) bool
+	invocations        map[string][][]interface{}
+	orderedInvocations []struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}
+	sequencer          *atomic.Uint64
+	invocationsChanged chan struct{}
+	callsSubscribers   []struct {
+		publish func(args []interface{})
+		cancel  context.CancelFunc
+		done    <-chan struct{}
+	}
+	invocationsMutex sync.RWMutex
+	strictViolations []string
+}
+
+// NewFakeStrictFunction returns a fake that is verified when the test completes.
+func NewFakeStrictFunction(t testing.TB) *FakeStrictFunction {
+	fake := &FakeStrictFunction{}
+	fake.StrictHandler = fake.recordStrictViolation
+	t.Cleanup(func() {
+		fake.verify(t)
+	})
+	return fake
+}
+
+type FakeStrictFunctionCall struct {
+	Arg1 string
+}
+
+func (fake *FakeStrictFunction) Spy(arg1 string) error {
+	fake.mutex.Lock()
+	call := len(fake.argsForCall)
+	ret, specificReturn := fake.returnsOnCall[len(fake.argsForCall)]
+	fake.argsForCall = append(fake.argsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.Stub
+	returnsForArgs := fake.returnsForArgs
+	returns := fake.returns
+	returnsConfigured := fake.returnsConfigured
+	fake.recordInvocation("StrictFunction", []interface{}{arg1})
+	fake.mutex.Unlock()
+	for i := len(returnsForArgs) - 1; i >= 0; i-- {
+		if fake.argsMatch(returnsForArgs[i].args, []interface{}{arg1}) {
+			return returnsForArgs[i].result1
+		}
+	}
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	if !returnsConfigured {
+		fake.unconfiguredCall("StrictFunction", call, []interface{}{arg1})
+	}
+	return returns.result1
+}
+
+func (fake *FakeStrictFunction) CallCount() int {
+	fake.mutex.RLock()
+	defer fake.mutex.RUnlock()
+	return len(fake.argsForCall)
+}
+
+func (fake *FakeStrictFunction) WaitForCalls(ctx context.Context, n int) error {
+	for {
+		fake.invocationsMutex.Lock()
+		if fake.invocationsChanged == nil {
+			fake.invocationsChanged = make(chan struct{})
+		}
+		changed := fake.invocationsChanged
+		fake.invocationsMutex.Unlock()
+		if fake.CallCount() >= n {
+			return nil
+		}
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (fake *FakeStrictFunction) CallsChan(ctx context.Context) <-chan []interface{} {
+	ctx, cancel := context.WithCancel(ctx)
+	calls := make(chan []interface{})
+	ready := make(chan struct{}, 1)
+	var pendingMutex sync.Mutex
+	var pending [][]interface{}
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	fake.callsSubscribers = append(fake.callsSubscribers, struct {
+		publish func(args []interface{})
+		cancel  context.CancelFunc
+		done    <-chan struct{}
+	}{func(args []interface{}) {
+		pendingMutex.Lock()
+		pending = append(pending, args)
+		pendingMutex.Unlock()
+		select {
+		case ready <- struct{}{}:
+		default:
+		}
+	}, cancel, ctx.Done()})
+	go func() {
+		defer close(calls)
+		for {
+			select {
+			case <-ready:
+			case <-ctx.Done():
+				return
+			}
+			pendingMutex.Lock()
+			queued := pending
+			pending = nil
+			pendingMutex.Unlock()
+			for _, args := range queued {
+				select {
+				case calls <- args:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return calls
+}
+
+func (fake *FakeStrictFunction) Calls(stub func(string) error) {
+	fake.mutex.Lock()
+	defer fake.mutex.Unlock()
+	fake.Stub = stub
+}
+
+func (fake *FakeStrictFunction) ArgsForCall(i int) string {
+	fake.mutex.RLock()
+	defer fake.mutex.RUnlock()
+	return fake.argsForCall[i].arg1
+}
+
+func (fake *FakeStrictFunction) CallHistory() []FakeStrictFunctionCall {
+	fake.mutex.RLock()
+	defer fake.mutex.RUnlock()
+	history := make([]FakeStrictFunctionCall, len(fake.argsForCall))
+	for i, argsForCall := range fake.argsForCall {
+		history[i] = FakeStrictFunctionCall{argsForCall.arg1}
+	}
+	return history
+}
+
+func (fake *FakeStrictFunction) Returns(result1 error) {
+	fake.mutex.Lock()
+	defer fake.mutex.Unlock()
+	fake.Stub = nil
+	fake.returns = struct {
+		result1 error
+	}{result1}
+	fake.returnsConfigured = true
+}
+
+func (fake *FakeStrictFunction) ReturnsOnCall(i int, result1 error) {
+	fake.mutex.Lock()
+	defer fake.mutex.Unlock()
+	fake.Stub = nil
+	if fake.returnsOnCall == nil {
+		fake.returnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.returnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+// ReturnsForArgs returns the values for the calls with the given arguments.
+// The arguments are checked before the stub, and the arguments registered last
+// win.
+func (fake *FakeStrictFunction) ReturnsForArgs(arg1 string) func(error) {
+	args := []interface{}{arg1}
+	return func(result1 error) {
+		fake.mutex.Lock()
+		defer fake.mutex.Unlock()
+		fake.Stub = nil
+		fake.returnsForArgs = append(fake.returnsForArgs, struct {
+			args    []interface{}
+			result1 error
+		}{args, result1})
+	}
+}
+
+func (fake *FakeStrictFunction) Reset() {
+	fake.ResetCalls()
+	fake.ResetStubs()
+}
+
+func (fake *FakeStrictFunction) ResetCalls() {
+	fake.mutex.Lock()
+	fake.argsForCall = nil
+	fake.mutex.Unlock()
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	fake.invocations = nil
+	fake.orderedInvocations = nil
+	for _, subscriber := range fake.callsSubscribers {
+		subscriber.cancel()
+	}
+	fake.callsSubscribers = nil
+}
+
+func (fake *FakeStrictFunction) ResetStubs() {
+	fake.mutex.Lock()
+	defer fake.mutex.Unlock()
+	fake.Stub = nil
+	fake.returns = struct {
+		result1 error
+	}{}
+	fake.returnsOnCall = nil
+	fake.returnsForArgs = nil
+	fake.returnsConfigured = false
+}
+
+func (fake *FakeStrictFunction) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.mutex.RLock()
+	defer fake.mutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeStrictFunction) OrderedInvocations() []struct {
+	Seq    uint64
+	Method string
+	Args   []interface{}
+} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	return append([]struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}{}, fake.orderedInvocations...)
+}
+
+func (fake *FakeStrictFunction) SetSequencer(sequencer *atomic.Uint64) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	fake.sequencer = sequencer
+}
+
+func (fake *FakeStrictFunction) argsMatch(expected []interface{}, actual []interface{}) bool {
+	if len(expected) != len(actual) {
+		return false
+	}
+	for i := range expected {
+		if fake.ArgsComparer != nil && !fake.ArgsComparer(expected[i], actual[i]) || fake.ArgsComparer == nil && !reflect.DeepEqual(expected[i], actual[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (fake *FakeStrictFunction) unconfiguredCall(method string, call int, args []interface{}) {
+	if fake.StrictHandler == nil {
+		panic(fmt.Sprintf("FakeStrictFunction.%s: call %d with arguments %v has no stub or return values configured", method, call, args))
+	}
+	fake.StrictHandler(method, call, args)
+}
+
+func (fake *FakeStrictFunction) recordStrictViolation(method string, call int, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	fake.strictViolations = append(fake.strictViolations, fmt.Sprintf("FakeStrictFunction.%s: call %d with arguments %v has no stub or return values configured", method, call, args))
+}
+
+func (fake *FakeStrictFunction) verify(t testing.TB) {
+	t.Helper()
+	fake.mutex.RLock()
+	var unusedReturns []int
+	for call := range fake.returnsOnCall {
+		if call >= len(fake.argsForCall) {
+			unusedReturns = append(unusedReturns, call)
+		}
+	}
+	fake.mutex.RUnlock()
+	fake.reportUnusedReturns(t, unusedReturns)
+	fake.invocationsMutex.RLock()
+	strictViolations := fake.strictViolations
+	fake.invocationsMutex.RUnlock()
+	for _, violation := range strictViolations {
+		t.Error(violation)
+	}
+}
+
+func (fake *FakeStrictFunction) reportUnusedReturns(t testing.TB, calls []int) {
+	t.Helper()
+	if len(calls) == 0 {
+		return
+	}
+	sort.Ints(calls)
+	t.Errorf("FakeStrictFunction.StrictFunction: return values were configured for calls %v, which were never made", calls)
+}
+
+func (fake *FakeStrictFunction) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+	if fake.sequencer == nil {
+		fake.sequencer = new(atomic.Uint64)
+	}
+	fake.orderedInvocations = append(fake.orderedInvocations, struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}{fake.sequencer.Add(1), key, args})
+	if fake.invocationsChanged != nil {
+		close(fake.invocationsChanged)
+		fake.invocationsChanged = nil
+	}
+	subscribers := fake.callsSubscribers[:0]
+	for _, subscriber := range fake.callsSubscribers {
+		select {
+		case <-subscriber.done:
+			continue
+		default:
+		}
+		subscriber.publish(args)
+		subscribers = append(subscribers, subscriber)
+	}
+	fake.callsSubscribers = subscribers
+}
+
+var _ fixtures.StrictFunction = new(FakeStrictFunction).Spy
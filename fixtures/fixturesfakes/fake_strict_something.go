@@ -0,0 +1,516 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package fixturesfakes
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/maxbrunsfeld/counterfeiter/v6/fixtures"
+)
+
+type FakeStrictSomething struct {
+	DoNothingStub        func()
+	doNothingMutex       sync.RWMutex
+	doNothingArgsForCall []struct {
+	}
+	DoThingsStub        func(string, uint64) (int, error)
+	doThingsMutex       sync.RWMutex
+	doThingsArgsForCall []struct {
+		arg1 string
+		arg2 uint64
+	}
+	doThingsWhen []struct {
+		matcher func(string, uint64) bool
+		stub    func(string, uint64) (int, error)
+	}
+	doThingsReturns struct {
+		result1 int
+		result2 error
+	}
+	doThingsReturnsOnCall map[int]struct {
+		result1 int
+		result2 error
+	}
+	doThingsReturnsConfigured bool
+	StrictHandler             func(method string, call int, args []interface{})
+	ArgsComparer              func(expected interface{}, actual interface{}) bool
+	invocations               map[string][][]interface{}
+	orderedInvocations        []struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}
+	sequencer          *atomic.Uint64
+	invocationsChanged chan struct{}
+	callsSubscribers   map[string][]struct {
+		publish func(args []interface{})
+		cancel  context.CancelFunc
+		done    <-chan struct{}
+	}
+	invocationsMutex sync.RWMutex
+	strictViolations []string
+}
+
+// NewFakeStrictSomething returns a fake that is verified when the test completes.
+func NewFakeStrictSomething(t testing.TB) *FakeStrictSomething {
+	fake := &FakeStrictSomething{}
+	fake.StrictHandler = fake.recordStrictViolation
+	t.Cleanup(func() {
+		fake.verify(t)
+	})
+	return fake
+}
+
+func (fake *FakeStrictSomething) DoNothing() {
+	fake.doNothingMutex.Lock()
+	fake.doNothingArgsForCall = append(fake.doNothingArgsForCall, struct {
+	}{})
+	stub := fake.DoNothingStub
+	fake.recordInvocation("DoNothing", []interface{}{})
+	fake.doNothingMutex.Unlock()
+	if stub != nil {
+		fake.DoNothingStub()
+	}
+}
+
+func (fake *FakeStrictSomething) DoNothingCallCount() int {
+	fake.doNothingMutex.RLock()
+	defer fake.doNothingMutex.RUnlock()
+	return len(fake.doNothingArgsForCall)
+}
+
+func (fake *FakeStrictSomething) WaitForDoNothingCalls(ctx context.Context, n int) error {
+	return fake.waitForCalls(ctx, fake.DoNothingCallCount, n)
+}
+
+func (fake *FakeStrictSomething) DoNothingCallsChan(ctx context.Context) <-chan []interface{} {
+	return fake.callsChan(ctx, "DoNothing")
+}
+
+func (fake *FakeStrictSomething) DoNothingCalls(stub func()) {
+	fake.doNothingMutex.Lock()
+	defer fake.doNothingMutex.Unlock()
+	fake.DoNothingStub = stub
+}
+
+func (fake *FakeStrictSomething) ResetDoNothing() {
+	fake.ResetDoNothingCalls()
+	fake.ResetDoNothingStubs()
+}
+
+func (fake *FakeStrictSomething) ResetDoNothingCalls() {
+	fake.doNothingMutex.Lock()
+	defer fake.doNothingMutex.Unlock()
+	fake.doNothingArgsForCall = nil
+	fake.forgetInvocations("DoNothing")
+}
+
+func (fake *FakeStrictSomething) ResetDoNothingStubs() {
+	fake.doNothingMutex.Lock()
+	defer fake.doNothingMutex.Unlock()
+	fake.DoNothingStub = nil
+}
+
+type FakeStrictSomethingDoThingsCall struct {
+	Arg1 string
+	Arg2 uint64
+}
+
+func (fake *FakeStrictSomething) DoThings(arg1 string, arg2 uint64) (int, error) {
+	fake.doThingsMutex.Lock()
+	call := len(fake.doThingsArgsForCall)
+	ret, specificReturn := fake.doThingsReturnsOnCall[len(fake.doThingsArgsForCall)]
+	fake.doThingsArgsForCall = append(fake.doThingsArgsForCall, struct {
+		arg1 string
+		arg2 uint64
+	}{arg1, arg2})
+	stub := fake.DoThingsStub
+	whens := fake.doThingsWhen
+	fakeReturns := fake.doThingsReturns
+	returnsConfigured := fake.doThingsReturnsConfigured
+	fake.recordInvocation("DoThings", []interface{}{arg1, arg2})
+	fake.doThingsMutex.Unlock()
+	for i := len(whens) - 1; i >= 0; i-- {
+		if whens[i].matcher(arg1, arg2) {
+			return whens[i].stub(arg1, arg2)
+		}
+	}
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	if !returnsConfigured {
+		fake.unconfiguredCall("DoThings", call, []interface{}{arg1, arg2})
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStrictSomething) DoThingsCallCount() int {
+	fake.doThingsMutex.RLock()
+	defer fake.doThingsMutex.RUnlock()
+	return len(fake.doThingsArgsForCall)
+}
+
+func (fake *FakeStrictSomething) WaitForDoThingsCalls(ctx context.Context, n int) error {
+	return fake.waitForCalls(ctx, fake.DoThingsCallCount, n)
+}
+
+func (fake *FakeStrictSomething) DoThingsCallsChan(ctx context.Context) <-chan []interface{} {
+	return fake.callsChan(ctx, "DoThings")
+}
+
+func (fake *FakeStrictSomething) DoThingsCalls(stub func(string, uint64) (int, error)) {
+	fake.doThingsMutex.Lock()
+	defer fake.doThingsMutex.Unlock()
+	fake.DoThingsStub = stub
+}
+
+// DoThingsCallsWhen calls the stub for the calls whose arguments match.
+// Matchers are checked before the stub, and the matcher registered last wins.
+func (fake *FakeStrictSomething) DoThingsCallsWhen(matcher func(string, uint64) bool, stub func(string, uint64) (int, error)) {
+	fake.doThingsMutex.Lock()
+	defer fake.doThingsMutex.Unlock()
+	fake.doThingsWhen = append(fake.doThingsWhen, struct {
+		matcher func(string, uint64) bool
+		stub    func(string, uint64) (int, error)
+	}{matcher, stub})
+}
+
+func (fake *FakeStrictSomething) DoThingsArgsForCall(i int) (string, uint64) {
+	fake.doThingsMutex.RLock()
+	defer fake.doThingsMutex.RUnlock()
+	argsForCall := fake.doThingsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStrictSomething) DoThingsCallHistory() []FakeStrictSomethingDoThingsCall {
+	fake.doThingsMutex.RLock()
+	defer fake.doThingsMutex.RUnlock()
+	history := make([]FakeStrictSomethingDoThingsCall, len(fake.doThingsArgsForCall))
+	for i, argsForCall := range fake.doThingsArgsForCall {
+		history[i] = FakeStrictSomethingDoThingsCall{argsForCall.arg1, argsForCall.arg2}
+	}
+	return history
+}
+
+func (fake *FakeStrictSomething) DoThingsReturns(result1 int, result2 error) {
+	fake.doThingsMutex.Lock()
+	defer fake.doThingsMutex.Unlock()
+	fake.DoThingsStub = nil
+	fake.doThingsReturns = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+	fake.doThingsReturnsConfigured = true
+}
+
+func (fake *FakeStrictSomething) DoThingsReturnsOnCall(i int, result1 int, result2 error) {
+	fake.doThingsMutex.Lock()
+	defer fake.doThingsMutex.Unlock()
+	fake.DoThingsStub = nil
+	if fake.doThingsReturnsOnCall == nil {
+		fake.doThingsReturnsOnCall = make(map[int]struct {
+			result1 int
+			result2 error
+		})
+	}
+	fake.doThingsReturnsOnCall[i] = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+// DoThingsReturnsForArgs returns the values for the calls with the given
+// arguments. Like DoThingsCallsWhen, it is checked before the stub, and the
+// arguments or matcher registered last win.
+func (fake *FakeStrictSomething) DoThingsReturnsForArgs(arg1 string, arg2 uint64) func(int, error) {
+	args := []interface{}{arg1, arg2}
+	return func(result1 int, result2 error) {
+		fake.doThingsMutex.Lock()
+		defer fake.doThingsMutex.Unlock()
+		fake.DoThingsStub = nil
+		fake.doThingsWhen = append(fake.doThingsWhen, struct {
+			matcher func(string, uint64) bool
+			stub    func(string, uint64) (int, error)
+		}{
+			func(arg1 string, arg2 uint64) bool {
+				return fake.argsMatch(args, []interface{}{arg1, arg2})
+			},
+			func(string, uint64) (int, error) {
+				return result1, result2
+			},
+		})
+	}
+}
+
+// DoThingsReturnsWhen returns the values for the calls whose arguments
+// match. Like DoThingsCallsWhen, it is checked before the stub, and the
+// matcher registered last wins.
+func (fake *FakeStrictSomething) DoThingsReturnsWhen(matcher func(string, uint64) bool, result1 int, result2 error) {
+	fake.DoThingsCallsWhen(matcher, func(string, uint64) (int, error) {
+		return result1, result2
+	})
+}
+
+func (fake *FakeStrictSomething) ResetDoThings() {
+	fake.ResetDoThingsCalls()
+	fake.ResetDoThingsStubs()
+}
+
+func (fake *FakeStrictSomething) ResetDoThingsCalls() {
+	fake.doThingsMutex.Lock()
+	defer fake.doThingsMutex.Unlock()
+	fake.doThingsArgsForCall = nil
+	fake.forgetInvocations("DoThings")
+}
+
+func (fake *FakeStrictSomething) ResetDoThingsStubs() {
+	fake.doThingsMutex.Lock()
+	defer fake.doThingsMutex.Unlock()
+	fake.DoThingsStub = nil
+	fake.doThingsWhen = nil
+	fake.doThingsReturns = struct {
+		result1 int
+		result2 error
+	}{}
+	fake.doThingsReturnsOnCall = nil
+	fake.doThingsReturnsConfigured = false
+}
+
+func (fake *FakeStrictSomething) Reset() {
+	fake.ResetCalls()
+	fake.ResetStubs()
+}
+
+func (fake *FakeStrictSomething) ResetCalls() {
+	fake.ResetDoNothingCalls()
+	fake.ResetDoThingsCalls()
+}
+
+func (fake *FakeStrictSomething) ResetStubs() {
+	fake.ResetDoNothingStubs()
+	fake.ResetDoThingsStubs()
+}
+
+func (fake *FakeStrictSomething) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeStrictSomething) argsMatch(expected []interface{}, actual []interface{}) bool {
+	if len(expected) != len(actual) {
+		return false
+	}
+	for i := range expected {
+		if fake.ArgsComparer != nil && !fake.ArgsComparer(expected[i], actual[i]) || fake.ArgsComparer == nil && !reflect.DeepEqual(expected[i], actual[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (fake *FakeStrictSomething) unconfiguredCall(method string, call int, args []interface{}) {
+	if fake.StrictHandler == nil {
+		panic(fmt.Sprintf("FakeStrictSomething.%s: call %d with arguments %v has no stub or return values configured", method, call, args))
+	}
+	fake.StrictHandler(method, call, args)
+}
+
+func (fake *FakeStrictSomething) recordStrictViolation(method string, call int, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	fake.strictViolations = append(fake.strictViolations, fmt.Sprintf("FakeStrictSomething.%s: call %d with arguments %v has no stub or return values configured", method, call, args))
+}
+
+func (fake *FakeStrictSomething) OrderedInvocations() []struct {
+	Seq    uint64
+	Method string
+	Args   []interface{}
+} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	return append([]struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}{}, fake.orderedInvocations...)
+}
+
+func (fake *FakeStrictSomething) SetSequencer(sequencer *atomic.Uint64) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	fake.sequencer = sequencer
+}
+
+func (fake *FakeStrictSomething) waitForCalls(ctx context.Context, callCount func() int, n int) error {
+	for {
+		fake.invocationsMutex.Lock()
+		if fake.invocationsChanged == nil {
+			fake.invocationsChanged = make(chan struct{})
+		}
+		changed := fake.invocationsChanged
+		fake.invocationsMutex.Unlock()
+		if callCount() >= n {
+			return nil
+		}
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (fake *FakeStrictSomething) callsChan(ctx context.Context, key string) <-chan []interface{} {
+	ctx, cancel := context.WithCancel(ctx)
+	calls := make(chan []interface{})
+	ready := make(chan struct{}, 1)
+	var pendingMutex sync.Mutex
+	var pending [][]interface{}
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.callsSubscribers == nil {
+		fake.callsSubscribers = map[string][]struct {
+			publish func(args []interface{})
+			cancel  context.CancelFunc
+			done    <-chan struct{}
+		}{}
+	}
+	fake.callsSubscribers[key] = append(fake.callsSubscribers[key], struct {
+		publish func(args []interface{})
+		cancel  context.CancelFunc
+		done    <-chan struct{}
+	}{func(args []interface{}) {
+		pendingMutex.Lock()
+		pending = append(pending, args)
+		pendingMutex.Unlock()
+		select {
+		case ready <- struct{}{}:
+		default:
+		}
+	}, cancel, ctx.Done()})
+	go func() {
+		defer close(calls)
+		for {
+			select {
+			case <-ready:
+			case <-ctx.Done():
+				return
+			}
+			pendingMutex.Lock()
+			queued := pending
+			pending = nil
+			pendingMutex.Unlock()
+			for _, args := range queued {
+				select {
+				case calls <- args:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return calls
+}
+
+func (fake *FakeStrictSomething) verify(t testing.TB) {
+	t.Helper()
+	fake.doThingsMutex.RLock()
+	var unusedDoThingsReturns []int
+	for call := range fake.doThingsReturnsOnCall {
+		if call >= len(fake.doThingsArgsForCall) {
+			unusedDoThingsReturns = append(unusedDoThingsReturns, call)
+		}
+	}
+	fake.doThingsMutex.RUnlock()
+	fake.reportUnusedReturns(t, "DoThings", unusedDoThingsReturns)
+	fake.invocationsMutex.RLock()
+	strictViolations := fake.strictViolations
+	fake.invocationsMutex.RUnlock()
+	for _, violation := range strictViolations {
+		t.Error(violation)
+	}
+}
+
+func (fake *FakeStrictSomething) reportUnusedReturns(t testing.TB, method string, calls []int) {
+	t.Helper()
+	if len(calls) == 0 {
+		return
+	}
+	sort.Ints(calls)
+	t.Errorf("FakeStrictSomething.%s: return values were configured for calls %v, which were never made", method, calls)
+}
+
+func (fake *FakeStrictSomething) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+	if fake.sequencer == nil {
+		fake.sequencer = new(atomic.Uint64)
+	}
+	fake.orderedInvocations = append(fake.orderedInvocations, struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}{fake.sequencer.Add(1), key, args})
+	if fake.invocationsChanged != nil {
+		close(fake.invocationsChanged)
+		fake.invocationsChanged = nil
+	}
+	subscribers := fake.callsSubscribers[key][:0]
+	for _, subscriber := range fake.callsSubscribers[key] {
+		select {
+		case <-subscriber.done:
+			continue
+		default:
+		}
+		subscriber.publish(args)
+		subscribers = append(subscribers, subscriber)
+	}
+	if len(subscribers) > 0 {
+		fake.callsSubscribers[key] = subscribers
+	} else {
+		delete(fake.callsSubscribers, key)
+	}
+}
+
+func (fake *FakeStrictSomething) forgetInvocations(key string) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	delete(fake.invocations, key)
+	for _, subscriber := range fake.callsSubscribers[key] {
+		subscriber.cancel()
+	}
+	delete(fake.callsSubscribers, key)
+	var orderedInvocations []struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}
+	for _, invocation := range fake.orderedInvocations {
+		if invocation.Method != key {
+			orderedInvocations = append(orderedInvocations, invocation)
+		}
+	}
+	fake.orderedInvocations = orderedInvocations
+}
+
+var _ fixtures.StrictSomething = new(FakeStrictSomething)
@@ -2,7 +2,10 @@
 package fixturesfakes
 
 import (
+	"context"
+	"reflect"
 	"sync"
+	"sync/atomic"
 )
 
 type FakeUnexportedInterface struct {
@@ -12,16 +15,38 @@ type FakeUnexportedInterface struct {
 		arg1 string
 		arg2 map[string]interface{}
 	}
+	methodWhen []struct {
+		matcher func(string, map[string]interface{}) bool
+		stub    func(string, map[string]interface{}) string
+	}
 	methodReturns struct {
 		result1 string
 	}
 	methodReturnsOnCall map[int]struct {
 		result1 string
 	}
-	invocations      map[string][][]interface{}
+	ArgsComparer       func(expected interface{}, actual interface{}) bool
+	invocations        map[string][][]interface{}
+	orderedInvocations []struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}
+	sequencer          *atomic.Uint64
+	invocationsChanged chan struct{}
+	callsSubscribers   map[string][]struct {
+		publish func(args []interface{})
+		cancel  context.CancelFunc
+		done    <-chan struct{}
+	}
 	invocationsMutex sync.RWMutex
 }
 
+type FakeUnexportedInterfaceMethodCall struct {
+	Arg1 string
+	Arg2 map[string]interface{}
+}
+
 func (fake *FakeUnexportedInterface) Method(arg1 string, arg2 map[string]interface{}) string {
 	fake.methodMutex.Lock()
 	ret, specificReturn := fake.methodReturnsOnCall[len(fake.methodArgsForCall)]
@@ -30,9 +55,15 @@ func (fake *FakeUnexportedInterface) Method(arg1 string, arg2 map[string]interfa
 		arg2 map[string]interface{}
 	}{arg1, arg2})
 	stub := fake.MethodStub
+	whens := fake.methodWhen
 	fakeReturns := fake.methodReturns
 	fake.recordInvocation("Method", []interface{}{arg1, arg2})
 	fake.methodMutex.Unlock()
+	for i := len(whens) - 1; i >= 0; i-- {
+		if whens[i].matcher(arg1, arg2) {
+			return whens[i].stub(arg1, arg2)
+		}
+	}
 	if stub != nil {
 		return stub(arg1, arg2)
 	}
@@ -48,12 +79,31 @@ func (fake *FakeUnexportedInterface) MethodCallCount() int {
 	return len(fake.methodArgsForCall)
 }
 
+func (fake *FakeUnexportedInterface) WaitForMethodCalls(ctx context.Context, n int) error {
+	return fake.waitForCalls(ctx, fake.MethodCallCount, n)
+}
+
+func (fake *FakeUnexportedInterface) MethodCallsChan(ctx context.Context) <-chan []interface{} {
+	return fake.callsChan(ctx, "Method")
+}
+
 func (fake *FakeUnexportedInterface) MethodCalls(stub func(string, map[string]interface{}) string) {
 	fake.methodMutex.Lock()
 	defer fake.methodMutex.Unlock()
 	fake.MethodStub = stub
 }
 
+// MethodCallsWhen calls the stub for the calls whose arguments match.
+// Matchers are checked before the stub, and the matcher registered last wins.
+func (fake *FakeUnexportedInterface) MethodCallsWhen(matcher func(string, map[string]interface{}) bool, stub func(string, map[string]interface{}) string) {
+	fake.methodMutex.Lock()
+	defer fake.methodMutex.Unlock()
+	fake.methodWhen = append(fake.methodWhen, struct {
+		matcher func(string, map[string]interface{}) bool
+		stub    func(string, map[string]interface{}) string
+	}{matcher, stub})
+}
+
 func (fake *FakeUnexportedInterface) MethodArgsForCall(i int) (string, map[string]interface{}) {
 	fake.methodMutex.RLock()
 	defer fake.methodMutex.RUnlock()
@@ -61,6 +111,16 @@ func (fake *FakeUnexportedInterface) MethodArgsForCall(i int) (string, map[strin
 	return argsForCall.arg1, argsForCall.arg2
 }
 
+func (fake *FakeUnexportedInterface) MethodCallHistory() []FakeUnexportedInterfaceMethodCall {
+	fake.methodMutex.RLock()
+	defer fake.methodMutex.RUnlock()
+	history := make([]FakeUnexportedInterfaceMethodCall, len(fake.methodArgsForCall))
+	for i, argsForCall := range fake.methodArgsForCall {
+		history[i] = FakeUnexportedInterfaceMethodCall{argsForCall.arg1, argsForCall.arg2}
+	}
+	return history
+}
+
 func (fake *FakeUnexportedInterface) MethodReturns(result1 string) {
 	fake.methodMutex.Lock()
 	defer fake.methodMutex.Unlock()
@@ -84,6 +144,74 @@ func (fake *FakeUnexportedInterface) MethodReturnsOnCall(i int, result1 string)
 	}{result1}
 }
 
+// MethodReturnsForArgs returns the values for the calls with the given
+// arguments. Like MethodCallsWhen, it is checked before the stub, and the
+// arguments or matcher registered last win.
+func (fake *FakeUnexportedInterface) MethodReturnsForArgs(arg1 string, arg2 map[string]interface{}) func(string) {
+	args := []interface{}{arg1, arg2}
+	return func(result1 string) {
+		fake.methodMutex.Lock()
+		defer fake.methodMutex.Unlock()
+		fake.MethodStub = nil
+		fake.methodWhen = append(fake.methodWhen, struct {
+			matcher func(string, map[string]interface{}) bool
+			stub    func(string, map[string]interface{}) string
+		}{
+			func(arg1 string, arg2 map[string]interface{}) bool {
+				return fake.argsMatch(args, []interface{}{arg1, arg2})
+			},
+			func(string, map[string]interface{}) string {
+				return result1
+			},
+		})
+	}
+}
+
+// MethodReturnsWhen returns the values for the calls whose arguments
+// match. Like MethodCallsWhen, it is checked before the stub, and the
+// matcher registered last wins.
+func (fake *FakeUnexportedInterface) MethodReturnsWhen(matcher func(string, map[string]interface{}) bool, result1 string) {
+	fake.MethodCallsWhen(matcher, func(string, map[string]interface{}) string {
+		return result1
+	})
+}
+
+func (fake *FakeUnexportedInterface) ResetMethod() {
+	fake.ResetMethodCalls()
+	fake.ResetMethodStubs()
+}
+
+func (fake *FakeUnexportedInterface) ResetMethodCalls() {
+	fake.methodMutex.Lock()
+	defer fake.methodMutex.Unlock()
+	fake.methodArgsForCall = nil
+	fake.forgetInvocations("Method")
+}
+
+func (fake *FakeUnexportedInterface) ResetMethodStubs() {
+	fake.methodMutex.Lock()
+	defer fake.methodMutex.Unlock()
+	fake.MethodStub = nil
+	fake.methodWhen = nil
+	fake.methodReturns = struct {
+		result1 string
+	}{}
+	fake.methodReturnsOnCall = nil
+}
+
+func (fake *FakeUnexportedInterface) Reset() {
+	fake.ResetCalls()
+	fake.ResetStubs()
+}
+
+func (fake *FakeUnexportedInterface) ResetCalls() {
+	fake.ResetMethodCalls()
+}
+
+func (fake *FakeUnexportedInterface) ResetStubs() {
+	fake.ResetMethodStubs()
+}
+
 func (fake *FakeUnexportedInterface) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -94,6 +222,109 @@ func (fake *FakeUnexportedInterface) Invocations() map[string][][]interface{} {
 	return copiedInvocations
 }
 
+func (fake *FakeUnexportedInterface) argsMatch(expected []interface{}, actual []interface{}) bool {
+	if len(expected) != len(actual) {
+		return false
+	}
+	for i := range expected {
+		if fake.ArgsComparer != nil && !fake.ArgsComparer(expected[i], actual[i]) || fake.ArgsComparer == nil && !reflect.DeepEqual(expected[i], actual[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (fake *FakeUnexportedInterface) OrderedInvocations() []struct {
+	Seq    uint64
+	Method string
+	Args   []interface{}
+} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	return append([]struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}{}, fake.orderedInvocations...)
+}
+
+func (fake *FakeUnexportedInterface) SetSequencer(sequencer *atomic.Uint64) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	fake.sequencer = sequencer
+}
+
+func (fake *FakeUnexportedInterface) waitForCalls(ctx context.Context, callCount func() int, n int) error {
+	for {
+		fake.invocationsMutex.Lock()
+		if fake.invocationsChanged == nil {
+			fake.invocationsChanged = make(chan struct{})
+		}
+		changed := fake.invocationsChanged
+		fake.invocationsMutex.Unlock()
+		if callCount() >= n {
+			return nil
+		}
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (fake *FakeUnexportedInterface) callsChan(ctx context.Context, key string) <-chan []interface{} {
+	ctx, cancel := context.WithCancel(ctx)
+	calls := make(chan []interface{})
+	ready := make(chan struct{}, 1)
+	var pendingMutex sync.Mutex
+	var pending [][]interface{}
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.callsSubscribers == nil {
+		fake.callsSubscribers = map[string][]struct {
+			publish func(args []interface{})
+			cancel  context.CancelFunc
+			done    <-chan struct{}
+		}{}
+	}
+	fake.callsSubscribers[key] = append(fake.callsSubscribers[key], struct {
+		publish func(args []interface{})
+		cancel  context.CancelFunc
+		done    <-chan struct{}
+	}{func(args []interface{}) {
+		pendingMutex.Lock()
+		pending = append(pending, args)
+		pendingMutex.Unlock()
+		select {
+		case ready <- struct{}{}:
+		default:
+		}
+	}, cancel, ctx.Done()})
+	go func() {
+		defer close(calls)
+		for {
+			select {
+			case <-ready:
+			case <-ctx.Done():
+				return
+			}
+			pendingMutex.Lock()
+			queued := pending
+			pending = nil
+			pendingMutex.Unlock()
+			for _, args := range queued {
+				select {
+				case calls <- args:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return calls
+}
+
 func (fake *FakeUnexportedInterface) recordInvocation(key string, args []interface{}) {
 	fake.invocationsMutex.Lock()
 	defer fake.invocationsMutex.Unlock()
@@ -104,4 +335,52 @@ func (fake *FakeUnexportedInterface) recordInvocation(key string, args []interfa
 		fake.invocations[key] = [][]interface{}{}
 	}
 	fake.invocations[key] = append(fake.invocations[key], args)
+	if fake.sequencer == nil {
+		fake.sequencer = new(atomic.Uint64)
+	}
+	fake.orderedInvocations = append(fake.orderedInvocations, struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}{fake.sequencer.Add(1), key, args})
+	if fake.invocationsChanged != nil {
+		close(fake.invocationsChanged)
+		fake.invocationsChanged = nil
+	}
+	subscribers := fake.callsSubscribers[key][:0]
+	for _, subscriber := range fake.callsSubscribers[key] {
+		select {
+		case <-subscriber.done:
+			continue
+		default:
+		}
+		subscriber.publish(args)
+		subscribers = append(subscribers, subscriber)
+	}
+	if len(subscribers) > 0 {
+		fake.callsSubscribers[key] = subscribers
+	} else {
+		delete(fake.callsSubscribers, key)
+	}
+}
+
+func (fake *FakeUnexportedInterface) forgetInvocations(key string) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	delete(fake.invocations, key)
+	for _, subscriber := range fake.callsSubscribers[key] {
+		subscriber.cancel()
+	}
+	delete(fake.callsSubscribers, key)
+	var orderedInvocations []struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}
+	for _, invocation := range fake.orderedInvocations {
+		if invocation.Method != key {
+			orderedInvocations = append(orderedInvocations, invocation)
+		}
+	}
+	fake.orderedInvocations = orderedInvocations
 }
@@ -0,0 +1,71 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package fixturesfakes
+
+import (
+	"sync"
+
+	"github.com/maxbrunsfeld/counterfeiter/v6/fixtures"
+)
+
+type FuncsHasVarArgs struct {
+	DoMoreThingsFunc func(arg1 int, arg2 int, arg3 ...string) int
+	DoThingsFunc     func(arg1 int, arg2 ...string) int
+	calls            struct {
+		DoMoreThings []FuncsHasVarArgsDoMoreThingsCall
+		DoThings     []FuncsHasVarArgsDoThingsCall
+	}
+	lock sync.RWMutex
+}
+
+type FuncsHasVarArgsDoMoreThingsCall struct {
+	Arg1 int
+	Arg2 int
+	Arg3 []string
+}
+
+func (fake *FuncsHasVarArgs) DoMoreThings(arg1 int, arg2 int, arg3 ...string) int {
+	fake.lock.Lock()
+	fake.calls.DoMoreThings = append(fake.calls.DoMoreThings, FuncsHasVarArgsDoMoreThingsCall{arg1, arg2, arg3})
+	fn := fake.DoMoreThingsFunc
+	fake.lock.Unlock()
+	if fn == nil {
+		var result1 int
+		return result1
+	}
+	return fn(arg1, arg2, arg3...)
+}
+
+func (fake *FuncsHasVarArgs) DoMoreThingsCalls() []FuncsHasVarArgsDoMoreThingsCall {
+	fake.lock.RLock()
+	defer fake.lock.RUnlock()
+	calls := make([]FuncsHasVarArgsDoMoreThingsCall, len(fake.calls.DoMoreThings))
+	copy(calls, fake.calls.DoMoreThings)
+	return calls
+}
+
+type FuncsHasVarArgsDoThingsCall struct {
+	Arg1 int
+	Arg2 []string
+}
+
+func (fake *FuncsHasVarArgs) DoThings(arg1 int, arg2 ...string) int {
+	fake.lock.Lock()
+	fake.calls.DoThings = append(fake.calls.DoThings, FuncsHasVarArgsDoThingsCall{arg1, arg2})
+	fn := fake.DoThingsFunc
+	fake.lock.Unlock()
+	if fn == nil {
+		var result1 int
+		return result1
+	}
+	return fn(arg1, arg2...)
+}
+
+func (fake *FuncsHasVarArgs) DoThingsCalls() []FuncsHasVarArgsDoThingsCall {
+	fake.lock.RLock()
+	defer fake.lock.RUnlock()
+	calls := make([]FuncsHasVarArgsDoThingsCall, len(fake.calls.DoThings))
+	copy(calls, fake.calls.DoThings)
+	return calls
+}
+
+var _ fixtures.HasVarArgs = new(FuncsHasVarArgs)
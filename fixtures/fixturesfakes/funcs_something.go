@@ -0,0 +1,122 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package fixturesfakes
+
+import (
+	"sync"
+
+	"github.com/maxbrunsfeld/counterfeiter/v6/fixtures"
+)
+
+type FuncsSomething struct {
+	DoASliceFunc  func(arg1 []byte)
+	DoAnArrayFunc func(arg1 [4]byte)
+	DoNothingFunc func()
+	DoThingsFunc  func(arg1 string, arg2 uint64) (int, error)
+	calls         struct {
+		DoASlice  []FuncsSomethingDoASliceCall
+		DoAnArray []FuncsSomethingDoAnArrayCall
+		DoNothing []FuncsSomethingDoNothingCall
+		DoThings  []FuncsSomethingDoThingsCall
+	}
+	lock sync.RWMutex
+}
+
+type FuncsSomethingDoASliceCall struct {
+	Arg1 []byte
+}
+
+func (fake *FuncsSomething) DoASlice(arg1 []byte) {
+	var arg1Copy []byte
+	if arg1 != nil {
+		arg1Copy = make([]byte, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.lock.Lock()
+	fake.calls.DoASlice = append(fake.calls.DoASlice, FuncsSomethingDoASliceCall{arg1Copy})
+	fn := fake.DoASliceFunc
+	fake.lock.Unlock()
+	if fn == nil {
+		return
+	}
+	fn(arg1)
+}
+
+func (fake *FuncsSomething) DoASliceCalls() []FuncsSomethingDoASliceCall {
+	fake.lock.RLock()
+	defer fake.lock.RUnlock()
+	calls := make([]FuncsSomethingDoASliceCall, len(fake.calls.DoASlice))
+	copy(calls, fake.calls.DoASlice)
+	return calls
+}
+
+type FuncsSomethingDoAnArrayCall struct {
+	Arg1 [4]byte
+}
+
+func (fake *FuncsSomething) DoAnArray(arg1 [4]byte) {
+	fake.lock.Lock()
+	fake.calls.DoAnArray = append(fake.calls.DoAnArray, FuncsSomethingDoAnArrayCall{arg1})
+	fn := fake.DoAnArrayFunc
+	fake.lock.Unlock()
+	if fn == nil {
+		return
+	}
+	fn(arg1)
+}
+
+func (fake *FuncsSomething) DoAnArrayCalls() []FuncsSomethingDoAnArrayCall {
+	fake.lock.RLock()
+	defer fake.lock.RUnlock()
+	calls := make([]FuncsSomethingDoAnArrayCall, len(fake.calls.DoAnArray))
+	copy(calls, fake.calls.DoAnArray)
+	return calls
+}
+
+type FuncsSomethingDoNothingCall struct{}
+
+func (fake *FuncsSomething) DoNothing() {
+	fake.lock.Lock()
+	fake.calls.DoNothing = append(fake.calls.DoNothing, FuncsSomethingDoNothingCall{})
+	fn := fake.DoNothingFunc
+	fake.lock.Unlock()
+	if fn == nil {
+		return
+	}
+	fn()
+}
+
+func (fake *FuncsSomething) DoNothingCalls() []FuncsSomethingDoNothingCall {
+	fake.lock.RLock()
+	defer fake.lock.RUnlock()
+	calls := make([]FuncsSomethingDoNothingCall, len(fake.calls.DoNothing))
+	copy(calls, fake.calls.DoNothing)
+	return calls
+}
+
+type FuncsSomethingDoThingsCall struct {
+	Arg1 string
+	Arg2 uint64
+}
+
+func (fake *FuncsSomething) DoThings(arg1 string, arg2 uint64) (int, error) {
+	fake.lock.Lock()
+	fake.calls.DoThings = append(fake.calls.DoThings, FuncsSomethingDoThingsCall{arg1, arg2})
+	fn := fake.DoThingsFunc
+	fake.lock.Unlock()
+	if fn == nil {
+		var result1 int
+		var result2 error
+		return result1, result2
+	}
+	return fn(arg1, arg2)
+}
+
+func (fake *FuncsSomething) DoThingsCalls() []FuncsSomethingDoThingsCall {
+	fake.lock.RLock()
+	defer fake.lock.RUnlock()
+	calls := make([]FuncsSomethingDoThingsCall, len(fake.calls.DoThings))
+	copy(calls, fake.calls.DoThings)
+	return calls
+}
+
+var _ fixtures.Something = new(FuncsSomething)
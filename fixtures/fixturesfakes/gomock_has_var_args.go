@@ -0,0 +1,64 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package fixturesfakes
+
+import (
+	"reflect"
+
+	"github.com/maxbrunsfeld/counterfeiter/v6/fixtures"
+	"go.uber.org/mock/gomock"
+)
+
+type GomockHasVarArgs struct {
+	ctrl     *gomock.Controller
+	recorder *GomockHasVarArgsMockRecorder
+}
+
+type GomockHasVarArgsMockRecorder struct {
+	mock *GomockHasVarArgs
+}
+
+func NewGomockHasVarArgs(ctrl *gomock.Controller) *GomockHasVarArgs {
+	mock := &GomockHasVarArgs{ctrl: ctrl}
+	mock.recorder = &GomockHasVarArgsMockRecorder{mock}
+	return mock
+}
+
+func (m *GomockHasVarArgs) EXPECT() *GomockHasVarArgsMockRecorder {
+	return m.recorder
+}
+
+func (m *GomockHasVarArgs) DoMoreThings(arg1 int, arg2 int, arg3 ...string) int {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DoMoreThings", varargs...)
+	result1, _ := ret[0].(int)
+	return result1
+}
+
+func (mr *GomockHasVarArgsMockRecorder) DoMoreThings(arg1 interface{}, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DoMoreThings", reflect.TypeOf((*GomockHasVarArgs)(nil).DoMoreThings), varargs...)
+}
+
+func (m *GomockHasVarArgs) DoThings(arg1 int, arg2 ...string) int {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DoThings", varargs...)
+	result1, _ := ret[0].(int)
+	return result1
+}
+
+func (mr *GomockHasVarArgsMockRecorder) DoThings(arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DoThings", reflect.TypeOf((*GomockHasVarArgs)(nil).DoThings), varargs...)
+}
+
+var _ fixtures.HasVarArgs = new(GomockHasVarArgs)
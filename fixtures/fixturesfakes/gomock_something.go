@@ -0,0 +1,73 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package fixturesfakes
+
+import (
+	"reflect"
+
+	"github.com/maxbrunsfeld/counterfeiter/v6/fixtures"
+	"go.uber.org/mock/gomock"
+)
+
+type GomockSomething struct {
+	ctrl     *gomock.Controller
+	recorder *GomockSomethingMockRecorder
+}
+
+type GomockSomethingMockRecorder struct {
+	mock *GomockSomething
+}
+
+func NewGomockSomething(ctrl *gomock.Controller) *GomockSomething {
+	mock := &GomockSomething{ctrl: ctrl}
+	mock.recorder = &GomockSomethingMockRecorder{mock}
+	return mock
+}
+
+func (m *GomockSomething) EXPECT() *GomockSomethingMockRecorder {
+	return m.recorder
+}
+
+func (m *GomockSomething) DoASlice(arg1 []byte) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "DoASlice", arg1)
+}
+
+func (mr *GomockSomethingMockRecorder) DoASlice(arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DoASlice", reflect.TypeOf((*GomockSomething)(nil).DoASlice), arg1)
+}
+
+func (m *GomockSomething) DoAnArray(arg1 [4]byte) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "DoAnArray", arg1)
+}
+
+func (mr *GomockSomethingMockRecorder) DoAnArray(arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DoAnArray", reflect.TypeOf((*GomockSomething)(nil).DoAnArray), arg1)
+}
+
+func (m *GomockSomething) DoNothing() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "DoNothing")
+}
+
+func (mr *GomockSomethingMockRecorder) DoNothing() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DoNothing", reflect.TypeOf((*GomockSomething)(nil).DoNothing))
+}
+
+func (m *GomockSomething) DoThings(arg1 string, arg2 uint64) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DoThings", arg1, arg2)
+	result1, _ := ret[0].(int)
+	result2, _ := ret[1].(error)
+	return result1, result2
+}
+
+func (mr *GomockSomethingMockRecorder) DoThings(arg1 interface{}, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DoThings", reflect.TypeOf((*GomockSomething)(nil).DoThings), arg1, arg2)
+}
+
+var _ fixtures.Something = new(GomockSomething)
@@ -0,0 +1,209 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package fixturesfakes
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"reflect"
+	"sync"
+
+	"github.com/maxbrunsfeld/counterfeiter/v6/fixtures"
+)
+
+// ReplayCheckerRecordedCall is a call recorded by a ReplayCheckerRecorder.
+type ReplayCheckerRecordedCall struct {
+	Method  string            `json:"method"`
+	Args    []json.RawMessage `json:"args"`
+	Out     []json.RawMessage `json:"out,omitempty"`
+	Results []json.RawMessage `json:"results"`
+}
+
+// ReplayCheckerRecorder wraps a real implementation, and records the arguments and
+// results of every call, so that they can be replayed by a ReplayChecker. The
+// arguments are recorded as they were passed to the real implementation, and
+// the values of slices and pointers as they were after it returned.
+type ReplayCheckerRecorder struct {
+	real  fixtures.Checker
+	calls []ReplayCheckerRecordedCall
+	err   error
+	lock  sync.Mutex
+}
+
+// NewReplayCheckerRecorder returns a recorder that forwards every call to real.
+func NewReplayCheckerRecorder(real fixtures.Checker) *ReplayCheckerRecorder {
+	return &ReplayCheckerRecorder{real: real}
+}
+
+func (recorder *ReplayCheckerRecorder) Check(arg1 string) (*fixtures.CheckError, error) {
+	args, err := marshalReplayCheckerValues("Check", []interface{}{arg1})
+	result1, result2 := recorder.real.Check(arg1)
+	recorder.record("Check", args, err, nil, []interface{}{result1, marshalReplayCheckerError(result2)})
+	return result1, result2
+}
+
+// Save writes the recorded calls to the file at path, as JSON.
+func (recorder *ReplayCheckerRecorder) Save(path string) error {
+	recorder.lock.Lock()
+	defer recorder.lock.Unlock()
+	if recorder.err != nil {
+		return recorder.err
+	}
+	calls := recorder.calls
+	if calls == nil {
+		calls = []ReplayCheckerRecordedCall{}
+	}
+	b, err := json.MarshalIndent(calls, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(b, '\n'), 0644)
+}
+
+func (recorder *ReplayCheckerRecorder) record(method string, args []json.RawMessage, err error, out []interface{}, results []interface{}) {
+	recorder.lock.Lock()
+	defer recorder.lock.Unlock()
+	if recorder.err != nil {
+		return
+	}
+	if err != nil {
+		recorder.err = err
+		return
+	}
+	call := ReplayCheckerRecordedCall{Method: method, Args: args}
+	if out != nil {
+		call.Out, recorder.err = marshalReplayCheckerValues(method, out)
+		if recorder.err != nil {
+			return
+		}
+	}
+	call.Results, recorder.err = marshalReplayCheckerValues(method, results)
+	if recorder.err != nil {
+		return
+	}
+	recorder.calls = append(recorder.calls, call)
+}
+
+// ReplayChecker replays the calls recorded by a ReplayCheckerRecorder. Each call
+// returns the results of the first recorded call of the same method with the
+// same arguments that has not been replayed yet, or else of the last recorded
+// call with the same arguments, and writes the recorded values of slices and
+// pointers back through them. Calls that were never recorded panic.
+type ReplayChecker struct {
+	calls    []ReplayCheckerRecordedCall
+	replayed []bool
+	lock     sync.Mutex
+}
+
+// LoadReplayChecker returns a fake that replays the calls saved to the file at path
+// by a ReplayCheckerRecorder.
+func LoadReplayChecker(path string) (*ReplayChecker, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var calls []ReplayCheckerRecordedCall
+	if err := json.Unmarshal(b, &calls); err != nil {
+		return nil, fmt.Errorf("cannot load the recorded calls from %s: %v", path, err)
+	}
+	return &ReplayChecker{calls: calls, replayed: make([]bool, len(calls))}, nil
+}
+
+func (fake *ReplayChecker) Check(arg1 string) (*fixtures.CheckError, error) {
+	call := fake.replay("Check", []interface{}{arg1}, 2)
+	var result1 *fixtures.CheckError
+	fake.unmarshal(call, 0, &result1)
+	var result2 error
+	var result2Message *string
+	fake.unmarshal(call, 1, &result2Message)
+	if result2Message != nil {
+		result2 = fmt.Errorf("%s", *result2Message)
+	}
+	return result1, result2
+}
+
+func (fake *ReplayChecker) replay(method string, args []interface{}, results int) ReplayCheckerRecordedCall {
+	raw, err := marshalReplayCheckerValues(method, args)
+	if err != nil {
+		panic(err)
+	}
+	fake.lock.Lock()
+	defer fake.lock.Unlock()
+	last := -1
+	for i, call := range fake.calls {
+		if call.Method != method || len(call.Results) != results || !equalReplayCheckerValues(call.Args, raw) {
+			continue
+		}
+		if !fake.replayed[i] {
+			fake.replayed[i] = true
+			return call
+		}
+		last = i
+	}
+	if last < 0 {
+		b, _ := json.Marshal(raw)
+		panic(fmt.Sprintf("ReplayChecker.%s: no call with arguments %s was recorded", method, b))
+	}
+	return fake.calls[last]
+}
+
+func (fake *ReplayChecker) setArg(call ReplayCheckerRecordedCall, i int, arg interface{}) {
+	if i >= len(call.Out) {
+		return
+	}
+	if v := reflect.ValueOf(arg); v.Kind() != reflect.Ptr || v.IsNil() {
+		return
+	}
+	if err := json.Unmarshal(call.Out[i], arg); err != nil {
+		panic(fmt.Sprintf("ReplayChecker.%s: cannot replay argument %d: %v", call.Method, i+1, err))
+	}
+}
+
+func (fake *ReplayChecker) unmarshal(call ReplayCheckerRecordedCall, i int, result interface{}) {
+	if err := json.Unmarshal(call.Results[i], result); err != nil {
+		panic(fmt.Sprintf("ReplayChecker.%s: cannot replay result %d: %v", call.Method, i+1, err))
+	}
+}
+
+func marshalReplayCheckerValues(method string, values []interface{}) ([]json.RawMessage, error) {
+	raw := []json.RawMessage{}
+	for _, v := range values {
+		if v != nil {
+			switch reflect.TypeOf(v).Kind() {
+			case reflect.Func, reflect.Chan, reflect.UnsafePointer:
+				v = fmt.Sprintf("%T", v)
+			}
+		}
+		b, err := json.Marshal(v)
+		if err != nil {
+			return nil, fmt.Errorf("ReplayChecker.%s: cannot record %T: %v", method, v, err)
+		}
+		raw = append(raw, b)
+	}
+	return raw, nil
+}
+
+// marshalReplayCheckerError records an error as its message, as the type of
+// the error is not known when it is replayed.
+func marshalReplayCheckerError(err error) interface{} {
+	if err == nil {
+		return nil
+	}
+	return err.Error()
+}
+
+func equalReplayCheckerValues(a []json.RawMessage, b []json.RawMessage) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		var x, y interface{}
+		if json.Unmarshal(a[i], &x) != nil || json.Unmarshal(b[i], &y) != nil || !reflect.DeepEqual(x, y) {
+			return false
+		}
+	}
+	return true
+}
+
+var _ fixtures.Checker = new(ReplayChecker)
+var _ fixtures.Checker = new(ReplayCheckerRecorder)
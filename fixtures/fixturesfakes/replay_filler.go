@@ -0,0 +1,223 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package fixturesfakes
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"reflect"
+	"sync"
+
+	"github.com/maxbrunsfeld/counterfeiter/v6/fixtures"
+)
+
+// ReplayFillerRecordedCall is a call recorded by a ReplayFillerRecorder.
+type ReplayFillerRecordedCall struct {
+	Method  string            `json:"method"`
+	Args    []json.RawMessage `json:"args"`
+	Out     []json.RawMessage `json:"out,omitempty"`
+	Results []json.RawMessage `json:"results"`
+}
+
+// ReplayFillerRecorder wraps a real implementation, and records the arguments and
+// results of every call, so that they can be replayed by a ReplayFiller. The
+// arguments are recorded as they were passed to the real implementation, and
+// the values of slices and pointers as they were after it returned.
+type ReplayFillerRecorder struct {
+	real  fixtures.Filler
+	calls []ReplayFillerRecordedCall
+	err   error
+	lock  sync.Mutex
+}
+
+// NewReplayFillerRecorder returns a recorder that forwards every call to real.
+func NewReplayFillerRecorder(real fixtures.Filler) *ReplayFillerRecorder {
+	return &ReplayFillerRecorder{real: real}
+}
+
+func (recorder *ReplayFillerRecorder) Count(arg1 *int) {
+	args, err := marshalReplayFillerValues("Count", []interface{}{arg1})
+	recorder.real.Count(arg1)
+	recorder.record("Count", args, err, []interface{}{arg1}, []interface{}{})
+}
+
+func (recorder *ReplayFillerRecorder) Read(arg1 []byte) (int, error) {
+	args, err := marshalReplayFillerValues("Read", []interface{}{arg1})
+	result1, result2 := recorder.real.Read(arg1)
+	recorder.record("Read", args, err, []interface{}{arg1}, []interface{}{result1, marshalReplayFillerError(result2)})
+	return result1, result2
+}
+
+// Save writes the recorded calls to the file at path, as JSON.
+func (recorder *ReplayFillerRecorder) Save(path string) error {
+	recorder.lock.Lock()
+	defer recorder.lock.Unlock()
+	if recorder.err != nil {
+		return recorder.err
+	}
+	calls := recorder.calls
+	if calls == nil {
+		calls = []ReplayFillerRecordedCall{}
+	}
+	b, err := json.MarshalIndent(calls, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(b, '\n'), 0644)
+}
+
+func (recorder *ReplayFillerRecorder) record(method string, args []json.RawMessage, err error, out []interface{}, results []interface{}) {
+	recorder.lock.Lock()
+	defer recorder.lock.Unlock()
+	if recorder.err != nil {
+		return
+	}
+	if err != nil {
+		recorder.err = err
+		return
+	}
+	call := ReplayFillerRecordedCall{Method: method, Args: args}
+	if out != nil {
+		call.Out, recorder.err = marshalReplayFillerValues(method, out)
+		if recorder.err != nil {
+			return
+		}
+	}
+	call.Results, recorder.err = marshalReplayFillerValues(method, results)
+	if recorder.err != nil {
+		return
+	}
+	recorder.calls = append(recorder.calls, call)
+}
+
+// ReplayFiller replays the calls recorded by a ReplayFillerRecorder. Each call
+// returns the results of the first recorded call of the same method with the
+// same arguments that has not been replayed yet, or else of the last recorded
+// call with the same arguments, and writes the recorded values of slices and
+// pointers back through them. Calls that were never recorded panic.
+type ReplayFiller struct {
+	calls    []ReplayFillerRecordedCall
+	replayed []bool
+	lock     sync.Mutex
+}
+
+// LoadReplayFiller returns a fake that replays the calls saved to the file at path
+// by a ReplayFillerRecorder.
+func LoadReplayFiller(path string) (*ReplayFiller, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var calls []ReplayFillerRecordedCall
+	if err := json.Unmarshal(b, &calls); err != nil {
+		return nil, fmt.Errorf("cannot load the recorded calls from %s: %v", path, err)
+	}
+	return &ReplayFiller{calls: calls, replayed: make([]bool, len(calls))}, nil
+}
+
+func (fake *ReplayFiller) Count(arg1 *int) {
+	call := fake.replay("Count", []interface{}{arg1}, 0)
+	fake.setArg(call, 0, arg1)
+}
+
+func (fake *ReplayFiller) Read(arg1 []byte) (int, error) {
+	call := fake.replay("Read", []interface{}{arg1}, 2)
+	var arg1Out []byte
+	fake.setArg(call, 0, &arg1Out)
+	copy(arg1, arg1Out)
+	var result1 int
+	fake.unmarshal(call, 0, &result1)
+	var result2 error
+	var result2Message *string
+	fake.unmarshal(call, 1, &result2Message)
+	if result2Message != nil {
+		result2 = fmt.Errorf("%s", *result2Message)
+	}
+	return result1, result2
+}
+
+func (fake *ReplayFiller) replay(method string, args []interface{}, results int) ReplayFillerRecordedCall {
+	raw, err := marshalReplayFillerValues(method, args)
+	if err != nil {
+		panic(err)
+	}
+	fake.lock.Lock()
+	defer fake.lock.Unlock()
+	last := -1
+	for i, call := range fake.calls {
+		if call.Method != method || len(call.Results) != results || !equalReplayFillerValues(call.Args, raw) {
+			continue
+		}
+		if !fake.replayed[i] {
+			fake.replayed[i] = true
+			return call
+		}
+		last = i
+	}
+	if last < 0 {
+		b, _ := json.Marshal(raw)
+		panic(fmt.Sprintf("ReplayFiller.%s: no call with arguments %s was recorded", method, b))
+	}
+	return fake.calls[last]
+}
+
+func (fake *ReplayFiller) setArg(call ReplayFillerRecordedCall, i int, arg interface{}) {
+	if i >= len(call.Out) {
+		return
+	}
+	if v := reflect.ValueOf(arg); v.Kind() != reflect.Ptr || v.IsNil() {
+		return
+	}
+	if err := json.Unmarshal(call.Out[i], arg); err != nil {
+		panic(fmt.Sprintf("ReplayFiller.%s: cannot replay argument %d: %v", call.Method, i+1, err))
+	}
+}
+
+func (fake *ReplayFiller) unmarshal(call ReplayFillerRecordedCall, i int, result interface{}) {
+	if err := json.Unmarshal(call.Results[i], result); err != nil {
+		panic(fmt.Sprintf("ReplayFiller.%s: cannot replay result %d: %v", call.Method, i+1, err))
+	}
+}
+
+func marshalReplayFillerValues(method string, values []interface{}) ([]json.RawMessage, error) {
+	raw := []json.RawMessage{}
+	for _, v := range values {
+		if v != nil {
+			switch reflect.TypeOf(v).Kind() {
+			case reflect.Func, reflect.Chan, reflect.UnsafePointer:
+				v = fmt.Sprintf("%T", v)
+			}
+		}
+		b, err := json.Marshal(v)
+		if err != nil {
+			return nil, fmt.Errorf("ReplayFiller.%s: cannot record %T: %v", method, v, err)
+		}
+		raw = append(raw, b)
+	}
+	return raw, nil
+}
+
+// marshalReplayFillerError records an error as its message, as the type of
+// the error is not known when it is replayed.
+func marshalReplayFillerError(err error) interface{} {
+	if err == nil {
+		return nil
+	}
+	return err.Error()
+}
+
+func equalReplayFillerValues(a []json.RawMessage, b []json.RawMessage) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		var x, y interface{}
+		if json.Unmarshal(a[i], &x) != nil || json.Unmarshal(b[i], &y) != nil || !reflect.DeepEqual(x, y) {
+			return false
+		}
+	}
+	return true
+}
+
+var _ fixtures.Filler = new(ReplayFiller)
+var _ fixtures.Filler = new(ReplayFillerRecorder)
@@ -0,0 +1,217 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package fixturesfakes
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"reflect"
+	"sync"
+
+	"github.com/maxbrunsfeld/counterfeiter/v6/fixtures"
+)
+
+// ReplayHasVarArgsRecordedCall is a call recorded by a ReplayHasVarArgsRecorder.
+type ReplayHasVarArgsRecordedCall struct {
+	Method  string            `json:"method"`
+	Args    []json.RawMessage `json:"args"`
+	Out     []json.RawMessage `json:"out,omitempty"`
+	Results []json.RawMessage `json:"results"`
+}
+
+// ReplayHasVarArgsRecorder wraps a real implementation, and records the arguments and
+// results of every call, so that they can be replayed by a ReplayHasVarArgs. The
+// arguments are recorded as they were passed to the real implementation, and
+// the values of slices and pointers as they were after it returned.
+type ReplayHasVarArgsRecorder struct {
+	real  fixtures.HasVarArgs
+	calls []ReplayHasVarArgsRecordedCall
+	err   error
+	lock  sync.Mutex
+}
+
+// NewReplayHasVarArgsRecorder returns a recorder that forwards every call to real.
+func NewReplayHasVarArgsRecorder(real fixtures.HasVarArgs) *ReplayHasVarArgsRecorder {
+	return &ReplayHasVarArgsRecorder{real: real}
+}
+
+func (recorder *ReplayHasVarArgsRecorder) DoMoreThings(arg1 int, arg2 int, arg3 ...string) int {
+	args, err := marshalReplayHasVarArgsValues("DoMoreThings", []interface{}{arg1, arg2, arg3})
+	result1 := recorder.real.DoMoreThings(arg1, arg2, arg3...)
+	recorder.record("DoMoreThings", args, err, nil, []interface{}{result1})
+	return result1
+}
+
+func (recorder *ReplayHasVarArgsRecorder) DoThings(arg1 int, arg2 ...string) int {
+	args, err := marshalReplayHasVarArgsValues("DoThings", []interface{}{arg1, arg2})
+	result1 := recorder.real.DoThings(arg1, arg2...)
+	recorder.record("DoThings", args, err, nil, []interface{}{result1})
+	return result1
+}
+
+// Save writes the recorded calls to the file at path, as JSON.
+func (recorder *ReplayHasVarArgsRecorder) Save(path string) error {
+	recorder.lock.Lock()
+	defer recorder.lock.Unlock()
+	if recorder.err != nil {
+		return recorder.err
+	}
+	calls := recorder.calls
+	if calls == nil {
+		calls = []ReplayHasVarArgsRecordedCall{}
+	}
+	b, err := json.MarshalIndent(calls, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(b, '\n'), 0644)
+}
+
+func (recorder *ReplayHasVarArgsRecorder) record(method string, args []json.RawMessage, err error, out []interface{}, results []interface{}) {
+	recorder.lock.Lock()
+	defer recorder.lock.Unlock()
+	if recorder.err != nil {
+		return
+	}
+	if err != nil {
+		recorder.err = err
+		return
+	}
+	call := ReplayHasVarArgsRecordedCall{Method: method, Args: args}
+	if out != nil {
+		call.Out, recorder.err = marshalReplayHasVarArgsValues(method, out)
+		if recorder.err != nil {
+			return
+		}
+	}
+	call.Results, recorder.err = marshalReplayHasVarArgsValues(method, results)
+	if recorder.err != nil {
+		return
+	}
+	recorder.calls = append(recorder.calls, call)
+}
+
+// ReplayHasVarArgs replays the calls recorded by a ReplayHasVarArgsRecorder. Each call
+// returns the results of the first recorded call of the same method with the
+// same arguments that has not been replayed yet, or else of the last recorded
+// call with the same arguments, and writes the recorded values of slices and
+// pointers back through them. Calls that were never recorded panic.
+type ReplayHasVarArgs struct {
+	calls    []ReplayHasVarArgsRecordedCall
+	replayed []bool
+	lock     sync.Mutex
+}
+
+// LoadReplayHasVarArgs returns a fake that replays the calls saved to the file at path
+// by a ReplayHasVarArgsRecorder.
+func LoadReplayHasVarArgs(path string) (*ReplayHasVarArgs, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var calls []ReplayHasVarArgsRecordedCall
+	if err := json.Unmarshal(b, &calls); err != nil {
+		return nil, fmt.Errorf("cannot load the recorded calls from %s: %v", path, err)
+	}
+	return &ReplayHasVarArgs{calls: calls, replayed: make([]bool, len(calls))}, nil
+}
+
+func (fake *ReplayHasVarArgs) DoMoreThings(arg1 int, arg2 int, arg3 ...string) int {
+	call := fake.replay("DoMoreThings", []interface{}{arg1, arg2, arg3}, 1)
+	var result1 int
+	fake.unmarshal(call, 0, &result1)
+	return result1
+}
+
+func (fake *ReplayHasVarArgs) DoThings(arg1 int, arg2 ...string) int {
+	call := fake.replay("DoThings", []interface{}{arg1, arg2}, 1)
+	var result1 int
+	fake.unmarshal(call, 0, &result1)
+	return result1
+}
+
+func (fake *ReplayHasVarArgs) replay(method string, args []interface{}, results int) ReplayHasVarArgsRecordedCall {
+	raw, err := marshalReplayHasVarArgsValues(method, args)
+	if err != nil {
+		panic(err)
+	}
+	fake.lock.Lock()
+	defer fake.lock.Unlock()
+	last := -1
+	for i, call := range fake.calls {
+		if call.Method != method || len(call.Results) != results || !equalReplayHasVarArgsValues(call.Args, raw) {
+			continue
+		}
+		if !fake.replayed[i] {
+			fake.replayed[i] = true
+			return call
+		}
+		last = i
+	}
+	if last < 0 {
+		b, _ := json.Marshal(raw)
+		panic(fmt.Sprintf("ReplayHasVarArgs.%s: no call with arguments %s was recorded", method, b))
+	}
+	return fake.calls[last]
+}
+
+func (fake *ReplayHasVarArgs) setArg(call ReplayHasVarArgsRecordedCall, i int, arg interface{}) {
+	if i >= len(call.Out) {
+		return
+	}
+	if v := reflect.ValueOf(arg); v.Kind() != reflect.Ptr || v.IsNil() {
+		return
+	}
+	if err := json.Unmarshal(call.Out[i], arg); err != nil {
+		panic(fmt.Sprintf("ReplayHasVarArgs.%s: cannot replay argument %d: %v", call.Method, i+1, err))
+	}
+}
+
+func (fake *ReplayHasVarArgs) unmarshal(call ReplayHasVarArgsRecordedCall, i int, result interface{}) {
+	if err := json.Unmarshal(call.Results[i], result); err != nil {
+		panic(fmt.Sprintf("ReplayHasVarArgs.%s: cannot replay result %d: %v", call.Method, i+1, err))
+	}
+}
+
+func marshalReplayHasVarArgsValues(method string, values []interface{}) ([]json.RawMessage, error) {
+	raw := []json.RawMessage{}
+	for _, v := range values {
+		if v != nil {
+			switch reflect.TypeOf(v).Kind() {
+			case reflect.Func, reflect.Chan, reflect.UnsafePointer:
+				v = fmt.Sprintf("%T", v)
+			}
+		}
+		b, err := json.Marshal(v)
+		if err != nil {
+			return nil, fmt.Errorf("ReplayHasVarArgs.%s: cannot record %T: %v", method, v, err)
+		}
+		raw = append(raw, b)
+	}
+	return raw, nil
+}
+
+// marshalReplayHasVarArgsError records an error as its message, as the type of
+// the error is not known when it is replayed.
+func marshalReplayHasVarArgsError(err error) interface{} {
+	if err == nil {
+		return nil
+	}
+	return err.Error()
+}
+
+func equalReplayHasVarArgsValues(a []json.RawMessage, b []json.RawMessage) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		var x, y interface{}
+		if json.Unmarshal(a[i], &x) != nil || json.Unmarshal(b[i], &y) != nil || !reflect.DeepEqual(x, y) {
+			return false
+		}
+	}
+	return true
+}
+
+var _ fixtures.HasVarArgs = new(ReplayHasVarArgs)
+var _ fixtures.HasVarArgs = new(ReplayHasVarArgsRecorder)
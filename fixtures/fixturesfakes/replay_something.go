@@ -0,0 +1,242 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package fixturesfakes
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"reflect"
+	"sync"
+
+	"github.com/maxbrunsfeld/counterfeiter/v6/fixtures"
+)
+
+// ReplaySomethingRecordedCall is a call recorded by a ReplaySomethingRecorder.
+type ReplaySomethingRecordedCall struct {
+	Method  string            `json:"method"`
+	Args    []json.RawMessage `json:"args"`
+	Out     []json.RawMessage `json:"out,omitempty"`
+	Results []json.RawMessage `json:"results"`
+}
+
+// ReplaySomethingRecorder wraps a real implementation, and records the arguments and
+// results of every call, so that they can be replayed by a ReplaySomething. The
+// arguments are recorded as they were passed to the real implementation, and
+// the values of slices and pointers as they were after it returned.
+type ReplaySomethingRecorder struct {
+	real  fixtures.Something
+	calls []ReplaySomethingRecordedCall
+	err   error
+	lock  sync.Mutex
+}
+
+// NewReplaySomethingRecorder returns a recorder that forwards every call to real.
+func NewReplaySomethingRecorder(real fixtures.Something) *ReplaySomethingRecorder {
+	return &ReplaySomethingRecorder{real: real}
+}
+
+func (recorder *ReplaySomethingRecorder) DoASlice(arg1 []byte) {
+	args, err := marshalReplaySomethingValues("DoASlice", []interface{}{arg1})
+	recorder.real.DoASlice(arg1)
+	recorder.record("DoASlice", args, err, []interface{}{arg1}, []interface{}{})
+}
+
+func (recorder *ReplaySomethingRecorder) DoAnArray(arg1 [4]byte) {
+	args, err := marshalReplaySomethingValues("DoAnArray", []interface{}{arg1})
+	recorder.real.DoAnArray(arg1)
+	recorder.record("DoAnArray", args, err, nil, []interface{}{})
+}
+
+func (recorder *ReplaySomethingRecorder) DoNothing() {
+	args, err := marshalReplaySomethingValues("DoNothing", []interface{}{})
+	recorder.real.DoNothing()
+	recorder.record("DoNothing", args, err, nil, []interface{}{})
+}
+
+func (recorder *ReplaySomethingRecorder) DoThings(arg1 string, arg2 uint64) (int, error) {
+	args, err := marshalReplaySomethingValues("DoThings", []interface{}{arg1, arg2})
+	result1, result2 := recorder.real.DoThings(arg1, arg2)
+	recorder.record("DoThings", args, err, nil, []interface{}{result1, marshalReplaySomethingError(result2)})
+	return result1, result2
+}
+
+// Save writes the recorded calls to the file at path, as JSON.
+func (recorder *ReplaySomethingRecorder) Save(path string) error {
+	recorder.lock.Lock()
+	defer recorder.lock.Unlock()
+	if recorder.err != nil {
+		return recorder.err
+	}
+	calls := recorder.calls
+	if calls == nil {
+		calls = []ReplaySomethingRecordedCall{}
+	}
+	b, err := json.MarshalIndent(calls, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(b, '\n'), 0644)
+}
+
+func (recorder *ReplaySomethingRecorder) record(method string, args []json.RawMessage, err error, out []interface{}, results []interface{}) {
+	recorder.lock.Lock()
+	defer recorder.lock.Unlock()
+	if recorder.err != nil {
+		return
+	}
+	if err != nil {
+		recorder.err = err
+		return
+	}
+	call := ReplaySomethingRecordedCall{Method: method, Args: args}
+	if out != nil {
+		call.Out, recorder.err = marshalReplaySomethingValues(method, out)
+		if recorder.err != nil {
+			return
+		}
+	}
+	call.Results, recorder.err = marshalReplaySomethingValues(method, results)
+	if recorder.err != nil {
+		return
+	}
+	recorder.calls = append(recorder.calls, call)
+}
+
+// ReplaySomething replays the calls recorded by a ReplaySomethingRecorder. Each call
+// returns the results of the first recorded call of the same method with the
+// same arguments that has not been replayed yet, or else of the last recorded
+// call with the same arguments, and writes the recorded values of slices and
+// pointers back through them. Calls that were never recorded panic.
+type ReplaySomething struct {
+	calls    []ReplaySomethingRecordedCall
+	replayed []bool
+	lock     sync.Mutex
+}
+
+// LoadReplaySomething returns a fake that replays the calls saved to the file at path
+// by a ReplaySomethingRecorder.
+func LoadReplaySomething(path string) (*ReplaySomething, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var calls []ReplaySomethingRecordedCall
+	if err := json.Unmarshal(b, &calls); err != nil {
+		return nil, fmt.Errorf("cannot load the recorded calls from %s: %v", path, err)
+	}
+	return &ReplaySomething{calls: calls, replayed: make([]bool, len(calls))}, nil
+}
+
+func (fake *ReplaySomething) DoASlice(arg1 []byte) {
+	call := fake.replay("DoASlice", []interface{}{arg1}, 0)
+	var arg1Out []byte
+	fake.setArg(call, 0, &arg1Out)
+	copy(arg1, arg1Out)
+}
+
+func (fake *ReplaySomething) DoAnArray(arg1 [4]byte) {
+	fake.replay("DoAnArray", []interface{}{arg1}, 0)
+}
+
+func (fake *ReplaySomething) DoNothing() {
+	fake.replay("DoNothing", []interface{}{}, 0)
+}
+
+func (fake *ReplaySomething) DoThings(arg1 string, arg2 uint64) (int, error) {
+	call := fake.replay("DoThings", []interface{}{arg1, arg2}, 2)
+	var result1 int
+	fake.unmarshal(call, 0, &result1)
+	var result2 error
+	var result2Message *string
+	fake.unmarshal(call, 1, &result2Message)
+	if result2Message != nil {
+		result2 = fmt.Errorf("%s", *result2Message)
+	}
+	return result1, result2
+}
+
+func (fake *ReplaySomething) replay(method string, args []interface{}, results int) ReplaySomethingRecordedCall {
+	raw, err := marshalReplaySomethingValues(method, args)
+	if err != nil {
+		panic(err)
+	}
+	fake.lock.Lock()
+	defer fake.lock.Unlock()
+	last := -1
+	for i, call := range fake.calls {
+		if call.Method != method || len(call.Results) != results || !equalReplaySomethingValues(call.Args, raw) {
+			continue
+		}
+		if !fake.replayed[i] {
+			fake.replayed[i] = true
+			return call
+		}
+		last = i
+	}
+	if last < 0 {
+		b, _ := json.Marshal(raw)
+		panic(fmt.Sprintf("ReplaySomething.%s: no call with arguments %s was recorded", method, b))
+	}
+	return fake.calls[last]
+}
+
+func (fake *ReplaySomething) setArg(call ReplaySomethingRecordedCall, i int, arg interface{}) {
+	if i >= len(call.Out) {
+		return
+	}
+	if v := reflect.ValueOf(arg); v.Kind() != reflect.Ptr || v.IsNil() {
+		return
+	}
+	if err := json.Unmarshal(call.Out[i], arg); err != nil {
+		panic(fmt.Sprintf("ReplaySomething.%s: cannot replay argument %d: %v", call.Method, i+1, err))
+	}
+}
+
+func (fake *ReplaySomething) unmarshal(call ReplaySomethingRecordedCall, i int, result interface{}) {
+	if err := json.Unmarshal(call.Results[i], result); err != nil {
+		panic(fmt.Sprintf("ReplaySomething.%s: cannot replay result %d: %v", call.Method, i+1, err))
+	}
+}
+
+func marshalReplaySomethingValues(method string, values []interface{}) ([]json.RawMessage, error) {
+	raw := []json.RawMessage{}
+	for _, v := range values {
+		if v != nil {
+			switch reflect.TypeOf(v).Kind() {
+			case reflect.Func, reflect.Chan, reflect.UnsafePointer:
+				v = fmt.Sprintf("%T", v)
+			}
+		}
+		b, err := json.Marshal(v)
+		if err != nil {
+			return nil, fmt.Errorf("ReplaySomething.%s: cannot record %T: %v", method, v, err)
+		}
+		raw = append(raw, b)
+	}
+	return raw, nil
+}
+
+// marshalReplaySomethingError records an error as its message, as the type of
+// the error is not known when it is replayed.
+func marshalReplaySomethingError(err error) interface{} {
+	if err == nil {
+		return nil
+	}
+	return err.Error()
+}
+
+func equalReplaySomethingValues(a []json.RawMessage, b []json.RawMessage) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		var x, y interface{}
+		if json.Unmarshal(a[i], &x) != nil || json.Unmarshal(b[i], &y) != nil || !reflect.DeepEqual(x, y) {
+			return false
+		}
+	}
+	return true
+}
+
+var _ fixtures.Something = new(ReplaySomething)
+var _ fixtures.Something = new(ReplaySomethingRecorder)
@@ -0,0 +1,42 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package fixturesfakes
+
+import (
+	"sync"
+
+	"github.com/maxbrunsfeld/counterfeiter/v6/fixtures"
+)
+
+type StrictFuncsReusesArgTypes struct {
+	DoThingsFunc func(arg1 string, arg2 string)
+	calls        struct {
+		DoThings []StrictFuncsReusesArgTypesDoThingsCall
+	}
+	lock sync.RWMutex
+}
+
+type StrictFuncsReusesArgTypesDoThingsCall struct {
+	X string
+	Y string
+}
+
+func (fake *StrictFuncsReusesArgTypes) DoThings(arg1 string, arg2 string) {
+	fake.lock.Lock()
+	fake.calls.DoThings = append(fake.calls.DoThings, StrictFuncsReusesArgTypesDoThingsCall{arg1, arg2})
+	fn := fake.DoThingsFunc
+	fake.lock.Unlock()
+	if fn == nil {
+		return
+	}
+	fn(arg1, arg2)
+}
+
+func (fake *StrictFuncsReusesArgTypes) DoThingsCalls() []StrictFuncsReusesArgTypesDoThingsCall {
+	fake.lock.RLock()
+	defer fake.lock.RUnlock()
+	calls := make([]StrictFuncsReusesArgTypesDoThingsCall, len(fake.calls.DoThings))
+	copy(calls, fake.calls.DoThings)
+	return calls
+}
+
+var _ fixtures.ReusesArgTypes = new(StrictFuncsReusesArgTypes)
@@ -0,0 +1,120 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package fixturesfakes
+
+import (
+	"sync"
+
+	"github.com/maxbrunsfeld/counterfeiter/v6/fixtures"
+)
+
+type StrictFuncsSomething struct {
+	DoASliceFunc  func(arg1 []byte)
+	DoAnArrayFunc func(arg1 [4]byte)
+	DoNothingFunc func()
+	DoThingsFunc  func(arg1 string, arg2 uint64) (int, error)
+	calls         struct {
+		DoASlice  []StrictFuncsSomethingDoASliceCall
+		DoAnArray []StrictFuncsSomethingDoAnArrayCall
+		DoNothing []StrictFuncsSomethingDoNothingCall
+		DoThings  []StrictFuncsSomethingDoThingsCall
+	}
+	lock sync.RWMutex
+}
+
+type StrictFuncsSomethingDoASliceCall struct {
+	Arg1 []byte
+}
+
+func (fake *StrictFuncsSomething) DoASlice(arg1 []byte) {
+	var arg1Copy []byte
+	if arg1 != nil {
+		arg1Copy = make([]byte, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.lock.Lock()
+	fake.calls.DoASlice = append(fake.calls.DoASlice, StrictFuncsSomethingDoASliceCall{arg1Copy})
+	fn := fake.DoASliceFunc
+	fake.lock.Unlock()
+	if fn == nil {
+		return
+	}
+	fn(arg1)
+}
+
+func (fake *StrictFuncsSomething) DoASliceCalls() []StrictFuncsSomethingDoASliceCall {
+	fake.lock.RLock()
+	defer fake.lock.RUnlock()
+	calls := make([]StrictFuncsSomethingDoASliceCall, len(fake.calls.DoASlice))
+	copy(calls, fake.calls.DoASlice)
+	return calls
+}
+
+type StrictFuncsSomethingDoAnArrayCall struct {
+	Arg1 [4]byte
+}
+
+func (fake *StrictFuncsSomething) DoAnArray(arg1 [4]byte) {
+	fake.lock.Lock()
+	fake.calls.DoAnArray = append(fake.calls.DoAnArray, StrictFuncsSomethingDoAnArrayCall{arg1})
+	fn := fake.DoAnArrayFunc
+	fake.lock.Unlock()
+	if fn == nil {
+		return
+	}
+	fn(arg1)
+}
+
+func (fake *StrictFuncsSomething) DoAnArrayCalls() []StrictFuncsSomethingDoAnArrayCall {
+	fake.lock.RLock()
+	defer fake.lock.RUnlock()
+	calls := make([]StrictFuncsSomethingDoAnArrayCall, len(fake.calls.DoAnArray))
+	copy(calls, fake.calls.DoAnArray)
+	return calls
+}
+
+type StrictFuncsSomethingDoNothingCall struct{}
+
+func (fake *StrictFuncsSomething) DoNothing() {
+	fake.lock.Lock()
+	fake.calls.DoNothing = append(fake.calls.DoNothing, StrictFuncsSomethingDoNothingCall{})
+	fn := fake.DoNothingFunc
+	fake.lock.Unlock()
+	if fn == nil {
+		return
+	}
+	fn()
+}
+
+func (fake *StrictFuncsSomething) DoNothingCalls() []StrictFuncsSomethingDoNothingCall {
+	fake.lock.RLock()
+	defer fake.lock.RUnlock()
+	calls := make([]StrictFuncsSomethingDoNothingCall, len(fake.calls.DoNothing))
+	copy(calls, fake.calls.DoNothing)
+	return calls
+}
+
+type StrictFuncsSomethingDoThingsCall struct {
+	Arg1 string
+	Arg2 uint64
+}
+
+func (fake *StrictFuncsSomething) DoThings(arg1 string, arg2 uint64) (int, error) {
+	fake.lock.Lock()
+	fake.calls.DoThings = append(fake.calls.DoThings, StrictFuncsSomethingDoThingsCall{arg1, arg2})
+	fn := fake.DoThingsFunc
+	fake.lock.Unlock()
+	if fn == nil {
+		panic("StrictFuncsSomething.DoThings: DoThingsFunc is nil, but DoThings was called")
+	}
+	return fn(arg1, arg2)
+}
+
+func (fake *StrictFuncsSomething) DoThingsCalls() []StrictFuncsSomethingDoThingsCall {
+	fake.lock.RLock()
+	defer fake.lock.RUnlock()
+	calls := make([]StrictFuncsSomethingDoThingsCall, len(fake.calls.DoThings))
+	copy(calls, fake.calls.DoThings)
+	return calls
+}
+
+var _ fixtures.Something = new(StrictFuncsSomething)
@@ -0,0 +1,6 @@
+package fixtures
+
+//counterfeiter:generate -style funcs -fake-name FuncsSomething . Something
+//counterfeiter:generate -style funcs -fake-name FuncsHasVarArgs . HasVarArgs
+//counterfeiter:generate -style funcs -strict -fake-name StrictFuncsSomething . Something
+//counterfeiter:generate -style funcs -strict -fake-name StrictFuncsReusesArgTypes . ReusesArgTypes
@@ -2,7 +2,10 @@
 package genericinterfacefakes
 
 import (
+	"context"
+	"reflect"
 	"sync"
+	"sync/atomic"
 
 	"github.com/maxbrunsfeld/counterfeiter/v6/fixtures/genericinterface"
 )
@@ -27,6 +30,10 @@ type FakeGenericInterfaceAny[T any] struct {
 	takeAndReturnTArgsForCall []struct {
 		arg1 T
 	}
+	takeAndReturnTWhen []struct {
+		matcher func(T) bool
+		stub    func(T) T
+	}
 	takeAndReturnTReturns struct {
 		result1 T
 	}
@@ -38,7 +45,24 @@ type FakeGenericInterfaceAny[T any] struct {
 	takeTArgsForCall []struct {
 		arg1 T
 	}
-	invocations      map[string][][]interface{}
+	takeTWhen []struct {
+		matcher func(T) bool
+		stub    func(T)
+	}
+	ArgsComparer       func(expected interface{}, actual interface{}) bool
+	invocations        map[string][][]interface{}
+	orderedInvocations []struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}
+	sequencer          *atomic.Uint64
+	invocationsChanged chan struct{}
+	callsSubscribers   map[string][]struct {
+		publish func(args []interface{})
+		cancel  context.CancelFunc
+		done    <-chan struct{}
+	}
 	invocationsMutex sync.RWMutex
 }
 
@@ -60,12 +84,38 @@ func (fake *FakeGenericInterfaceAny[T]) DoSomethingCallCount() int {
 	return len(fake.doSomethingArgsForCall)
 }
 
+func (fake *FakeGenericInterfaceAny[T]) WaitForDoSomethingCalls(ctx context.Context, n int) error {
+	return fake.waitForCalls(ctx, fake.DoSomethingCallCount, n)
+}
+
+func (fake *FakeGenericInterfaceAny[T]) DoSomethingCallsChan(ctx context.Context) <-chan []interface{} {
+	return fake.callsChan(ctx, "DoSomething")
+}
+
 func (fake *FakeGenericInterfaceAny[T]) DoSomethingCalls(stub func()) {
 	fake.doSomethingMutex.Lock()
 	defer fake.doSomethingMutex.Unlock()
 	fake.DoSomethingStub = stub
 }
 
+func (fake *FakeGenericInterfaceAny[T]) ResetDoSomething() {
+	fake.ResetDoSomethingCalls()
+	fake.ResetDoSomethingStubs()
+}
+
+func (fake *FakeGenericInterfaceAny[T]) ResetDoSomethingCalls() {
+	fake.doSomethingMutex.Lock()
+	defer fake.doSomethingMutex.Unlock()
+	fake.doSomethingArgsForCall = nil
+	fake.forgetInvocations("DoSomething")
+}
+
+func (fake *FakeGenericInterfaceAny[T]) ResetDoSomethingStubs() {
+	fake.doSomethingMutex.Lock()
+	defer fake.doSomethingMutex.Unlock()
+	fake.DoSomethingStub = nil
+}
+
 func (fake *FakeGenericInterfaceAny[T]) ReturnT() T {
 	fake.returnTMutex.Lock()
 	ret, specificReturn := fake.returnTReturnsOnCall[len(fake.returnTArgsForCall)]
@@ -90,6 +140,14 @@ func (fake *FakeGenericInterfaceAny[T]) ReturnTCallCount() int {
 	return len(fake.returnTArgsForCall)
 }
 
+func (fake *FakeGenericInterfaceAny[T]) WaitForReturnTCalls(ctx context.Context, n int) error {
+	return fake.waitForCalls(ctx, fake.ReturnTCallCount, n)
+}
+
+func (fake *FakeGenericInterfaceAny[T]) ReturnTCallsChan(ctx context.Context) <-chan []interface{} {
+	return fake.callsChan(ctx, "ReturnT")
+}
+
 func (fake *FakeGenericInterfaceAny[T]) ReturnTCalls(stub func() T) {
 	fake.returnTMutex.Lock()
 	defer fake.returnTMutex.Unlock()
@@ -119,6 +177,32 @@ func (fake *FakeGenericInterfaceAny[T]) ReturnTReturnsOnCall(i int, result1 T) {
 	}{result1}
 }
 
+func (fake *FakeGenericInterfaceAny[T]) ResetReturnT() {
+	fake.ResetReturnTCalls()
+	fake.ResetReturnTStubs()
+}
+
+func (fake *FakeGenericInterfaceAny[T]) ResetReturnTCalls() {
+	fake.returnTMutex.Lock()
+	defer fake.returnTMutex.Unlock()
+	fake.returnTArgsForCall = nil
+	fake.forgetInvocations("ReturnT")
+}
+
+func (fake *FakeGenericInterfaceAny[T]) ResetReturnTStubs() {
+	fake.returnTMutex.Lock()
+	defer fake.returnTMutex.Unlock()
+	fake.ReturnTStub = nil
+	fake.returnTReturns = struct {
+		result1 T
+	}{}
+	fake.returnTReturnsOnCall = nil
+}
+
+type FakeGenericInterfaceAnyTakeAndReturnTCall[T any] struct {
+	Arg1 T
+}
+
 func (fake *FakeGenericInterfaceAny[T]) TakeAndReturnT(arg1 T) T {
 	fake.takeAndReturnTMutex.Lock()
 	ret, specificReturn := fake.takeAndReturnTReturnsOnCall[len(fake.takeAndReturnTArgsForCall)]
@@ -126,9 +210,15 @@ func (fake *FakeGenericInterfaceAny[T]) TakeAndReturnT(arg1 T) T {
 		arg1 T
 	}{arg1})
 	stub := fake.TakeAndReturnTStub
+	whens := fake.takeAndReturnTWhen
 	fakeReturns := fake.takeAndReturnTReturns
 	fake.recordInvocation("TakeAndReturnT", []interface{}{arg1})
 	fake.takeAndReturnTMutex.Unlock()
+	for i := len(whens) - 1; i >= 0; i-- {
+		if whens[i].matcher(arg1) {
+			return whens[i].stub(arg1)
+		}
+	}
 	if stub != nil {
 		return stub(arg1)
 	}
@@ -144,12 +234,31 @@ func (fake *FakeGenericInterfaceAny[T]) TakeAndReturnTCallCount() int {
 	return len(fake.takeAndReturnTArgsForCall)
 }
 
+func (fake *FakeGenericInterfaceAny[T]) WaitForTakeAndReturnTCalls(ctx context.Context, n int) error {
+	return fake.waitForCalls(ctx, fake.TakeAndReturnTCallCount, n)
+}
+
+func (fake *FakeGenericInterfaceAny[T]) TakeAndReturnTCallsChan(ctx context.Context) <-chan []interface{} {
+	return fake.callsChan(ctx, "TakeAndReturnT")
+}
+
 func (fake *FakeGenericInterfaceAny[T]) TakeAndReturnTCalls(stub func(T) T) {
 	fake.takeAndReturnTMutex.Lock()
 	defer fake.takeAndReturnTMutex.Unlock()
 	fake.TakeAndReturnTStub = stub
 }
 
+// TakeAndReturnTCallsWhen calls the stub for the calls whose arguments match.
+// Matchers are checked before the stub, and the matcher registered last wins.
+func (fake *FakeGenericInterfaceAny[T]) TakeAndReturnTCallsWhen(matcher func(T) bool, stub func(T) T) {
+	fake.takeAndReturnTMutex.Lock()
+	defer fake.takeAndReturnTMutex.Unlock()
+	fake.takeAndReturnTWhen = append(fake.takeAndReturnTWhen, struct {
+		matcher func(T) bool
+		stub    func(T) T
+	}{matcher, stub})
+}
+
 func (fake *FakeGenericInterfaceAny[T]) TakeAndReturnTArgsForCall(i int) T {
 	fake.takeAndReturnTMutex.RLock()
 	defer fake.takeAndReturnTMutex.RUnlock()
@@ -157,6 +266,16 @@ func (fake *FakeGenericInterfaceAny[T]) TakeAndReturnTArgsForCall(i int) T {
 	return argsForCall.arg1
 }
 
+func (fake *FakeGenericInterfaceAny[T]) TakeAndReturnTCallHistory() []FakeGenericInterfaceAnyTakeAndReturnTCall[T] {
+	fake.takeAndReturnTMutex.RLock()
+	defer fake.takeAndReturnTMutex.RUnlock()
+	history := make([]FakeGenericInterfaceAnyTakeAndReturnTCall[T], len(fake.takeAndReturnTArgsForCall))
+	for i, argsForCall := range fake.takeAndReturnTArgsForCall {
+		history[i] = FakeGenericInterfaceAnyTakeAndReturnTCall[T]{argsForCall.arg1}
+	}
+	return history
+}
+
 func (fake *FakeGenericInterfaceAny[T]) TakeAndReturnTReturns(result1 T) {
 	fake.takeAndReturnTMutex.Lock()
 	defer fake.takeAndReturnTMutex.Unlock()
@@ -180,14 +299,80 @@ func (fake *FakeGenericInterfaceAny[T]) TakeAndReturnTReturnsOnCall(i int, resul
 	}{result1}
 }
 
+// TakeAndReturnTReturnsForArgs returns the values for the calls with the given
+// arguments. Like TakeAndReturnTCallsWhen, it is checked before the stub, and the
+// arguments or matcher registered last win.
+func (fake *FakeGenericInterfaceAny[T]) TakeAndReturnTReturnsForArgs(arg1 T) func(T) {
+	args := []interface{}{arg1}
+	return func(result1 T) {
+		fake.takeAndReturnTMutex.Lock()
+		defer fake.takeAndReturnTMutex.Unlock()
+		fake.TakeAndReturnTStub = nil
+		fake.takeAndReturnTWhen = append(fake.takeAndReturnTWhen, struct {
+			matcher func(T) bool
+			stub    func(T) T
+		}{
+			func(arg1 T) bool {
+				return fake.argsMatch(args, []interface{}{arg1})
+			},
+			func(T) T {
+				return result1
+			},
+		})
+	}
+}
+
+// TakeAndReturnTReturnsWhen returns the values for the calls whose arguments
+// match. Like TakeAndReturnTCallsWhen, it is checked before the stub, and the
+// matcher registered last wins.
+func (fake *FakeGenericInterfaceAny[T]) TakeAndReturnTReturnsWhen(matcher func(T) bool, result1 T) {
+	fake.TakeAndReturnTCallsWhen(matcher, func(T) T {
+		return result1
+	})
+}
+
+func (fake *FakeGenericInterfaceAny[T]) ResetTakeAndReturnT() {
+	fake.ResetTakeAndReturnTCalls()
+	fake.ResetTakeAndReturnTStubs()
+}
+
+func (fake *FakeGenericInterfaceAny[T]) ResetTakeAndReturnTCalls() {
+	fake.takeAndReturnTMutex.Lock()
+	defer fake.takeAndReturnTMutex.Unlock()
+	fake.takeAndReturnTArgsForCall = nil
+	fake.forgetInvocations("TakeAndReturnT")
+}
+
+func (fake *FakeGenericInterfaceAny[T]) ResetTakeAndReturnTStubs() {
+	fake.takeAndReturnTMutex.Lock()
+	defer fake.takeAndReturnTMutex.Unlock()
+	fake.TakeAndReturnTStub = nil
+	fake.takeAndReturnTWhen = nil
+	fake.takeAndReturnTReturns = struct {
+		result1 T
+	}{}
+	fake.takeAndReturnTReturnsOnCall = nil
+}
+
+type FakeGenericInterfaceAnyTakeTCall[T any] struct {
+	Arg1 T
+}
+
 func (fake *FakeGenericInterfaceAny[T]) TakeT(arg1 T) {
 	fake.takeTMutex.Lock()
 	fake.takeTArgsForCall = append(fake.takeTArgsForCall, struct {
 		arg1 T
 	}{arg1})
 	stub := fake.TakeTStub
+	whens := fake.takeTWhen
 	fake.recordInvocation("TakeT", []interface{}{arg1})
 	fake.takeTMutex.Unlock()
+	for i := len(whens) - 1; i >= 0; i-- {
+		if whens[i].matcher(arg1) {
+			whens[i].stub(arg1)
+			return
+		}
+	}
 	if stub != nil {
 		fake.TakeTStub(arg1)
 	}
@@ -199,12 +384,31 @@ func (fake *FakeGenericInterfaceAny[T]) TakeTCallCount() int {
 	return len(fake.takeTArgsForCall)
 }
 
+func (fake *FakeGenericInterfaceAny[T]) WaitForTakeTCalls(ctx context.Context, n int) error {
+	return fake.waitForCalls(ctx, fake.TakeTCallCount, n)
+}
+
+func (fake *FakeGenericInterfaceAny[T]) TakeTCallsChan(ctx context.Context) <-chan []interface{} {
+	return fake.callsChan(ctx, "TakeT")
+}
+
 func (fake *FakeGenericInterfaceAny[T]) TakeTCalls(stub func(T)) {
 	fake.takeTMutex.Lock()
 	defer fake.takeTMutex.Unlock()
 	fake.TakeTStub = stub
 }
 
+// TakeTCallsWhen calls the stub for the calls whose arguments match.
+// Matchers are checked before the stub, and the matcher registered last wins.
+func (fake *FakeGenericInterfaceAny[T]) TakeTCallsWhen(matcher func(T) bool, stub func(T)) {
+	fake.takeTMutex.Lock()
+	defer fake.takeTMutex.Unlock()
+	fake.takeTWhen = append(fake.takeTWhen, struct {
+		matcher func(T) bool
+		stub    func(T)
+	}{matcher, stub})
+}
+
 func (fake *FakeGenericInterfaceAny[T]) TakeTArgsForCall(i int) T {
 	fake.takeTMutex.RLock()
 	defer fake.takeTMutex.RUnlock()
@@ -212,6 +416,54 @@ func (fake *FakeGenericInterfaceAny[T]) TakeTArgsForCall(i int) T {
 	return argsForCall.arg1
 }
 
+func (fake *FakeGenericInterfaceAny[T]) TakeTCallHistory() []FakeGenericInterfaceAnyTakeTCall[T] {
+	fake.takeTMutex.RLock()
+	defer fake.takeTMutex.RUnlock()
+	history := make([]FakeGenericInterfaceAnyTakeTCall[T], len(fake.takeTArgsForCall))
+	for i, argsForCall := range fake.takeTArgsForCall {
+		history[i] = FakeGenericInterfaceAnyTakeTCall[T]{argsForCall.arg1}
+	}
+	return history
+}
+
+func (fake *FakeGenericInterfaceAny[T]) ResetTakeT() {
+	fake.ResetTakeTCalls()
+	fake.ResetTakeTStubs()
+}
+
+func (fake *FakeGenericInterfaceAny[T]) ResetTakeTCalls() {
+	fake.takeTMutex.Lock()
+	defer fake.takeTMutex.Unlock()
+	fake.takeTArgsForCall = nil
+	fake.forgetInvocations("TakeT")
+}
+
+func (fake *FakeGenericInterfaceAny[T]) ResetTakeTStubs() {
+	fake.takeTMutex.Lock()
+	defer fake.takeTMutex.Unlock()
+	fake.TakeTStub = nil
+	fake.takeTWhen = nil
+}
+
+func (fake *FakeGenericInterfaceAny[T]) Reset() {
+	fake.ResetCalls()
+	fake.ResetStubs()
+}
+
+func (fake *FakeGenericInterfaceAny[T]) ResetCalls() {
+	fake.ResetDoSomethingCalls()
+	fake.ResetReturnTCalls()
+	fake.ResetTakeAndReturnTCalls()
+	fake.ResetTakeTCalls()
+}
+
+func (fake *FakeGenericInterfaceAny[T]) ResetStubs() {
+	fake.ResetDoSomethingStubs()
+	fake.ResetReturnTStubs()
+	fake.ResetTakeAndReturnTStubs()
+	fake.ResetTakeTStubs()
+}
+
 func (fake *FakeGenericInterfaceAny[T]) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -222,6 +474,109 @@ func (fake *FakeGenericInterfaceAny[T]) Invocations() map[string][][]interface{}
 	return copiedInvocations
 }
 
+func (fake *FakeGenericInterfaceAny[T]) argsMatch(expected []interface{}, actual []interface{}) bool {
+	if len(expected) != len(actual) {
+		return false
+	}
+	for i := range expected {
+		if fake.ArgsComparer != nil && !fake.ArgsComparer(expected[i], actual[i]) || fake.ArgsComparer == nil && !reflect.DeepEqual(expected[i], actual[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (fake *FakeGenericInterfaceAny[T]) OrderedInvocations() []struct {
+	Seq    uint64
+	Method string
+	Args   []interface{}
+} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	return append([]struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}{}, fake.orderedInvocations...)
+}
+
+func (fake *FakeGenericInterfaceAny[T]) SetSequencer(sequencer *atomic.Uint64) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	fake.sequencer = sequencer
+}
+
+func (fake *FakeGenericInterfaceAny[T]) waitForCalls(ctx context.Context, callCount func() int, n int) error {
+	for {
+		fake.invocationsMutex.Lock()
+		if fake.invocationsChanged == nil {
+			fake.invocationsChanged = make(chan struct{})
+		}
+		changed := fake.invocationsChanged
+		fake.invocationsMutex.Unlock()
+		if callCount() >= n {
+			return nil
+		}
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (fake *FakeGenericInterfaceAny[T]) callsChan(ctx context.Context, key string) <-chan []interface{} {
+	ctx, cancel := context.WithCancel(ctx)
+	calls := make(chan []interface{})
+	ready := make(chan struct{}, 1)
+	var pendingMutex sync.Mutex
+	var pending [][]interface{}
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.callsSubscribers == nil {
+		fake.callsSubscribers = map[string][]struct {
+			publish func(args []interface{})
+			cancel  context.CancelFunc
+			done    <-chan struct{}
+		}{}
+	}
+	fake.callsSubscribers[key] = append(fake.callsSubscribers[key], struct {
+		publish func(args []interface{})
+		cancel  context.CancelFunc
+		done    <-chan struct{}
+	}{func(args []interface{}) {
+		pendingMutex.Lock()
+		pending = append(pending, args)
+		pendingMutex.Unlock()
+		select {
+		case ready <- struct{}{}:
+		default:
+		}
+	}, cancel, ctx.Done()})
+	go func() {
+		defer close(calls)
+		for {
+			select {
+			case <-ready:
+			case <-ctx.Done():
+				return
+			}
+			pendingMutex.Lock()
+			queued := pending
+			pending = nil
+			pendingMutex.Unlock()
+			for _, args := range queued {
+				select {
+				case calls <- args:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return calls
+}
+
 func (fake *FakeGenericInterfaceAny[T]) recordInvocation(key string, args []interface{}) {
 	fake.invocationsMutex.Lock()
 	defer fake.invocationsMutex.Unlock()
@@ -232,6 +587,54 @@ func (fake *FakeGenericInterfaceAny[T]) recordInvocation(key string, args []inte
 		fake.invocations[key] = [][]interface{}{}
 	}
 	fake.invocations[key] = append(fake.invocations[key], args)
+	if fake.sequencer == nil {
+		fake.sequencer = new(atomic.Uint64)
+	}
+	fake.orderedInvocations = append(fake.orderedInvocations, struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}{fake.sequencer.Add(1), key, args})
+	if fake.invocationsChanged != nil {
+		close(fake.invocationsChanged)
+		fake.invocationsChanged = nil
+	}
+	subscribers := fake.callsSubscribers[key][:0]
+	for _, subscriber := range fake.callsSubscribers[key] {
+		select {
+		case <-subscriber.done:
+			continue
+		default:
+		}
+		subscriber.publish(args)
+		subscribers = append(subscribers, subscriber)
+	}
+	if len(subscribers) > 0 {
+		fake.callsSubscribers[key] = subscribers
+	} else {
+		delete(fake.callsSubscribers, key)
+	}
+}
+
+func (fake *FakeGenericInterfaceAny[T]) forgetInvocations(key string) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	delete(fake.invocations, key)
+	for _, subscriber := range fake.callsSubscribers[key] {
+		subscriber.cancel()
+	}
+	delete(fake.callsSubscribers, key)
+	var orderedInvocations []struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}
+	for _, invocation := range fake.orderedInvocations {
+		if invocation.Method != key {
+			orderedInvocations = append(orderedInvocations, invocation)
+		}
+	}
+	fake.orderedInvocations = orderedInvocations
 }
 
 var _ genericinterface.GenericInterfaceAny[any] = new(FakeGenericInterfaceAny[any])
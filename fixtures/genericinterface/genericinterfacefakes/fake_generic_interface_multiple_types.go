@@ -2,7 +2,10 @@
 package genericinterfacefakes
 
 import (
+	"context"
+	"reflect"
 	"sync"
+	"sync/atomic"
 
 	"github.com/maxbrunsfeld/counterfeiter/v6/fixtures/genericinterface"
 )
@@ -49,6 +52,10 @@ type FakeGenericInterfaceMultipleTypes[T genericinterface.CustomTypeT, U generic
 	takeAndReturnTArgsForCall []struct {
 		arg1 T
 	}
+	takeAndReturnTWhen []struct {
+		matcher func(T) bool
+		stub    func(T) T
+	}
 	takeAndReturnTReturns struct {
 		result1 T
 	}
@@ -61,6 +68,10 @@ type FakeGenericInterfaceMultipleTypes[T genericinterface.CustomTypeT, U generic
 		arg1 T
 		arg2 U
 	}
+	takeAndReturnTAndUWhen []struct {
+		matcher func(T, U) bool
+		stub    func(T, U) (T, U)
+	}
 	takeAndReturnTAndUReturns struct {
 		result1 T
 		result2 U
@@ -74,6 +85,10 @@ type FakeGenericInterfaceMultipleTypes[T genericinterface.CustomTypeT, U generic
 	takeAndReturnUArgsForCall []struct {
 		arg1 U
 	}
+	takeAndReturnUWhen []struct {
+		matcher func(U) bool
+		stub    func(U) U
+	}
 	takeAndReturnUReturns struct {
 		result1 U
 	}
@@ -85,11 +100,19 @@ type FakeGenericInterfaceMultipleTypes[T genericinterface.CustomTypeT, U generic
 	takeTArgsForCall []struct {
 		arg1 T
 	}
+	takeTWhen []struct {
+		matcher func(T) bool
+		stub    func(T)
+	}
 	TakeTAndReturnUStub        func(T) U
 	takeTAndReturnUMutex       sync.RWMutex
 	takeTAndReturnUArgsForCall []struct {
 		arg1 T
 	}
+	takeTAndReturnUWhen []struct {
+		matcher func(T) bool
+		stub    func(T) U
+	}
 	takeTAndReturnUReturns struct {
 		result1 U
 	}
@@ -102,12 +125,33 @@ type FakeGenericInterfaceMultipleTypes[T genericinterface.CustomTypeT, U generic
 		arg1 T
 		arg2 U
 	}
+	takeTAndUWhen []struct {
+		matcher func(T, U) bool
+		stub    func(T, U)
+	}
 	TakeUStub        func(U)
 	takeUMutex       sync.RWMutex
 	takeUArgsForCall []struct {
 		arg1 U
 	}
-	invocations      map[string][][]interface{}
+	takeUWhen []struct {
+		matcher func(U) bool
+		stub    func(U)
+	}
+	ArgsComparer       func(expected interface{}, actual interface{}) bool
+	invocations        map[string][][]interface{}
+	orderedInvocations []struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}
+	sequencer          *atomic.Uint64
+	invocationsChanged chan struct{}
+	callsSubscribers   map[string][]struct {
+		publish func(args []interface{})
+		cancel  context.CancelFunc
+		done    <-chan struct{}
+	}
 	invocationsMutex sync.RWMutex
 }
 
@@ -129,12 +173,38 @@ func (fake *FakeGenericInterfaceMultipleTypes[T, U]) DoSomethingCallCount() int
 	return len(fake.doSomethingArgsForCall)
 }
 
+func (fake *FakeGenericInterfaceMultipleTypes[T, U]) WaitForDoSomethingCalls(ctx context.Context, n int) error {
+	return fake.waitForCalls(ctx, fake.DoSomethingCallCount, n)
+}
+
+func (fake *FakeGenericInterfaceMultipleTypes[T, U]) DoSomethingCallsChan(ctx context.Context) <-chan []interface{} {
+	return fake.callsChan(ctx, "DoSomething")
+}
+
 func (fake *FakeGenericInterfaceMultipleTypes[T, U]) DoSomethingCalls(stub func()) {
 	fake.doSomethingMutex.Lock()
 	defer fake.doSomethingMutex.Unlock()
 	fake.DoSomethingStub = stub
 }
 
+func (fake *FakeGenericInterfaceMultipleTypes[T, U]) ResetDoSomething() {
+	fake.ResetDoSomethingCalls()
+	fake.ResetDoSomethingStubs()
+}
+
+func (fake *FakeGenericInterfaceMultipleTypes[T, U]) ResetDoSomethingCalls() {
+	fake.doSomethingMutex.Lock()
+	defer fake.doSomethingMutex.Unlock()
+	fake.doSomethingArgsForCall = nil
+	fake.forgetInvocations("DoSomething")
+}
+
+func (fake *FakeGenericInterfaceMultipleTypes[T, U]) ResetDoSomethingStubs() {
+	fake.doSomethingMutex.Lock()
+	defer fake.doSomethingMutex.Unlock()
+	fake.DoSomethingStub = nil
+}
+
 func (fake *FakeGenericInterfaceMultipleTypes[T, U]) ReturnT() T {
 	fake.returnTMutex.Lock()
 	ret, specificReturn := fake.returnTReturnsOnCall[len(fake.returnTArgsForCall)]
@@ -159,6 +229,14 @@ func (fake *FakeGenericInterfaceMultipleTypes[T, U]) ReturnTCallCount() int {
 	return len(fake.returnTArgsForCall)
 }
 
+func (fake *FakeGenericInterfaceMultipleTypes[T, U]) WaitForReturnTCalls(ctx context.Context, n int) error {
+	return fake.waitForCalls(ctx, fake.ReturnTCallCount, n)
+}
+
+func (fake *FakeGenericInterfaceMultipleTypes[T, U]) ReturnTCallsChan(ctx context.Context) <-chan []interface{} {
+	return fake.callsChan(ctx, "ReturnT")
+}
+
 func (fake *FakeGenericInterfaceMultipleTypes[T, U]) ReturnTCalls(stub func() T) {
 	fake.returnTMutex.Lock()
 	defer fake.returnTMutex.Unlock()
@@ -188,6 +266,28 @@ func (fake *FakeGenericInterfaceMultipleTypes[T, U]) ReturnTReturnsOnCall(i int,
 	}{result1}
 }
 
+func (fake *FakeGenericInterfaceMultipleTypes[T, U]) ResetReturnT() {
+	fake.ResetReturnTCalls()
+	fake.ResetReturnTStubs()
+}
+
+func (fake *FakeGenericInterfaceMultipleTypes[T, U]) ResetReturnTCalls() {
+	fake.returnTMutex.Lock()
+	defer fake.returnTMutex.Unlock()
+	fake.returnTArgsForCall = nil
+	fake.forgetInvocations("ReturnT")
+}
+
+func (fake *FakeGenericInterfaceMultipleTypes[T, U]) ResetReturnTStubs() {
+	fake.returnTMutex.Lock()
+	defer fake.returnTMutex.Unlock()
+	fake.ReturnTStub = nil
+	fake.returnTReturns = struct {
+		result1 T
+	}{}
+	fake.returnTReturnsOnCall = nil
+}
+
 func (fake *FakeGenericInterfaceMultipleTypes[T, U]) ReturnTAndU() (T, U) {
 	fake.returnTAndUMutex.Lock()
 	ret, specificReturn := fake.returnTAndUReturnsOnCall[len(fake.returnTAndUArgsForCall)]
@@ -212,6 +312,14 @@ func (fake *FakeGenericInterfaceMultipleTypes[T, U]) ReturnTAndUCallCount() int
 	return len(fake.returnTAndUArgsForCall)
 }
 
+func (fake *FakeGenericInterfaceMultipleTypes[T, U]) WaitForReturnTAndUCalls(ctx context.Context, n int) error {
+	return fake.waitForCalls(ctx, fake.ReturnTAndUCallCount, n)
+}
+
+func (fake *FakeGenericInterfaceMultipleTypes[T, U]) ReturnTAndUCallsChan(ctx context.Context) <-chan []interface{} {
+	return fake.callsChan(ctx, "ReturnTAndU")
+}
+
 func (fake *FakeGenericInterfaceMultipleTypes[T, U]) ReturnTAndUCalls(stub func() (T, U)) {
 	fake.returnTAndUMutex.Lock()
 	defer fake.returnTAndUMutex.Unlock()
@@ -244,6 +352,29 @@ func (fake *FakeGenericInterfaceMultipleTypes[T, U]) ReturnTAndUReturnsOnCall(i
 	}{result1, result2}
 }
 
+func (fake *FakeGenericInterfaceMultipleTypes[T, U]) ResetReturnTAndU() {
+	fake.ResetReturnTAndUCalls()
+	fake.ResetReturnTAndUStubs()
+}
+
+func (fake *FakeGenericInterfaceMultipleTypes[T, U]) ResetReturnTAndUCalls() {
+	fake.returnTAndUMutex.Lock()
+	defer fake.returnTAndUMutex.Unlock()
+	fake.returnTAndUArgsForCall = nil
+	fake.forgetInvocations("ReturnTAndU")
+}
+
+func (fake *FakeGenericInterfaceMultipleTypes[T, U]) ResetReturnTAndUStubs() {
+	fake.returnTAndUMutex.Lock()
+	defer fake.returnTAndUMutex.Unlock()
+	fake.ReturnTAndUStub = nil
+	fake.returnTAndUReturns = struct {
+		result1 T
+		result2 U
+	}{}
+	fake.returnTAndUReturnsOnCall = nil
+}
+
 func (fake *FakeGenericInterfaceMultipleTypes[T, U]) ReturnU() U {
 	fake.returnUMutex.Lock()
 	ret, specificReturn := fake.returnUReturnsOnCall[len(fake.returnUArgsForCall)]
@@ -268,6 +399,14 @@ func (fake *FakeGenericInterfaceMultipleTypes[T, U]) ReturnUCallCount() int {
 	return len(fake.returnUArgsForCall)
 }
 
+func (fake *FakeGenericInterfaceMultipleTypes[T, U]) WaitForReturnUCalls(ctx context.Context, n int) error {
+	return fake.waitForCalls(ctx, fake.ReturnUCallCount, n)
+}
+
+func (fake *FakeGenericInterfaceMultipleTypes[T, U]) ReturnUCallsChan(ctx context.Context) <-chan []interface{} {
+	return fake.callsChan(ctx, "ReturnU")
+}
+
 func (fake *FakeGenericInterfaceMultipleTypes[T, U]) ReturnUCalls(stub func() U) {
 	fake.returnUMutex.Lock()
 	defer fake.returnUMutex.Unlock()
@@ -297,6 +436,32 @@ func (fake *FakeGenericInterfaceMultipleTypes[T, U]) ReturnUReturnsOnCall(i int,
 	}{result1}
 }
 
+func (fake *FakeGenericInterfaceMultipleTypes[T, U]) ResetReturnU() {
+	fake.ResetReturnUCalls()
+	fake.ResetReturnUStubs()
+}
+
+func (fake *FakeGenericInterfaceMultipleTypes[T, U]) ResetReturnUCalls() {
+	fake.returnUMutex.Lock()
+	defer fake.returnUMutex.Unlock()
+	fake.returnUArgsForCall = nil
+	fake.forgetInvocations("ReturnU")
+}
+
+func (fake *FakeGenericInterfaceMultipleTypes[T, U]) ResetReturnUStubs() {
+	fake.returnUMutex.Lock()
+	defer fake.returnUMutex.Unlock()
+	fake.ReturnUStub = nil
+	fake.returnUReturns = struct {
+		result1 U
+	}{}
+	fake.returnUReturnsOnCall = nil
+}
+
+type FakeGenericInterfaceMultipleTypesTakeAndReturnTCall[T genericinterface.CustomTypeT, U genericinterface.CustomTypeU] struct {
+	Arg1 T
+}
+
 func (fake *FakeGenericInterfaceMultipleTypes[T, U]) TakeAndReturnT(arg1 T) T {
 	fake.takeAndReturnTMutex.Lock()
 	ret, specificReturn := fake.takeAndReturnTReturnsOnCall[len(fake.takeAndReturnTArgsForCall)]
@@ -304,9 +469,15 @@ func (fake *FakeGenericInterfaceMultipleTypes[T, U]) TakeAndReturnT(arg1 T) T {
 		arg1 T
 	}{arg1})
 	stub := fake.TakeAndReturnTStub
+	whens := fake.takeAndReturnTWhen
 	fakeReturns := fake.takeAndReturnTReturns
 	fake.recordInvocation("TakeAndReturnT", []interface{}{arg1})
 	fake.takeAndReturnTMutex.Unlock()
+	for i := len(whens) - 1; i >= 0; i-- {
+		if whens[i].matcher(arg1) {
+			return whens[i].stub(arg1)
+		}
+	}
 	if stub != nil {
 		return stub(arg1)
 	}
@@ -322,12 +493,31 @@ func (fake *FakeGenericInterfaceMultipleTypes[T, U]) TakeAndReturnTCallCount() i
 	return len(fake.takeAndReturnTArgsForCall)
 }
 
+func (fake *FakeGenericInterfaceMultipleTypes[T, U]) WaitForTakeAndReturnTCalls(ctx context.Context, n int) error {
+	return fake.waitForCalls(ctx, fake.TakeAndReturnTCallCount, n)
+}
+
+func (fake *FakeGenericInterfaceMultipleTypes[T, U]) TakeAndReturnTCallsChan(ctx context.Context) <-chan []interface{} {
+	return fake.callsChan(ctx, "TakeAndReturnT")
+}
+
 func (fake *FakeGenericInterfaceMultipleTypes[T, U]) TakeAndReturnTCalls(stub func(T) T) {
 	fake.takeAndReturnTMutex.Lock()
 	defer fake.takeAndReturnTMutex.Unlock()
 	fake.TakeAndReturnTStub = stub
 }
 
+// TakeAndReturnTCallsWhen calls the stub for the calls whose arguments match.
+// Matchers are checked before the stub, and the matcher registered last wins.
+func (fake *FakeGenericInterfaceMultipleTypes[T, U]) TakeAndReturnTCallsWhen(matcher func(T) bool, stub func(T) T) {
+	fake.takeAndReturnTMutex.Lock()
+	defer fake.takeAndReturnTMutex.Unlock()
+	fake.takeAndReturnTWhen = append(fake.takeAndReturnTWhen, struct {
+		matcher func(T) bool
+		stub    func(T) T
+	}{matcher, stub})
+}
+
 func (fake *FakeGenericInterfaceMultipleTypes[T, U]) TakeAndReturnTArgsForCall(i int) T {
 	fake.takeAndReturnTMutex.RLock()
 	defer fake.takeAndReturnTMutex.RUnlock()
@@ -335,6 +525,16 @@ func (fake *FakeGenericInterfaceMultipleTypes[T, U]) TakeAndReturnTArgsForCall(i
 	return argsForCall.arg1
 }
 
+func (fake *FakeGenericInterfaceMultipleTypes[T, U]) TakeAndReturnTCallHistory() []FakeGenericInterfaceMultipleTypesTakeAndReturnTCall[T, U] {
+	fake.takeAndReturnTMutex.RLock()
+	defer fake.takeAndReturnTMutex.RUnlock()
+	history := make([]FakeGenericInterfaceMultipleTypesTakeAndReturnTCall[T, U], len(fake.takeAndReturnTArgsForCall))
+	for i, argsForCall := range fake.takeAndReturnTArgsForCall {
+		history[i] = FakeGenericInterfaceMultipleTypesTakeAndReturnTCall[T, U]{argsForCall.arg1}
+	}
+	return history
+}
+
 func (fake *FakeGenericInterfaceMultipleTypes[T, U]) TakeAndReturnTReturns(result1 T) {
 	fake.takeAndReturnTMutex.Lock()
 	defer fake.takeAndReturnTMutex.Unlock()
@@ -358,6 +558,66 @@ func (fake *FakeGenericInterfaceMultipleTypes[T, U]) TakeAndReturnTReturnsOnCall
 	}{result1}
 }
 
+// TakeAndReturnTReturnsForArgs returns the values for the calls with the given
+// arguments. Like TakeAndReturnTCallsWhen, it is checked before the stub, and the
+// arguments or matcher registered last win.
+func (fake *FakeGenericInterfaceMultipleTypes[T, U]) TakeAndReturnTReturnsForArgs(arg1 T) func(T) {
+	args := []interface{}{arg1}
+	return func(result1 T) {
+		fake.takeAndReturnTMutex.Lock()
+		defer fake.takeAndReturnTMutex.Unlock()
+		fake.TakeAndReturnTStub = nil
+		fake.takeAndReturnTWhen = append(fake.takeAndReturnTWhen, struct {
+			matcher func(T) bool
+			stub    func(T) T
+		}{
+			func(arg1 T) bool {
+				return fake.argsMatch(args, []interface{}{arg1})
+			},
+			func(T) T {
+				return result1
+			},
+		})
+	}
+}
+
+// TakeAndReturnTReturnsWhen returns the values for the calls whose arguments
+// match. Like TakeAndReturnTCallsWhen, it is checked before the stub, and the
+// matcher registered last wins.
+func (fake *FakeGenericInterfaceMultipleTypes[T, U]) TakeAndReturnTReturnsWhen(matcher func(T) bool, result1 T) {
+	fake.TakeAndReturnTCallsWhen(matcher, func(T) T {
+		return result1
+	})
+}
+
+func (fake *FakeGenericInterfaceMultipleTypes[T, U]) ResetTakeAndReturnT() {
+	fake.ResetTakeAndReturnTCalls()
+	fake.ResetTakeAndReturnTStubs()
+}
+
+func (fake *FakeGenericInterfaceMultipleTypes[T, U]) ResetTakeAndReturnTCalls() {
+	fake.takeAndReturnTMutex.Lock()
+	defer fake.takeAndReturnTMutex.Unlock()
+	fake.takeAndReturnTArgsForCall = nil
+	fake.forgetInvocations("TakeAndReturnT")
+}
+
+func (fake *FakeGenericInterfaceMultipleTypes[T, U]) ResetTakeAndReturnTStubs() {
+	fake.takeAndReturnTMutex.Lock()
+	defer fake.takeAndReturnTMutex.Unlock()
+	fake.TakeAndReturnTStub = nil
+	fake.takeAndReturnTWhen = nil
+	fake.takeAndReturnTReturns = struct {
+		result1 T
+	}{}
+	fake.takeAndReturnTReturnsOnCall = nil
+}
+
+type FakeGenericInterfaceMultipleTypesTakeAndReturnTAndUCall[T genericinterface.CustomTypeT, U genericinterface.CustomTypeU] struct {
+	Arg1 T
+	Arg2 U
+}
+
 func (fake *FakeGenericInterfaceMultipleTypes[T, U]) TakeAndReturnTAndU(arg1 T, arg2 U) (T, U) {
 	fake.takeAndReturnTAndUMutex.Lock()
 	ret, specificReturn := fake.takeAndReturnTAndUReturnsOnCall[len(fake.takeAndReturnTAndUArgsForCall)]
@@ -366,9 +626,15 @@ func (fake *FakeGenericInterfaceMultipleTypes[T, U]) TakeAndReturnTAndU(arg1 T,
 		arg2 U
 	}{arg1, arg2})
 	stub := fake.TakeAndReturnTAndUStub
+	whens := fake.takeAndReturnTAndUWhen
 	fakeReturns := fake.takeAndReturnTAndUReturns
 	fake.recordInvocation("TakeAndReturnTAndU", []interface{}{arg1, arg2})
 	fake.takeAndReturnTAndUMutex.Unlock()
+	for i := len(whens) - 1; i >= 0; i-- {
+		if whens[i].matcher(arg1, arg2) {
+			return whens[i].stub(arg1, arg2)
+		}
+	}
 	if stub != nil {
 		return stub(arg1, arg2)
 	}
@@ -384,12 +650,31 @@ func (fake *FakeGenericInterfaceMultipleTypes[T, U]) TakeAndReturnTAndUCallCount
 	return len(fake.takeAndReturnTAndUArgsForCall)
 }
 
+func (fake *FakeGenericInterfaceMultipleTypes[T, U]) WaitForTakeAndReturnTAndUCalls(ctx context.Context, n int) error {
+	return fake.waitForCalls(ctx, fake.TakeAndReturnTAndUCallCount, n)
+}
+
+func (fake *FakeGenericInterfaceMultipleTypes[T, U]) TakeAndReturnTAndUCallsChan(ctx context.Context) <-chan []interface{} {
+	return fake.callsChan(ctx, "TakeAndReturnTAndU")
+}
+
 func (fake *FakeGenericInterfaceMultipleTypes[T, U]) TakeAndReturnTAndUCalls(stub func(T, U) (T, U)) {
 	fake.takeAndReturnTAndUMutex.Lock()
 	defer fake.takeAndReturnTAndUMutex.Unlock()
 	fake.TakeAndReturnTAndUStub = stub
 }
 
+// TakeAndReturnTAndUCallsWhen calls the stub for the calls whose arguments match.
+// Matchers are checked before the stub, and the matcher registered last wins.
+func (fake *FakeGenericInterfaceMultipleTypes[T, U]) TakeAndReturnTAndUCallsWhen(matcher func(T, U) bool, stub func(T, U) (T, U)) {
+	fake.takeAndReturnTAndUMutex.Lock()
+	defer fake.takeAndReturnTAndUMutex.Unlock()
+	fake.takeAndReturnTAndUWhen = append(fake.takeAndReturnTAndUWhen, struct {
+		matcher func(T, U) bool
+		stub    func(T, U) (T, U)
+	}{matcher, stub})
+}
+
 func (fake *FakeGenericInterfaceMultipleTypes[T, U]) TakeAndReturnTAndUArgsForCall(i int) (T, U) {
 	fake.takeAndReturnTAndUMutex.RLock()
 	defer fake.takeAndReturnTAndUMutex.RUnlock()
@@ -397,6 +682,16 @@ func (fake *FakeGenericInterfaceMultipleTypes[T, U]) TakeAndReturnTAndUArgsForCa
 	return argsForCall.arg1, argsForCall.arg2
 }
 
+func (fake *FakeGenericInterfaceMultipleTypes[T, U]) TakeAndReturnTAndUCallHistory() []FakeGenericInterfaceMultipleTypesTakeAndReturnTAndUCall[T, U] {
+	fake.takeAndReturnTAndUMutex.RLock()
+	defer fake.takeAndReturnTAndUMutex.RUnlock()
+	history := make([]FakeGenericInterfaceMultipleTypesTakeAndReturnTAndUCall[T, U], len(fake.takeAndReturnTAndUArgsForCall))
+	for i, argsForCall := range fake.takeAndReturnTAndUArgsForCall {
+		history[i] = FakeGenericInterfaceMultipleTypesTakeAndReturnTAndUCall[T, U]{argsForCall.arg1, argsForCall.arg2}
+	}
+	return history
+}
+
 func (fake *FakeGenericInterfaceMultipleTypes[T, U]) TakeAndReturnTAndUReturns(result1 T, result2 U) {
 	fake.takeAndReturnTAndUMutex.Lock()
 	defer fake.takeAndReturnTAndUMutex.Unlock()
@@ -423,6 +718,66 @@ func (fake *FakeGenericInterfaceMultipleTypes[T, U]) TakeAndReturnTAndUReturnsOn
 	}{result1, result2}
 }
 
+// TakeAndReturnTAndUReturnsForArgs returns the values for the calls with the given
+// arguments. Like TakeAndReturnTAndUCallsWhen, it is checked before the stub, and the
+// arguments or matcher registered last win.
+func (fake *FakeGenericInterfaceMultipleTypes[T, U]) TakeAndReturnTAndUReturnsForArgs(arg1 T, arg2 U) func(T, U) {
+	args := []interface{}{arg1, arg2}
+	return func(result1 T, result2 U) {
+		fake.takeAndReturnTAndUMutex.Lock()
+		defer fake.takeAndReturnTAndUMutex.Unlock()
+		fake.TakeAndReturnTAndUStub = nil
+		fake.takeAndReturnTAndUWhen = append(fake.takeAndReturnTAndUWhen, struct {
+			matcher func(T, U) bool
+			stub    func(T, U) (T, U)
+		}{
+			func(arg1 T, arg2 U) bool {
+				return fake.argsMatch(args, []interface{}{arg1, arg2})
+			},
+			func(T, U) (T, U) {
+				return result1, result2
+			},
+		})
+	}
+}
+
+// TakeAndReturnTAndUReturnsWhen returns the values for the calls whose arguments
+// match. Like TakeAndReturnTAndUCallsWhen, it is checked before the stub, and the
+// matcher registered last wins.
+func (fake *FakeGenericInterfaceMultipleTypes[T, U]) TakeAndReturnTAndUReturnsWhen(matcher func(T, U) bool, result1 T, result2 U) {
+	fake.TakeAndReturnTAndUCallsWhen(matcher, func(T, U) (T, U) {
+		return result1, result2
+	})
+}
+
+func (fake *FakeGenericInterfaceMultipleTypes[T, U]) ResetTakeAndReturnTAndU() {
+	fake.ResetTakeAndReturnTAndUCalls()
+	fake.ResetTakeAndReturnTAndUStubs()
+}
+
+func (fake *FakeGenericInterfaceMultipleTypes[T, U]) ResetTakeAndReturnTAndUCalls() {
+	fake.takeAndReturnTAndUMutex.Lock()
+	defer fake.takeAndReturnTAndUMutex.Unlock()
+	fake.takeAndReturnTAndUArgsForCall = nil
+	fake.forgetInvocations("TakeAndReturnTAndU")
+}
+
+func (fake *FakeGenericInterfaceMultipleTypes[T, U]) ResetTakeAndReturnTAndUStubs() {
+	fake.takeAndReturnTAndUMutex.Lock()
+	defer fake.takeAndReturnTAndUMutex.Unlock()
+	fake.TakeAndReturnTAndUStub = nil
+	fake.takeAndReturnTAndUWhen = nil
+	fake.takeAndReturnTAndUReturns = struct {
+		result1 T
+		result2 U
+	}{}
+	fake.takeAndReturnTAndUReturnsOnCall = nil
+}
+
+type FakeGenericInterfaceMultipleTypesTakeAndReturnUCall[T genericinterface.CustomTypeT, U genericinterface.CustomTypeU] struct {
+	Arg1 U
+}
+
 func (fake *FakeGenericInterfaceMultipleTypes[T, U]) TakeAndReturnU(arg1 U) U {
 	fake.takeAndReturnUMutex.Lock()
 	ret, specificReturn := fake.takeAndReturnUReturnsOnCall[len(fake.takeAndReturnUArgsForCall)]
@@ -430,9 +785,15 @@ func (fake *FakeGenericInterfaceMultipleTypes[T, U]) TakeAndReturnU(arg1 U) U {
 		arg1 U
 	}{arg1})
 	stub := fake.TakeAndReturnUStub
+	whens := fake.takeAndReturnUWhen
 	fakeReturns := fake.takeAndReturnUReturns
 	fake.recordInvocation("TakeAndReturnU", []interface{}{arg1})
 	fake.takeAndReturnUMutex.Unlock()
+	for i := len(whens) - 1; i >= 0; i-- {
+		if whens[i].matcher(arg1) {
+			return whens[i].stub(arg1)
+		}
+	}
 	if stub != nil {
 		return stub(arg1)
 	}
@@ -448,12 +809,31 @@ func (fake *FakeGenericInterfaceMultipleTypes[T, U]) TakeAndReturnUCallCount() i
 	return len(fake.takeAndReturnUArgsForCall)
 }
 
+func (fake *FakeGenericInterfaceMultipleTypes[T, U]) WaitForTakeAndReturnUCalls(ctx context.Context, n int) error {
+	return fake.waitForCalls(ctx, fake.TakeAndReturnUCallCount, n)
+}
+
+func (fake *FakeGenericInterfaceMultipleTypes[T, U]) TakeAndReturnUCallsChan(ctx context.Context) <-chan []interface{} {
+	return fake.callsChan(ctx, "TakeAndReturnU")
+}
+
 func (fake *FakeGenericInterfaceMultipleTypes[T, U]) TakeAndReturnUCalls(stub func(U) U) {
 	fake.takeAndReturnUMutex.Lock()
 	defer fake.takeAndReturnUMutex.Unlock()
 	fake.TakeAndReturnUStub = stub
 }
 
+// TakeAndReturnUCallsWhen calls the stub for the calls whose arguments match.
+// Matchers are checked before the stub, and the matcher registered last wins.
+func (fake *FakeGenericInterfaceMultipleTypes[T, U]) TakeAndReturnUCallsWhen(matcher func(U) bool, stub func(U) U) {
+	fake.takeAndReturnUMutex.Lock()
+	defer fake.takeAndReturnUMutex.Unlock()
+	fake.takeAndReturnUWhen = append(fake.takeAndReturnUWhen, struct {
+		matcher func(U) bool
+		stub    func(U) U
+	}{matcher, stub})
+}
+
 func (fake *FakeGenericInterfaceMultipleTypes[T, U]) TakeAndReturnUArgsForCall(i int) U {
 	fake.takeAndReturnUMutex.RLock()
 	defer fake.takeAndReturnUMutex.RUnlock()
@@ -461,6 +841,16 @@ func (fake *FakeGenericInterfaceMultipleTypes[T, U]) TakeAndReturnUArgsForCall(i
 	return argsForCall.arg1
 }
 
+func (fake *FakeGenericInterfaceMultipleTypes[T, U]) TakeAndReturnUCallHistory() []FakeGenericInterfaceMultipleTypesTakeAndReturnUCall[T, U] {
+	fake.takeAndReturnUMutex.RLock()
+	defer fake.takeAndReturnUMutex.RUnlock()
+	history := make([]FakeGenericInterfaceMultipleTypesTakeAndReturnUCall[T, U], len(fake.takeAndReturnUArgsForCall))
+	for i, argsForCall := range fake.takeAndReturnUArgsForCall {
+		history[i] = FakeGenericInterfaceMultipleTypesTakeAndReturnUCall[T, U]{argsForCall.arg1}
+	}
+	return history
+}
+
 func (fake *FakeGenericInterfaceMultipleTypes[T, U]) TakeAndReturnUReturns(result1 U) {
 	fake.takeAndReturnUMutex.Lock()
 	defer fake.takeAndReturnUMutex.Unlock()
@@ -484,14 +874,80 @@ func (fake *FakeGenericInterfaceMultipleTypes[T, U]) TakeAndReturnUReturnsOnCall
 	}{result1}
 }
 
+// TakeAndReturnUReturnsForArgs returns the values for the calls with the given
+// arguments. Like TakeAndReturnUCallsWhen, it is checked before the stub, and the
+// arguments or matcher registered last win.
+func (fake *FakeGenericInterfaceMultipleTypes[T, U]) TakeAndReturnUReturnsForArgs(arg1 U) func(U) {
+	args := []interface{}{arg1}
+	return func(result1 U) {
+		fake.takeAndReturnUMutex.Lock()
+		defer fake.takeAndReturnUMutex.Unlock()
+		fake.TakeAndReturnUStub = nil
+		fake.takeAndReturnUWhen = append(fake.takeAndReturnUWhen, struct {
+			matcher func(U) bool
+			stub    func(U) U
+		}{
+			func(arg1 U) bool {
+				return fake.argsMatch(args, []interface{}{arg1})
+			},
+			func(U) U {
+				return result1
+			},
+		})
+	}
+}
+
+// TakeAndReturnUReturnsWhen returns the values for the calls whose arguments
+// match. Like TakeAndReturnUCallsWhen, it is checked before the stub, and the
+// matcher registered last wins.
+func (fake *FakeGenericInterfaceMultipleTypes[T, U]) TakeAndReturnUReturnsWhen(matcher func(U) bool, result1 U) {
+	fake.TakeAndReturnUCallsWhen(matcher, func(U) U {
+		return result1
+	})
+}
+
+func (fake *FakeGenericInterfaceMultipleTypes[T, U]) ResetTakeAndReturnU() {
+	fake.ResetTakeAndReturnUCalls()
+	fake.ResetTakeAndReturnUStubs()
+}
+
+func (fake *FakeGenericInterfaceMultipleTypes[T, U]) ResetTakeAndReturnUCalls() {
+	fake.takeAndReturnUMutex.Lock()
+	defer fake.takeAndReturnUMutex.Unlock()
+	fake.takeAndReturnUArgsForCall = nil
+	fake.forgetInvocations("TakeAndReturnU")
+}
+
+func (fake *FakeGenericInterfaceMultipleTypes[T, U]) ResetTakeAndReturnUStubs() {
+	fake.takeAndReturnUMutex.Lock()
+	defer fake.takeAndReturnUMutex.Unlock()
+	fake.TakeAndReturnUStub = nil
+	fake.takeAndReturnUWhen = nil
+	fake.takeAndReturnUReturns = struct {
+		result1 U
+	}{}
+	fake.takeAndReturnUReturnsOnCall = nil
+}
+
+type FakeGenericInterfaceMultipleTypesTakeTCall[T genericinterface.CustomTypeT, U genericinterface.CustomTypeU] struct {
+	Arg1 T
+}
+
 func (fake *FakeGenericInterfaceMultipleTypes[T, U]) TakeT(arg1 T) {
 	fake.takeTMutex.Lock()
 	fake.takeTArgsForCall = append(fake.takeTArgsForCall, struct {
 		arg1 T
 	}{arg1})
 	stub := fake.TakeTStub
+	whens := fake.takeTWhen
 	fake.recordInvocation("TakeT", []interface{}{arg1})
 	fake.takeTMutex.Unlock()
+	for i := len(whens) - 1; i >= 0; i-- {
+		if whens[i].matcher(arg1) {
+			whens[i].stub(arg1)
+			return
+		}
+	}
 	if stub != nil {
 		fake.TakeTStub(arg1)
 	}
@@ -503,12 +959,31 @@ func (fake *FakeGenericInterfaceMultipleTypes[T, U]) TakeTCallCount() int {
 	return len(fake.takeTArgsForCall)
 }
 
+func (fake *FakeGenericInterfaceMultipleTypes[T, U]) WaitForTakeTCalls(ctx context.Context, n int) error {
+	return fake.waitForCalls(ctx, fake.TakeTCallCount, n)
+}
+
+func (fake *FakeGenericInterfaceMultipleTypes[T, U]) TakeTCallsChan(ctx context.Context) <-chan []interface{} {
+	return fake.callsChan(ctx, "TakeT")
+}
+
 func (fake *FakeGenericInterfaceMultipleTypes[T, U]) TakeTCalls(stub func(T)) {
 	fake.takeTMutex.Lock()
 	defer fake.takeTMutex.Unlock()
 	fake.TakeTStub = stub
 }
 
+// TakeTCallsWhen calls the stub for the calls whose arguments match.
+// Matchers are checked before the stub, and the matcher registered last wins.
+func (fake *FakeGenericInterfaceMultipleTypes[T, U]) TakeTCallsWhen(matcher func(T) bool, stub func(T)) {
+	fake.takeTMutex.Lock()
+	defer fake.takeTMutex.Unlock()
+	fake.takeTWhen = append(fake.takeTWhen, struct {
+		matcher func(T) bool
+		stub    func(T)
+	}{matcher, stub})
+}
+
 func (fake *FakeGenericInterfaceMultipleTypes[T, U]) TakeTArgsForCall(i int) T {
 	fake.takeTMutex.RLock()
 	defer fake.takeTMutex.RUnlock()
@@ -516,6 +991,39 @@ func (fake *FakeGenericInterfaceMultipleTypes[T, U]) TakeTArgsForCall(i int) T {
 	return argsForCall.arg1
 }
 
+func (fake *FakeGenericInterfaceMultipleTypes[T, U]) TakeTCallHistory() []FakeGenericInterfaceMultipleTypesTakeTCall[T, U] {
+	fake.takeTMutex.RLock()
+	defer fake.takeTMutex.RUnlock()
+	history := make([]FakeGenericInterfaceMultipleTypesTakeTCall[T, U], len(fake.takeTArgsForCall))
+	for i, argsForCall := range fake.takeTArgsForCall {
+		history[i] = FakeGenericInterfaceMultipleTypesTakeTCall[T, U]{argsForCall.arg1}
+	}
+	return history
+}
+
+func (fake *FakeGenericInterfaceMultipleTypes[T, U]) ResetTakeT() {
+	fake.ResetTakeTCalls()
+	fake.ResetTakeTStubs()
+}
+
+func (fake *FakeGenericInterfaceMultipleTypes[T, U]) ResetTakeTCalls() {
+	fake.takeTMutex.Lock()
+	defer fake.takeTMutex.Unlock()
+	fake.takeTArgsForCall = nil
+	fake.forgetInvocations("TakeT")
+}
+
+func (fake *FakeGenericInterfaceMultipleTypes[T, U]) ResetTakeTStubs() {
+	fake.takeTMutex.Lock()
+	defer fake.takeTMutex.Unlock()
+	fake.TakeTStub = nil
+	fake.takeTWhen = nil
+}
+
+type FakeGenericInterfaceMultipleTypesTakeTAndReturnUCall[T genericinterface.CustomTypeT, U genericinterface.CustomTypeU] struct {
+	Arg1 T
+}
+
 func (fake *FakeGenericInterfaceMultipleTypes[T, U]) TakeTAndReturnU(arg1 T) U {
 	fake.takeTAndReturnUMutex.Lock()
 	ret, specificReturn := fake.takeTAndReturnUReturnsOnCall[len(fake.takeTAndReturnUArgsForCall)]
@@ -523,9 +1031,15 @@ func (fake *FakeGenericInterfaceMultipleTypes[T, U]) TakeTAndReturnU(arg1 T) U {
 		arg1 T
 	}{arg1})
 	stub := fake.TakeTAndReturnUStub
+	whens := fake.takeTAndReturnUWhen
 	fakeReturns := fake.takeTAndReturnUReturns
 	fake.recordInvocation("TakeTAndReturnU", []interface{}{arg1})
 	fake.takeTAndReturnUMutex.Unlock()
+	for i := len(whens) - 1; i >= 0; i-- {
+		if whens[i].matcher(arg1) {
+			return whens[i].stub(arg1)
+		}
+	}
 	if stub != nil {
 		return stub(arg1)
 	}
@@ -541,12 +1055,31 @@ func (fake *FakeGenericInterfaceMultipleTypes[T, U]) TakeTAndReturnUCallCount()
 	return len(fake.takeTAndReturnUArgsForCall)
 }
 
+func (fake *FakeGenericInterfaceMultipleTypes[T, U]) WaitForTakeTAndReturnUCalls(ctx context.Context, n int) error {
+	return fake.waitForCalls(ctx, fake.TakeTAndReturnUCallCount, n)
+}
+
+func (fake *FakeGenericInterfaceMultipleTypes[T, U]) TakeTAndReturnUCallsChan(ctx context.Context) <-chan []interface{} {
+	return fake.callsChan(ctx, "TakeTAndReturnU")
+}
+
 func (fake *FakeGenericInterfaceMultipleTypes[T, U]) TakeTAndReturnUCalls(stub func(T) U) {
 	fake.takeTAndReturnUMutex.Lock()
 	defer fake.takeTAndReturnUMutex.Unlock()
 	fake.TakeTAndReturnUStub = stub
 }
 
+// TakeTAndReturnUCallsWhen calls the stub for the calls whose arguments match.
+// Matchers are checked before the stub, and the matcher registered last wins.
+func (fake *FakeGenericInterfaceMultipleTypes[T, U]) TakeTAndReturnUCallsWhen(matcher func(T) bool, stub func(T) U) {
+	fake.takeTAndReturnUMutex.Lock()
+	defer fake.takeTAndReturnUMutex.Unlock()
+	fake.takeTAndReturnUWhen = append(fake.takeTAndReturnUWhen, struct {
+		matcher func(T) bool
+		stub    func(T) U
+	}{matcher, stub})
+}
+
 func (fake *FakeGenericInterfaceMultipleTypes[T, U]) TakeTAndReturnUArgsForCall(i int) T {
 	fake.takeTAndReturnUMutex.RLock()
 	defer fake.takeTAndReturnUMutex.RUnlock()
@@ -554,6 +1087,16 @@ func (fake *FakeGenericInterfaceMultipleTypes[T, U]) TakeTAndReturnUArgsForCall(
 	return argsForCall.arg1
 }
 
+func (fake *FakeGenericInterfaceMultipleTypes[T, U]) TakeTAndReturnUCallHistory() []FakeGenericInterfaceMultipleTypesTakeTAndReturnUCall[T, U] {
+	fake.takeTAndReturnUMutex.RLock()
+	defer fake.takeTAndReturnUMutex.RUnlock()
+	history := make([]FakeGenericInterfaceMultipleTypesTakeTAndReturnUCall[T, U], len(fake.takeTAndReturnUArgsForCall))
+	for i, argsForCall := range fake.takeTAndReturnUArgsForCall {
+		history[i] = FakeGenericInterfaceMultipleTypesTakeTAndReturnUCall[T, U]{argsForCall.arg1}
+	}
+	return history
+}
+
 func (fake *FakeGenericInterfaceMultipleTypes[T, U]) TakeTAndReturnUReturns(result1 U) {
 	fake.takeTAndReturnUMutex.Lock()
 	defer fake.takeTAndReturnUMutex.Unlock()
@@ -577,6 +1120,66 @@ func (fake *FakeGenericInterfaceMultipleTypes[T, U]) TakeTAndReturnUReturnsOnCal
 	}{result1}
 }
 
+// TakeTAndReturnUReturnsForArgs returns the values for the calls with the given
+// arguments. Like TakeTAndReturnUCallsWhen, it is checked before the stub, and the
+// arguments or matcher registered last win.
+func (fake *FakeGenericInterfaceMultipleTypes[T, U]) TakeTAndReturnUReturnsForArgs(arg1 T) func(U) {
+	args := []interface{}{arg1}
+	return func(result1 U) {
+		fake.takeTAndReturnUMutex.Lock()
+		defer fake.takeTAndReturnUMutex.Unlock()
+		fake.TakeTAndReturnUStub = nil
+		fake.takeTAndReturnUWhen = append(fake.takeTAndReturnUWhen, struct {
+			matcher func(T) bool
+			stub    func(T) U
+		}{
+			func(arg1 T) bool {
+				return fake.argsMatch(args, []interface{}{arg1})
+			},
+			func(T) U {
+				return result1
+			},
+		})
+	}
+}
+
+// TakeTAndReturnUReturnsWhen returns the values for the calls whose arguments
+// match. Like TakeTAndReturnUCallsWhen, it is checked before the stub, and the
+// matcher registered last wins.
+func (fake *FakeGenericInterfaceMultipleTypes[T, U]) TakeTAndReturnUReturnsWhen(matcher func(T) bool, result1 U) {
+	fake.TakeTAndReturnUCallsWhen(matcher, func(T) U {
+		return result1
+	})
+}
+
+func (fake *FakeGenericInterfaceMultipleTypes[T, U]) ResetTakeTAndReturnU() {
+	fake.ResetTakeTAndReturnUCalls()
+	fake.ResetTakeTAndReturnUStubs()
+}
+
+func (fake *FakeGenericInterfaceMultipleTypes[T, U]) ResetTakeTAndReturnUCalls() {
+	fake.takeTAndReturnUMutex.Lock()
+	defer fake.takeTAndReturnUMutex.Unlock()
+	fake.takeTAndReturnUArgsForCall = nil
+	fake.forgetInvocations("TakeTAndReturnU")
+}
+
+func (fake *FakeGenericInterfaceMultipleTypes[T, U]) ResetTakeTAndReturnUStubs() {
+	fake.takeTAndReturnUMutex.Lock()
+	defer fake.takeTAndReturnUMutex.Unlock()
+	fake.TakeTAndReturnUStub = nil
+	fake.takeTAndReturnUWhen = nil
+	fake.takeTAndReturnUReturns = struct {
+		result1 U
+	}{}
+	fake.takeTAndReturnUReturnsOnCall = nil
+}
+
+type FakeGenericInterfaceMultipleTypesTakeTAndUCall[T genericinterface.CustomTypeT, U genericinterface.CustomTypeU] struct {
+	Arg1 T
+	Arg2 U
+}
+
 func (fake *FakeGenericInterfaceMultipleTypes[T, U]) TakeTAndU(arg1 T, arg2 U) {
 	fake.takeTAndUMutex.Lock()
 	fake.takeTAndUArgsForCall = append(fake.takeTAndUArgsForCall, struct {
@@ -584,8 +1187,15 @@ func (fake *FakeGenericInterfaceMultipleTypes[T, U]) TakeTAndU(arg1 T, arg2 U) {
 		arg2 U
 	}{arg1, arg2})
 	stub := fake.TakeTAndUStub
+	whens := fake.takeTAndUWhen
 	fake.recordInvocation("TakeTAndU", []interface{}{arg1, arg2})
 	fake.takeTAndUMutex.Unlock()
+	for i := len(whens) - 1; i >= 0; i-- {
+		if whens[i].matcher(arg1, arg2) {
+			whens[i].stub(arg1, arg2)
+			return
+		}
+	}
 	if stub != nil {
 		fake.TakeTAndUStub(arg1, arg2)
 	}
@@ -597,12 +1207,31 @@ func (fake *FakeGenericInterfaceMultipleTypes[T, U]) TakeTAndUCallCount() int {
 	return len(fake.takeTAndUArgsForCall)
 }
 
+func (fake *FakeGenericInterfaceMultipleTypes[T, U]) WaitForTakeTAndUCalls(ctx context.Context, n int) error {
+	return fake.waitForCalls(ctx, fake.TakeTAndUCallCount, n)
+}
+
+func (fake *FakeGenericInterfaceMultipleTypes[T, U]) TakeTAndUCallsChan(ctx context.Context) <-chan []interface{} {
+	return fake.callsChan(ctx, "TakeTAndU")
+}
+
 func (fake *FakeGenericInterfaceMultipleTypes[T, U]) TakeTAndUCalls(stub func(T, U)) {
 	fake.takeTAndUMutex.Lock()
 	defer fake.takeTAndUMutex.Unlock()
 	fake.TakeTAndUStub = stub
 }
 
+// TakeTAndUCallsWhen calls the stub for the calls whose arguments match.
+// Matchers are checked before the stub, and the matcher registered last wins.
+func (fake *FakeGenericInterfaceMultipleTypes[T, U]) TakeTAndUCallsWhen(matcher func(T, U) bool, stub func(T, U)) {
+	fake.takeTAndUMutex.Lock()
+	defer fake.takeTAndUMutex.Unlock()
+	fake.takeTAndUWhen = append(fake.takeTAndUWhen, struct {
+		matcher func(T, U) bool
+		stub    func(T, U)
+	}{matcher, stub})
+}
+
 func (fake *FakeGenericInterfaceMultipleTypes[T, U]) TakeTAndUArgsForCall(i int) (T, U) {
 	fake.takeTAndUMutex.RLock()
 	defer fake.takeTAndUMutex.RUnlock()
@@ -610,14 +1239,54 @@ func (fake *FakeGenericInterfaceMultipleTypes[T, U]) TakeTAndUArgsForCall(i int)
 	return argsForCall.arg1, argsForCall.arg2
 }
 
+func (fake *FakeGenericInterfaceMultipleTypes[T, U]) TakeTAndUCallHistory() []FakeGenericInterfaceMultipleTypesTakeTAndUCall[T, U] {
+	fake.takeTAndUMutex.RLock()
+	defer fake.takeTAndUMutex.RUnlock()
+	history := make([]FakeGenericInterfaceMultipleTypesTakeTAndUCall[T, U], len(fake.takeTAndUArgsForCall))
+	for i, argsForCall := range fake.takeTAndUArgsForCall {
+		history[i] = FakeGenericInterfaceMultipleTypesTakeTAndUCall[T, U]{argsForCall.arg1, argsForCall.arg2}
+	}
+	return history
+}
+
+func (fake *FakeGenericInterfaceMultipleTypes[T, U]) ResetTakeTAndU() {
+	fake.ResetTakeTAndUCalls()
+	fake.ResetTakeTAndUStubs()
+}
+
+func (fake *FakeGenericInterfaceMultipleTypes[T, U]) ResetTakeTAndUCalls() {
+	fake.takeTAndUMutex.Lock()
+	defer fake.takeTAndUMutex.Unlock()
+	fake.takeTAndUArgsForCall = nil
+	fake.forgetInvocations("TakeTAndU")
+}
+
+func (fake *FakeGenericInterfaceMultipleTypes[T, U]) ResetTakeTAndUStubs() {
+	fake.takeTAndUMutex.Lock()
+	defer fake.takeTAndUMutex.Unlock()
+	fake.TakeTAndUStub = nil
+	fake.takeTAndUWhen = nil
+}
+
+type FakeGenericInterfaceMultipleTypesTakeUCall[T genericinterface.CustomTypeT, U genericinterface.CustomTypeU] struct {
+	Arg1 U
+}
+
 func (fake *FakeGenericInterfaceMultipleTypes[T, U]) TakeU(arg1 U) {
 	fake.takeUMutex.Lock()
 	fake.takeUArgsForCall = append(fake.takeUArgsForCall, struct {
 		arg1 U
 	}{arg1})
 	stub := fake.TakeUStub
+	whens := fake.takeUWhen
 	fake.recordInvocation("TakeU", []interface{}{arg1})
 	fake.takeUMutex.Unlock()
+	for i := len(whens) - 1; i >= 0; i-- {
+		if whens[i].matcher(arg1) {
+			whens[i].stub(arg1)
+			return
+		}
+	}
 	if stub != nil {
 		fake.TakeUStub(arg1)
 	}
@@ -629,12 +1298,31 @@ func (fake *FakeGenericInterfaceMultipleTypes[T, U]) TakeUCallCount() int {
 	return len(fake.takeUArgsForCall)
 }
 
+func (fake *FakeGenericInterfaceMultipleTypes[T, U]) WaitForTakeUCalls(ctx context.Context, n int) error {
+	return fake.waitForCalls(ctx, fake.TakeUCallCount, n)
+}
+
+func (fake *FakeGenericInterfaceMultipleTypes[T, U]) TakeUCallsChan(ctx context.Context) <-chan []interface{} {
+	return fake.callsChan(ctx, "TakeU")
+}
+
 func (fake *FakeGenericInterfaceMultipleTypes[T, U]) TakeUCalls(stub func(U)) {
 	fake.takeUMutex.Lock()
 	defer fake.takeUMutex.Unlock()
 	fake.TakeUStub = stub
 }
 
+// TakeUCallsWhen calls the stub for the calls whose arguments match.
+// Matchers are checked before the stub, and the matcher registered last wins.
+func (fake *FakeGenericInterfaceMultipleTypes[T, U]) TakeUCallsWhen(matcher func(U) bool, stub func(U)) {
+	fake.takeUMutex.Lock()
+	defer fake.takeUMutex.Unlock()
+	fake.takeUWhen = append(fake.takeUWhen, struct {
+		matcher func(U) bool
+		stub    func(U)
+	}{matcher, stub})
+}
+
 func (fake *FakeGenericInterfaceMultipleTypes[T, U]) TakeUArgsForCall(i int) U {
 	fake.takeUMutex.RLock()
 	defer fake.takeUMutex.RUnlock()
@@ -642,6 +1330,68 @@ func (fake *FakeGenericInterfaceMultipleTypes[T, U]) TakeUArgsForCall(i int) U {
 	return argsForCall.arg1
 }
 
+func (fake *FakeGenericInterfaceMultipleTypes[T, U]) TakeUCallHistory() []FakeGenericInterfaceMultipleTypesTakeUCall[T, U] {
+	fake.takeUMutex.RLock()
+	defer fake.takeUMutex.RUnlock()
+	history := make([]FakeGenericInterfaceMultipleTypesTakeUCall[T, U], len(fake.takeUArgsForCall))
+	for i, argsForCall := range fake.takeUArgsForCall {
+		history[i] = FakeGenericInterfaceMultipleTypesTakeUCall[T, U]{argsForCall.arg1}
+	}
+	return history
+}
+
+func (fake *FakeGenericInterfaceMultipleTypes[T, U]) ResetTakeU() {
+	fake.ResetTakeUCalls()
+	fake.ResetTakeUStubs()
+}
+
+func (fake *FakeGenericInterfaceMultipleTypes[T, U]) ResetTakeUCalls() {
+	fake.takeUMutex.Lock()
+	defer fake.takeUMutex.Unlock()
+	fake.takeUArgsForCall = nil
+	fake.forgetInvocations("TakeU")
+}
+
+func (fake *FakeGenericInterfaceMultipleTypes[T, U]) ResetTakeUStubs() {
+	fake.takeUMutex.Lock()
+	defer fake.takeUMutex.Unlock()
+	fake.TakeUStub = nil
+	fake.takeUWhen = nil
+}
+
+func (fake *FakeGenericInterfaceMultipleTypes[T, U]) Reset() {
+	fake.ResetCalls()
+	fake.ResetStubs()
+}
+
+func (fake *FakeGenericInterfaceMultipleTypes[T, U]) ResetCalls() {
+	fake.ResetDoSomethingCalls()
+	fake.ResetReturnTCalls()
+	fake.ResetReturnTAndUCalls()
+	fake.ResetReturnUCalls()
+	fake.ResetTakeAndReturnTCalls()
+	fake.ResetTakeAndReturnTAndUCalls()
+	fake.ResetTakeAndReturnUCalls()
+	fake.ResetTakeTCalls()
+	fake.ResetTakeTAndReturnUCalls()
+	fake.ResetTakeTAndUCalls()
+	fake.ResetTakeUCalls()
+}
+
+func (fake *FakeGenericInterfaceMultipleTypes[T, U]) ResetStubs() {
+	fake.ResetDoSomethingStubs()
+	fake.ResetReturnTStubs()
+	fake.ResetReturnTAndUStubs()
+	fake.ResetReturnUStubs()
+	fake.ResetTakeAndReturnTStubs()
+	fake.ResetTakeAndReturnTAndUStubs()
+	fake.ResetTakeAndReturnUStubs()
+	fake.ResetTakeTStubs()
+	fake.ResetTakeTAndReturnUStubs()
+	fake.ResetTakeTAndUStubs()
+	fake.ResetTakeUStubs()
+}
+
 func (fake *FakeGenericInterfaceMultipleTypes[T, U]) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -652,6 +1402,109 @@ func (fake *FakeGenericInterfaceMultipleTypes[T, U]) Invocations() map[string][]
 	return copiedInvocations
 }
 
+func (fake *FakeGenericInterfaceMultipleTypes[T, U]) argsMatch(expected []interface{}, actual []interface{}) bool {
+	if len(expected) != len(actual) {
+		return false
+	}
+	for i := range expected {
+		if fake.ArgsComparer != nil && !fake.ArgsComparer(expected[i], actual[i]) || fake.ArgsComparer == nil && !reflect.DeepEqual(expected[i], actual[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (fake *FakeGenericInterfaceMultipleTypes[T, U]) OrderedInvocations() []struct {
+	Seq    uint64
+	Method string
+	Args   []interface{}
+} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	return append([]struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}{}, fake.orderedInvocations...)
+}
+
+func (fake *FakeGenericInterfaceMultipleTypes[T, U]) SetSequencer(sequencer *atomic.Uint64) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	fake.sequencer = sequencer
+}
+
+func (fake *FakeGenericInterfaceMultipleTypes[T, U]) waitForCalls(ctx context.Context, callCount func() int, n int) error {
+	for {
+		fake.invocationsMutex.Lock()
+		if fake.invocationsChanged == nil {
+			fake.invocationsChanged = make(chan struct{})
+		}
+		changed := fake.invocationsChanged
+		fake.invocationsMutex.Unlock()
+		if callCount() >= n {
+			return nil
+		}
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (fake *FakeGenericInterfaceMultipleTypes[T, U]) callsChan(ctx context.Context, key string) <-chan []interface{} {
+	ctx, cancel := context.WithCancel(ctx)
+	calls := make(chan []interface{})
+	ready := make(chan struct{}, 1)
+	var pendingMutex sync.Mutex
+	var pending [][]interface{}
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.callsSubscribers == nil {
+		fake.callsSubscribers = map[string][]struct {
+			publish func(args []interface{})
+			cancel  context.CancelFunc
+			done    <-chan struct{}
+		}{}
+	}
+	fake.callsSubscribers[key] = append(fake.callsSubscribers[key], struct {
+		publish func(args []interface{})
+		cancel  context.CancelFunc
+		done    <-chan struct{}
+	}{func(args []interface{}) {
+		pendingMutex.Lock()
+		pending = append(pending, args)
+		pendingMutex.Unlock()
+		select {
+		case ready <- struct{}{}:
+		default:
+		}
+	}, cancel, ctx.Done()})
+	go func() {
+		defer close(calls)
+		for {
+			select {
+			case <-ready:
+			case <-ctx.Done():
+				return
+			}
+			pendingMutex.Lock()
+			queued := pending
+			pending = nil
+			pendingMutex.Unlock()
+			for _, args := range queued {
+				select {
+				case calls <- args:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return calls
+}
+
 func (fake *FakeGenericInterfaceMultipleTypes[T, U]) recordInvocation(key string, args []interface{}) {
 	fake.invocationsMutex.Lock()
 	defer fake.invocationsMutex.Unlock()
@@ -662,6 +1515,54 @@ func (fake *FakeGenericInterfaceMultipleTypes[T, U]) recordInvocation(key string
 		fake.invocations[key] = [][]interface{}{}
 	}
 	fake.invocations[key] = append(fake.invocations[key], args)
+	if fake.sequencer == nil {
+		fake.sequencer = new(atomic.Uint64)
+	}
+	fake.orderedInvocations = append(fake.orderedInvocations, struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}{fake.sequencer.Add(1), key, args})
+	if fake.invocationsChanged != nil {
+		close(fake.invocationsChanged)
+		fake.invocationsChanged = nil
+	}
+	subscribers := fake.callsSubscribers[key][:0]
+	for _, subscriber := range fake.callsSubscribers[key] {
+		select {
+		case <-subscriber.done:
+			continue
+		default:
+		}
+		subscriber.publish(args)
+		subscribers = append(subscribers, subscriber)
+	}
+	if len(subscribers) > 0 {
+		fake.callsSubscribers[key] = subscribers
+	} else {
+		delete(fake.callsSubscribers, key)
+	}
+}
+
+func (fake *FakeGenericInterfaceMultipleTypes[T, U]) forgetInvocations(key string) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	delete(fake.invocations, key)
+	for _, subscriber := range fake.callsSubscribers[key] {
+		subscriber.cancel()
+	}
+	delete(fake.callsSubscribers, key)
+	var orderedInvocations []struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}
+	for _, invocation := range fake.orderedInvocations {
+		if invocation.Method != key {
+			orderedInvocations = append(orderedInvocations, invocation)
+		}
+	}
+	fake.orderedInvocations = orderedInvocations
 }
 
 var _ genericinterface.GenericInterfaceMultipleTypes[genericinterface.CustomTypeT, genericinterface.CustomTypeU] = new(FakeGenericInterfaceMultipleTypes[genericinterface.CustomTypeT, genericinterface.CustomTypeU])
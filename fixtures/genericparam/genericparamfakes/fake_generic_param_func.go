@@ -2,7 +2,10 @@
 package genericparamfakes
 
 import (
+	"context"
+	"reflect"
 	"sync"
+	"sync/atomic"
 
 	"github.com/maxbrunsfeld/counterfeiter/v6/fixtures/genericparam"
 	"github.com/maxbrunsfeld/counterfeiter/v6/fixtures/genericparam/genericparamtype"
@@ -21,10 +24,31 @@ type FakeGenericParamFunc struct {
 	returnsOnCall map[int]struct {
 		result1 genericparam.Generic[genericreturntype.R]
 	}
-	invocations      map[string][][]interface{}
+	returnsForArgs []struct {
+		args    []interface{}
+		result1 genericparam.Generic[genericreturntype.R]
+	}
+	ArgsComparer       func(expected interface{}, actual interface{}) bool
+	invocations        map[string][][]interface{}
+	orderedInvocations []struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}
+	sequencer          *atomic.Uint64
+	invocationsChanged chan struct{}
+	callsSubscribers   []struct {
+		publish func(args []interface{})
+		cancel  context.CancelFunc
+		done    <-chan struct{}
+	}
 	invocationsMutex sync.RWMutex
 }
 
+type FakeGenericParamFuncCall struct {
+	Arg1 genericparam.Generic[genericparamtype.T]
+}
+
 func (fake *FakeGenericParamFunc) Spy(arg1 genericparam.Generic[genericparamtype.T]) genericparam.Generic[genericreturntype.R] {
 	fake.mutex.Lock()
 	ret, specificReturn := fake.returnsOnCall[len(fake.argsForCall)]
@@ -32,9 +56,15 @@ func (fake *FakeGenericParamFunc) Spy(arg1 genericparam.Generic[genericparamtype
 		arg1 genericparam.Generic[genericparamtype.T]
 	}{arg1})
 	stub := fake.Stub
+	returnsForArgs := fake.returnsForArgs
 	returns := fake.returns
 	fake.recordInvocation("GenericParamFunc", []interface{}{arg1})
 	fake.mutex.Unlock()
+	for i := len(returnsForArgs) - 1; i >= 0; i-- {
+		if fake.argsMatch(returnsForArgs[i].args, []interface{}{arg1}) {
+			return returnsForArgs[i].result1
+		}
+	}
 	if stub != nil {
 		return stub(arg1)
 	}
@@ -50,6 +80,70 @@ func (fake *FakeGenericParamFunc) CallCount() int {
 	return len(fake.argsForCall)
 }
 
+func (fake *FakeGenericParamFunc) WaitForCalls(ctx context.Context, n int) error {
+	for {
+		fake.invocationsMutex.Lock()
+		if fake.invocationsChanged == nil {
+			fake.invocationsChanged = make(chan struct{})
+		}
+		changed := fake.invocationsChanged
+		fake.invocationsMutex.Unlock()
+		if fake.CallCount() >= n {
+			return nil
+		}
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (fake *FakeGenericParamFunc) CallsChan(ctx context.Context) <-chan []interface{} {
+	ctx, cancel := context.WithCancel(ctx)
+	calls := make(chan []interface{})
+	ready := make(chan struct{}, 1)
+	var pendingMutex sync.Mutex
+	var pending [][]interface{}
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	fake.callsSubscribers = append(fake.callsSubscribers, struct {
+		publish func(args []interface{})
+		cancel  context.CancelFunc
+		done    <-chan struct{}
+	}{func(args []interface{}) {
+		pendingMutex.Lock()
+		pending = append(pending, args)
+		pendingMutex.Unlock()
+		select {
+		case ready <- struct{}{}:
+		default:
+		}
+	}, cancel, ctx.Done()})
+	go func() {
+		defer close(calls)
+		for {
+			select {
+			case <-ready:
+			case <-ctx.Done():
+				return
+			}
+			pendingMutex.Lock()
+			queued := pending
+			pending = nil
+			pendingMutex.Unlock()
+			for _, args := range queued {
+				select {
+				case calls <- args:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return calls
+}
+
 func (fake *FakeGenericParamFunc) Calls(stub func(genericparam.Generic[genericparamtype.T]) genericparam.Generic[genericreturntype.R]) {
 	fake.mutex.Lock()
 	defer fake.mutex.Unlock()
@@ -62,6 +156,16 @@ func (fake *FakeGenericParamFunc) ArgsForCall(i int) genericparam.Generic[generi
 	return fake.argsForCall[i].arg1
 }
 
+func (fake *FakeGenericParamFunc) CallHistory() []FakeGenericParamFuncCall {
+	fake.mutex.RLock()
+	defer fake.mutex.RUnlock()
+	history := make([]FakeGenericParamFuncCall, len(fake.argsForCall))
+	for i, argsForCall := range fake.argsForCall {
+		history[i] = FakeGenericParamFuncCall{argsForCall.arg1}
+	}
+	return history
+}
+
 func (fake *FakeGenericParamFunc) Returns(result1 genericparam.Generic[genericreturntype.R]) {
 	fake.mutex.Lock()
 	defer fake.mutex.Unlock()
@@ -85,6 +189,52 @@ func (fake *FakeGenericParamFunc) ReturnsOnCall(i int, result1 genericparam.Gene
 	}{result1}
 }
 
+// ReturnsForArgs returns the values for the calls with the given arguments.
+// The arguments are checked before the stub, and the arguments registered last
+// win.
+func (fake *FakeGenericParamFunc) ReturnsForArgs(arg1 genericparam.Generic[genericparamtype.T]) func(genericparam.Generic[genericreturntype.R]) {
+	args := []interface{}{arg1}
+	return func(result1 genericparam.Generic[genericreturntype.R]) {
+		fake.mutex.Lock()
+		defer fake.mutex.Unlock()
+		fake.Stub = nil
+		fake.returnsForArgs = append(fake.returnsForArgs, struct {
+			args    []interface{}
+			result1 genericparam.Generic[genericreturntype.R]
+		}{args, result1})
+	}
+}
+
+func (fake *FakeGenericParamFunc) Reset() {
+	fake.ResetCalls()
+	fake.ResetStubs()
+}
+
+func (fake *FakeGenericParamFunc) ResetCalls() {
+	fake.mutex.Lock()
+	fake.argsForCall = nil
+	fake.mutex.Unlock()
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	fake.invocations = nil
+	fake.orderedInvocations = nil
+	for _, subscriber := range fake.callsSubscribers {
+		subscriber.cancel()
+	}
+	fake.callsSubscribers = nil
+}
+
+func (fake *FakeGenericParamFunc) ResetStubs() {
+	fake.mutex.Lock()
+	defer fake.mutex.Unlock()
+	fake.Stub = nil
+	fake.returns = struct {
+		result1 genericparam.Generic[genericreturntype.R]
+	}{}
+	fake.returnsOnCall = nil
+	fake.returnsForArgs = nil
+}
+
 func (fake *FakeGenericParamFunc) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -97,6 +247,38 @@ func (fake *FakeGenericParamFunc) Invocations() map[string][][]interface{} {
 	return copiedInvocations
 }
 
+func (fake *FakeGenericParamFunc) OrderedInvocations() []struct {
+	Seq    uint64
+	Method string
+	Args   []interface{}
+} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	return append([]struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}{}, fake.orderedInvocations...)
+}
+
+func (fake *FakeGenericParamFunc) SetSequencer(sequencer *atomic.Uint64) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	fake.sequencer = sequencer
+}
+
+func (fake *FakeGenericParamFunc) argsMatch(expected []interface{}, actual []interface{}) bool {
+	if len(expected) != len(actual) {
+		return false
+	}
+	for i := range expected {
+		if fake.ArgsComparer != nil && !fake.ArgsComparer(expected[i], actual[i]) || fake.ArgsComparer == nil && !reflect.DeepEqual(expected[i], actual[i]) {
+			return false
+		}
+	}
+	return true
+}
+
 func (fake *FakeGenericParamFunc) recordInvocation(key string, args []interface{}) {
 	fake.invocationsMutex.Lock()
 	defer fake.invocationsMutex.Unlock()
@@ -107,6 +289,29 @@ func (fake *FakeGenericParamFunc) recordInvocation(key string, args []interface{
 		fake.invocations[key] = [][]interface{}{}
 	}
 	fake.invocations[key] = append(fake.invocations[key], args)
+	if fake.sequencer == nil {
+		fake.sequencer = new(atomic.Uint64)
+	}
+	fake.orderedInvocations = append(fake.orderedInvocations, struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}{fake.sequencer.Add(1), key, args})
+	if fake.invocationsChanged != nil {
+		close(fake.invocationsChanged)
+		fake.invocationsChanged = nil
+	}
+	subscribers := fake.callsSubscribers[:0]
+	for _, subscriber := range fake.callsSubscribers {
+		select {
+		case <-subscriber.done:
+			continue
+		default:
+		}
+		subscriber.publish(args)
+		subscribers = append(subscribers, subscriber)
+	}
+	fake.callsSubscribers = subscribers
 }
 
 var _ genericparam.GenericParamFunc = new(FakeGenericParamFunc).Spy
@@ -2,7 +2,10 @@
 package genericparamfakes
 
 import (
+	"context"
+	"reflect"
 	"sync"
+	"sync/atomic"
 
 	"github.com/maxbrunsfeld/counterfeiter/v6/fixtures/genericparam"
 	"github.com/maxbrunsfeld/counterfeiter/v6/fixtures/genericparam/genericparamtype"
@@ -15,16 +18,37 @@ type FakeGenericParamInterface struct {
 	doSomethingArgsForCall []struct {
 		arg1 genericparam.Generic[genericparamtype.T]
 	}
+	doSomethingWhen []struct {
+		matcher func(genericparam.Generic[genericparamtype.T]) bool
+		stub    func(genericparam.Generic[genericparamtype.T]) genericparam.Generic[genericreturntype.R]
+	}
 	doSomethingReturns struct {
 		result1 genericparam.Generic[genericreturntype.R]
 	}
 	doSomethingReturnsOnCall map[int]struct {
 		result1 genericparam.Generic[genericreturntype.R]
 	}
-	invocations      map[string][][]interface{}
+	ArgsComparer       func(expected interface{}, actual interface{}) bool
+	invocations        map[string][][]interface{}
+	orderedInvocations []struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}
+	sequencer          *atomic.Uint64
+	invocationsChanged chan struct{}
+	callsSubscribers   map[string][]struct {
+		publish func(args []interface{})
+		cancel  context.CancelFunc
+		done    <-chan struct{}
+	}
 	invocationsMutex sync.RWMutex
 }
 
+type FakeGenericParamInterfaceDoSomethingCall struct {
+	Arg1 genericparam.Generic[genericparamtype.T]
+}
+
 func (fake *FakeGenericParamInterface) DoSomething(arg1 genericparam.Generic[genericparamtype.T]) genericparam.Generic[genericreturntype.R] {
 	fake.doSomethingMutex.Lock()
 	ret, specificReturn := fake.doSomethingReturnsOnCall[len(fake.doSomethingArgsForCall)]
@@ -32,9 +56,15 @@ func (fake *FakeGenericParamInterface) DoSomething(arg1 genericparam.Generic[gen
 		arg1 genericparam.Generic[genericparamtype.T]
 	}{arg1})
 	stub := fake.DoSomethingStub
+	whens := fake.doSomethingWhen
 	fakeReturns := fake.doSomethingReturns
 	fake.recordInvocation("DoSomething", []interface{}{arg1})
 	fake.doSomethingMutex.Unlock()
+	for i := len(whens) - 1; i >= 0; i-- {
+		if whens[i].matcher(arg1) {
+			return whens[i].stub(arg1)
+		}
+	}
 	if stub != nil {
 		return stub(arg1)
 	}
@@ -50,12 +80,31 @@ func (fake *FakeGenericParamInterface) DoSomethingCallCount() int {
 	return len(fake.doSomethingArgsForCall)
 }
 
+func (fake *FakeGenericParamInterface) WaitForDoSomethingCalls(ctx context.Context, n int) error {
+	return fake.waitForCalls(ctx, fake.DoSomethingCallCount, n)
+}
+
+func (fake *FakeGenericParamInterface) DoSomethingCallsChan(ctx context.Context) <-chan []interface{} {
+	return fake.callsChan(ctx, "DoSomething")
+}
+
 func (fake *FakeGenericParamInterface) DoSomethingCalls(stub func(genericparam.Generic[genericparamtype.T]) genericparam.Generic[genericreturntype.R]) {
 	fake.doSomethingMutex.Lock()
 	defer fake.doSomethingMutex.Unlock()
 	fake.DoSomethingStub = stub
 }
 
+// DoSomethingCallsWhen calls the stub for the calls whose arguments match.
+// Matchers are checked before the stub, and the matcher registered last wins.
+func (fake *FakeGenericParamInterface) DoSomethingCallsWhen(matcher func(genericparam.Generic[genericparamtype.T]) bool, stub func(genericparam.Generic[genericparamtype.T]) genericparam.Generic[genericreturntype.R]) {
+	fake.doSomethingMutex.Lock()
+	defer fake.doSomethingMutex.Unlock()
+	fake.doSomethingWhen = append(fake.doSomethingWhen, struct {
+		matcher func(genericparam.Generic[genericparamtype.T]) bool
+		stub    func(genericparam.Generic[genericparamtype.T]) genericparam.Generic[genericreturntype.R]
+	}{matcher, stub})
+}
+
 func (fake *FakeGenericParamInterface) DoSomethingArgsForCall(i int) genericparam.Generic[genericparamtype.T] {
 	fake.doSomethingMutex.RLock()
 	defer fake.doSomethingMutex.RUnlock()
@@ -63,6 +112,16 @@ func (fake *FakeGenericParamInterface) DoSomethingArgsForCall(i int) genericpara
 	return argsForCall.arg1
 }
 
+func (fake *FakeGenericParamInterface) DoSomethingCallHistory() []FakeGenericParamInterfaceDoSomethingCall {
+	fake.doSomethingMutex.RLock()
+	defer fake.doSomethingMutex.RUnlock()
+	history := make([]FakeGenericParamInterfaceDoSomethingCall, len(fake.doSomethingArgsForCall))
+	for i, argsForCall := range fake.doSomethingArgsForCall {
+		history[i] = FakeGenericParamInterfaceDoSomethingCall{argsForCall.arg1}
+	}
+	return history
+}
+
 func (fake *FakeGenericParamInterface) DoSomethingReturns(result1 genericparam.Generic[genericreturntype.R]) {
 	fake.doSomethingMutex.Lock()
 	defer fake.doSomethingMutex.Unlock()
@@ -86,6 +145,74 @@ func (fake *FakeGenericParamInterface) DoSomethingReturnsOnCall(i int, result1 g
 	}{result1}
 }
 
+// DoSomethingReturnsForArgs returns the values for the calls with the given
+// arguments. Like DoSomethingCallsWhen, it is checked before the stub, and the
+// arguments or matcher registered last win.
+func (fake *FakeGenericParamInterface) DoSomethingReturnsForArgs(arg1 genericparam.Generic[genericparamtype.T]) func(genericparam.Generic[genericreturntype.R]) {
+	args := []interface{}{arg1}
+	return func(result1 genericparam.Generic[genericreturntype.R]) {
+		fake.doSomethingMutex.Lock()
+		defer fake.doSomethingMutex.Unlock()
+		fake.DoSomethingStub = nil
+		fake.doSomethingWhen = append(fake.doSomethingWhen, struct {
+			matcher func(genericparam.Generic[genericparamtype.T]) bool
+			stub    func(genericparam.Generic[genericparamtype.T]) genericparam.Generic[genericreturntype.R]
+		}{
+			func(arg1 genericparam.Generic[genericparamtype.T]) bool {
+				return fake.argsMatch(args, []interface{}{arg1})
+			},
+			func(genericparam.Generic[genericparamtype.T]) genericparam.Generic[genericreturntype.R] {
+				return result1
+			},
+		})
+	}
+}
+
+// DoSomethingReturnsWhen returns the values for the calls whose arguments
+// match. Like DoSomethingCallsWhen, it is checked before the stub, and the
+// matcher registered last wins.
+func (fake *FakeGenericParamInterface) DoSomethingReturnsWhen(matcher func(genericparam.Generic[genericparamtype.T]) bool, result1 genericparam.Generic[genericreturntype.R]) {
+	fake.DoSomethingCallsWhen(matcher, func(genericparam.Generic[genericparamtype.T]) genericparam.Generic[genericreturntype.R] {
+		return result1
+	})
+}
+
+func (fake *FakeGenericParamInterface) ResetDoSomething() {
+	fake.ResetDoSomethingCalls()
+	fake.ResetDoSomethingStubs()
+}
+
+func (fake *FakeGenericParamInterface) ResetDoSomethingCalls() {
+	fake.doSomethingMutex.Lock()
+	defer fake.doSomethingMutex.Unlock()
+	fake.doSomethingArgsForCall = nil
+	fake.forgetInvocations("DoSomething")
+}
+
+func (fake *FakeGenericParamInterface) ResetDoSomethingStubs() {
+	fake.doSomethingMutex.Lock()
+	defer fake.doSomethingMutex.Unlock()
+	fake.DoSomethingStub = nil
+	fake.doSomethingWhen = nil
+	fake.doSomethingReturns = struct {
+		result1 genericparam.Generic[genericreturntype.R]
+	}{}
+	fake.doSomethingReturnsOnCall = nil
+}
+
+func (fake *FakeGenericParamInterface) Reset() {
+	fake.ResetCalls()
+	fake.ResetStubs()
+}
+
+func (fake *FakeGenericParamInterface) ResetCalls() {
+	fake.ResetDoSomethingCalls()
+}
+
+func (fake *FakeGenericParamInterface) ResetStubs() {
+	fake.ResetDoSomethingStubs()
+}
+
 func (fake *FakeGenericParamInterface) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -96,6 +223,109 @@ func (fake *FakeGenericParamInterface) Invocations() map[string][][]interface{}
 	return copiedInvocations
 }
 
+func (fake *FakeGenericParamInterface) argsMatch(expected []interface{}, actual []interface{}) bool {
+	if len(expected) != len(actual) {
+		return false
+	}
+	for i := range expected {
+		if fake.ArgsComparer != nil && !fake.ArgsComparer(expected[i], actual[i]) || fake.ArgsComparer == nil && !reflect.DeepEqual(expected[i], actual[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (fake *FakeGenericParamInterface) OrderedInvocations() []struct {
+	Seq    uint64
+	Method string
+	Args   []interface{}
+} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	return append([]struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}{}, fake.orderedInvocations...)
+}
+
+func (fake *FakeGenericParamInterface) SetSequencer(sequencer *atomic.Uint64) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	fake.sequencer = sequencer
+}
+
+func (fake *FakeGenericParamInterface) waitForCalls(ctx context.Context, callCount func() int, n int) error {
+	for {
+		fake.invocationsMutex.Lock()
+		if fake.invocationsChanged == nil {
+			fake.invocationsChanged = make(chan struct{})
+		}
+		changed := fake.invocationsChanged
+		fake.invocationsMutex.Unlock()
+		if callCount() >= n {
+			return nil
+		}
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (fake *FakeGenericParamInterface) callsChan(ctx context.Context, key string) <-chan []interface{} {
+	ctx, cancel := context.WithCancel(ctx)
+	calls := make(chan []interface{})
+	ready := make(chan struct{}, 1)
+	var pendingMutex sync.Mutex
+	var pending [][]interface{}
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.callsSubscribers == nil {
+		fake.callsSubscribers = map[string][]struct {
+			publish func(args []interface{})
+			cancel  context.CancelFunc
+			done    <-chan struct{}
+		}{}
+	}
+	fake.callsSubscribers[key] = append(fake.callsSubscribers[key], struct {
+		publish func(args []interface{})
+		cancel  context.CancelFunc
+		done    <-chan struct{}
+	}{func(args []interface{}) {
+		pendingMutex.Lock()
+		pending = append(pending, args)
+		pendingMutex.Unlock()
+		select {
+		case ready <- struct{}{}:
+		default:
+		}
+	}, cancel, ctx.Done()})
+	go func() {
+		defer close(calls)
+		for {
+			select {
+			case <-ready:
+			case <-ctx.Done():
+				return
+			}
+			pendingMutex.Lock()
+			queued := pending
+			pending = nil
+			pendingMutex.Unlock()
+			for _, args := range queued {
+				select {
+				case calls <- args:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return calls
+}
+
 func (fake *FakeGenericParamInterface) recordInvocation(key string, args []interface{}) {
 	fake.invocationsMutex.Lock()
 	defer fake.invocationsMutex.Unlock()
@@ -106,6 +336,54 @@ func (fake *FakeGenericParamInterface) recordInvocation(key string, args []inter
 		fake.invocations[key] = [][]interface{}{}
 	}
 	fake.invocations[key] = append(fake.invocations[key], args)
+	if fake.sequencer == nil {
+		fake.sequencer = new(atomic.Uint64)
+	}
+	fake.orderedInvocations = append(fake.orderedInvocations, struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}{fake.sequencer.Add(1), key, args})
+	if fake.invocationsChanged != nil {
+		close(fake.invocationsChanged)
+		fake.invocationsChanged = nil
+	}
+	subscribers := fake.callsSubscribers[key][:0]
+	for _, subscriber := range fake.callsSubscribers[key] {
+		select {
+		case <-subscriber.done:
+			continue
+		default:
+		}
+		subscriber.publish(args)
+		subscribers = append(subscribers, subscriber)
+	}
+	if len(subscribers) > 0 {
+		fake.callsSubscribers[key] = subscribers
+	} else {
+		delete(fake.callsSubscribers, key)
+	}
+}
+
+func (fake *FakeGenericParamInterface) forgetInvocations(key string) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	delete(fake.invocations, key)
+	for _, subscriber := range fake.callsSubscribers[key] {
+		subscriber.cancel()
+	}
+	delete(fake.callsSubscribers, key)
+	var orderedInvocations []struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}
+	for _, invocation := range fake.orderedInvocations {
+		if invocation.Method != key {
+			orderedInvocations = append(orderedInvocations, invocation)
+		}
+	}
+	fake.orderedInvocations = orderedInvocations
 }
 
 var _ genericparam.GenericParamInterface = new(FakeGenericParamInterface)
@@ -0,0 +1,4 @@
+package fixtures
+
+//counterfeiter:generate -style gomock -fake-name GomockSomething . Something
+//counterfeiter:generate -style gomock -fake-name GomockHasVarArgs . HasVarArgs
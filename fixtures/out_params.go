@@ -0,0 +1,11 @@
+package fixtures
+
+//counterfeiter:generate . Scanner
+type Scanner interface {
+	Scan(dest ...interface{}) error
+	Decode(v any) error
+	Load(key string, into *Order) (bool, error)
+}
+
+//counterfeiter:generate . DecodeFunction
+type DecodeFunction func(data []byte, v interface{}) error
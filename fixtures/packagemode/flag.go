@@ -5,6 +5,8 @@ import "flag"
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -generate
 //counterfeiter:generate -p github.com/maxbrunsfeld/counterfeiter/v6/fixtures/packagemode
 //counterfeiter:generate -o flagcustomfakesdir -p github.com/maxbrunsfeld/counterfeiter/v6/fixtures/packagemode
+//counterfeiter:generate -o flagfilteredshim -include Arg,Bool.* -exclude BoolVar -instantiate Value[bool] -instantiate StringValue=Value[string] --fake-name Flags -p github.com/maxbrunsfeld/counterfeiter/v6/fixtures/packagemode
+//counterfeiter:generate -o flagcombinedshim -with-fake -include Arg,Args,NArg --fake-name Flags -p github.com/maxbrunsfeld/counterfeiter/v6/fixtures/packagemode flag
 
 func Arg(arg1 int) string {
 	return flag.Arg(arg1)
@@ -21,3 +23,23 @@ func Bool(arg1 string, arg2 bool, arg3 string) *bool {
 func BoolVar(arg1 *bool, arg2 string, arg3 bool, arg4 string) {
 	flag.BoolVar(arg1, arg2, arg3, arg4)
 }
+
+func Value[T any](arg1 string) (T, bool) {
+	var zero T
+	f := flag.Lookup(arg1)
+	if f == nil {
+		return zero, false
+	}
+	getter, ok := f.Value.(flag.Getter)
+	if !ok {
+		return zero, false
+	}
+	v, ok := getter.Get().(T)
+	return v, ok
+}
+
+type visitor func(*flag.Flag)
+
+func Visit(arg1 visitor) {
+	flag.Visit(arg1)
+}
@@ -0,0 +1,844 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package flagcombinedshimfakes
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"sync/atomic"
+
+	packagemodeshim "github.com/maxbrunsfeld/counterfeiter/v6/fixtures/packagemode/flagcombinedshim"
+)
+
+type FakeFlags struct {
+	FlagArgStub        func(int) string
+	flagArgMutex       sync.RWMutex
+	flagArgArgsForCall []struct {
+		arg1 int
+	}
+	flagArgWhen []struct {
+		matcher func(int) bool
+		stub    func(int) string
+	}
+	flagArgReturns struct {
+		result1 string
+	}
+	flagArgReturnsOnCall map[int]struct {
+		result1 string
+	}
+	FlagArgsStub        func() []string
+	flagArgsMutex       sync.RWMutex
+	flagArgsArgsForCall []struct {
+	}
+	flagArgsReturns struct {
+		result1 []string
+	}
+	flagArgsReturnsOnCall map[int]struct {
+		result1 []string
+	}
+	NArgStub        func() int
+	nArgMutex       sync.RWMutex
+	nArgArgsForCall []struct {
+	}
+	nArgReturns struct {
+		result1 int
+	}
+	nArgReturnsOnCall map[int]struct {
+		result1 int
+	}
+	PackagemodeArgStub        func(int) string
+	packagemodeArgMutex       sync.RWMutex
+	packagemodeArgArgsForCall []struct {
+		arg1 int
+	}
+	packagemodeArgWhen []struct {
+		matcher func(int) bool
+		stub    func(int) string
+	}
+	packagemodeArgReturns struct {
+		result1 string
+	}
+	packagemodeArgReturnsOnCall map[int]struct {
+		result1 string
+	}
+	PackagemodeArgsStub        func() []string
+	packagemodeArgsMutex       sync.RWMutex
+	packagemodeArgsArgsForCall []struct {
+	}
+	packagemodeArgsReturns struct {
+		result1 []string
+	}
+	packagemodeArgsReturnsOnCall map[int]struct {
+		result1 []string
+	}
+	ArgsComparer       func(expected interface{}, actual interface{}) bool
+	invocations        map[string][][]interface{}
+	orderedInvocations []struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}
+	sequencer          *atomic.Uint64
+	invocationsChanged chan struct{}
+	callsSubscribers   map[string][]struct {
+		publish func(args []interface{})
+		cancel  context.CancelFunc
+		done    <-chan struct{}
+	}
+	invocationsMutex sync.RWMutex
+}
+
+type FakeFlagsFlagArgCall struct {
+	Arg1 int
+}
+
+func (fake *FakeFlags) FlagArg(arg1 int) string {
+	fake.flagArgMutex.Lock()
+	ret, specificReturn := fake.flagArgReturnsOnCall[len(fake.flagArgArgsForCall)]
+	fake.flagArgArgsForCall = append(fake.flagArgArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	stub := fake.FlagArgStub
+	whens := fake.flagArgWhen
+	fakeReturns := fake.flagArgReturns
+	fake.recordInvocation("FlagArg", []interface{}{arg1})
+	fake.flagArgMutex.Unlock()
+	for i := len(whens) - 1; i >= 0; i-- {
+		if whens[i].matcher(arg1) {
+			return whens[i].stub(arg1)
+		}
+	}
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeFlags) FlagArgCallCount() int {
+	fake.flagArgMutex.RLock()
+	defer fake.flagArgMutex.RUnlock()
+	return len(fake.flagArgArgsForCall)
+}
+
+func (fake *FakeFlags) WaitForFlagArgCalls(ctx context.Context, n int) error {
+	return fake.waitForCalls(ctx, fake.FlagArgCallCount, n)
+}
+
+func (fake *FakeFlags) FlagArgCallsChan(ctx context.Context) <-chan []interface{} {
+	return fake.callsChan(ctx, "FlagArg")
+}
+
+func (fake *FakeFlags) FlagArgCalls(stub func(int) string) {
+	fake.flagArgMutex.Lock()
+	defer fake.flagArgMutex.Unlock()
+	fake.FlagArgStub = stub
+}
+
+// FlagArgCallsWhen calls the stub for the calls whose arguments match.
+// Matchers are checked before the stub, and the matcher registered last wins.
+func (fake *FakeFlags) FlagArgCallsWhen(matcher func(int) bool, stub func(int) string) {
+	fake.flagArgMutex.Lock()
+	defer fake.flagArgMutex.Unlock()
+	fake.flagArgWhen = append(fake.flagArgWhen, struct {
+		matcher func(int) bool
+		stub    func(int) string
+	}{matcher, stub})
+}
+
+func (fake *FakeFlags) FlagArgArgsForCall(i int) int {
+	fake.flagArgMutex.RLock()
+	defer fake.flagArgMutex.RUnlock()
+	argsForCall := fake.flagArgArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeFlags) FlagArgCallHistory() []FakeFlagsFlagArgCall {
+	fake.flagArgMutex.RLock()
+	defer fake.flagArgMutex.RUnlock()
+	history := make([]FakeFlagsFlagArgCall, len(fake.flagArgArgsForCall))
+	for i, argsForCall := range fake.flagArgArgsForCall {
+		history[i] = FakeFlagsFlagArgCall{argsForCall.arg1}
+	}
+	return history
+}
+
+func (fake *FakeFlags) FlagArgReturns(result1 string) {
+	fake.flagArgMutex.Lock()
+	defer fake.flagArgMutex.Unlock()
+	fake.FlagArgStub = nil
+	fake.flagArgReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeFlags) FlagArgReturnsOnCall(i int, result1 string) {
+	fake.flagArgMutex.Lock()
+	defer fake.flagArgMutex.Unlock()
+	fake.FlagArgStub = nil
+	if fake.flagArgReturnsOnCall == nil {
+		fake.flagArgReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.flagArgReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+// FlagArgReturnsForArgs returns the values for the calls with the given
+// arguments. Like FlagArgCallsWhen, it is checked before the stub, and the
+// arguments or matcher registered last win.
+func (fake *FakeFlags) FlagArgReturnsForArgs(arg1 int) func(string) {
+	args := []interface{}{arg1}
+	return func(result1 string) {
+		fake.flagArgMutex.Lock()
+		defer fake.flagArgMutex.Unlock()
+		fake.FlagArgStub = nil
+		fake.flagArgWhen = append(fake.flagArgWhen, struct {
+			matcher func(int) bool
+			stub    func(int) string
+		}{
+			func(arg1 int) bool {
+				return fake.argsMatch(args, []interface{}{arg1})
+			},
+			func(int) string {
+				return result1
+			},
+		})
+	}
+}
+
+// FlagArgReturnsWhen returns the values for the calls whose arguments
+// match. Like FlagArgCallsWhen, it is checked before the stub, and the
+// matcher registered last wins.
+func (fake *FakeFlags) FlagArgReturnsWhen(matcher func(int) bool, result1 string) {
+	fake.FlagArgCallsWhen(matcher, func(int) string {
+		return result1
+	})
+}
+
+func (fake *FakeFlags) ResetFlagArg() {
+	fake.ResetFlagArgCalls()
+	fake.ResetFlagArgStubs()
+}
+
+func (fake *FakeFlags) ResetFlagArgCalls() {
+	fake.flagArgMutex.Lock()
+	defer fake.flagArgMutex.Unlock()
+	fake.flagArgArgsForCall = nil
+	fake.forgetInvocations("FlagArg")
+}
+
+func (fake *FakeFlags) ResetFlagArgStubs() {
+	fake.flagArgMutex.Lock()
+	defer fake.flagArgMutex.Unlock()
+	fake.FlagArgStub = nil
+	fake.flagArgWhen = nil
+	fake.flagArgReturns = struct {
+		result1 string
+	}{}
+	fake.flagArgReturnsOnCall = nil
+}
+
+func (fake *FakeFlags) FlagArgs() []string {
+	fake.flagArgsMutex.Lock()
+	ret, specificReturn := fake.flagArgsReturnsOnCall[len(fake.flagArgsArgsForCall)]
+	fake.flagArgsArgsForCall = append(fake.flagArgsArgsForCall, struct {
+	}{})
+	stub := fake.FlagArgsStub
+	fakeReturns := fake.flagArgsReturns
+	fake.recordInvocation("FlagArgs", []interface{}{})
+	fake.flagArgsMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeFlags) FlagArgsCallCount() int {
+	fake.flagArgsMutex.RLock()
+	defer fake.flagArgsMutex.RUnlock()
+	return len(fake.flagArgsArgsForCall)
+}
+
+func (fake *FakeFlags) WaitForFlagArgsCalls(ctx context.Context, n int) error {
+	return fake.waitForCalls(ctx, fake.FlagArgsCallCount, n)
+}
+
+func (fake *FakeFlags) FlagArgsCallsChan(ctx context.Context) <-chan []interface{} {
+	return fake.callsChan(ctx, "FlagArgs")
+}
+
+func (fake *FakeFlags) FlagArgsCalls(stub func() []string) {
+	fake.flagArgsMutex.Lock()
+	defer fake.flagArgsMutex.Unlock()
+	fake.FlagArgsStub = stub
+}
+
+func (fake *FakeFlags) FlagArgsReturns(result1 []string) {
+	fake.flagArgsMutex.Lock()
+	defer fake.flagArgsMutex.Unlock()
+	fake.FlagArgsStub = nil
+	fake.flagArgsReturns = struct {
+		result1 []string
+	}{result1}
+}
+
+func (fake *FakeFlags) FlagArgsReturnsOnCall(i int, result1 []string) {
+	fake.flagArgsMutex.Lock()
+	defer fake.flagArgsMutex.Unlock()
+	fake.FlagArgsStub = nil
+	if fake.flagArgsReturnsOnCall == nil {
+		fake.flagArgsReturnsOnCall = make(map[int]struct {
+			result1 []string
+		})
+	}
+	fake.flagArgsReturnsOnCall[i] = struct {
+		result1 []string
+	}{result1}
+}
+
+func (fake *FakeFlags) ResetFlagArgs() {
+	fake.ResetFlagArgsCalls()
+	fake.ResetFlagArgsStubs()
+}
+
+func (fake *FakeFlags) ResetFlagArgsCalls() {
+	fake.flagArgsMutex.Lock()
+	defer fake.flagArgsMutex.Unlock()
+	fake.flagArgsArgsForCall = nil
+	fake.forgetInvocations("FlagArgs")
+}
+
+func (fake *FakeFlags) ResetFlagArgsStubs() {
+	fake.flagArgsMutex.Lock()
+	defer fake.flagArgsMutex.Unlock()
+	fake.FlagArgsStub = nil
+	fake.flagArgsReturns = struct {
+		result1 []string
+	}{}
+	fake.flagArgsReturnsOnCall = nil
+}
+
+func (fake *FakeFlags) NArg() int {
+	fake.nArgMutex.Lock()
+	ret, specificReturn := fake.nArgReturnsOnCall[len(fake.nArgArgsForCall)]
+	fake.nArgArgsForCall = append(fake.nArgArgsForCall, struct {
+	}{})
+	stub := fake.NArgStub
+	fakeReturns := fake.nArgReturns
+	fake.recordInvocation("NArg", []interface{}{})
+	fake.nArgMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeFlags) NArgCallCount() int {
+	fake.nArgMutex.RLock()
+	defer fake.nArgMutex.RUnlock()
+	return len(fake.nArgArgsForCall)
+}
+
+func (fake *FakeFlags) WaitForNArgCalls(ctx context.Context, n int) error {
+	return fake.waitForCalls(ctx, fake.NArgCallCount, n)
+}
+
+func (fake *FakeFlags) NArgCallsChan(ctx context.Context) <-chan []interface{} {
+	return fake.callsChan(ctx, "NArg")
+}
+
+func (fake *FakeFlags) NArgCalls(stub func() int) {
+	fake.nArgMutex.Lock()
+	defer fake.nArgMutex.Unlock()
+	fake.NArgStub = stub
+}
+
+func (fake *FakeFlags) NArgReturns(result1 int) {
+	fake.nArgMutex.Lock()
+	defer fake.nArgMutex.Unlock()
+	fake.NArgStub = nil
+	fake.nArgReturns = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeFlags) NArgReturnsOnCall(i int, result1 int) {
+	fake.nArgMutex.Lock()
+	defer fake.nArgMutex.Unlock()
+	fake.NArgStub = nil
+	if fake.nArgReturnsOnCall == nil {
+		fake.nArgReturnsOnCall = make(map[int]struct {
+			result1 int
+		})
+	}
+	fake.nArgReturnsOnCall[i] = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeFlags) ResetNArg() {
+	fake.ResetNArgCalls()
+	fake.ResetNArgStubs()
+}
+
+func (fake *FakeFlags) ResetNArgCalls() {
+	fake.nArgMutex.Lock()
+	defer fake.nArgMutex.Unlock()
+	fake.nArgArgsForCall = nil
+	fake.forgetInvocations("NArg")
+}
+
+func (fake *FakeFlags) ResetNArgStubs() {
+	fake.nArgMutex.Lock()
+	defer fake.nArgMutex.Unlock()
+	fake.NArgStub = nil
+	fake.nArgReturns = struct {
+		result1 int
+	}{}
+	fake.nArgReturnsOnCall = nil
+}
+
+type FakeFlagsPackagemodeArgCall struct {
+	Arg1 int
+}
+
+func (fake *FakeFlags) PackagemodeArg(arg1 int) string {
+	fake.packagemodeArgMutex.Lock()
+	ret, specificReturn := fake.packagemodeArgReturnsOnCall[len(fake.packagemodeArgArgsForCall)]
+	fake.packagemodeArgArgsForCall = append(fake.packagemodeArgArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	stub := fake.PackagemodeArgStub
+	whens := fake.packagemodeArgWhen
+	fakeReturns := fake.packagemodeArgReturns
+	fake.recordInvocation("PackagemodeArg", []interface{}{arg1})
+	fake.packagemodeArgMutex.Unlock()
+	for i := len(whens) - 1; i >= 0; i-- {
+		if whens[i].matcher(arg1) {
+			return whens[i].stub(arg1)
+		}
+	}
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeFlags) PackagemodeArgCallCount() int {
+	fake.packagemodeArgMutex.RLock()
+	defer fake.packagemodeArgMutex.RUnlock()
+	return len(fake.packagemodeArgArgsForCall)
+}
+
+func (fake *FakeFlags) WaitForPackagemodeArgCalls(ctx context.Context, n int) error {
+	return fake.waitForCalls(ctx, fake.PackagemodeArgCallCount, n)
+}
+
+func (fake *FakeFlags) PackagemodeArgCallsChan(ctx context.Context) <-chan []interface{} {
+	return fake.callsChan(ctx, "PackagemodeArg")
+}
+
+func (fake *FakeFlags) PackagemodeArgCalls(stub func(int) string) {
+	fake.packagemodeArgMutex.Lock()
+	defer fake.packagemodeArgMutex.Unlock()
+	fake.PackagemodeArgStub = stub
+}
+
+// PackagemodeArgCallsWhen calls the stub for the calls whose arguments match.
+// Matchers are checked before the stub, and the matcher registered last wins.
+func (fake *FakeFlags) PackagemodeArgCallsWhen(matcher func(int) bool, stub func(int) string) {
+	fake.packagemodeArgMutex.Lock()
+	defer fake.packagemodeArgMutex.Unlock()
+	fake.packagemodeArgWhen = append(fake.packagemodeArgWhen, struct {
+		matcher func(int) bool
+		stub    func(int) string
+	}{matcher, stub})
+}
+
+func (fake *FakeFlags) PackagemodeArgArgsForCall(i int) int {
+	fake.packagemodeArgMutex.RLock()
+	defer fake.packagemodeArgMutex.RUnlock()
+	argsForCall := fake.packagemodeArgArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeFlags) PackagemodeArgCallHistory() []FakeFlagsPackagemodeArgCall {
+	fake.packagemodeArgMutex.RLock()
+	defer fake.packagemodeArgMutex.RUnlock()
+	history := make([]FakeFlagsPackagemodeArgCall, len(fake.packagemodeArgArgsForCall))
+	for i, argsForCall := range fake.packagemodeArgArgsForCall {
+		history[i] = FakeFlagsPackagemodeArgCall{argsForCall.arg1}
+	}
+	return history
+}
+
+func (fake *FakeFlags) PackagemodeArgReturns(result1 string) {
+	fake.packagemodeArgMutex.Lock()
+	defer fake.packagemodeArgMutex.Unlock()
+	fake.PackagemodeArgStub = nil
+	fake.packagemodeArgReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeFlags) PackagemodeArgReturnsOnCall(i int, result1 string) {
+	fake.packagemodeArgMutex.Lock()
+	defer fake.packagemodeArgMutex.Unlock()
+	fake.PackagemodeArgStub = nil
+	if fake.packagemodeArgReturnsOnCall == nil {
+		fake.packagemodeArgReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.packagemodeArgReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+// PackagemodeArgReturnsForArgs returns the values for the calls with the given
+// arguments. Like PackagemodeArgCallsWhen, it is checked before the stub, and the
+// arguments or matcher registered last win.
+func (fake *FakeFlags) PackagemodeArgReturnsForArgs(arg1 int) func(string) {
+	args := []interface{}{arg1}
+	return func(result1 string) {
+		fake.packagemodeArgMutex.Lock()
+		defer fake.packagemodeArgMutex.Unlock()
+		fake.PackagemodeArgStub = nil
+		fake.packagemodeArgWhen = append(fake.packagemodeArgWhen, struct {
+			matcher func(int) bool
+			stub    func(int) string
+		}{
+			func(arg1 int) bool {
+				return fake.argsMatch(args, []interface{}{arg1})
+			},
+			func(int) string {
+				return result1
+			},
+		})
+	}
+}
+
+// PackagemodeArgReturnsWhen returns the values for the calls whose arguments
+// match. Like PackagemodeArgCallsWhen, it is checked before the stub, and the
+// matcher registered last wins.
+func (fake *FakeFlags) PackagemodeArgReturnsWhen(matcher func(int) bool, result1 string) {
+	fake.PackagemodeArgCallsWhen(matcher, func(int) string {
+		return result1
+	})
+}
+
+func (fake *FakeFlags) ResetPackagemodeArg() {
+	fake.ResetPackagemodeArgCalls()
+	fake.ResetPackagemodeArgStubs()
+}
+
+func (fake *FakeFlags) ResetPackagemodeArgCalls() {
+	fake.packagemodeArgMutex.Lock()
+	defer fake.packagemodeArgMutex.Unlock()
+	fake.packagemodeArgArgsForCall = nil
+	fake.forgetInvocations("PackagemodeArg")
+}
+
+func (fake *FakeFlags) ResetPackagemodeArgStubs() {
+	fake.packagemodeArgMutex.Lock()
+	defer fake.packagemodeArgMutex.Unlock()
+	fake.PackagemodeArgStub = nil
+	fake.packagemodeArgWhen = nil
+	fake.packagemodeArgReturns = struct {
+		result1 string
+	}{}
+	fake.packagemodeArgReturnsOnCall = nil
+}
+
+func (fake *FakeFlags) PackagemodeArgs() []string {
+	fake.packagemodeArgsMutex.Lock()
+	ret, specificReturn := fake.packagemodeArgsReturnsOnCall[len(fake.packagemodeArgsArgsForCall)]
+	fake.packagemodeArgsArgsForCall = append(fake.packagemodeArgsArgsForCall, struct {
+	}{})
+	stub := fake.PackagemodeArgsStub
+	fakeReturns := fake.packagemodeArgsReturns
+	fake.recordInvocation("PackagemodeArgs", []interface{}{})
+	fake.packagemodeArgsMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeFlags) PackagemodeArgsCallCount() int {
+	fake.packagemodeArgsMutex.RLock()
+	defer fake.packagemodeArgsMutex.RUnlock()
+	return len(fake.packagemodeArgsArgsForCall)
+}
+
+func (fake *FakeFlags) WaitForPackagemodeArgsCalls(ctx context.Context, n int) error {
+	return fake.waitForCalls(ctx, fake.PackagemodeArgsCallCount, n)
+}
+
+func (fake *FakeFlags) PackagemodeArgsCallsChan(ctx context.Context) <-chan []interface{} {
+	return fake.callsChan(ctx, "PackagemodeArgs")
+}
+
+func (fake *FakeFlags) PackagemodeArgsCalls(stub func() []string) {
+	fake.packagemodeArgsMutex.Lock()
+	defer fake.packagemodeArgsMutex.Unlock()
+	fake.PackagemodeArgsStub = stub
+}
+
+func (fake *FakeFlags) PackagemodeArgsReturns(result1 []string) {
+	fake.packagemodeArgsMutex.Lock()
+	defer fake.packagemodeArgsMutex.Unlock()
+	fake.PackagemodeArgsStub = nil
+	fake.packagemodeArgsReturns = struct {
+		result1 []string
+	}{result1}
+}
+
+func (fake *FakeFlags) PackagemodeArgsReturnsOnCall(i int, result1 []string) {
+	fake.packagemodeArgsMutex.Lock()
+	defer fake.packagemodeArgsMutex.Unlock()
+	fake.PackagemodeArgsStub = nil
+	if fake.packagemodeArgsReturnsOnCall == nil {
+		fake.packagemodeArgsReturnsOnCall = make(map[int]struct {
+			result1 []string
+		})
+	}
+	fake.packagemodeArgsReturnsOnCall[i] = struct {
+		result1 []string
+	}{result1}
+}
+
+func (fake *FakeFlags) ResetPackagemodeArgs() {
+	fake.ResetPackagemodeArgsCalls()
+	fake.ResetPackagemodeArgsStubs()
+}
+
+func (fake *FakeFlags) ResetPackagemodeArgsCalls() {
+	fake.packagemodeArgsMutex.Lock()
+	defer fake.packagemodeArgsMutex.Unlock()
+	fake.packagemodeArgsArgsForCall = nil
+	fake.forgetInvocations("PackagemodeArgs")
+}
+
+func (fake *FakeFlags) ResetPackagemodeArgsStubs() {
+	fake.packagemodeArgsMutex.Lock()
+	defer fake.packagemodeArgsMutex.Unlock()
+	fake.PackagemodeArgsStub = nil
+	fake.packagemodeArgsReturns = struct {
+		result1 []string
+	}{}
+	fake.packagemodeArgsReturnsOnCall = nil
+}
+
+func (fake *FakeFlags) Reset() {
+	fake.ResetCalls()
+	fake.ResetStubs()
+}
+
+func (fake *FakeFlags) ResetCalls() {
+	fake.ResetFlagArgCalls()
+	fake.ResetFlagArgsCalls()
+	fake.ResetNArgCalls()
+	fake.ResetPackagemodeArgCalls()
+	fake.ResetPackagemodeArgsCalls()
+}
+
+func (fake *FakeFlags) ResetStubs() {
+	fake.ResetFlagArgStubs()
+	fake.ResetFlagArgsStubs()
+	fake.ResetNArgStubs()
+	fake.ResetPackagemodeArgStubs()
+	fake.ResetPackagemodeArgsStubs()
+}
+
+func (fake *FakeFlags) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeFlags) argsMatch(expected []interface{}, actual []interface{}) bool {
+	if len(expected) != len(actual) {
+		return false
+	}
+	for i := range expected {
+		if fake.ArgsComparer != nil && !fake.ArgsComparer(expected[i], actual[i]) || fake.ArgsComparer == nil && !reflect.DeepEqual(expected[i], actual[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (fake *FakeFlags) OrderedInvocations() []struct {
+	Seq    uint64
+	Method string
+	Args   []interface{}
+} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	return append([]struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}{}, fake.orderedInvocations...)
+}
+
+func (fake *FakeFlags) SetSequencer(sequencer *atomic.Uint64) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	fake.sequencer = sequencer
+}
+
+func (fake *FakeFlags) waitForCalls(ctx context.Context, callCount func() int, n int) error {
+	for {
+		fake.invocationsMutex.Lock()
+		if fake.invocationsChanged == nil {
+			fake.invocationsChanged = make(chan struct{})
+		}
+		changed := fake.invocationsChanged
+		fake.invocationsMutex.Unlock()
+		if callCount() >= n {
+			return nil
+		}
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (fake *FakeFlags) callsChan(ctx context.Context, key string) <-chan []interface{} {
+	ctx, cancel := context.WithCancel(ctx)
+	calls := make(chan []interface{})
+	ready := make(chan struct{}, 1)
+	var pendingMutex sync.Mutex
+	var pending [][]interface{}
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.callsSubscribers == nil {
+		fake.callsSubscribers = map[string][]struct {
+			publish func(args []interface{})
+			cancel  context.CancelFunc
+			done    <-chan struct{}
+		}{}
+	}
+	fake.callsSubscribers[key] = append(fake.callsSubscribers[key], struct {
+		publish func(args []interface{})
+		cancel  context.CancelFunc
+		done    <-chan struct{}
+	}{func(args []interface{}) {
+		pendingMutex.Lock()
+		pending = append(pending, args)
+		pendingMutex.Unlock()
+		select {
+		case ready <- struct{}{}:
+		default:
+		}
+	}, cancel, ctx.Done()})
+	go func() {
+		defer close(calls)
+		for {
+			select {
+			case <-ready:
+			case <-ctx.Done():
+				return
+			}
+			pendingMutex.Lock()
+			queued := pending
+			pending = nil
+			pendingMutex.Unlock()
+			for _, args := range queued {
+				select {
+				case calls <- args:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return calls
+}
+
+func (fake *FakeFlags) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+	if fake.sequencer == nil {
+		fake.sequencer = new(atomic.Uint64)
+	}
+	fake.orderedInvocations = append(fake.orderedInvocations, struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}{fake.sequencer.Add(1), key, args})
+	if fake.invocationsChanged != nil {
+		close(fake.invocationsChanged)
+		fake.invocationsChanged = nil
+	}
+	subscribers := fake.callsSubscribers[key][:0]
+	for _, subscriber := range fake.callsSubscribers[key] {
+		select {
+		case <-subscriber.done:
+			continue
+		default:
+		}
+		subscriber.publish(args)
+		subscribers = append(subscribers, subscriber)
+	}
+	if len(subscribers) > 0 {
+		fake.callsSubscribers[key] = subscribers
+	} else {
+		delete(fake.callsSubscribers, key)
+	}
+}
+
+func (fake *FakeFlags) forgetInvocations(key string) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	delete(fake.invocations, key)
+	for _, subscriber := range fake.callsSubscribers[key] {
+		subscriber.cancel()
+	}
+	delete(fake.callsSubscribers, key)
+	var orderedInvocations []struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}
+	for _, invocation := range fake.orderedInvocations {
+		if invocation.Method != key {
+			orderedInvocations = append(orderedInvocations, invocation)
+		}
+	}
+	fake.orderedInvocations = orderedInvocations
+}
+
+var _ packagemodeshim.Flags = new(FakeFlags)
@@ -0,0 +1,45 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package packagemodeshim
+
+import (
+	"flag"
+
+	"github.com/maxbrunsfeld/counterfeiter/v6/fixtures/packagemode"
+)
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -generate
+//counterfeiter:generate . Flags
+
+// Flags is a generated interface representing the exported functions
+// in the github.com/maxbrunsfeld/counterfeiter/v6/fixtures/packagemode, flag packages.
+type Flags interface {
+	PackagemodeArg(arg1 int) string
+	PackagemodeArgs() []string
+	FlagArg(arg1 int) string
+	FlagArgs() []string
+	NArg() int
+}
+
+type FlagsShim struct{}
+
+func (p *FlagsShim) PackagemodeArg(arg1 int) string {
+	return packagemode.Arg(arg1)
+}
+
+func (p *FlagsShim) PackagemodeArgs() []string {
+	return packagemode.Args()
+}
+
+func (p *FlagsShim) FlagArg(arg1 int) string {
+	return flag.Arg(arg1)
+}
+
+func (p *FlagsShim) FlagArgs() []string {
+	return flag.Args()
+}
+
+func (p *FlagsShim) NArg() int {
+	return flag.NArg()
+}
+
+var _ Flags = new(FlagsShim)
@@ -10,6 +10,10 @@ import (
 
 // Packagemode is a generated interface representing the exported functions
 // in the github.com/maxbrunsfeld/counterfeiter/v6/fixtures/packagemode package.
+//
+// It leaves out the functions that cannot be methods of an interface:
+//   - Value, because it is generic
+//   - Visit, because it uses the unexported type visitor
 type Packagemode interface {
 	Arg(arg1 int) string
 	Args() []string
@@ -0,0 +1,921 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package flagfilteredshimfakes
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"sync/atomic"
+
+	packagemodeshim "github.com/maxbrunsfeld/counterfeiter/v6/fixtures/packagemode/flagfilteredshim"
+)
+
+type FakeFlags struct {
+	ArgStub        func(int) string
+	argMutex       sync.RWMutex
+	argArgsForCall []struct {
+		arg1 int
+	}
+	argWhen []struct {
+		matcher func(int) bool
+		stub    func(int) string
+	}
+	argReturns struct {
+		result1 string
+	}
+	argReturnsOnCall map[int]struct {
+		result1 string
+	}
+	BoolStub        func(string, bool, string) *bool
+	boolMutex       sync.RWMutex
+	boolArgsForCall []struct {
+		arg1 string
+		arg2 bool
+		arg3 string
+	}
+	boolWhen []struct {
+		matcher func(string, bool, string) bool
+		stub    func(string, bool, string) *bool
+	}
+	boolReturns struct {
+		result1 *bool
+	}
+	boolReturnsOnCall map[int]struct {
+		result1 *bool
+	}
+	StringValueStub        func(string) (string, bool)
+	stringValueMutex       sync.RWMutex
+	stringValueArgsForCall []struct {
+		arg1 string
+	}
+	stringValueWhen []struct {
+		matcher func(string) bool
+		stub    func(string) (string, bool)
+	}
+	stringValueReturns struct {
+		result1 string
+		result2 bool
+	}
+	stringValueReturnsOnCall map[int]struct {
+		result1 string
+		result2 bool
+	}
+	ValueBoolStub        func(string) (bool, bool)
+	valueBoolMutex       sync.RWMutex
+	valueBoolArgsForCall []struct {
+		arg1 string
+	}
+	valueBoolWhen []struct {
+		matcher func(string) bool
+		stub    func(string) (bool, bool)
+	}
+	valueBoolReturns struct {
+		result1 bool
+		result2 bool
+	}
+	valueBoolReturnsOnCall map[int]struct {
+		result1 bool
+		result2 bool
+	}
+	ArgsComparer       func(expected interface{}, actual interface{}) bool
+	invocations        map[string][][]interface{}
+	orderedInvocations []struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}
+	sequencer          *atomic.Uint64
+	invocationsChanged chan struct{}
+	callsSubscribers   map[string][]struct {
+		publish func(args []interface{})
+		cancel  context.CancelFunc
+		done    <-chan struct{}
+	}
+	invocationsMutex sync.RWMutex
+}
+
+type FakeFlagsArgCall struct {
+	Arg1 int
+}
+
+func (fake *FakeFlags) Arg(arg1 int) string {
+	fake.argMutex.Lock()
+	ret, specificReturn := fake.argReturnsOnCall[len(fake.argArgsForCall)]
+	fake.argArgsForCall = append(fake.argArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	stub := fake.ArgStub
+	whens := fake.argWhen
+	fakeReturns := fake.argReturns
+	fake.recordInvocation("Arg", []interface{}{arg1})
+	fake.argMutex.Unlock()
+	for i := len(whens) - 1; i >= 0; i-- {
+		if whens[i].matcher(arg1) {
+			return whens[i].stub(arg1)
+		}
+	}
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeFlags) ArgCallCount() int {
+	fake.argMutex.RLock()
+	defer fake.argMutex.RUnlock()
+	return len(fake.argArgsForCall)
+}
+
+func (fake *FakeFlags) WaitForArgCalls(ctx context.Context, n int) error {
+	return fake.waitForCalls(ctx, fake.ArgCallCount, n)
+}
+
+func (fake *FakeFlags) ArgCallsChan(ctx context.Context) <-chan []interface{} {
+	return fake.callsChan(ctx, "Arg")
+}
+
+func (fake *FakeFlags) ArgCalls(stub func(int) string) {
+	fake.argMutex.Lock()
+	defer fake.argMutex.Unlock()
+	fake.ArgStub = stub
+}
+
+// ArgCallsWhen calls the stub for the calls whose arguments match.
+// Matchers are checked before the stub, and the matcher registered last wins.
+func (fake *FakeFlags) ArgCallsWhen(matcher func(int) bool, stub func(int) string) {
+	fake.argMutex.Lock()
+	defer fake.argMutex.Unlock()
+	fake.argWhen = append(fake.argWhen, struct {
+		matcher func(int) bool
+		stub    func(int) string
+	}{matcher, stub})
+}
+
+func (fake *FakeFlags) ArgArgsForCall(i int) int {
+	fake.argMutex.RLock()
+	defer fake.argMutex.RUnlock()
+	argsForCall := fake.argArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeFlags) ArgCallHistory() []FakeFlagsArgCall {
+	fake.argMutex.RLock()
+	defer fake.argMutex.RUnlock()
+	history := make([]FakeFlagsArgCall, len(fake.argArgsForCall))
+	for i, argsForCall := range fake.argArgsForCall {
+		history[i] = FakeFlagsArgCall{argsForCall.arg1}
+	}
+	return history
+}
+
+func (fake *FakeFlags) ArgReturns(result1 string) {
+	fake.argMutex.Lock()
+	defer fake.argMutex.Unlock()
+	fake.ArgStub = nil
+	fake.argReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeFlags) ArgReturnsOnCall(i int, result1 string) {
+	fake.argMutex.Lock()
+	defer fake.argMutex.Unlock()
+	fake.ArgStub = nil
+	if fake.argReturnsOnCall == nil {
+		fake.argReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.argReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+// ArgReturnsForArgs returns the values for the calls with the given
+// arguments. Like ArgCallsWhen, it is checked before the stub, and the
+// arguments or matcher registered last win.
+func (fake *FakeFlags) ArgReturnsForArgs(arg1 int) func(string) {
+	args := []interface{}{arg1}
+	return func(result1 string) {
+		fake.argMutex.Lock()
+		defer fake.argMutex.Unlock()
+		fake.ArgStub = nil
+		fake.argWhen = append(fake.argWhen, struct {
+			matcher func(int) bool
+			stub    func(int) string
+		}{
+			func(arg1 int) bool {
+				return fake.argsMatch(args, []interface{}{arg1})
+			},
+			func(int) string {
+				return result1
+			},
+		})
+	}
+}
+
+// ArgReturnsWhen returns the values for the calls whose arguments
+// match. Like ArgCallsWhen, it is checked before the stub, and the
+// matcher registered last wins.
+func (fake *FakeFlags) ArgReturnsWhen(matcher func(int) bool, result1 string) {
+	fake.ArgCallsWhen(matcher, func(int) string {
+		return result1
+	})
+}
+
+func (fake *FakeFlags) ResetArg() {
+	fake.ResetArgCalls()
+	fake.ResetArgStubs()
+}
+
+func (fake *FakeFlags) ResetArgCalls() {
+	fake.argMutex.Lock()
+	defer fake.argMutex.Unlock()
+	fake.argArgsForCall = nil
+	fake.forgetInvocations("Arg")
+}
+
+func (fake *FakeFlags) ResetArgStubs() {
+	fake.argMutex.Lock()
+	defer fake.argMutex.Unlock()
+	fake.ArgStub = nil
+	fake.argWhen = nil
+	fake.argReturns = struct {
+		result1 string
+	}{}
+	fake.argReturnsOnCall = nil
+}
+
+type FakeFlagsBoolCall struct {
+	Arg1 string
+	Arg2 bool
+	Arg3 string
+}
+
+func (fake *FakeFlags) Bool(arg1 string, arg2 bool, arg3 string) *bool {
+	fake.boolMutex.Lock()
+	ret, specificReturn := fake.boolReturnsOnCall[len(fake.boolArgsForCall)]
+	fake.boolArgsForCall = append(fake.boolArgsForCall, struct {
+		arg1 string
+		arg2 bool
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.BoolStub
+	whens := fake.boolWhen
+	fakeReturns := fake.boolReturns
+	fake.recordInvocation("Bool", []interface{}{arg1, arg2, arg3})
+	fake.boolMutex.Unlock()
+	for i := len(whens) - 1; i >= 0; i-- {
+		if whens[i].matcher(arg1, arg2, arg3) {
+			return whens[i].stub(arg1, arg2, arg3)
+		}
+	}
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeFlags) BoolCallCount() int {
+	fake.boolMutex.RLock()
+	defer fake.boolMutex.RUnlock()
+	return len(fake.boolArgsForCall)
+}
+
+func (fake *FakeFlags) WaitForBoolCalls(ctx context.Context, n int) error {
+	return fake.waitForCalls(ctx, fake.BoolCallCount, n)
+}
+
+func (fake *FakeFlags) BoolCallsChan(ctx context.Context) <-chan []interface{} {
+	return fake.callsChan(ctx, "Bool")
+}
+
+func (fake *FakeFlags) BoolCalls(stub func(string, bool, string) *bool) {
+	fake.boolMutex.Lock()
+	defer fake.boolMutex.Unlock()
+	fake.BoolStub = stub
+}
+
+// BoolCallsWhen calls the stub for the calls whose arguments match.
+// Matchers are checked before the stub, and the matcher registered last wins.
+func (fake *FakeFlags) BoolCallsWhen(matcher func(string, bool, string) bool, stub func(string, bool, string) *bool) {
+	fake.boolMutex.Lock()
+	defer fake.boolMutex.Unlock()
+	fake.boolWhen = append(fake.boolWhen, struct {
+		matcher func(string, bool, string) bool
+		stub    func(string, bool, string) *bool
+	}{matcher, stub})
+}
+
+func (fake *FakeFlags) BoolArgsForCall(i int) (string, bool, string) {
+	fake.boolMutex.RLock()
+	defer fake.boolMutex.RUnlock()
+	argsForCall := fake.boolArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeFlags) BoolCallHistory() []FakeFlagsBoolCall {
+	fake.boolMutex.RLock()
+	defer fake.boolMutex.RUnlock()
+	history := make([]FakeFlagsBoolCall, len(fake.boolArgsForCall))
+	for i, argsForCall := range fake.boolArgsForCall {
+		history[i] = FakeFlagsBoolCall{argsForCall.arg1, argsForCall.arg2, argsForCall.arg3}
+	}
+	return history
+}
+
+func (fake *FakeFlags) BoolReturns(result1 *bool) {
+	fake.boolMutex.Lock()
+	defer fake.boolMutex.Unlock()
+	fake.BoolStub = nil
+	fake.boolReturns = struct {
+		result1 *bool
+	}{result1}
+}
+
+func (fake *FakeFlags) BoolReturnsOnCall(i int, result1 *bool) {
+	fake.boolMutex.Lock()
+	defer fake.boolMutex.Unlock()
+	fake.BoolStub = nil
+	if fake.boolReturnsOnCall == nil {
+		fake.boolReturnsOnCall = make(map[int]struct {
+			result1 *bool
+		})
+	}
+	fake.boolReturnsOnCall[i] = struct {
+		result1 *bool
+	}{result1}
+}
+
+// BoolReturnsForArgs returns the values for the calls with the given
+// arguments. Like BoolCallsWhen, it is checked before the stub, and the
+// arguments or matcher registered last win.
+func (fake *FakeFlags) BoolReturnsForArgs(arg1 string, arg2 bool, arg3 string) func(*bool) {
+	args := []interface{}{arg1, arg2, arg3}
+	return func(result1 *bool) {
+		fake.boolMutex.Lock()
+		defer fake.boolMutex.Unlock()
+		fake.BoolStub = nil
+		fake.boolWhen = append(fake.boolWhen, struct {
+			matcher func(string, bool, string) bool
+			stub    func(string, bool, string) *bool
+		}{
+			func(arg1 string, arg2 bool, arg3 string) bool {
+				return fake.argsMatch(args, []interface{}{arg1, arg2, arg3})
+			},
+			func(string, bool, string) *bool {
+				return result1
+			},
+		})
+	}
+}
+
+// BoolReturnsWhen returns the values for the calls whose arguments
+// match. Like BoolCallsWhen, it is checked before the stub, and the
+// matcher registered last wins.
+func (fake *FakeFlags) BoolReturnsWhen(matcher func(string, bool, string) bool, result1 *bool) {
+	fake.BoolCallsWhen(matcher, func(string, bool, string) *bool {
+		return result1
+	})
+}
+
+func (fake *FakeFlags) ResetBool() {
+	fake.ResetBoolCalls()
+	fake.ResetBoolStubs()
+}
+
+func (fake *FakeFlags) ResetBoolCalls() {
+	fake.boolMutex.Lock()
+	defer fake.boolMutex.Unlock()
+	fake.boolArgsForCall = nil
+	fake.forgetInvocations("Bool")
+}
+
+func (fake *FakeFlags) ResetBoolStubs() {
+	fake.boolMutex.Lock()
+	defer fake.boolMutex.Unlock()
+	fake.BoolStub = nil
+	fake.boolWhen = nil
+	fake.boolReturns = struct {
+		result1 *bool
+	}{}
+	fake.boolReturnsOnCall = nil
+}
+
+type FakeFlagsStringValueCall struct {
+	Arg1 string
+}
+
+func (fake *FakeFlags) StringValue(arg1 string) (string, bool) {
+	fake.stringValueMutex.Lock()
+	ret, specificReturn := fake.stringValueReturnsOnCall[len(fake.stringValueArgsForCall)]
+	fake.stringValueArgsForCall = append(fake.stringValueArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.StringValueStub
+	whens := fake.stringValueWhen
+	fakeReturns := fake.stringValueReturns
+	fake.recordInvocation("StringValue", []interface{}{arg1})
+	fake.stringValueMutex.Unlock()
+	for i := len(whens) - 1; i >= 0; i-- {
+		if whens[i].matcher(arg1) {
+			return whens[i].stub(arg1)
+		}
+	}
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeFlags) StringValueCallCount() int {
+	fake.stringValueMutex.RLock()
+	defer fake.stringValueMutex.RUnlock()
+	return len(fake.stringValueArgsForCall)
+}
+
+func (fake *FakeFlags) WaitForStringValueCalls(ctx context.Context, n int) error {
+	return fake.waitForCalls(ctx, fake.StringValueCallCount, n)
+}
+
+func (fake *FakeFlags) StringValueCallsChan(ctx context.Context) <-chan []interface{} {
+	return fake.callsChan(ctx, "StringValue")
+}
+
+func (fake *FakeFlags) StringValueCalls(stub func(string) (string, bool)) {
+	fake.stringValueMutex.Lock()
+	defer fake.stringValueMutex.Unlock()
+	fake.StringValueStub = stub
+}
+
+// StringValueCallsWhen calls the stub for the calls whose arguments match.
+// Matchers are checked before the stub, and the matcher registered last wins.
+func (fake *FakeFlags) StringValueCallsWhen(matcher func(string) bool, stub func(string) (string, bool)) {
+	fake.stringValueMutex.Lock()
+	defer fake.stringValueMutex.Unlock()
+	fake.stringValueWhen = append(fake.stringValueWhen, struct {
+		matcher func(string) bool
+		stub    func(string) (string, bool)
+	}{matcher, stub})
+}
+
+func (fake *FakeFlags) StringValueArgsForCall(i int) string {
+	fake.stringValueMutex.RLock()
+	defer fake.stringValueMutex.RUnlock()
+	argsForCall := fake.stringValueArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeFlags) StringValueCallHistory() []FakeFlagsStringValueCall {
+	fake.stringValueMutex.RLock()
+	defer fake.stringValueMutex.RUnlock()
+	history := make([]FakeFlagsStringValueCall, len(fake.stringValueArgsForCall))
+	for i, argsForCall := range fake.stringValueArgsForCall {
+		history[i] = FakeFlagsStringValueCall{argsForCall.arg1}
+	}
+	return history
+}
+
+func (fake *FakeFlags) StringValueReturns(result1 string, result2 bool) {
+	fake.stringValueMutex.Lock()
+	defer fake.stringValueMutex.Unlock()
+	fake.StringValueStub = nil
+	fake.stringValueReturns = struct {
+		result1 string
+		result2 bool
+	}{result1, result2}
+}
+
+func (fake *FakeFlags) StringValueReturnsOnCall(i int, result1 string, result2 bool) {
+	fake.stringValueMutex.Lock()
+	defer fake.stringValueMutex.Unlock()
+	fake.StringValueStub = nil
+	if fake.stringValueReturnsOnCall == nil {
+		fake.stringValueReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 bool
+		})
+	}
+	fake.stringValueReturnsOnCall[i] = struct {
+		result1 string
+		result2 bool
+	}{result1, result2}
+}
+
+// StringValueReturnsForArgs returns the values for the calls with the given
+// arguments. Like StringValueCallsWhen, it is checked before the stub, and the
+// arguments or matcher registered last win.
+func (fake *FakeFlags) StringValueReturnsForArgs(arg1 string) func(string, bool) {
+	args := []interface{}{arg1}
+	return func(result1 string, result2 bool) {
+		fake.stringValueMutex.Lock()
+		defer fake.stringValueMutex.Unlock()
+		fake.StringValueStub = nil
+		fake.stringValueWhen = append(fake.stringValueWhen, struct {
+			matcher func(string) bool
+			stub    func(string) (string, bool)
+		}{
+			func(arg1 string) bool {
+				return fake.argsMatch(args, []interface{}{arg1})
+			},
+			func(string) (string, bool) {
+				return result1, result2
+			},
+		})
+	}
+}
+
+// StringValueReturnsWhen returns the values for the calls whose arguments
+// match. Like StringValueCallsWhen, it is checked before the stub, and the
+// matcher registered last wins.
+func (fake *FakeFlags) StringValueReturnsWhen(matcher func(string) bool, result1 string, result2 bool) {
+	fake.StringValueCallsWhen(matcher, func(string) (string, bool) {
+		return result1, result2
+	})
+}
+
+func (fake *FakeFlags) ResetStringValue() {
+	fake.ResetStringValueCalls()
+	fake.ResetStringValueStubs()
+}
+
+func (fake *FakeFlags) ResetStringValueCalls() {
+	fake.stringValueMutex.Lock()
+	defer fake.stringValueMutex.Unlock()
+	fake.stringValueArgsForCall = nil
+	fake.forgetInvocations("StringValue")
+}
+
+func (fake *FakeFlags) ResetStringValueStubs() {
+	fake.stringValueMutex.Lock()
+	defer fake.stringValueMutex.Unlock()
+	fake.StringValueStub = nil
+	fake.stringValueWhen = nil
+	fake.stringValueReturns = struct {
+		result1 string
+		result2 bool
+	}{}
+	fake.stringValueReturnsOnCall = nil
+}
+
+type FakeFlagsValueBoolCall struct {
+	Arg1 string
+}
+
+func (fake *FakeFlags) ValueBool(arg1 string) (bool, bool) {
+	fake.valueBoolMutex.Lock()
+	ret, specificReturn := fake.valueBoolReturnsOnCall[len(fake.valueBoolArgsForCall)]
+	fake.valueBoolArgsForCall = append(fake.valueBoolArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.ValueBoolStub
+	whens := fake.valueBoolWhen
+	fakeReturns := fake.valueBoolReturns
+	fake.recordInvocation("ValueBool", []interface{}{arg1})
+	fake.valueBoolMutex.Unlock()
+	for i := len(whens) - 1; i >= 0; i-- {
+		if whens[i].matcher(arg1) {
+			return whens[i].stub(arg1)
+		}
+	}
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeFlags) ValueBoolCallCount() int {
+	fake.valueBoolMutex.RLock()
+	defer fake.valueBoolMutex.RUnlock()
+	return len(fake.valueBoolArgsForCall)
+}
+
+func (fake *FakeFlags) WaitForValueBoolCalls(ctx context.Context, n int) error {
+	return fake.waitForCalls(ctx, fake.ValueBoolCallCount, n)
+}
+
+func (fake *FakeFlags) ValueBoolCallsChan(ctx context.Context) <-chan []interface{} {
+	return fake.callsChan(ctx, "ValueBool")
+}
+
+func (fake *FakeFlags) ValueBoolCalls(stub func(string) (bool, bool)) {
+	fake.valueBoolMutex.Lock()
+	defer fake.valueBoolMutex.Unlock()
+	fake.ValueBoolStub = stub
+}
+
+// ValueBoolCallsWhen calls the stub for the calls whose arguments match.
+// Matchers are checked before the stub, and the matcher registered last wins.
+func (fake *FakeFlags) ValueBoolCallsWhen(matcher func(string) bool, stub func(string) (bool, bool)) {
+	fake.valueBoolMutex.Lock()
+	defer fake.valueBoolMutex.Unlock()
+	fake.valueBoolWhen = append(fake.valueBoolWhen, struct {
+		matcher func(string) bool
+		stub    func(string) (bool, bool)
+	}{matcher, stub})
+}
+
+func (fake *FakeFlags) ValueBoolArgsForCall(i int) string {
+	fake.valueBoolMutex.RLock()
+	defer fake.valueBoolMutex.RUnlock()
+	argsForCall := fake.valueBoolArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeFlags) ValueBoolCallHistory() []FakeFlagsValueBoolCall {
+	fake.valueBoolMutex.RLock()
+	defer fake.valueBoolMutex.RUnlock()
+	history := make([]FakeFlagsValueBoolCall, len(fake.valueBoolArgsForCall))
+	for i, argsForCall := range fake.valueBoolArgsForCall {
+		history[i] = FakeFlagsValueBoolCall{argsForCall.arg1}
+	}
+	return history
+}
+
+func (fake *FakeFlags) ValueBoolReturns(result1 bool, result2 bool) {
+	fake.valueBoolMutex.Lock()
+	defer fake.valueBoolMutex.Unlock()
+	fake.ValueBoolStub = nil
+	fake.valueBoolReturns = struct {
+		result1 bool
+		result2 bool
+	}{result1, result2}
+}
+
+func (fake *FakeFlags) ValueBoolReturnsOnCall(i int, result1 bool, result2 bool) {
+	fake.valueBoolMutex.Lock()
+	defer fake.valueBoolMutex.Unlock()
+	fake.ValueBoolStub = nil
+	if fake.valueBoolReturnsOnCall == nil {
+		fake.valueBoolReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 bool
+		})
+	}
+	fake.valueBoolReturnsOnCall[i] = struct {
+		result1 bool
+		result2 bool
+	}{result1, result2}
+}
+
+// ValueBoolReturnsForArgs returns the values for the calls with the given
+// arguments. Like ValueBoolCallsWhen, it is checked before the stub, and the
+// arguments or matcher registered last win.
+func (fake *FakeFlags) ValueBoolReturnsForArgs(arg1 string) func(bool, bool) {
+	args := []interface{}{arg1}
+	return func(result1 bool, result2 bool) {
+		fake.valueBoolMutex.Lock()
+		defer fake.valueBoolMutex.Unlock()
+		fake.ValueBoolStub = nil
+		fake.valueBoolWhen = append(fake.valueBoolWhen, struct {
+			matcher func(string) bool
+			stub    func(string) (bool, bool)
+		}{
+			func(arg1 string) bool {
+				return fake.argsMatch(args, []interface{}{arg1})
+			},
+			func(string) (bool, bool) {
+				return result1, result2
+			},
+		})
+	}
+}
+
+// ValueBoolReturnsWhen returns the values for the calls whose arguments
+// match. Like ValueBoolCallsWhen, it is checked before the stub, and the
+// matcher registered last wins.
+func (fake *FakeFlags) ValueBoolReturnsWhen(matcher func(string) bool, result1 bool, result2 bool) {
+	fake.ValueBoolCallsWhen(matcher, func(string) (bool, bool) {
+		return result1, result2
+	})
+}
+
+func (fake *FakeFlags) ResetValueBool() {
+	fake.ResetValueBoolCalls()
+	fake.ResetValueBoolStubs()
+}
+
+func (fake *FakeFlags) ResetValueBoolCalls() {
+	fake.valueBoolMutex.Lock()
+	defer fake.valueBoolMutex.Unlock()
+	fake.valueBoolArgsForCall = nil
+	fake.forgetInvocations("ValueBool")
+}
+
+func (fake *FakeFlags) ResetValueBoolStubs() {
+	fake.valueBoolMutex.Lock()
+	defer fake.valueBoolMutex.Unlock()
+	fake.ValueBoolStub = nil
+	fake.valueBoolWhen = nil
+	fake.valueBoolReturns = struct {
+		result1 bool
+		result2 bool
+	}{}
+	fake.valueBoolReturnsOnCall = nil
+}
+
+func (fake *FakeFlags) Reset() {
+	fake.ResetCalls()
+	fake.ResetStubs()
+}
+
+func (fake *FakeFlags) ResetCalls() {
+	fake.ResetArgCalls()
+	fake.ResetBoolCalls()
+	fake.ResetStringValueCalls()
+	fake.ResetValueBoolCalls()
+}
+
+func (fake *FakeFlags) ResetStubs() {
+	fake.ResetArgStubs()
+	fake.ResetBoolStubs()
+	fake.ResetStringValueStubs()
+	fake.ResetValueBoolStubs()
+}
+
+func (fake *FakeFlags) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeFlags) argsMatch(expected []interface{}, actual []interface{}) bool {
+	if len(expected) != len(actual) {
+		return false
+	}
+	for i := range expected {
+		if fake.ArgsComparer != nil && !fake.ArgsComparer(expected[i], actual[i]) || fake.ArgsComparer == nil && !reflect.DeepEqual(expected[i], actual[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (fake *FakeFlags) OrderedInvocations() []struct {
+	Seq    uint64
+	Method string
+	Args   []interface{}
+} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	return append([]struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}{}, fake.orderedInvocations...)
+}
+
+func (fake *FakeFlags) SetSequencer(sequencer *atomic.Uint64) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	fake.sequencer = sequencer
+}
+
+func (fake *FakeFlags) waitForCalls(ctx context.Context, callCount func() int, n int) error {
+	for {
+		fake.invocationsMutex.Lock()
+		if fake.invocationsChanged == nil {
+			fake.invocationsChanged = make(chan struct{})
+		}
+		changed := fake.invocationsChanged
+		fake.invocationsMutex.Unlock()
+		if callCount() >= n {
+			return nil
+		}
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (fake *FakeFlags) callsChan(ctx context.Context, key string) <-chan []interface{} {
+	ctx, cancel := context.WithCancel(ctx)
+	calls := make(chan []interface{})
+	ready := make(chan struct{}, 1)
+	var pendingMutex sync.Mutex
+	var pending [][]interface{}
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.callsSubscribers == nil {
+		fake.callsSubscribers = map[string][]struct {
+			publish func(args []interface{})
+			cancel  context.CancelFunc
+			done    <-chan struct{}
+		}{}
+	}
+	fake.callsSubscribers[key] = append(fake.callsSubscribers[key], struct {
+		publish func(args []interface{})
+		cancel  context.CancelFunc
+		done    <-chan struct{}
+	}{func(args []interface{}) {
+		pendingMutex.Lock()
+		pending = append(pending, args)
+		pendingMutex.Unlock()
+		select {
+		case ready <- struct{}{}:
+		default:
+		}
+	}, cancel, ctx.Done()})
+	go func() {
+		defer close(calls)
+		for {
+			select {
+			case <-ready:
+			case <-ctx.Done():
+				return
+			}
+			pendingMutex.Lock()
+			queued := pending
+			pending = nil
+			pendingMutex.Unlock()
+			for _, args := range queued {
+				select {
+				case calls <- args:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return calls
+}
+
+func (fake *FakeFlags) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+	if fake.sequencer == nil {
+		fake.sequencer = new(atomic.Uint64)
+	}
+	fake.orderedInvocations = append(fake.orderedInvocations, struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}{fake.sequencer.Add(1), key, args})
+	if fake.invocationsChanged != nil {
+		close(fake.invocationsChanged)
+		fake.invocationsChanged = nil
+	}
+	subscribers := fake.callsSubscribers[key][:0]
+	for _, subscriber := range fake.callsSubscribers[key] {
+		select {
+		case <-subscriber.done:
+			continue
+		default:
+		}
+		subscriber.publish(args)
+		subscribers = append(subscribers, subscriber)
+	}
+	if len(subscribers) > 0 {
+		fake.callsSubscribers[key] = subscribers
+	} else {
+		delete(fake.callsSubscribers, key)
+	}
+}
+
+func (fake *FakeFlags) forgetInvocations(key string) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	delete(fake.invocations, key)
+	for _, subscriber := range fake.callsSubscribers[key] {
+		subscriber.cancel()
+	}
+	delete(fake.callsSubscribers, key)
+	var orderedInvocations []struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}
+	for _, invocation := range fake.orderedInvocations {
+		if invocation.Method != key {
+			orderedInvocations = append(orderedInvocations, invocation)
+		}
+	}
+	fake.orderedInvocations = orderedInvocations
+}
+
+var _ packagemodeshim.Flags = new(FakeFlags)
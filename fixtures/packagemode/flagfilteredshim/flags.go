@@ -0,0 +1,38 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package packagemodeshim
+
+import (
+	"github.com/maxbrunsfeld/counterfeiter/v6/fixtures/packagemode"
+)
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -generate
+//counterfeiter:generate . Flags
+
+// Flags is a generated interface representing the exported functions
+// in the github.com/maxbrunsfeld/counterfeiter/v6/fixtures/packagemode package.
+type Flags interface {
+	Arg(arg1 int) string
+	Bool(arg1 string, arg2 bool, arg3 string) *bool
+	ValueBool(arg1 string) (bool, bool)
+	StringValue(arg1 string) (string, bool)
+}
+
+type FlagsShim struct{}
+
+func (p *FlagsShim) Arg(arg1 int) string {
+	return packagemode.Arg(arg1)
+}
+
+func (p *FlagsShim) Bool(arg1 string, arg2 bool, arg3 string) *bool {
+	return packagemode.Bool(arg1, arg2, arg3)
+}
+
+func (p *FlagsShim) ValueBool(arg1 string) (bool, bool) {
+	return packagemode.Value[bool](arg1)
+}
+
+func (p *FlagsShim) StringValue(arg1 string) (string, bool) {
+	return packagemode.Value[string](arg1)
+}
+
+var _ Flags = new(FlagsShim)
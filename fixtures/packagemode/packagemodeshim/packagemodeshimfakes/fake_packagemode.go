@@ -2,7 +2,11 @@
 package packagemodeshimfakes
 
 import (
+	"context"
+	"fmt"
+	"reflect"
 	"sync"
+	"sync/atomic"
 
 	"github.com/maxbrunsfeld/counterfeiter/v6/fixtures/packagemode/packagemodeshim"
 )
@@ -13,6 +17,10 @@ type FakePackagemode struct {
 	argArgsForCall []struct {
 		arg1 int
 	}
+	argWhen []struct {
+		matcher func(int) bool
+		stub    func(int) string
+	}
 	argReturns struct {
 		result1 string
 	}
@@ -36,6 +44,10 @@ type FakePackagemode struct {
 		arg2 bool
 		arg3 string
 	}
+	boolWhen []struct {
+		matcher func(string, bool, string) bool
+		stub    func(string, bool, string) *bool
+	}
 	boolReturns struct {
 		result1 *bool
 	}
@@ -50,10 +62,32 @@ type FakePackagemode struct {
 		arg3 bool
 		arg4 string
 	}
-	invocations      map[string][][]interface{}
+	boolVarWhen []struct {
+		matcher func(*bool, string, bool, string) bool
+		stub    func(*bool, string, bool, string)
+	}
+	boolVarSetsArgs    map[int]interface{}
+	ArgsComparer       func(expected interface{}, actual interface{}) bool
+	invocations        map[string][][]interface{}
+	orderedInvocations []struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}
+	sequencer          *atomic.Uint64
+	invocationsChanged chan struct{}
+	callsSubscribers   map[string][]struct {
+		publish func(args []interface{})
+		cancel  context.CancelFunc
+		done    <-chan struct{}
+	}
 	invocationsMutex sync.RWMutex
 }
 
+type FakePackagemodeArgCall struct {
+	Arg1 int
+}
+
 func (fake *FakePackagemode) Arg(arg1 int) string {
 	fake.argMutex.Lock()
 	ret, specificReturn := fake.argReturnsOnCall[len(fake.argArgsForCall)]
@@ -61,9 +95,15 @@ func (fake *FakePackagemode) Arg(arg1 int) string {
 		arg1 int
 	}{arg1})
 	stub := fake.ArgStub
+	whens := fake.argWhen
 	fakeReturns := fake.argReturns
 	fake.recordInvocation("Arg", []interface{}{arg1})
 	fake.argMutex.Unlock()
+	for i := len(whens) - 1; i >= 0; i-- {
+		if whens[i].matcher(arg1) {
+			return whens[i].stub(arg1)
+		}
+	}
 	if stub != nil {
 		return stub(arg1)
 	}
@@ -79,12 +119,31 @@ func (fake *FakePackagemode) ArgCallCount() int {
 	return len(fake.argArgsForCall)
 }
 
+func (fake *FakePackagemode) WaitForArgCalls(ctx context.Context, n int) error {
+	return fake.waitForCalls(ctx, fake.ArgCallCount, n)
+}
+
+func (fake *FakePackagemode) ArgCallsChan(ctx context.Context) <-chan []interface{} {
+	return fake.callsChan(ctx, "Arg")
+}
+
 func (fake *FakePackagemode) ArgCalls(stub func(int) string) {
 	fake.argMutex.Lock()
 	defer fake.argMutex.Unlock()
 	fake.ArgStub = stub
 }
 
+// ArgCallsWhen calls the stub for the calls whose arguments match.
+// Matchers are checked before the stub, and the matcher registered last wins.
+func (fake *FakePackagemode) ArgCallsWhen(matcher func(int) bool, stub func(int) string) {
+	fake.argMutex.Lock()
+	defer fake.argMutex.Unlock()
+	fake.argWhen = append(fake.argWhen, struct {
+		matcher func(int) bool
+		stub    func(int) string
+	}{matcher, stub})
+}
+
 func (fake *FakePackagemode) ArgArgsForCall(i int) int {
 	fake.argMutex.RLock()
 	defer fake.argMutex.RUnlock()
@@ -92,6 +151,16 @@ func (fake *FakePackagemode) ArgArgsForCall(i int) int {
 	return argsForCall.arg1
 }
 
+func (fake *FakePackagemode) ArgCallHistory() []FakePackagemodeArgCall {
+	fake.argMutex.RLock()
+	defer fake.argMutex.RUnlock()
+	history := make([]FakePackagemodeArgCall, len(fake.argArgsForCall))
+	for i, argsForCall := range fake.argArgsForCall {
+		history[i] = FakePackagemodeArgCall{argsForCall.arg1}
+	}
+	return history
+}
+
 func (fake *FakePackagemode) ArgReturns(result1 string) {
 	fake.argMutex.Lock()
 	defer fake.argMutex.Unlock()
@@ -115,6 +184,61 @@ func (fake *FakePackagemode) ArgReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+// ArgReturnsForArgs returns the values for the calls with the given
+// arguments. Like ArgCallsWhen, it is checked before the stub, and the
+// arguments or matcher registered last win.
+func (fake *FakePackagemode) ArgReturnsForArgs(arg1 int) func(string) {
+	args := []interface{}{arg1}
+	return func(result1 string) {
+		fake.argMutex.Lock()
+		defer fake.argMutex.Unlock()
+		fake.ArgStub = nil
+		fake.argWhen = append(fake.argWhen, struct {
+			matcher func(int) bool
+			stub    func(int) string
+		}{
+			func(arg1 int) bool {
+				return fake.argsMatch(args, []interface{}{arg1})
+			},
+			func(int) string {
+				return result1
+			},
+		})
+	}
+}
+
+// ArgReturnsWhen returns the values for the calls whose arguments
+// match. Like ArgCallsWhen, it is checked before the stub, and the
+// matcher registered last wins.
+func (fake *FakePackagemode) ArgReturnsWhen(matcher func(int) bool, result1 string) {
+	fake.ArgCallsWhen(matcher, func(int) string {
+		return result1
+	})
+}
+
+func (fake *FakePackagemode) ResetArg() {
+	fake.ResetArgCalls()
+	fake.ResetArgStubs()
+}
+
+func (fake *FakePackagemode) ResetArgCalls() {
+	fake.argMutex.Lock()
+	defer fake.argMutex.Unlock()
+	fake.argArgsForCall = nil
+	fake.forgetInvocations("Arg")
+}
+
+func (fake *FakePackagemode) ResetArgStubs() {
+	fake.argMutex.Lock()
+	defer fake.argMutex.Unlock()
+	fake.ArgStub = nil
+	fake.argWhen = nil
+	fake.argReturns = struct {
+		result1 string
+	}{}
+	fake.argReturnsOnCall = nil
+}
+
 func (fake *FakePackagemode) Args() []string {
 	fake.argsMutex.Lock()
 	ret, specificReturn := fake.argsReturnsOnCall[len(fake.argsArgsForCall)]
@@ -139,6 +263,14 @@ func (fake *FakePackagemode) ArgsCallCount() int {
 	return len(fake.argsArgsForCall)
 }
 
+func (fake *FakePackagemode) WaitForArgsCalls(ctx context.Context, n int) error {
+	return fake.waitForCalls(ctx, fake.ArgsCallCount, n)
+}
+
+func (fake *FakePackagemode) ArgsCallsChan(ctx context.Context) <-chan []interface{} {
+	return fake.callsChan(ctx, "Args")
+}
+
 func (fake *FakePackagemode) ArgsCalls(stub func() []string) {
 	fake.argsMutex.Lock()
 	defer fake.argsMutex.Unlock()
@@ -168,6 +300,34 @@ func (fake *FakePackagemode) ArgsReturnsOnCall(i int, result1 []string) {
 	}{result1}
 }
 
+func (fake *FakePackagemode) ResetArgs() {
+	fake.ResetArgsCalls()
+	fake.ResetArgsStubs()
+}
+
+func (fake *FakePackagemode) ResetArgsCalls() {
+	fake.argsMutex.Lock()
+	defer fake.argsMutex.Unlock()
+	fake.argsArgsForCall = nil
+	fake.forgetInvocations("Args")
+}
+
+func (fake *FakePackagemode) ResetArgsStubs() {
+	fake.argsMutex.Lock()
+	defer fake.argsMutex.Unlock()
+	fake.ArgsStub = nil
+	fake.argsReturns = struct {
+		result1 []string
+	}{}
+	fake.argsReturnsOnCall = nil
+}
+
+type FakePackagemodeBoolCall struct {
+	Arg1 string
+	Arg2 bool
+	Arg3 string
+}
+
 func (fake *FakePackagemode) Bool(arg1 string, arg2 bool, arg3 string) *bool {
 	fake.boolMutex.Lock()
 	ret, specificReturn := fake.boolReturnsOnCall[len(fake.boolArgsForCall)]
@@ -177,9 +337,15 @@ func (fake *FakePackagemode) Bool(arg1 string, arg2 bool, arg3 string) *bool {
 		arg3 string
 	}{arg1, arg2, arg3})
 	stub := fake.BoolStub
+	whens := fake.boolWhen
 	fakeReturns := fake.boolReturns
 	fake.recordInvocation("Bool", []interface{}{arg1, arg2, arg3})
 	fake.boolMutex.Unlock()
+	for i := len(whens) - 1; i >= 0; i-- {
+		if whens[i].matcher(arg1, arg2, arg3) {
+			return whens[i].stub(arg1, arg2, arg3)
+		}
+	}
 	if stub != nil {
 		return stub(arg1, arg2, arg3)
 	}
@@ -195,12 +361,31 @@ func (fake *FakePackagemode) BoolCallCount() int {
 	return len(fake.boolArgsForCall)
 }
 
+func (fake *FakePackagemode) WaitForBoolCalls(ctx context.Context, n int) error {
+	return fake.waitForCalls(ctx, fake.BoolCallCount, n)
+}
+
+func (fake *FakePackagemode) BoolCallsChan(ctx context.Context) <-chan []interface{} {
+	return fake.callsChan(ctx, "Bool")
+}
+
 func (fake *FakePackagemode) BoolCalls(stub func(string, bool, string) *bool) {
 	fake.boolMutex.Lock()
 	defer fake.boolMutex.Unlock()
 	fake.BoolStub = stub
 }
 
+// BoolCallsWhen calls the stub for the calls whose arguments match.
+// Matchers are checked before the stub, and the matcher registered last wins.
+func (fake *FakePackagemode) BoolCallsWhen(matcher func(string, bool, string) bool, stub func(string, bool, string) *bool) {
+	fake.boolMutex.Lock()
+	defer fake.boolMutex.Unlock()
+	fake.boolWhen = append(fake.boolWhen, struct {
+		matcher func(string, bool, string) bool
+		stub    func(string, bool, string) *bool
+	}{matcher, stub})
+}
+
 func (fake *FakePackagemode) BoolArgsForCall(i int) (string, bool, string) {
 	fake.boolMutex.RLock()
 	defer fake.boolMutex.RUnlock()
@@ -208,6 +393,16 @@ func (fake *FakePackagemode) BoolArgsForCall(i int) (string, bool, string) {
 	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
+func (fake *FakePackagemode) BoolCallHistory() []FakePackagemodeBoolCall {
+	fake.boolMutex.RLock()
+	defer fake.boolMutex.RUnlock()
+	history := make([]FakePackagemodeBoolCall, len(fake.boolArgsForCall))
+	for i, argsForCall := range fake.boolArgsForCall {
+		history[i] = FakePackagemodeBoolCall{argsForCall.arg1, argsForCall.arg2, argsForCall.arg3}
+	}
+	return history
+}
+
 func (fake *FakePackagemode) BoolReturns(result1 *bool) {
 	fake.boolMutex.Lock()
 	defer fake.boolMutex.Unlock()
@@ -231,6 +426,68 @@ func (fake *FakePackagemode) BoolReturnsOnCall(i int, result1 *bool) {
 	}{result1}
 }
 
+// BoolReturnsForArgs returns the values for the calls with the given
+// arguments. Like BoolCallsWhen, it is checked before the stub, and the
+// arguments or matcher registered last win.
+func (fake *FakePackagemode) BoolReturnsForArgs(arg1 string, arg2 bool, arg3 string) func(*bool) {
+	args := []interface{}{arg1, arg2, arg3}
+	return func(result1 *bool) {
+		fake.boolMutex.Lock()
+		defer fake.boolMutex.Unlock()
+		fake.BoolStub = nil
+		fake.boolWhen = append(fake.boolWhen, struct {
+			matcher func(string, bool, string) bool
+			stub    func(string, bool, string) *bool
+		}{
+			func(arg1 string, arg2 bool, arg3 string) bool {
+				return fake.argsMatch(args, []interface{}{arg1, arg2, arg3})
+			},
+			func(string, bool, string) *bool {
+				return result1
+			},
+		})
+	}
+}
+
+// BoolReturnsWhen returns the values for the calls whose arguments
+// match. Like BoolCallsWhen, it is checked before the stub, and the
+// matcher registered last wins.
+func (fake *FakePackagemode) BoolReturnsWhen(matcher func(string, bool, string) bool, result1 *bool) {
+	fake.BoolCallsWhen(matcher, func(string, bool, string) *bool {
+		return result1
+	})
+}
+
+func (fake *FakePackagemode) ResetBool() {
+	fake.ResetBoolCalls()
+	fake.ResetBoolStubs()
+}
+
+func (fake *FakePackagemode) ResetBoolCalls() {
+	fake.boolMutex.Lock()
+	defer fake.boolMutex.Unlock()
+	fake.boolArgsForCall = nil
+	fake.forgetInvocations("Bool")
+}
+
+func (fake *FakePackagemode) ResetBoolStubs() {
+	fake.boolMutex.Lock()
+	defer fake.boolMutex.Unlock()
+	fake.BoolStub = nil
+	fake.boolWhen = nil
+	fake.boolReturns = struct {
+		result1 *bool
+	}{}
+	fake.boolReturnsOnCall = nil
+}
+
+type FakePackagemodeBoolVarCall struct {
+	Arg1 *bool
+	Arg2 string
+	Arg3 bool
+	Arg4 string
+}
+
 func (fake *FakePackagemode) BoolVar(arg1 *bool, arg2 string, arg3 bool, arg4 string) {
 	fake.boolVarMutex.Lock()
 	fake.boolVarArgsForCall = append(fake.boolVarArgsForCall, struct {
@@ -240,8 +497,20 @@ func (fake *FakePackagemode) BoolVar(arg1 *bool, arg2 string, arg3 bool, arg4 st
 		arg4 string
 	}{arg1, arg2, arg3, arg4})
 	stub := fake.BoolVarStub
+	whens := fake.boolVarWhen
+	setsArgs := fake.boolVarSetsArgs
 	fake.recordInvocation("BoolVar", []interface{}{arg1, arg2, arg3, arg4})
 	fake.boolVarMutex.Unlock()
+	if len(setsArgs) > 0 {
+		args := []interface{}{arg1, arg2, arg3, arg4}
+		fake.setArgs("BoolVar", setsArgs, args)
+	}
+	for i := len(whens) - 1; i >= 0; i-- {
+		if whens[i].matcher(arg1, arg2, arg3, arg4) {
+			whens[i].stub(arg1, arg2, arg3, arg4)
+			return
+		}
+	}
 	if stub != nil {
 		fake.BoolVarStub(arg1, arg2, arg3, arg4)
 	}
@@ -253,12 +522,43 @@ func (fake *FakePackagemode) BoolVarCallCount() int {
 	return len(fake.boolVarArgsForCall)
 }
 
+func (fake *FakePackagemode) WaitForBoolVarCalls(ctx context.Context, n int) error {
+	return fake.waitForCalls(ctx, fake.BoolVarCallCount, n)
+}
+
+func (fake *FakePackagemode) BoolVarCallsChan(ctx context.Context) <-chan []interface{} {
+	return fake.callsChan(ctx, "BoolVar")
+}
+
 func (fake *FakePackagemode) BoolVarCalls(stub func(*bool, string, bool, string)) {
 	fake.boolVarMutex.Lock()
 	defer fake.boolVarMutex.Unlock()
 	fake.BoolVarStub = stub
 }
 
+func (fake *FakePackagemode) BoolVarSetsArg(i int, value interface{}) {
+	fake.boolVarMutex.Lock()
+	defer fake.boolVarMutex.Unlock()
+	setsArgs := map[int]interface{}{i: value}
+	for j, v := range fake.boolVarSetsArgs {
+		if j != i {
+			setsArgs[j] = v
+		}
+	}
+	fake.boolVarSetsArgs = setsArgs
+}
+
+// BoolVarCallsWhen calls the stub for the calls whose arguments match.
+// Matchers are checked before the stub, and the matcher registered last wins.
+func (fake *FakePackagemode) BoolVarCallsWhen(matcher func(*bool, string, bool, string) bool, stub func(*bool, string, bool, string)) {
+	fake.boolVarMutex.Lock()
+	defer fake.boolVarMutex.Unlock()
+	fake.boolVarWhen = append(fake.boolVarWhen, struct {
+		matcher func(*bool, string, bool, string) bool
+		stub    func(*bool, string, bool, string)
+	}{matcher, stub})
+}
+
 func (fake *FakePackagemode) BoolVarArgsForCall(i int) (*bool, string, bool, string) {
 	fake.boolVarMutex.RLock()
 	defer fake.boolVarMutex.RUnlock()
@@ -266,6 +566,55 @@ func (fake *FakePackagemode) BoolVarArgsForCall(i int) (*bool, string, bool, str
 	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
 }
 
+func (fake *FakePackagemode) BoolVarCallHistory() []FakePackagemodeBoolVarCall {
+	fake.boolVarMutex.RLock()
+	defer fake.boolVarMutex.RUnlock()
+	history := make([]FakePackagemodeBoolVarCall, len(fake.boolVarArgsForCall))
+	for i, argsForCall := range fake.boolVarArgsForCall {
+		history[i] = FakePackagemodeBoolVarCall{argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4}
+	}
+	return history
+}
+
+func (fake *FakePackagemode) ResetBoolVar() {
+	fake.ResetBoolVarCalls()
+	fake.ResetBoolVarStubs()
+}
+
+func (fake *FakePackagemode) ResetBoolVarCalls() {
+	fake.boolVarMutex.Lock()
+	defer fake.boolVarMutex.Unlock()
+	fake.boolVarArgsForCall = nil
+	fake.forgetInvocations("BoolVar")
+}
+
+func (fake *FakePackagemode) ResetBoolVarStubs() {
+	fake.boolVarMutex.Lock()
+	defer fake.boolVarMutex.Unlock()
+	fake.BoolVarStub = nil
+	fake.boolVarWhen = nil
+	fake.boolVarSetsArgs = nil
+}
+
+func (fake *FakePackagemode) Reset() {
+	fake.ResetCalls()
+	fake.ResetStubs()
+}
+
+func (fake *FakePackagemode) ResetCalls() {
+	fake.ResetArgCalls()
+	fake.ResetArgsCalls()
+	fake.ResetBoolCalls()
+	fake.ResetBoolVarCalls()
+}
+
+func (fake *FakePackagemode) ResetStubs() {
+	fake.ResetArgStubs()
+	fake.ResetArgsStubs()
+	fake.ResetBoolStubs()
+	fake.ResetBoolVarStubs()
+}
+
 func (fake *FakePackagemode) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -276,6 +625,129 @@ func (fake *FakePackagemode) Invocations() map[string][][]interface{} {
 	return copiedInvocations
 }
 
+func (fake *FakePackagemode) setArgs(method string, values map[int]interface{}, args []interface{}) {
+	for i, value := range values {
+		if i < 0 || i >= len(args) {
+			panic(fmt.Sprintf("FakePackagemode.%s: cannot set argument %d, because it was called with %d arguments", method, i, len(args)))
+		}
+		target := reflect.ValueOf(args[i])
+		if target.Kind() != reflect.Ptr || target.IsNil() {
+			panic(fmt.Sprintf("FakePackagemode.%s: cannot set argument %d, because it is %T instead of a non-nil pointer", method, i, args[i]))
+		}
+		v := reflect.ValueOf(value)
+		if !v.IsValid() {
+			v = reflect.Zero(target.Elem().Type())
+		}
+		if !v.Type().AssignableTo(target.Elem().Type()) {
+			panic(fmt.Sprintf("FakePackagemode.%s: cannot set argument %d, because %T is not assignable to %s", method, i, value, target.Elem().Type()))
+		}
+		target.Elem().Set(v)
+	}
+}
+
+func (fake *FakePackagemode) argsMatch(expected []interface{}, actual []interface{}) bool {
+	if len(expected) != len(actual) {
+		return false
+	}
+	for i := range expected {
+		if fake.ArgsComparer != nil && !fake.ArgsComparer(expected[i], actual[i]) || fake.ArgsComparer == nil && !reflect.DeepEqual(expected[i], actual[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (fake *FakePackagemode) OrderedInvocations() []struct {
+	Seq    uint64
+	Method string
+	Args   []interface{}
+} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	return append([]struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}{}, fake.orderedInvocations...)
+}
+
+func (fake *FakePackagemode) SetSequencer(sequencer *atomic.Uint64) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	fake.sequencer = sequencer
+}
+
+func (fake *FakePackagemode) waitForCalls(ctx context.Context, callCount func() int, n int) error {
+	for {
+		fake.invocationsMutex.Lock()
+		if fake.invocationsChanged == nil {
+			fake.invocationsChanged = make(chan struct{})
+		}
+		changed := fake.invocationsChanged
+		fake.invocationsMutex.Unlock()
+		if callCount() >= n {
+			return nil
+		}
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (fake *FakePackagemode) callsChan(ctx context.Context, key string) <-chan []interface{} {
+	ctx, cancel := context.WithCancel(ctx)
+	calls := make(chan []interface{})
+	ready := make(chan struct{}, 1)
+	var pendingMutex sync.Mutex
+	var pending [][]interface{}
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.callsSubscribers == nil {
+		fake.callsSubscribers = map[string][]struct {
+			publish func(args []interface{})
+			cancel  context.CancelFunc
+			done    <-chan struct{}
+		}{}
+	}
+	fake.callsSubscribers[key] = append(fake.callsSubscribers[key], struct {
+		publish func(args []interface{})
+		cancel  context.CancelFunc
+		done    <-chan struct{}
+	}{func(args []interface{}) {
+		pendingMutex.Lock()
+		pending = append(pending, args)
+		pendingMutex.Unlock()
+		select {
+		case ready <- struct{}{}:
+		default:
+		}
+	}, cancel, ctx.Done()})
+	go func() {
+		defer close(calls)
+		for {
+			select {
+			case <-ready:
+			case <-ctx.Done():
+				return
+			}
+			pendingMutex.Lock()
+			queued := pending
+			pending = nil
+			pendingMutex.Unlock()
+			for _, args := range queued {
+				select {
+				case calls <- args:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return calls
+}
+
 func (fake *FakePackagemode) recordInvocation(key string, args []interface{}) {
 	fake.invocationsMutex.Lock()
 	defer fake.invocationsMutex.Unlock()
@@ -286,6 +758,54 @@ func (fake *FakePackagemode) recordInvocation(key string, args []interface{}) {
 		fake.invocations[key] = [][]interface{}{}
 	}
 	fake.invocations[key] = append(fake.invocations[key], args)
+	if fake.sequencer == nil {
+		fake.sequencer = new(atomic.Uint64)
+	}
+	fake.orderedInvocations = append(fake.orderedInvocations, struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}{fake.sequencer.Add(1), key, args})
+	if fake.invocationsChanged != nil {
+		close(fake.invocationsChanged)
+		fake.invocationsChanged = nil
+	}
+	subscribers := fake.callsSubscribers[key][:0]
+	for _, subscriber := range fake.callsSubscribers[key] {
+		select {
+		case <-subscriber.done:
+			continue
+		default:
+		}
+		subscriber.publish(args)
+		subscribers = append(subscribers, subscriber)
+	}
+	if len(subscribers) > 0 {
+		fake.callsSubscribers[key] = subscribers
+	} else {
+		delete(fake.callsSubscribers, key)
+	}
+}
+
+func (fake *FakePackagemode) forgetInvocations(key string) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	delete(fake.invocations, key)
+	for _, subscriber := range fake.callsSubscribers[key] {
+		subscriber.cancel()
+	}
+	delete(fake.callsSubscribers, key)
+	var orderedInvocations []struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}
+	for _, invocation := range fake.orderedInvocations {
+		if invocation.Method != key {
+			orderedInvocations = append(orderedInvocations, invocation)
+		}
+	}
+	fake.orderedInvocations = orderedInvocations
 }
 
 var _ packagemodeshim.Packagemode = new(FakePackagemode)
@@ -0,0 +1,37 @@
+package fixtures
+
+import (
+	"fmt"
+	"io"
+)
+
+//counterfeiter:generate -style replay -fake-name ReplaySomething . Something
+//counterfeiter:generate -style replay -fake-name ReplayHasVarArgs . HasVarArgs
+
+//counterfeiter:generate -style replay -fake-name ReplayChecker . Checker
+type Checker interface {
+	Check(name string) (*CheckError, error)
+}
+
+type CheckError struct {
+	Code int
+}
+
+func (e *CheckError) Error() string {
+	return fmt.Sprintf("check failed with code %d", e.Code)
+}
+
+// Filler writes through its arguments, which a replay style fake writes back
+// when it replays a call.
+//
+//counterfeiter:generate -style replay -fake-name ReplayFiller . Filler
+type Filler interface {
+	Read(p []byte) (int, error)
+	Count(total *int)
+}
+
+// Opener cannot be faked with the replay style, as an io.ReadCloser cannot be
+// unmarshaled from JSON.
+type Opener interface {
+	Open(name string) (io.ReadCloser, error)
+}
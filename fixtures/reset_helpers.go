@@ -0,0 +1,26 @@
+package fixtures
+
+import "hash"
+
+//counterfeiter:generate . RecordsCalls
+type RecordsCalls interface {
+	Calls() []string
+	Stubs(name string) error
+}
+
+// CollidingResetHelpers has a method with the name of the Reset helper of its
+// Calls method, so the fake leaves that helper out.
+//
+//counterfeiter:generate . CollidingResetHelpers
+type CollidingResetHelpers interface {
+	Calls() []string
+	ResetCalls()
+}
+
+// Hash has a Reset method, whose Calls helper is named like the ResetCalls
+// helper of the whole fake.
+//
+//counterfeiter:generate . Hash
+type Hash interface {
+	hash.Hash
+}
@@ -1,6 +1,6 @@
 package fixtures
 
-//counterfeiter:generate . Something
+//counterfeiter:generate -constructor . Something
 type Something interface {
 	DoThings(string, uint64) (int, error)
 	DoNothing()
@@ -2,8 +2,12 @@
 package sqlfakes
 
 import (
+	"context"
 	sqla "database/sql"
+	"fmt"
+	"reflect"
 	"sync"
+	"sync/atomic"
 
 	"github.com/maxbrunsfeld/counterfeiter/v6/fixtures/sql"
 )
@@ -15,7 +19,12 @@ type FakeDB struct {
 		arg1 string
 		arg2 []interface{}
 	}
-	execReturns struct {
+	execWhen []struct {
+		matcher func(string, ...interface{}) bool
+		stub    func(string, ...interface{}) (sqla.Result, error)
+	}
+	execSetsArgs map[int]interface{}
+	execReturns  struct {
 		result1 sqla.Result
 		result2 error
 	}
@@ -23,10 +32,28 @@ type FakeDB struct {
 		result1 sqla.Result
 		result2 error
 	}
-	invocations      map[string][][]interface{}
+	ArgsComparer       func(expected interface{}, actual interface{}) bool
+	invocations        map[string][][]interface{}
+	orderedInvocations []struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}
+	sequencer          *atomic.Uint64
+	invocationsChanged chan struct{}
+	callsSubscribers   map[string][]struct {
+		publish func(args []interface{})
+		cancel  context.CancelFunc
+		done    <-chan struct{}
+	}
 	invocationsMutex sync.RWMutex
 }
 
+type FakeDBExecCall struct {
+	Query string
+	Args  []interface{}
+}
+
 func (fake *FakeDB) Exec(arg1 string, arg2 ...interface{}) (sqla.Result, error) {
 	fake.execMutex.Lock()
 	ret, specificReturn := fake.execReturnsOnCall[len(fake.execArgsForCall)]
@@ -35,9 +62,23 @@ func (fake *FakeDB) Exec(arg1 string, arg2 ...interface{}) (sqla.Result, error)
 		arg2 []interface{}
 	}{arg1, arg2})
 	stub := fake.ExecStub
+	whens := fake.execWhen
+	setsArgs := fake.execSetsArgs
 	fakeReturns := fake.execReturns
 	fake.recordInvocation("Exec", []interface{}{arg1, arg2})
 	fake.execMutex.Unlock()
+	if len(setsArgs) > 0 {
+		args := []interface{}{arg1}
+		for _, arg := range arg2 {
+			args = append(args, arg)
+		}
+		fake.setArgs("Exec", setsArgs, args)
+	}
+	for i := len(whens) - 1; i >= 0; i-- {
+		if whens[i].matcher(arg1, arg2...) {
+			return whens[i].stub(arg1, arg2...)
+		}
+	}
 	if stub != nil {
 		return stub(arg1, arg2...)
 	}
@@ -53,12 +94,43 @@ func (fake *FakeDB) ExecCallCount() int {
 	return len(fake.execArgsForCall)
 }
 
+func (fake *FakeDB) WaitForExecCalls(ctx context.Context, n int) error {
+	return fake.waitForCalls(ctx, fake.ExecCallCount, n)
+}
+
+func (fake *FakeDB) ExecCallsChan(ctx context.Context) <-chan []interface{} {
+	return fake.callsChan(ctx, "Exec")
+}
+
 func (fake *FakeDB) ExecCalls(stub func(string, ...interface{}) (sqla.Result, error)) {
 	fake.execMutex.Lock()
 	defer fake.execMutex.Unlock()
 	fake.ExecStub = stub
 }
 
+func (fake *FakeDB) ExecSetsArg(i int, value interface{}) {
+	fake.execMutex.Lock()
+	defer fake.execMutex.Unlock()
+	setsArgs := map[int]interface{}{i: value}
+	for j, v := range fake.execSetsArgs {
+		if j != i {
+			setsArgs[j] = v
+		}
+	}
+	fake.execSetsArgs = setsArgs
+}
+
+// ExecCallsWhen calls the stub for the calls whose arguments match.
+// Matchers are checked before the stub, and the matcher registered last wins.
+func (fake *FakeDB) ExecCallsWhen(matcher func(string, ...interface{}) bool, stub func(string, ...interface{}) (sqla.Result, error)) {
+	fake.execMutex.Lock()
+	defer fake.execMutex.Unlock()
+	fake.execWhen = append(fake.execWhen, struct {
+		matcher func(string, ...interface{}) bool
+		stub    func(string, ...interface{}) (sqla.Result, error)
+	}{matcher, stub})
+}
+
 func (fake *FakeDB) ExecArgsForCall(i int) (string, []interface{}) {
 	fake.execMutex.RLock()
 	defer fake.execMutex.RUnlock()
@@ -66,6 +138,16 @@ func (fake *FakeDB) ExecArgsForCall(i int) (string, []interface{}) {
 	return argsForCall.arg1, argsForCall.arg2
 }
 
+func (fake *FakeDB) ExecCallHistory() []FakeDBExecCall {
+	fake.execMutex.RLock()
+	defer fake.execMutex.RUnlock()
+	history := make([]FakeDBExecCall, len(fake.execArgsForCall))
+	for i, argsForCall := range fake.execArgsForCall {
+		history[i] = FakeDBExecCall{argsForCall.arg1, argsForCall.arg2}
+	}
+	return history
+}
+
 func (fake *FakeDB) ExecReturns(result1 sqla.Result, result2 error) {
 	fake.execMutex.Lock()
 	defer fake.execMutex.Unlock()
@@ -92,6 +174,76 @@ func (fake *FakeDB) ExecReturnsOnCall(i int, result1 sqla.Result, result2 error)
 	}{result1, result2}
 }
 
+// ExecReturnsForArgs returns the values for the calls with the given
+// arguments. Like ExecCallsWhen, it is checked before the stub, and the
+// arguments or matcher registered last win.
+func (fake *FakeDB) ExecReturnsForArgs(arg1 string, arg2 ...interface{}) func(sqla.Result, error) {
+	args := []interface{}{arg1, arg2}
+	return func(result1 sqla.Result, result2 error) {
+		fake.execMutex.Lock()
+		defer fake.execMutex.Unlock()
+		fake.ExecStub = nil
+		fake.execWhen = append(fake.execWhen, struct {
+			matcher func(string, ...interface{}) bool
+			stub    func(string, ...interface{}) (sqla.Result, error)
+		}{
+			func(arg1 string, arg2 ...interface{}) bool {
+				return fake.argsMatch(args, []interface{}{arg1, arg2})
+			},
+			func(string, ...interface{}) (sqla.Result, error) {
+				return result1, result2
+			},
+		})
+	}
+}
+
+// ExecReturnsWhen returns the values for the calls whose arguments
+// match. Like ExecCallsWhen, it is checked before the stub, and the
+// matcher registered last wins.
+func (fake *FakeDB) ExecReturnsWhen(matcher func(string, ...interface{}) bool, result1 sqla.Result, result2 error) {
+	fake.ExecCallsWhen(matcher, func(string, ...interface{}) (sqla.Result, error) {
+		return result1, result2
+	})
+}
+
+func (fake *FakeDB) ResetExec() {
+	fake.ResetExecCalls()
+	fake.ResetExecStubs()
+}
+
+func (fake *FakeDB) ResetExecCalls() {
+	fake.execMutex.Lock()
+	defer fake.execMutex.Unlock()
+	fake.execArgsForCall = nil
+	fake.forgetInvocations("Exec")
+}
+
+func (fake *FakeDB) ResetExecStubs() {
+	fake.execMutex.Lock()
+	defer fake.execMutex.Unlock()
+	fake.ExecStub = nil
+	fake.execWhen = nil
+	fake.execSetsArgs = nil
+	fake.execReturns = struct {
+		result1 sqla.Result
+		result2 error
+	}{}
+	fake.execReturnsOnCall = nil
+}
+
+func (fake *FakeDB) Reset() {
+	fake.ResetCalls()
+	fake.ResetStubs()
+}
+
+func (fake *FakeDB) ResetCalls() {
+	fake.ResetExecCalls()
+}
+
+func (fake *FakeDB) ResetStubs() {
+	fake.ResetExecStubs()
+}
+
 func (fake *FakeDB) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -102,6 +254,129 @@ func (fake *FakeDB) Invocations() map[string][][]interface{} {
 	return copiedInvocations
 }
 
+func (fake *FakeDB) setArgs(method string, values map[int]interface{}, args []interface{}) {
+	for i, value := range values {
+		if i < 0 || i >= len(args) {
+			panic(fmt.Sprintf("FakeDB.%s: cannot set argument %d, because it was called with %d arguments", method, i, len(args)))
+		}
+		target := reflect.ValueOf(args[i])
+		if target.Kind() != reflect.Ptr || target.IsNil() {
+			panic(fmt.Sprintf("FakeDB.%s: cannot set argument %d, because it is %T instead of a non-nil pointer", method, i, args[i]))
+		}
+		v := reflect.ValueOf(value)
+		if !v.IsValid() {
+			v = reflect.Zero(target.Elem().Type())
+		}
+		if !v.Type().AssignableTo(target.Elem().Type()) {
+			panic(fmt.Sprintf("FakeDB.%s: cannot set argument %d, because %T is not assignable to %s", method, i, value, target.Elem().Type()))
+		}
+		target.Elem().Set(v)
+	}
+}
+
+func (fake *FakeDB) argsMatch(expected []interface{}, actual []interface{}) bool {
+	if len(expected) != len(actual) {
+		return false
+	}
+	for i := range expected {
+		if fake.ArgsComparer != nil && !fake.ArgsComparer(expected[i], actual[i]) || fake.ArgsComparer == nil && !reflect.DeepEqual(expected[i], actual[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (fake *FakeDB) OrderedInvocations() []struct {
+	Seq    uint64
+	Method string
+	Args   []interface{}
+} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	return append([]struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}{}, fake.orderedInvocations...)
+}
+
+func (fake *FakeDB) SetSequencer(sequencer *atomic.Uint64) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	fake.sequencer = sequencer
+}
+
+func (fake *FakeDB) waitForCalls(ctx context.Context, callCount func() int, n int) error {
+	for {
+		fake.invocationsMutex.Lock()
+		if fake.invocationsChanged == nil {
+			fake.invocationsChanged = make(chan struct{})
+		}
+		changed := fake.invocationsChanged
+		fake.invocationsMutex.Unlock()
+		if callCount() >= n {
+			return nil
+		}
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (fake *FakeDB) callsChan(ctx context.Context, key string) <-chan []interface{} {
+	ctx, cancel := context.WithCancel(ctx)
+	calls := make(chan []interface{})
+	ready := make(chan struct{}, 1)
+	var pendingMutex sync.Mutex
+	var pending [][]interface{}
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.callsSubscribers == nil {
+		fake.callsSubscribers = map[string][]struct {
+			publish func(args []interface{})
+			cancel  context.CancelFunc
+			done    <-chan struct{}
+		}{}
+	}
+	fake.callsSubscribers[key] = append(fake.callsSubscribers[key], struct {
+		publish func(args []interface{})
+		cancel  context.CancelFunc
+		done    <-chan struct{}
+	}{func(args []interface{}) {
+		pendingMutex.Lock()
+		pending = append(pending, args)
+		pendingMutex.Unlock()
+		select {
+		case ready <- struct{}{}:
+		default:
+		}
+	}, cancel, ctx.Done()})
+	go func() {
+		defer close(calls)
+		for {
+			select {
+			case <-ready:
+			case <-ctx.Done():
+				return
+			}
+			pendingMutex.Lock()
+			queued := pending
+			pending = nil
+			pendingMutex.Unlock()
+			for _, args := range queued {
+				select {
+				case calls <- args:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return calls
+}
+
 func (fake *FakeDB) recordInvocation(key string, args []interface{}) {
 	fake.invocationsMutex.Lock()
 	defer fake.invocationsMutex.Unlock()
@@ -112,6 +387,54 @@ func (fake *FakeDB) recordInvocation(key string, args []interface{}) {
 		fake.invocations[key] = [][]interface{}{}
 	}
 	fake.invocations[key] = append(fake.invocations[key], args)
+	if fake.sequencer == nil {
+		fake.sequencer = new(atomic.Uint64)
+	}
+	fake.orderedInvocations = append(fake.orderedInvocations, struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}{fake.sequencer.Add(1), key, args})
+	if fake.invocationsChanged != nil {
+		close(fake.invocationsChanged)
+		fake.invocationsChanged = nil
+	}
+	subscribers := fake.callsSubscribers[key][:0]
+	for _, subscriber := range fake.callsSubscribers[key] {
+		select {
+		case <-subscriber.done:
+			continue
+		default:
+		}
+		subscriber.publish(args)
+		subscribers = append(subscribers, subscriber)
+	}
+	if len(subscribers) > 0 {
+		fake.callsSubscribers[key] = subscribers
+	} else {
+		delete(fake.callsSubscribers, key)
+	}
+}
+
+func (fake *FakeDB) forgetInvocations(key string) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	delete(fake.invocations, key)
+	for _, subscriber := range fake.callsSubscribers[key] {
+		subscriber.cancel()
+	}
+	delete(fake.callsSubscribers, key)
+	var orderedInvocations []struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}
+	for _, invocation := range fake.orderedInvocations {
+		if invocation.Method != key {
+			orderedInvocations = append(orderedInvocations, invocation)
+		}
+	}
+	fake.orderedInvocations = orderedInvocations
 }
 
 var _ sql.DB = new(FakeDB)
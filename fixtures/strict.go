@@ -0,0 +1,10 @@
+package fixtures
+
+//counterfeiter:generate -constructor -strict . StrictSomething
+type StrictSomething interface {
+	DoThings(string, uint64) (int, error)
+	DoNothing()
+}
+
+//counterfeiter:generate -constructor -strict . StrictFunction
+type StrictFunction func(string) error
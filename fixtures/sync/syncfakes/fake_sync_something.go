@@ -2,7 +2,10 @@
 package syncfakes
 
 import (
+	"context"
+	"reflect"
 	"sync"
+	"sync/atomic"
 
 	synca "github.com/maxbrunsfeld/counterfeiter/v6/fixtures/sync"
 )
@@ -13,11 +16,19 @@ type FakeSyncSomething struct {
 	doASliceArgsForCall []struct {
 		arg1 []byte
 	}
+	doASliceWhen []struct {
+		matcher func([]byte) bool
+		stub    func([]byte)
+	}
 	DoAnArrayStub        func([4]byte)
 	doAnArrayMutex       sync.RWMutex
 	doAnArrayArgsForCall []struct {
 		arg1 [4]byte
 	}
+	doAnArrayWhen []struct {
+		matcher func([4]byte) bool
+		stub    func([4]byte)
+	}
 	DoNothingStub        func()
 	doNothingMutex       sync.RWMutex
 	doNothingArgsForCall []struct {
@@ -28,6 +39,10 @@ type FakeSyncSomething struct {
 		arg1 string
 		arg2 uint64
 	}
+	doThingsWhen []struct {
+		matcher func(string, uint64) bool
+		stub    func(string, uint64) (int, error)
+	}
 	doThingsReturns struct {
 		result1 int
 		result2 error
@@ -36,10 +51,27 @@ type FakeSyncSomething struct {
 		result1 int
 		result2 error
 	}
-	invocations      map[string][][]interface{}
+	ArgsComparer       func(expected interface{}, actual interface{}) bool
+	invocations        map[string][][]interface{}
+	orderedInvocations []struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}
+	sequencer          *atomic.Uint64
+	invocationsChanged chan struct{}
+	callsSubscribers   map[string][]struct {
+		publish func(args []interface{})
+		cancel  context.CancelFunc
+		done    <-chan struct{}
+	}
 	invocationsMutex sync.RWMutex
 }
 
+type FakeSyncSomethingDoASliceCall struct {
+	Arg1 []byte
+}
+
 func (fake *FakeSyncSomething) DoASlice(arg1 []byte) {
 	var arg1Copy []byte
 	if arg1 != nil {
@@ -51,8 +83,15 @@ func (fake *FakeSyncSomething) DoASlice(arg1 []byte) {
 		arg1 []byte
 	}{arg1Copy})
 	stub := fake.DoASliceStub
+	whens := fake.doASliceWhen
 	fake.recordInvocation("DoASlice", []interface{}{arg1Copy})
 	fake.doASliceMutex.Unlock()
+	for i := len(whens) - 1; i >= 0; i-- {
+		if whens[i].matcher(arg1) {
+			whens[i].stub(arg1)
+			return
+		}
+	}
 	if stub != nil {
 		fake.DoASliceStub(arg1)
 	}
@@ -64,12 +103,31 @@ func (fake *FakeSyncSomething) DoASliceCallCount() int {
 	return len(fake.doASliceArgsForCall)
 }
 
+func (fake *FakeSyncSomething) WaitForDoASliceCalls(ctx context.Context, n int) error {
+	return fake.waitForCalls(ctx, fake.DoASliceCallCount, n)
+}
+
+func (fake *FakeSyncSomething) DoASliceCallsChan(ctx context.Context) <-chan []interface{} {
+	return fake.callsChan(ctx, "DoASlice")
+}
+
 func (fake *FakeSyncSomething) DoASliceCalls(stub func([]byte)) {
 	fake.doASliceMutex.Lock()
 	defer fake.doASliceMutex.Unlock()
 	fake.DoASliceStub = stub
 }
 
+// DoASliceCallsWhen calls the stub for the calls whose arguments match.
+// Matchers are checked before the stub, and the matcher registered last wins.
+func (fake *FakeSyncSomething) DoASliceCallsWhen(matcher func([]byte) bool, stub func([]byte)) {
+	fake.doASliceMutex.Lock()
+	defer fake.doASliceMutex.Unlock()
+	fake.doASliceWhen = append(fake.doASliceWhen, struct {
+		matcher func([]byte) bool
+		stub    func([]byte)
+	}{matcher, stub})
+}
+
 func (fake *FakeSyncSomething) DoASliceArgsForCall(i int) []byte {
 	fake.doASliceMutex.RLock()
 	defer fake.doASliceMutex.RUnlock()
@@ -77,14 +135,54 @@ func (fake *FakeSyncSomething) DoASliceArgsForCall(i int) []byte {
 	return argsForCall.arg1
 }
 
+func (fake *FakeSyncSomething) DoASliceCallHistory() []FakeSyncSomethingDoASliceCall {
+	fake.doASliceMutex.RLock()
+	defer fake.doASliceMutex.RUnlock()
+	history := make([]FakeSyncSomethingDoASliceCall, len(fake.doASliceArgsForCall))
+	for i, argsForCall := range fake.doASliceArgsForCall {
+		history[i] = FakeSyncSomethingDoASliceCall{argsForCall.arg1}
+	}
+	return history
+}
+
+func (fake *FakeSyncSomething) ResetDoASlice() {
+	fake.ResetDoASliceCalls()
+	fake.ResetDoASliceStubs()
+}
+
+func (fake *FakeSyncSomething) ResetDoASliceCalls() {
+	fake.doASliceMutex.Lock()
+	defer fake.doASliceMutex.Unlock()
+	fake.doASliceArgsForCall = nil
+	fake.forgetInvocations("DoASlice")
+}
+
+func (fake *FakeSyncSomething) ResetDoASliceStubs() {
+	fake.doASliceMutex.Lock()
+	defer fake.doASliceMutex.Unlock()
+	fake.DoASliceStub = nil
+	fake.doASliceWhen = nil
+}
+
+type FakeSyncSomethingDoAnArrayCall struct {
+	Arg1 [4]byte
+}
+
 func (fake *FakeSyncSomething) DoAnArray(arg1 [4]byte) {
 	fake.doAnArrayMutex.Lock()
 	fake.doAnArrayArgsForCall = append(fake.doAnArrayArgsForCall, struct {
 		arg1 [4]byte
 	}{arg1})
 	stub := fake.DoAnArrayStub
+	whens := fake.doAnArrayWhen
 	fake.recordInvocation("DoAnArray", []interface{}{arg1})
 	fake.doAnArrayMutex.Unlock()
+	for i := len(whens) - 1; i >= 0; i-- {
+		if whens[i].matcher(arg1) {
+			whens[i].stub(arg1)
+			return
+		}
+	}
 	if stub != nil {
 		fake.DoAnArrayStub(arg1)
 	}
@@ -96,12 +194,31 @@ func (fake *FakeSyncSomething) DoAnArrayCallCount() int {
 	return len(fake.doAnArrayArgsForCall)
 }
 
+func (fake *FakeSyncSomething) WaitForDoAnArrayCalls(ctx context.Context, n int) error {
+	return fake.waitForCalls(ctx, fake.DoAnArrayCallCount, n)
+}
+
+func (fake *FakeSyncSomething) DoAnArrayCallsChan(ctx context.Context) <-chan []interface{} {
+	return fake.callsChan(ctx, "DoAnArray")
+}
+
 func (fake *FakeSyncSomething) DoAnArrayCalls(stub func([4]byte)) {
 	fake.doAnArrayMutex.Lock()
 	defer fake.doAnArrayMutex.Unlock()
 	fake.DoAnArrayStub = stub
 }
 
+// DoAnArrayCallsWhen calls the stub for the calls whose arguments match.
+// Matchers are checked before the stub, and the matcher registered last wins.
+func (fake *FakeSyncSomething) DoAnArrayCallsWhen(matcher func([4]byte) bool, stub func([4]byte)) {
+	fake.doAnArrayMutex.Lock()
+	defer fake.doAnArrayMutex.Unlock()
+	fake.doAnArrayWhen = append(fake.doAnArrayWhen, struct {
+		matcher func([4]byte) bool
+		stub    func([4]byte)
+	}{matcher, stub})
+}
+
 func (fake *FakeSyncSomething) DoAnArrayArgsForCall(i int) [4]byte {
 	fake.doAnArrayMutex.RLock()
 	defer fake.doAnArrayMutex.RUnlock()
@@ -109,6 +226,35 @@ func (fake *FakeSyncSomething) DoAnArrayArgsForCall(i int) [4]byte {
 	return argsForCall.arg1
 }
 
+func (fake *FakeSyncSomething) DoAnArrayCallHistory() []FakeSyncSomethingDoAnArrayCall {
+	fake.doAnArrayMutex.RLock()
+	defer fake.doAnArrayMutex.RUnlock()
+	history := make([]FakeSyncSomethingDoAnArrayCall, len(fake.doAnArrayArgsForCall))
+	for i, argsForCall := range fake.doAnArrayArgsForCall {
+		history[i] = FakeSyncSomethingDoAnArrayCall{argsForCall.arg1}
+	}
+	return history
+}
+
+func (fake *FakeSyncSomething) ResetDoAnArray() {
+	fake.ResetDoAnArrayCalls()
+	fake.ResetDoAnArrayStubs()
+}
+
+func (fake *FakeSyncSomething) ResetDoAnArrayCalls() {
+	fake.doAnArrayMutex.Lock()
+	defer fake.doAnArrayMutex.Unlock()
+	fake.doAnArrayArgsForCall = nil
+	fake.forgetInvocations("DoAnArray")
+}
+
+func (fake *FakeSyncSomething) ResetDoAnArrayStubs() {
+	fake.doAnArrayMutex.Lock()
+	defer fake.doAnArrayMutex.Unlock()
+	fake.DoAnArrayStub = nil
+	fake.doAnArrayWhen = nil
+}
+
 func (fake *FakeSyncSomething) DoNothing() {
 	fake.doNothingMutex.Lock()
 	fake.doNothingArgsForCall = append(fake.doNothingArgsForCall, struct {
@@ -127,12 +273,43 @@ func (fake *FakeSyncSomething) DoNothingCallCount() int {
 	return len(fake.doNothingArgsForCall)
 }
 
+func (fake *FakeSyncSomething) WaitForDoNothingCalls(ctx context.Context, n int) error {
+	return fake.waitForCalls(ctx, fake.DoNothingCallCount, n)
+}
+
+func (fake *FakeSyncSomething) DoNothingCallsChan(ctx context.Context) <-chan []interface{} {
+	return fake.callsChan(ctx, "DoNothing")
+}
+
 func (fake *FakeSyncSomething) DoNothingCalls(stub func()) {
 	fake.doNothingMutex.Lock()
 	defer fake.doNothingMutex.Unlock()
 	fake.DoNothingStub = stub
 }
 
+func (fake *FakeSyncSomething) ResetDoNothing() {
+	fake.ResetDoNothingCalls()
+	fake.ResetDoNothingStubs()
+}
+
+func (fake *FakeSyncSomething) ResetDoNothingCalls() {
+	fake.doNothingMutex.Lock()
+	defer fake.doNothingMutex.Unlock()
+	fake.doNothingArgsForCall = nil
+	fake.forgetInvocations("DoNothing")
+}
+
+func (fake *FakeSyncSomething) ResetDoNothingStubs() {
+	fake.doNothingMutex.Lock()
+	defer fake.doNothingMutex.Unlock()
+	fake.DoNothingStub = nil
+}
+
+type FakeSyncSomethingDoThingsCall struct {
+	Arg1 string
+	Arg2 uint64
+}
+
 func (fake *FakeSyncSomething) DoThings(arg1 string, arg2 uint64) (int, error) {
 	fake.doThingsMutex.Lock()
 	ret, specificReturn := fake.doThingsReturnsOnCall[len(fake.doThingsArgsForCall)]
@@ -141,9 +318,15 @@ func (fake *FakeSyncSomething) DoThings(arg1 string, arg2 uint64) (int, error) {
 		arg2 uint64
 	}{arg1, arg2})
 	stub := fake.DoThingsStub
+	whens := fake.doThingsWhen
 	fakeReturns := fake.doThingsReturns
 	fake.recordInvocation("DoThings", []interface{}{arg1, arg2})
 	fake.doThingsMutex.Unlock()
+	for i := len(whens) - 1; i >= 0; i-- {
+		if whens[i].matcher(arg1, arg2) {
+			return whens[i].stub(arg1, arg2)
+		}
+	}
 	if stub != nil {
 		return stub(arg1, arg2)
 	}
@@ -159,12 +342,31 @@ func (fake *FakeSyncSomething) DoThingsCallCount() int {
 	return len(fake.doThingsArgsForCall)
 }
 
+func (fake *FakeSyncSomething) WaitForDoThingsCalls(ctx context.Context, n int) error {
+	return fake.waitForCalls(ctx, fake.DoThingsCallCount, n)
+}
+
+func (fake *FakeSyncSomething) DoThingsCallsChan(ctx context.Context) <-chan []interface{} {
+	return fake.callsChan(ctx, "DoThings")
+}
+
 func (fake *FakeSyncSomething) DoThingsCalls(stub func(string, uint64) (int, error)) {
 	fake.doThingsMutex.Lock()
 	defer fake.doThingsMutex.Unlock()
 	fake.DoThingsStub = stub
 }
 
+// DoThingsCallsWhen calls the stub for the calls whose arguments match.
+// Matchers are checked before the stub, and the matcher registered last wins.
+func (fake *FakeSyncSomething) DoThingsCallsWhen(matcher func(string, uint64) bool, stub func(string, uint64) (int, error)) {
+	fake.doThingsMutex.Lock()
+	defer fake.doThingsMutex.Unlock()
+	fake.doThingsWhen = append(fake.doThingsWhen, struct {
+		matcher func(string, uint64) bool
+		stub    func(string, uint64) (int, error)
+	}{matcher, stub})
+}
+
 func (fake *FakeSyncSomething) DoThingsArgsForCall(i int) (string, uint64) {
 	fake.doThingsMutex.RLock()
 	defer fake.doThingsMutex.RUnlock()
@@ -172,6 +374,16 @@ func (fake *FakeSyncSomething) DoThingsArgsForCall(i int) (string, uint64) {
 	return argsForCall.arg1, argsForCall.arg2
 }
 
+func (fake *FakeSyncSomething) DoThingsCallHistory() []FakeSyncSomethingDoThingsCall {
+	fake.doThingsMutex.RLock()
+	defer fake.doThingsMutex.RUnlock()
+	history := make([]FakeSyncSomethingDoThingsCall, len(fake.doThingsArgsForCall))
+	for i, argsForCall := range fake.doThingsArgsForCall {
+		history[i] = FakeSyncSomethingDoThingsCall{argsForCall.arg1, argsForCall.arg2}
+	}
+	return history
+}
+
 func (fake *FakeSyncSomething) DoThingsReturns(result1 int, result2 error) {
 	fake.doThingsMutex.Lock()
 	defer fake.doThingsMutex.Unlock()
@@ -198,6 +410,81 @@ func (fake *FakeSyncSomething) DoThingsReturnsOnCall(i int, result1 int, result2
 	}{result1, result2}
 }
 
+// DoThingsReturnsForArgs returns the values for the calls with the given
+// arguments. Like DoThingsCallsWhen, it is checked before the stub, and the
+// arguments or matcher registered last win.
+func (fake *FakeSyncSomething) DoThingsReturnsForArgs(arg1 string, arg2 uint64) func(int, error) {
+	args := []interface{}{arg1, arg2}
+	return func(result1 int, result2 error) {
+		fake.doThingsMutex.Lock()
+		defer fake.doThingsMutex.Unlock()
+		fake.DoThingsStub = nil
+		fake.doThingsWhen = append(fake.doThingsWhen, struct {
+			matcher func(string, uint64) bool
+			stub    func(string, uint64) (int, error)
+		}{
+			func(arg1 string, arg2 uint64) bool {
+				return fake.argsMatch(args, []interface{}{arg1, arg2})
+			},
+			func(string, uint64) (int, error) {
+				return result1, result2
+			},
+		})
+	}
+}
+
+// DoThingsReturnsWhen returns the values for the calls whose arguments
+// match. Like DoThingsCallsWhen, it is checked before the stub, and the
+// matcher registered last wins.
+func (fake *FakeSyncSomething) DoThingsReturnsWhen(matcher func(string, uint64) bool, result1 int, result2 error) {
+	fake.DoThingsCallsWhen(matcher, func(string, uint64) (int, error) {
+		return result1, result2
+	})
+}
+
+func (fake *FakeSyncSomething) ResetDoThings() {
+	fake.ResetDoThingsCalls()
+	fake.ResetDoThingsStubs()
+}
+
+func (fake *FakeSyncSomething) ResetDoThingsCalls() {
+	fake.doThingsMutex.Lock()
+	defer fake.doThingsMutex.Unlock()
+	fake.doThingsArgsForCall = nil
+	fake.forgetInvocations("DoThings")
+}
+
+func (fake *FakeSyncSomething) ResetDoThingsStubs() {
+	fake.doThingsMutex.Lock()
+	defer fake.doThingsMutex.Unlock()
+	fake.DoThingsStub = nil
+	fake.doThingsWhen = nil
+	fake.doThingsReturns = struct {
+		result1 int
+		result2 error
+	}{}
+	fake.doThingsReturnsOnCall = nil
+}
+
+func (fake *FakeSyncSomething) Reset() {
+	fake.ResetCalls()
+	fake.ResetStubs()
+}
+
+func (fake *FakeSyncSomething) ResetCalls() {
+	fake.ResetDoASliceCalls()
+	fake.ResetDoAnArrayCalls()
+	fake.ResetDoNothingCalls()
+	fake.ResetDoThingsCalls()
+}
+
+func (fake *FakeSyncSomething) ResetStubs() {
+	fake.ResetDoASliceStubs()
+	fake.ResetDoAnArrayStubs()
+	fake.ResetDoNothingStubs()
+	fake.ResetDoThingsStubs()
+}
+
 func (fake *FakeSyncSomething) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -208,6 +495,109 @@ func (fake *FakeSyncSomething) Invocations() map[string][][]interface{} {
 	return copiedInvocations
 }
 
+func (fake *FakeSyncSomething) argsMatch(expected []interface{}, actual []interface{}) bool {
+	if len(expected) != len(actual) {
+		return false
+	}
+	for i := range expected {
+		if fake.ArgsComparer != nil && !fake.ArgsComparer(expected[i], actual[i]) || fake.ArgsComparer == nil && !reflect.DeepEqual(expected[i], actual[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (fake *FakeSyncSomething) OrderedInvocations() []struct {
+	Seq    uint64
+	Method string
+	Args   []interface{}
+} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	return append([]struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}{}, fake.orderedInvocations...)
+}
+
+func (fake *FakeSyncSomething) SetSequencer(sequencer *atomic.Uint64) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	fake.sequencer = sequencer
+}
+
+func (fake *FakeSyncSomething) waitForCalls(ctx context.Context, callCount func() int, n int) error {
+	for {
+		fake.invocationsMutex.Lock()
+		if fake.invocationsChanged == nil {
+			fake.invocationsChanged = make(chan struct{})
+		}
+		changed := fake.invocationsChanged
+		fake.invocationsMutex.Unlock()
+		if callCount() >= n {
+			return nil
+		}
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (fake *FakeSyncSomething) callsChan(ctx context.Context, key string) <-chan []interface{} {
+	ctx, cancel := context.WithCancel(ctx)
+	calls := make(chan []interface{})
+	ready := make(chan struct{}, 1)
+	var pendingMutex sync.Mutex
+	var pending [][]interface{}
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.callsSubscribers == nil {
+		fake.callsSubscribers = map[string][]struct {
+			publish func(args []interface{})
+			cancel  context.CancelFunc
+			done    <-chan struct{}
+		}{}
+	}
+	fake.callsSubscribers[key] = append(fake.callsSubscribers[key], struct {
+		publish func(args []interface{})
+		cancel  context.CancelFunc
+		done    <-chan struct{}
+	}{func(args []interface{}) {
+		pendingMutex.Lock()
+		pending = append(pending, args)
+		pendingMutex.Unlock()
+		select {
+		case ready <- struct{}{}:
+		default:
+		}
+	}, cancel, ctx.Done()})
+	go func() {
+		defer close(calls)
+		for {
+			select {
+			case <-ready:
+			case <-ctx.Done():
+				return
+			}
+			pendingMutex.Lock()
+			queued := pending
+			pending = nil
+			pendingMutex.Unlock()
+			for _, args := range queued {
+				select {
+				case calls <- args:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return calls
+}
+
 func (fake *FakeSyncSomething) recordInvocation(key string, args []interface{}) {
 	fake.invocationsMutex.Lock()
 	defer fake.invocationsMutex.Unlock()
@@ -218,6 +608,54 @@ func (fake *FakeSyncSomething) recordInvocation(key string, args []interface{})
 		fake.invocations[key] = [][]interface{}{}
 	}
 	fake.invocations[key] = append(fake.invocations[key], args)
+	if fake.sequencer == nil {
+		fake.sequencer = new(atomic.Uint64)
+	}
+	fake.orderedInvocations = append(fake.orderedInvocations, struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}{fake.sequencer.Add(1), key, args})
+	if fake.invocationsChanged != nil {
+		close(fake.invocationsChanged)
+		fake.invocationsChanged = nil
+	}
+	subscribers := fake.callsSubscribers[key][:0]
+	for _, subscriber := range fake.callsSubscribers[key] {
+		select {
+		case <-subscriber.done:
+			continue
+		default:
+		}
+		subscriber.publish(args)
+		subscribers = append(subscribers, subscriber)
+	}
+	if len(subscribers) > 0 {
+		fake.callsSubscribers[key] = subscribers
+	} else {
+		delete(fake.callsSubscribers, key)
+	}
+}
+
+func (fake *FakeSyncSomething) forgetInvocations(key string) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	delete(fake.invocations, key)
+	for _, subscriber := range fake.callsSubscribers[key] {
+		subscriber.cancel()
+	}
+	delete(fake.callsSubscribers, key)
+	var orderedInvocations []struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}
+	for _, invocation := range fake.orderedInvocations {
+		if invocation.Method != key {
+			orderedInvocations = append(orderedInvocations, invocation)
+		}
+	}
+	fake.orderedInvocations = orderedInvocations
 }
 
 var _ synca.SyncSomething = new(FakeSyncSomething)
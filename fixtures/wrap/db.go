@@ -0,0 +1,47 @@
+package wrap
+
+import "errors"
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -generate
+
+//counterfeiter:generate -p -with-fake -with-default -variables -wrap DB,Tx -wrap-depth 2 github.com/maxbrunsfeld/counterfeiter/v6/fixtures/wrap
+
+// DB is a concrete type returned by Open.
+type DB struct {
+	data map[string]string
+}
+
+// Current is a package variable, which has a getter and a setter in the shim.
+var Current *DB
+
+// Open returns a DB, or nil and an error when no name is given.
+func Open(name string) (*DB, error) {
+	if name == "" {
+		return nil, errors.New("no name")
+	}
+	return &DB{data: map[string]string{}}, nil
+}
+
+// Begin returns a Tx, which is only wrapped with a wrap depth of 2 or more.
+func (db *DB) Begin() *Tx {
+	return &Tx{db: db}
+}
+
+func (db *DB) Get(key string) (string, bool) {
+	value, ok := db.data[key]
+	return value, ok
+}
+
+// Tx is a concrete type returned by DB.
+type Tx struct {
+	db *DB
+}
+
+func (tx *Tx) Put(key string, value string) {
+	tx.db.data[key] = value
+}
+
+// DB returns the DB of the Tx, which is wrapped already.
+func (tx *Tx) DB() *DB {
+	return tx.db
+}
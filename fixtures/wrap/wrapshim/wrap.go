@@ -0,0 +1,131 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package wrapshim
+
+import (
+	"testing"
+
+	"github.com/maxbrunsfeld/counterfeiter/v6/fixtures/wrap"
+)
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -generate
+//counterfeiter:generate . Wrap
+//counterfeiter:generate . DB
+//counterfeiter:generate . Tx
+
+// Wrap is a generated interface representing the exported functions
+// and variables in the github.com/maxbrunsfeld/counterfeiter/v6/fixtures/wrap package.
+type Wrap interface {
+	Open(arg1 string) (DB, error)
+	Current() DB
+	SetCurrent(arg1 *wrap.DB)
+}
+
+type WrapShim struct{}
+
+func (p *WrapShim) Open(arg1 string) (DB, error) {
+	result1, result2 := wrap.Open(arg1)
+	return wrapDB(result1), result2
+}
+
+func (p *WrapShim) Current() DB {
+	result1 := wrap.Current
+	return wrapDB(result1)
+}
+
+func (p *WrapShim) SetCurrent(arg1 *wrap.DB) {
+	wrap.Current = arg1
+}
+
+var _ Wrap = new(WrapShim)
+
+// DefaultWrap is the Wrap used by code that calls it instead of the
+// functions of the package, and that does not have a Wrap injected.
+var DefaultWrap Wrap = new(WrapShim)
+
+// SwapWrapForTest makes fake the DefaultWrap until the end of the test, when
+// the original is restored. Tests that swap the DefaultWrap cannot run in
+// parallel.
+func SwapWrapForTest(t testing.TB, fake Wrap) {
+	t.Helper()
+	original := DefaultWrap
+	DefaultWrap = fake
+	t.Cleanup(func() {
+		DefaultWrap = original
+	})
+}
+
+// DB is a generated interface representing the exported methods of
+// wrap.DB.
+type DB interface {
+	Begin() Tx
+	Get(arg1 string) (string, bool)
+}
+
+// DBAdapter implements DB by forwarding every call to a
+// wrap.DB.
+type DBAdapter struct {
+	target *wrap.DB
+}
+
+// NewDBAdapter returns a DBAdapter that forwards calls to target.
+func NewDBAdapter(target *wrap.DB) *DBAdapter {
+	return &DBAdapter{target: target}
+}
+
+// wrapDB returns nil for a nil target, so that a nil DB compares
+// equal to nil.
+func wrapDB(target *wrap.DB) DB {
+	if target == nil {
+		return nil
+	}
+	return NewDBAdapter(target)
+}
+
+func (a *DBAdapter) Begin() Tx {
+	result1 := a.target.Begin()
+	return wrapTx(result1)
+}
+
+func (a *DBAdapter) Get(arg1 string) (string, bool) {
+	return a.target.Get(arg1)
+}
+
+var _ DB = new(DBAdapter)
+
+// Tx is a generated interface representing the exported methods of
+// wrap.Tx.
+type Tx interface {
+	DB() DB
+	Put(arg1 string, arg2 string)
+}
+
+// TxAdapter implements Tx by forwarding every call to a
+// wrap.Tx.
+type TxAdapter struct {
+	target *wrap.Tx
+}
+
+// NewTxAdapter returns a TxAdapter that forwards calls to target.
+func NewTxAdapter(target *wrap.Tx) *TxAdapter {
+	return &TxAdapter{target: target}
+}
+
+// wrapTx returns nil for a nil target, so that a nil Tx compares
+// equal to nil.
+func wrapTx(target *wrap.Tx) Tx {
+	if target == nil {
+		return nil
+	}
+	return NewTxAdapter(target)
+}
+
+func (a *TxAdapter) DB() DB {
+	result1 := a.target.DB()
+	return wrapDB(result1)
+}
+
+func (a *TxAdapter) Put(arg1 string, arg2 string) {
+	a.target.Put(arg1, arg2)
+}
+
+var _ Tx = new(TxAdapter)
@@ -0,0 +1,488 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package wrapshimfakes
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"sync/atomic"
+
+	"github.com/maxbrunsfeld/counterfeiter/v6/fixtures/wrap/wrapshim"
+)
+
+type FakeDB struct {
+	BeginStub        func() wrapshim.Tx
+	beginMutex       sync.RWMutex
+	beginArgsForCall []struct {
+	}
+	beginReturns struct {
+		result1 wrapshim.Tx
+	}
+	beginReturnsOnCall map[int]struct {
+		result1 wrapshim.Tx
+	}
+	GetStub        func(string) (string, bool)
+	getMutex       sync.RWMutex
+	getArgsForCall []struct {
+		arg1 string
+	}
+	getWhen []struct {
+		matcher func(string) bool
+		stub    func(string) (string, bool)
+	}
+	getReturns struct {
+		result1 string
+		result2 bool
+	}
+	getReturnsOnCall map[int]struct {
+		result1 string
+		result2 bool
+	}
+	ArgsComparer       func(expected interface{}, actual interface{}) bool
+	invocations        map[string][][]interface{}
+	orderedInvocations []struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}
+	sequencer          *atomic.Uint64
+	invocationsChanged chan struct{}
+	callsSubscribers   map[string][]struct {
+		publish func(args []interface{})
+		cancel  context.CancelFunc
+		done    <-chan struct{}
+	}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeDB) Begin() wrapshim.Tx {
+	fake.beginMutex.Lock()
+	ret, specificReturn := fake.beginReturnsOnCall[len(fake.beginArgsForCall)]
+	fake.beginArgsForCall = append(fake.beginArgsForCall, struct {
+	}{})
+	stub := fake.BeginStub
+	fakeReturns := fake.beginReturns
+	fake.recordInvocation("Begin", []interface{}{})
+	fake.beginMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeDB) BeginCallCount() int {
+	fake.beginMutex.RLock()
+	defer fake.beginMutex.RUnlock()
+	return len(fake.beginArgsForCall)
+}
+
+func (fake *FakeDB) WaitForBeginCalls(ctx context.Context, n int) error {
+	return fake.waitForCalls(ctx, fake.BeginCallCount, n)
+}
+
+func (fake *FakeDB) BeginCallsChan(ctx context.Context) <-chan []interface{} {
+	return fake.callsChan(ctx, "Begin")
+}
+
+func (fake *FakeDB) BeginCalls(stub func() wrapshim.Tx) {
+	fake.beginMutex.Lock()
+	defer fake.beginMutex.Unlock()
+	fake.BeginStub = stub
+}
+
+func (fake *FakeDB) BeginReturns(result1 wrapshim.Tx) {
+	fake.beginMutex.Lock()
+	defer fake.beginMutex.Unlock()
+	fake.BeginStub = nil
+	fake.beginReturns = struct {
+		result1 wrapshim.Tx
+	}{result1}
+}
+
+func (fake *FakeDB) BeginReturnsOnCall(i int, result1 wrapshim.Tx) {
+	fake.beginMutex.Lock()
+	defer fake.beginMutex.Unlock()
+	fake.BeginStub = nil
+	if fake.beginReturnsOnCall == nil {
+		fake.beginReturnsOnCall = make(map[int]struct {
+			result1 wrapshim.Tx
+		})
+	}
+	fake.beginReturnsOnCall[i] = struct {
+		result1 wrapshim.Tx
+	}{result1}
+}
+
+func (fake *FakeDB) ResetBegin() {
+	fake.ResetBeginCalls()
+	fake.ResetBeginStubs()
+}
+
+func (fake *FakeDB) ResetBeginCalls() {
+	fake.beginMutex.Lock()
+	defer fake.beginMutex.Unlock()
+	fake.beginArgsForCall = nil
+	fake.forgetInvocations("Begin")
+}
+
+func (fake *FakeDB) ResetBeginStubs() {
+	fake.beginMutex.Lock()
+	defer fake.beginMutex.Unlock()
+	fake.BeginStub = nil
+	fake.beginReturns = struct {
+		result1 wrapshim.Tx
+	}{}
+	fake.beginReturnsOnCall = nil
+}
+
+type FakeDBGetCall struct {
+	Arg1 string
+}
+
+func (fake *FakeDB) Get(arg1 string) (string, bool) {
+	fake.getMutex.Lock()
+	ret, specificReturn := fake.getReturnsOnCall[len(fake.getArgsForCall)]
+	fake.getArgsForCall = append(fake.getArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.GetStub
+	whens := fake.getWhen
+	fakeReturns := fake.getReturns
+	fake.recordInvocation("Get", []interface{}{arg1})
+	fake.getMutex.Unlock()
+	for i := len(whens) - 1; i >= 0; i-- {
+		if whens[i].matcher(arg1) {
+			return whens[i].stub(arg1)
+		}
+	}
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDB) GetCallCount() int {
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	return len(fake.getArgsForCall)
+}
+
+func (fake *FakeDB) WaitForGetCalls(ctx context.Context, n int) error {
+	return fake.waitForCalls(ctx, fake.GetCallCount, n)
+}
+
+func (fake *FakeDB) GetCallsChan(ctx context.Context) <-chan []interface{} {
+	return fake.callsChan(ctx, "Get")
+}
+
+func (fake *FakeDB) GetCalls(stub func(string) (string, bool)) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = stub
+}
+
+// GetCallsWhen calls the stub for the calls whose arguments match.
+// Matchers are checked before the stub, and the matcher registered last wins.
+func (fake *FakeDB) GetCallsWhen(matcher func(string) bool, stub func(string) (string, bool)) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.getWhen = append(fake.getWhen, struct {
+		matcher func(string) bool
+		stub    func(string) (string, bool)
+	}{matcher, stub})
+}
+
+func (fake *FakeDB) GetArgsForCall(i int) string {
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	argsForCall := fake.getArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeDB) GetCallHistory() []FakeDBGetCall {
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	history := make([]FakeDBGetCall, len(fake.getArgsForCall))
+	for i, argsForCall := range fake.getArgsForCall {
+		history[i] = FakeDBGetCall{argsForCall.arg1}
+	}
+	return history
+}
+
+func (fake *FakeDB) GetReturns(result1 string, result2 bool) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = nil
+	fake.getReturns = struct {
+		result1 string
+		result2 bool
+	}{result1, result2}
+}
+
+func (fake *FakeDB) GetReturnsOnCall(i int, result1 string, result2 bool) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = nil
+	if fake.getReturnsOnCall == nil {
+		fake.getReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 bool
+		})
+	}
+	fake.getReturnsOnCall[i] = struct {
+		result1 string
+		result2 bool
+	}{result1, result2}
+}
+
+// GetReturnsForArgs returns the values for the calls with the given
+// arguments. Like GetCallsWhen, it is checked before the stub, and the
+// arguments or matcher registered last win.
+func (fake *FakeDB) GetReturnsForArgs(arg1 string) func(string, bool) {
+	args := []interface{}{arg1}
+	return func(result1 string, result2 bool) {
+		fake.getMutex.Lock()
+		defer fake.getMutex.Unlock()
+		fake.GetStub = nil
+		fake.getWhen = append(fake.getWhen, struct {
+			matcher func(string) bool
+			stub    func(string) (string, bool)
+		}{
+			func(arg1 string) bool {
+				return fake.argsMatch(args, []interface{}{arg1})
+			},
+			func(string) (string, bool) {
+				return result1, result2
+			},
+		})
+	}
+}
+
+// GetReturnsWhen returns the values for the calls whose arguments
+// match. Like GetCallsWhen, it is checked before the stub, and the
+// matcher registered last wins.
+func (fake *FakeDB) GetReturnsWhen(matcher func(string) bool, result1 string, result2 bool) {
+	fake.GetCallsWhen(matcher, func(string) (string, bool) {
+		return result1, result2
+	})
+}
+
+func (fake *FakeDB) ResetGet() {
+	fake.ResetGetCalls()
+	fake.ResetGetStubs()
+}
+
+func (fake *FakeDB) ResetGetCalls() {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.getArgsForCall = nil
+	fake.forgetInvocations("Get")
+}
+
+func (fake *FakeDB) ResetGetStubs() {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = nil
+	fake.getWhen = nil
+	fake.getReturns = struct {
+		result1 string
+		result2 bool
+	}{}
+	fake.getReturnsOnCall = nil
+}
+
+func (fake *FakeDB) Reset() {
+	fake.ResetCalls()
+	fake.ResetStubs()
+}
+
+func (fake *FakeDB) ResetCalls() {
+	fake.ResetBeginCalls()
+	fake.ResetGetCalls()
+}
+
+func (fake *FakeDB) ResetStubs() {
+	fake.ResetBeginStubs()
+	fake.ResetGetStubs()
+}
+
+func (fake *FakeDB) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeDB) argsMatch(expected []interface{}, actual []interface{}) bool {
+	if len(expected) != len(actual) {
+		return false
+	}
+	for i := range expected {
+		if fake.ArgsComparer != nil && !fake.ArgsComparer(expected[i], actual[i]) || fake.ArgsComparer == nil && !reflect.DeepEqual(expected[i], actual[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (fake *FakeDB) OrderedInvocations() []struct {
+	Seq    uint64
+	Method string
+	Args   []interface{}
+} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	return append([]struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}{}, fake.orderedInvocations...)
+}
+
+func (fake *FakeDB) SetSequencer(sequencer *atomic.Uint64) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	fake.sequencer = sequencer
+}
+
+func (fake *FakeDB) waitForCalls(ctx context.Context, callCount func() int, n int) error {
+	for {
+		fake.invocationsMutex.Lock()
+		if fake.invocationsChanged == nil {
+			fake.invocationsChanged = make(chan struct{})
+		}
+		changed := fake.invocationsChanged
+		fake.invocationsMutex.Unlock()
+		if callCount() >= n {
+			return nil
+		}
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (fake *FakeDB) callsChan(ctx context.Context, key string) <-chan []interface{} {
+	ctx, cancel := context.WithCancel(ctx)
+	calls := make(chan []interface{})
+	ready := make(chan struct{}, 1)
+	var pendingMutex sync.Mutex
+	var pending [][]interface{}
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.callsSubscribers == nil {
+		fake.callsSubscribers = map[string][]struct {
+			publish func(args []interface{})
+			cancel  context.CancelFunc
+			done    <-chan struct{}
+		}{}
+	}
+	fake.callsSubscribers[key] = append(fake.callsSubscribers[key], struct {
+		publish func(args []interface{})
+		cancel  context.CancelFunc
+		done    <-chan struct{}
+	}{func(args []interface{}) {
+		pendingMutex.Lock()
+		pending = append(pending, args)
+		pendingMutex.Unlock()
+		select {
+		case ready <- struct{}{}:
+		default:
+		}
+	}, cancel, ctx.Done()})
+	go func() {
+		defer close(calls)
+		for {
+			select {
+			case <-ready:
+			case <-ctx.Done():
+				return
+			}
+			pendingMutex.Lock()
+			queued := pending
+			pending = nil
+			pendingMutex.Unlock()
+			for _, args := range queued {
+				select {
+				case calls <- args:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return calls
+}
+
+func (fake *FakeDB) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+	if fake.sequencer == nil {
+		fake.sequencer = new(atomic.Uint64)
+	}
+	fake.orderedInvocations = append(fake.orderedInvocations, struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}{fake.sequencer.Add(1), key, args})
+	if fake.invocationsChanged != nil {
+		close(fake.invocationsChanged)
+		fake.invocationsChanged = nil
+	}
+	subscribers := fake.callsSubscribers[key][:0]
+	for _, subscriber := range fake.callsSubscribers[key] {
+		select {
+		case <-subscriber.done:
+			continue
+		default:
+		}
+		subscriber.publish(args)
+		subscribers = append(subscribers, subscriber)
+	}
+	if len(subscribers) > 0 {
+		fake.callsSubscribers[key] = subscribers
+	} else {
+		delete(fake.callsSubscribers, key)
+	}
+}
+
+func (fake *FakeDB) forgetInvocations(key string) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	delete(fake.invocations, key)
+	for _, subscriber := range fake.callsSubscribers[key] {
+		subscriber.cancel()
+	}
+	delete(fake.callsSubscribers, key)
+	var orderedInvocations []struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}
+	for _, invocation := range fake.orderedInvocations {
+		if invocation.Method != key {
+			orderedInvocations = append(orderedInvocations, invocation)
+		}
+	}
+	fake.orderedInvocations = orderedInvocations
+}
+
+var _ wrapshim.DB = new(FakeDB)
@@ -0,0 +1,401 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package wrapshimfakes
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+
+	"github.com/maxbrunsfeld/counterfeiter/v6/fixtures/wrap/wrapshim"
+)
+
+type FakeTx struct {
+	DBStub        func() wrapshim.DB
+	dBMutex       sync.RWMutex
+	dBArgsForCall []struct {
+	}
+	dBReturns struct {
+		result1 wrapshim.DB
+	}
+	dBReturnsOnCall map[int]struct {
+		result1 wrapshim.DB
+	}
+	PutStub        func(string, string)
+	putMutex       sync.RWMutex
+	putArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	putWhen []struct {
+		matcher func(string, string) bool
+		stub    func(string, string)
+	}
+	invocations        map[string][][]interface{}
+	orderedInvocations []struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}
+	sequencer          *atomic.Uint64
+	invocationsChanged chan struct{}
+	callsSubscribers   map[string][]struct {
+		publish func(args []interface{})
+		cancel  context.CancelFunc
+		done    <-chan struct{}
+	}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeTx) DB() wrapshim.DB {
+	fake.dBMutex.Lock()
+	ret, specificReturn := fake.dBReturnsOnCall[len(fake.dBArgsForCall)]
+	fake.dBArgsForCall = append(fake.dBArgsForCall, struct {
+	}{})
+	stub := fake.DBStub
+	fakeReturns := fake.dBReturns
+	fake.recordInvocation("DB", []interface{}{})
+	fake.dBMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeTx) DBCallCount() int {
+	fake.dBMutex.RLock()
+	defer fake.dBMutex.RUnlock()
+	return len(fake.dBArgsForCall)
+}
+
+func (fake *FakeTx) WaitForDBCalls(ctx context.Context, n int) error {
+	return fake.waitForCalls(ctx, fake.DBCallCount, n)
+}
+
+func (fake *FakeTx) DBCallsChan(ctx context.Context) <-chan []interface{} {
+	return fake.callsChan(ctx, "DB")
+}
+
+func (fake *FakeTx) DBCalls(stub func() wrapshim.DB) {
+	fake.dBMutex.Lock()
+	defer fake.dBMutex.Unlock()
+	fake.DBStub = stub
+}
+
+func (fake *FakeTx) DBReturns(result1 wrapshim.DB) {
+	fake.dBMutex.Lock()
+	defer fake.dBMutex.Unlock()
+	fake.DBStub = nil
+	fake.dBReturns = struct {
+		result1 wrapshim.DB
+	}{result1}
+}
+
+func (fake *FakeTx) DBReturnsOnCall(i int, result1 wrapshim.DB) {
+	fake.dBMutex.Lock()
+	defer fake.dBMutex.Unlock()
+	fake.DBStub = nil
+	if fake.dBReturnsOnCall == nil {
+		fake.dBReturnsOnCall = make(map[int]struct {
+			result1 wrapshim.DB
+		})
+	}
+	fake.dBReturnsOnCall[i] = struct {
+		result1 wrapshim.DB
+	}{result1}
+}
+
+func (fake *FakeTx) ResetDB() {
+	fake.ResetDBCalls()
+	fake.ResetDBStubs()
+}
+
+func (fake *FakeTx) ResetDBCalls() {
+	fake.dBMutex.Lock()
+	defer fake.dBMutex.Unlock()
+	fake.dBArgsForCall = nil
+	fake.forgetInvocations("DB")
+}
+
+func (fake *FakeTx) ResetDBStubs() {
+	fake.dBMutex.Lock()
+	defer fake.dBMutex.Unlock()
+	fake.DBStub = nil
+	fake.dBReturns = struct {
+		result1 wrapshim.DB
+	}{}
+	fake.dBReturnsOnCall = nil
+}
+
+type FakeTxPutCall struct {
+	Arg1 string
+	Arg2 string
+}
+
+func (fake *FakeTx) Put(arg1 string, arg2 string) {
+	fake.putMutex.Lock()
+	fake.putArgsForCall = append(fake.putArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.PutStub
+	whens := fake.putWhen
+	fake.recordInvocation("Put", []interface{}{arg1, arg2})
+	fake.putMutex.Unlock()
+	for i := len(whens) - 1; i >= 0; i-- {
+		if whens[i].matcher(arg1, arg2) {
+			whens[i].stub(arg1, arg2)
+			return
+		}
+	}
+	if stub != nil {
+		fake.PutStub(arg1, arg2)
+	}
+}
+
+func (fake *FakeTx) PutCallCount() int {
+	fake.putMutex.RLock()
+	defer fake.putMutex.RUnlock()
+	return len(fake.putArgsForCall)
+}
+
+func (fake *FakeTx) WaitForPutCalls(ctx context.Context, n int) error {
+	return fake.waitForCalls(ctx, fake.PutCallCount, n)
+}
+
+func (fake *FakeTx) PutCallsChan(ctx context.Context) <-chan []interface{} {
+	return fake.callsChan(ctx, "Put")
+}
+
+func (fake *FakeTx) PutCalls(stub func(string, string)) {
+	fake.putMutex.Lock()
+	defer fake.putMutex.Unlock()
+	fake.PutStub = stub
+}
+
+// PutCallsWhen calls the stub for the calls whose arguments match.
+// Matchers are checked before the stub, and the matcher registered last wins.
+func (fake *FakeTx) PutCallsWhen(matcher func(string, string) bool, stub func(string, string)) {
+	fake.putMutex.Lock()
+	defer fake.putMutex.Unlock()
+	fake.putWhen = append(fake.putWhen, struct {
+		matcher func(string, string) bool
+		stub    func(string, string)
+	}{matcher, stub})
+}
+
+func (fake *FakeTx) PutArgsForCall(i int) (string, string) {
+	fake.putMutex.RLock()
+	defer fake.putMutex.RUnlock()
+	argsForCall := fake.putArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTx) PutCallHistory() []FakeTxPutCall {
+	fake.putMutex.RLock()
+	defer fake.putMutex.RUnlock()
+	history := make([]FakeTxPutCall, len(fake.putArgsForCall))
+	for i, argsForCall := range fake.putArgsForCall {
+		history[i] = FakeTxPutCall{argsForCall.arg1, argsForCall.arg2}
+	}
+	return history
+}
+
+func (fake *FakeTx) ResetPut() {
+	fake.ResetPutCalls()
+	fake.ResetPutStubs()
+}
+
+func (fake *FakeTx) ResetPutCalls() {
+	fake.putMutex.Lock()
+	defer fake.putMutex.Unlock()
+	fake.putArgsForCall = nil
+	fake.forgetInvocations("Put")
+}
+
+func (fake *FakeTx) ResetPutStubs() {
+	fake.putMutex.Lock()
+	defer fake.putMutex.Unlock()
+	fake.PutStub = nil
+	fake.putWhen = nil
+}
+
+func (fake *FakeTx) Reset() {
+	fake.ResetCalls()
+	fake.ResetStubs()
+}
+
+func (fake *FakeTx) ResetCalls() {
+	fake.ResetDBCalls()
+	fake.ResetPutCalls()
+}
+
+func (fake *FakeTx) ResetStubs() {
+	fake.ResetDBStubs()
+	fake.ResetPutStubs()
+}
+
+func (fake *FakeTx) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeTx) OrderedInvocations() []struct {
+	Seq    uint64
+	Method string
+	Args   []interface{}
+} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	return append([]struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}{}, fake.orderedInvocations...)
+}
+
+func (fake *FakeTx) SetSequencer(sequencer *atomic.Uint64) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	fake.sequencer = sequencer
+}
+
+func (fake *FakeTx) waitForCalls(ctx context.Context, callCount func() int, n int) error {
+	for {
+		fake.invocationsMutex.Lock()
+		if fake.invocationsChanged == nil {
+			fake.invocationsChanged = make(chan struct{})
+		}
+		changed := fake.invocationsChanged
+		fake.invocationsMutex.Unlock()
+		if callCount() >= n {
+			return nil
+		}
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (fake *FakeTx) callsChan(ctx context.Context, key string) <-chan []interface{} {
+	ctx, cancel := context.WithCancel(ctx)
+	calls := make(chan []interface{})
+	ready := make(chan struct{}, 1)
+	var pendingMutex sync.Mutex
+	var pending [][]interface{}
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.callsSubscribers == nil {
+		fake.callsSubscribers = map[string][]struct {
+			publish func(args []interface{})
+			cancel  context.CancelFunc
+			done    <-chan struct{}
+		}{}
+	}
+	fake.callsSubscribers[key] = append(fake.callsSubscribers[key], struct {
+		publish func(args []interface{})
+		cancel  context.CancelFunc
+		done    <-chan struct{}
+	}{func(args []interface{}) {
+		pendingMutex.Lock()
+		pending = append(pending, args)
+		pendingMutex.Unlock()
+		select {
+		case ready <- struct{}{}:
+		default:
+		}
+	}, cancel, ctx.Done()})
+	go func() {
+		defer close(calls)
+		for {
+			select {
+			case <-ready:
+			case <-ctx.Done():
+				return
+			}
+			pendingMutex.Lock()
+			queued := pending
+			pending = nil
+			pendingMutex.Unlock()
+			for _, args := range queued {
+				select {
+				case calls <- args:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return calls
+}
+
+func (fake *FakeTx) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+	if fake.sequencer == nil {
+		fake.sequencer = new(atomic.Uint64)
+	}
+	fake.orderedInvocations = append(fake.orderedInvocations, struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}{fake.sequencer.Add(1), key, args})
+	if fake.invocationsChanged != nil {
+		close(fake.invocationsChanged)
+		fake.invocationsChanged = nil
+	}
+	subscribers := fake.callsSubscribers[key][:0]
+	for _, subscriber := range fake.callsSubscribers[key] {
+		select {
+		case <-subscriber.done:
+			continue
+		default:
+		}
+		subscriber.publish(args)
+		subscribers = append(subscribers, subscriber)
+	}
+	if len(subscribers) > 0 {
+		fake.callsSubscribers[key] = subscribers
+	} else {
+		delete(fake.callsSubscribers, key)
+	}
+}
+
+func (fake *FakeTx) forgetInvocations(key string) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	delete(fake.invocations, key)
+	for _, subscriber := range fake.callsSubscribers[key] {
+		subscriber.cancel()
+	}
+	delete(fake.callsSubscribers, key)
+	var orderedInvocations []struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}
+	for _, invocation := range fake.orderedInvocations {
+		if invocation.Method != key {
+			orderedInvocations = append(orderedInvocations, invocation)
+		}
+	}
+	fake.orderedInvocations = orderedInvocations
+}
+
+var _ wrapshim.Tx = new(FakeTx)
@@ -0,0 +1,631 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package wrapshimfakes
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+	"sync/atomic"
+
+	"github.com/maxbrunsfeld/counterfeiter/v6/fixtures/wrap"
+	"github.com/maxbrunsfeld/counterfeiter/v6/fixtures/wrap/wrapshim"
+)
+
+type FakeWrap struct {
+	CurrentStub        func() wrapshim.DB
+	currentMutex       sync.RWMutex
+	currentArgsForCall []struct {
+	}
+	currentReturns struct {
+		result1 wrapshim.DB
+	}
+	currentReturnsOnCall map[int]struct {
+		result1 wrapshim.DB
+	}
+	OpenStub        func(string) (wrapshim.DB, error)
+	openMutex       sync.RWMutex
+	openArgsForCall []struct {
+		arg1 string
+	}
+	openWhen []struct {
+		matcher func(string) bool
+		stub    func(string) (wrapshim.DB, error)
+	}
+	openReturns struct {
+		result1 wrapshim.DB
+		result2 error
+	}
+	openReturnsOnCall map[int]struct {
+		result1 wrapshim.DB
+		result2 error
+	}
+	SetCurrentStub        func(*wrap.DB)
+	setCurrentMutex       sync.RWMutex
+	setCurrentArgsForCall []struct {
+		arg1 *wrap.DB
+	}
+	setCurrentWhen []struct {
+		matcher func(*wrap.DB) bool
+		stub    func(*wrap.DB)
+	}
+	setCurrentSetsArgs map[int]interface{}
+	ArgsComparer       func(expected interface{}, actual interface{}) bool
+	invocations        map[string][][]interface{}
+	orderedInvocations []struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}
+	sequencer          *atomic.Uint64
+	invocationsChanged chan struct{}
+	callsSubscribers   map[string][]struct {
+		publish func(args []interface{})
+		cancel  context.CancelFunc
+		done    <-chan struct{}
+	}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeWrap) Current() wrapshim.DB {
+	fake.currentMutex.Lock()
+	ret, specificReturn := fake.currentReturnsOnCall[len(fake.currentArgsForCall)]
+	fake.currentArgsForCall = append(fake.currentArgsForCall, struct {
+	}{})
+	stub := fake.CurrentStub
+	fakeReturns := fake.currentReturns
+	fake.recordInvocation("Current", []interface{}{})
+	fake.currentMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeWrap) CurrentCallCount() int {
+	fake.currentMutex.RLock()
+	defer fake.currentMutex.RUnlock()
+	return len(fake.currentArgsForCall)
+}
+
+func (fake *FakeWrap) WaitForCurrentCalls(ctx context.Context, n int) error {
+	return fake.waitForCalls(ctx, fake.CurrentCallCount, n)
+}
+
+func (fake *FakeWrap) CurrentCallsChan(ctx context.Context) <-chan []interface{} {
+	return fake.callsChan(ctx, "Current")
+}
+
+func (fake *FakeWrap) CurrentCalls(stub func() wrapshim.DB) {
+	fake.currentMutex.Lock()
+	defer fake.currentMutex.Unlock()
+	fake.CurrentStub = stub
+}
+
+func (fake *FakeWrap) CurrentReturns(result1 wrapshim.DB) {
+	fake.currentMutex.Lock()
+	defer fake.currentMutex.Unlock()
+	fake.CurrentStub = nil
+	fake.currentReturns = struct {
+		result1 wrapshim.DB
+	}{result1}
+}
+
+func (fake *FakeWrap) CurrentReturnsOnCall(i int, result1 wrapshim.DB) {
+	fake.currentMutex.Lock()
+	defer fake.currentMutex.Unlock()
+	fake.CurrentStub = nil
+	if fake.currentReturnsOnCall == nil {
+		fake.currentReturnsOnCall = make(map[int]struct {
+			result1 wrapshim.DB
+		})
+	}
+	fake.currentReturnsOnCall[i] = struct {
+		result1 wrapshim.DB
+	}{result1}
+}
+
+func (fake *FakeWrap) ResetCurrent() {
+	fake.ResetCurrentCalls()
+	fake.ResetCurrentStubs()
+}
+
+func (fake *FakeWrap) ResetCurrentCalls() {
+	fake.currentMutex.Lock()
+	defer fake.currentMutex.Unlock()
+	fake.currentArgsForCall = nil
+	fake.forgetInvocations("Current")
+}
+
+func (fake *FakeWrap) ResetCurrentStubs() {
+	fake.currentMutex.Lock()
+	defer fake.currentMutex.Unlock()
+	fake.CurrentStub = nil
+	fake.currentReturns = struct {
+		result1 wrapshim.DB
+	}{}
+	fake.currentReturnsOnCall = nil
+}
+
+type FakeWrapOpenCall struct {
+	Arg1 string
+}
+
+func (fake *FakeWrap) Open(arg1 string) (wrapshim.DB, error) {
+	fake.openMutex.Lock()
+	ret, specificReturn := fake.openReturnsOnCall[len(fake.openArgsForCall)]
+	fake.openArgsForCall = append(fake.openArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.OpenStub
+	whens := fake.openWhen
+	fakeReturns := fake.openReturns
+	fake.recordInvocation("Open", []interface{}{arg1})
+	fake.openMutex.Unlock()
+	for i := len(whens) - 1; i >= 0; i-- {
+		if whens[i].matcher(arg1) {
+			return whens[i].stub(arg1)
+		}
+	}
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeWrap) OpenCallCount() int {
+	fake.openMutex.RLock()
+	defer fake.openMutex.RUnlock()
+	return len(fake.openArgsForCall)
+}
+
+func (fake *FakeWrap) WaitForOpenCalls(ctx context.Context, n int) error {
+	return fake.waitForCalls(ctx, fake.OpenCallCount, n)
+}
+
+func (fake *FakeWrap) OpenCallsChan(ctx context.Context) <-chan []interface{} {
+	return fake.callsChan(ctx, "Open")
+}
+
+func (fake *FakeWrap) OpenCalls(stub func(string) (wrapshim.DB, error)) {
+	fake.openMutex.Lock()
+	defer fake.openMutex.Unlock()
+	fake.OpenStub = stub
+}
+
+// OpenCallsWhen calls the stub for the calls whose arguments match.
+// Matchers are checked before the stub, and the matcher registered last wins.
+func (fake *FakeWrap) OpenCallsWhen(matcher func(string) bool, stub func(string) (wrapshim.DB, error)) {
+	fake.openMutex.Lock()
+	defer fake.openMutex.Unlock()
+	fake.openWhen = append(fake.openWhen, struct {
+		matcher func(string) bool
+		stub    func(string) (wrapshim.DB, error)
+	}{matcher, stub})
+}
+
+func (fake *FakeWrap) OpenArgsForCall(i int) string {
+	fake.openMutex.RLock()
+	defer fake.openMutex.RUnlock()
+	argsForCall := fake.openArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeWrap) OpenCallHistory() []FakeWrapOpenCall {
+	fake.openMutex.RLock()
+	defer fake.openMutex.RUnlock()
+	history := make([]FakeWrapOpenCall, len(fake.openArgsForCall))
+	for i, argsForCall := range fake.openArgsForCall {
+		history[i] = FakeWrapOpenCall{argsForCall.arg1}
+	}
+	return history
+}
+
+func (fake *FakeWrap) OpenReturns(result1 wrapshim.DB, result2 error) {
+	fake.openMutex.Lock()
+	defer fake.openMutex.Unlock()
+	fake.OpenStub = nil
+	fake.openReturns = struct {
+		result1 wrapshim.DB
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeWrap) OpenReturnsOnCall(i int, result1 wrapshim.DB, result2 error) {
+	fake.openMutex.Lock()
+	defer fake.openMutex.Unlock()
+	fake.OpenStub = nil
+	if fake.openReturnsOnCall == nil {
+		fake.openReturnsOnCall = make(map[int]struct {
+			result1 wrapshim.DB
+			result2 error
+		})
+	}
+	fake.openReturnsOnCall[i] = struct {
+		result1 wrapshim.DB
+		result2 error
+	}{result1, result2}
+}
+
+// OpenReturnsForArgs returns the values for the calls with the given
+// arguments. Like OpenCallsWhen, it is checked before the stub, and the
+// arguments or matcher registered last win.
+func (fake *FakeWrap) OpenReturnsForArgs(arg1 string) func(wrapshim.DB, error) {
+	args := []interface{}{arg1}
+	return func(result1 wrapshim.DB, result2 error) {
+		fake.openMutex.Lock()
+		defer fake.openMutex.Unlock()
+		fake.OpenStub = nil
+		fake.openWhen = append(fake.openWhen, struct {
+			matcher func(string) bool
+			stub    func(string) (wrapshim.DB, error)
+		}{
+			func(arg1 string) bool {
+				return fake.argsMatch(args, []interface{}{arg1})
+			},
+			func(string) (wrapshim.DB, error) {
+				return result1, result2
+			},
+		})
+	}
+}
+
+// OpenReturnsWhen returns the values for the calls whose arguments
+// match. Like OpenCallsWhen, it is checked before the stub, and the
+// matcher registered last wins.
+func (fake *FakeWrap) OpenReturnsWhen(matcher func(string) bool, result1 wrapshim.DB, result2 error) {
+	fake.OpenCallsWhen(matcher, func(string) (wrapshim.DB, error) {
+		return result1, result2
+	})
+}
+
+func (fake *FakeWrap) ResetOpen() {
+	fake.ResetOpenCalls()
+	fake.ResetOpenStubs()
+}
+
+func (fake *FakeWrap) ResetOpenCalls() {
+	fake.openMutex.Lock()
+	defer fake.openMutex.Unlock()
+	fake.openArgsForCall = nil
+	fake.forgetInvocations("Open")
+}
+
+func (fake *FakeWrap) ResetOpenStubs() {
+	fake.openMutex.Lock()
+	defer fake.openMutex.Unlock()
+	fake.OpenStub = nil
+	fake.openWhen = nil
+	fake.openReturns = struct {
+		result1 wrapshim.DB
+		result2 error
+	}{}
+	fake.openReturnsOnCall = nil
+}
+
+type FakeWrapSetCurrentCall struct {
+	Arg1 *wrap.DB
+}
+
+func (fake *FakeWrap) SetCurrent(arg1 *wrap.DB) {
+	fake.setCurrentMutex.Lock()
+	fake.setCurrentArgsForCall = append(fake.setCurrentArgsForCall, struct {
+		arg1 *wrap.DB
+	}{arg1})
+	stub := fake.SetCurrentStub
+	whens := fake.setCurrentWhen
+	setsArgs := fake.setCurrentSetsArgs
+	fake.recordInvocation("SetCurrent", []interface{}{arg1})
+	fake.setCurrentMutex.Unlock()
+	if len(setsArgs) > 0 {
+		args := []interface{}{arg1}
+		fake.setArgs("SetCurrent", setsArgs, args)
+	}
+	for i := len(whens) - 1; i >= 0; i-- {
+		if whens[i].matcher(arg1) {
+			whens[i].stub(arg1)
+			return
+		}
+	}
+	if stub != nil {
+		fake.SetCurrentStub(arg1)
+	}
+}
+
+func (fake *FakeWrap) SetCurrentCallCount() int {
+	fake.setCurrentMutex.RLock()
+	defer fake.setCurrentMutex.RUnlock()
+	return len(fake.setCurrentArgsForCall)
+}
+
+func (fake *FakeWrap) WaitForSetCurrentCalls(ctx context.Context, n int) error {
+	return fake.waitForCalls(ctx, fake.SetCurrentCallCount, n)
+}
+
+func (fake *FakeWrap) SetCurrentCallsChan(ctx context.Context) <-chan []interface{} {
+	return fake.callsChan(ctx, "SetCurrent")
+}
+
+func (fake *FakeWrap) SetCurrentCalls(stub func(*wrap.DB)) {
+	fake.setCurrentMutex.Lock()
+	defer fake.setCurrentMutex.Unlock()
+	fake.SetCurrentStub = stub
+}
+
+func (fake *FakeWrap) SetCurrentSetsArg(i int, value interface{}) {
+	fake.setCurrentMutex.Lock()
+	defer fake.setCurrentMutex.Unlock()
+	setsArgs := map[int]interface{}{i: value}
+	for j, v := range fake.setCurrentSetsArgs {
+		if j != i {
+			setsArgs[j] = v
+		}
+	}
+	fake.setCurrentSetsArgs = setsArgs
+}
+
+// SetCurrentCallsWhen calls the stub for the calls whose arguments match.
+// Matchers are checked before the stub, and the matcher registered last wins.
+func (fake *FakeWrap) SetCurrentCallsWhen(matcher func(*wrap.DB) bool, stub func(*wrap.DB)) {
+	fake.setCurrentMutex.Lock()
+	defer fake.setCurrentMutex.Unlock()
+	fake.setCurrentWhen = append(fake.setCurrentWhen, struct {
+		matcher func(*wrap.DB) bool
+		stub    func(*wrap.DB)
+	}{matcher, stub})
+}
+
+func (fake *FakeWrap) SetCurrentArgsForCall(i int) *wrap.DB {
+	fake.setCurrentMutex.RLock()
+	defer fake.setCurrentMutex.RUnlock()
+	argsForCall := fake.setCurrentArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeWrap) SetCurrentCallHistory() []FakeWrapSetCurrentCall {
+	fake.setCurrentMutex.RLock()
+	defer fake.setCurrentMutex.RUnlock()
+	history := make([]FakeWrapSetCurrentCall, len(fake.setCurrentArgsForCall))
+	for i, argsForCall := range fake.setCurrentArgsForCall {
+		history[i] = FakeWrapSetCurrentCall{argsForCall.arg1}
+	}
+	return history
+}
+
+func (fake *FakeWrap) ResetSetCurrent() {
+	fake.ResetSetCurrentCalls()
+	fake.ResetSetCurrentStubs()
+}
+
+func (fake *FakeWrap) ResetSetCurrentCalls() {
+	fake.setCurrentMutex.Lock()
+	defer fake.setCurrentMutex.Unlock()
+	fake.setCurrentArgsForCall = nil
+	fake.forgetInvocations("SetCurrent")
+}
+
+func (fake *FakeWrap) ResetSetCurrentStubs() {
+	fake.setCurrentMutex.Lock()
+	defer fake.setCurrentMutex.Unlock()
+	fake.SetCurrentStub = nil
+	fake.setCurrentWhen = nil
+	fake.setCurrentSetsArgs = nil
+}
+
+func (fake *FakeWrap) Reset() {
+	fake.ResetCalls()
+	fake.ResetStubs()
+}
+
+func (fake *FakeWrap) ResetCalls() {
+	fake.ResetCurrentCalls()
+	fake.ResetOpenCalls()
+	fake.ResetSetCurrentCalls()
+}
+
+func (fake *FakeWrap) ResetStubs() {
+	fake.ResetCurrentStubs()
+	fake.ResetOpenStubs()
+	fake.ResetSetCurrentStubs()
+}
+
+func (fake *FakeWrap) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeWrap) setArgs(method string, values map[int]interface{}, args []interface{}) {
+	for i, value := range values {
+		if i < 0 || i >= len(args) {
+			panic(fmt.Sprintf("FakeWrap.%s: cannot set argument %d, because it was called with %d arguments", method, i, len(args)))
+		}
+		target := reflect.ValueOf(args[i])
+		if target.Kind() != reflect.Ptr || target.IsNil() {
+			panic(fmt.Sprintf("FakeWrap.%s: cannot set argument %d, because it is %T instead of a non-nil pointer", method, i, args[i]))
+		}
+		v := reflect.ValueOf(value)
+		if !v.IsValid() {
+			v = reflect.Zero(target.Elem().Type())
+		}
+		if !v.Type().AssignableTo(target.Elem().Type()) {
+			panic(fmt.Sprintf("FakeWrap.%s: cannot set argument %d, because %T is not assignable to %s", method, i, value, target.Elem().Type()))
+		}
+		target.Elem().Set(v)
+	}
+}
+
+func (fake *FakeWrap) argsMatch(expected []interface{}, actual []interface{}) bool {
+	if len(expected) != len(actual) {
+		return false
+	}
+	for i := range expected {
+		if fake.ArgsComparer != nil && !fake.ArgsComparer(expected[i], actual[i]) || fake.ArgsComparer == nil && !reflect.DeepEqual(expected[i], actual[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (fake *FakeWrap) OrderedInvocations() []struct {
+	Seq    uint64
+	Method string
+	Args   []interface{}
+} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	return append([]struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}{}, fake.orderedInvocations...)
+}
+
+func (fake *FakeWrap) SetSequencer(sequencer *atomic.Uint64) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	fake.sequencer = sequencer
+}
+
+func (fake *FakeWrap) waitForCalls(ctx context.Context, callCount func() int, n int) error {
+	for {
+		fake.invocationsMutex.Lock()
+		if fake.invocationsChanged == nil {
+			fake.invocationsChanged = make(chan struct{})
+		}
+		changed := fake.invocationsChanged
+		fake.invocationsMutex.Unlock()
+		if callCount() >= n {
+			return nil
+		}
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (fake *FakeWrap) callsChan(ctx context.Context, key string) <-chan []interface{} {
+	ctx, cancel := context.WithCancel(ctx)
+	calls := make(chan []interface{})
+	ready := make(chan struct{}, 1)
+	var pendingMutex sync.Mutex
+	var pending [][]interface{}
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.callsSubscribers == nil {
+		fake.callsSubscribers = map[string][]struct {
+			publish func(args []interface{})
+			cancel  context.CancelFunc
+			done    <-chan struct{}
+		}{}
+	}
+	fake.callsSubscribers[key] = append(fake.callsSubscribers[key], struct {
+		publish func(args []interface{})
+		cancel  context.CancelFunc
+		done    <-chan struct{}
+	}{func(args []interface{}) {
+		pendingMutex.Lock()
+		pending = append(pending, args)
+		pendingMutex.Unlock()
+		select {
+		case ready <- struct{}{}:
+		default:
+		}
+	}, cancel, ctx.Done()})
+	go func() {
+		defer close(calls)
+		for {
+			select {
+			case <-ready:
+			case <-ctx.Done():
+				return
+			}
+			pendingMutex.Lock()
+			queued := pending
+			pending = nil
+			pendingMutex.Unlock()
+			for _, args := range queued {
+				select {
+				case calls <- args:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return calls
+}
+
+func (fake *FakeWrap) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+	if fake.sequencer == nil {
+		fake.sequencer = new(atomic.Uint64)
+	}
+	fake.orderedInvocations = append(fake.orderedInvocations, struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}{fake.sequencer.Add(1), key, args})
+	if fake.invocationsChanged != nil {
+		close(fake.invocationsChanged)
+		fake.invocationsChanged = nil
+	}
+	subscribers := fake.callsSubscribers[key][:0]
+	for _, subscriber := range fake.callsSubscribers[key] {
+		select {
+		case <-subscriber.done:
+			continue
+		default:
+		}
+		subscriber.publish(args)
+		subscribers = append(subscribers, subscriber)
+	}
+	if len(subscribers) > 0 {
+		fake.callsSubscribers[key] = subscribers
+	} else {
+		delete(fake.callsSubscribers, key)
+	}
+}
+
+func (fake *FakeWrap) forgetInvocations(key string) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	delete(fake.invocations, key)
+	for _, subscriber := range fake.callsSubscribers[key] {
+		subscriber.cancel()
+	}
+	delete(fake.callsSubscribers, key)
+	var orderedInvocations []struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}
+	for _, invocation := range fake.orderedInvocations {
+		if invocation.Method != key {
+			orderedInvocations = append(orderedInvocations, invocation)
+		}
+	}
+	fake.orderedInvocations = orderedInvocations
+}
+
+var _ wrapshim.Wrap = new(FakeWrap)
@@ -1,16 +1,33 @@
 package main_test
 
 import (
+	"bytes"
+	"context"
 	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	"testing"
 
 	"github.com/maxbrunsfeld/counterfeiter/v6/fixtures"
+	"github.com/maxbrunsfeld/counterfeiter/v6/fixtures/extract"
+	"github.com/maxbrunsfeld/counterfeiter/v6/fixtures/extract/extractshim"
+	"github.com/maxbrunsfeld/counterfeiter/v6/fixtures/extract/extractshim/extractshimfakes"
 	"github.com/maxbrunsfeld/counterfeiter/v6/fixtures/fixturesfakes"
+	"github.com/maxbrunsfeld/counterfeiter/v6/fixtures/wrap"
+	"github.com/maxbrunsfeld/counterfeiter/v6/fixtures/wrap/wrapshim"
+	"github.com/maxbrunsfeld/counterfeiter/v6/fixtures/wrap/wrapshim/wrapshimfakes"
 
 	. "github.com/onsi/gomega"
 	"github.com/sclevine/spec"
 	"github.com/sclevine/spec/report"
+	"go.uber.org/mock/gomock"
 )
 
 func TestFakes(t *testing.T) {
@@ -73,6 +90,165 @@ func testFakes(t *testing.T, when spec.G, it spec.S) {
 		Expect(err).To(Equal(errors.New("other-error")))
 	})
 
+	when("return values are configured for matching arguments", func() {
+		it.Before(func() {
+			fake.DoThingsReturns(1, nil)
+			fake.DoThingsReturnsWhen(func(arg1 string, arg2 uint64) bool {
+				return arg1 == "stuff"
+			}, 2, nil)
+			fake.DoThingsReturnsWhen(func(arg1 string, arg2 uint64) bool {
+				return arg2 == 5
+			}, 3, errors.New("the-error"))
+		})
+
+		it("returns the values for the last matcher that matches", func() {
+			num, err := fake.DoThings("stuff", 5)
+			Expect(num).To(Equal(3))
+			Expect(err).To(Equal(errors.New("the-error")))
+
+			num, err = fake.DoThings("stuff", 6)
+			Expect(num).To(Equal(2))
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		it("falls back to the default return values when no matcher matches", func() {
+			num, err := fake.DoThings("other-stuff", 6)
+			Expect(num).To(Equal(1))
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		it("checks the matchers before the stub function", func() {
+			fake.DoThingsCalls(func(string, uint64) (int, error) {
+				return 4, nil
+			})
+
+			num, _ := fake.DoThings("stuff", 6)
+			Expect(num).To(Equal(2))
+			num, _ = fake.DoThings("other-stuff", 6)
+			Expect(num).To(Equal(4))
+		})
+
+		it("still records the call", func() {
+			_, _ = fake.DoThings("stuff", 5)
+
+			Expect(fake.DoThingsCallCount()).To(Equal(1))
+			arg1, arg2 := fake.DoThingsArgsForCall(0)
+			Expect(arg1).To(Equal("stuff"))
+			Expect(arg2).To(Equal(uint64(5)))
+		})
+	})
+
+	when("return values are configured for specific arguments", func() {
+		it.Before(func() {
+			fake.DoThingsReturns(1, nil)
+			fake.DoThingsReturnsForArgs("stuff", 5)(2, nil)
+			fake.DoThingsReturnsForArgs("other-stuff", 6)(3, errors.New("the-error"))
+		})
+
+		it("returns the values configured for equal arguments", func() {
+			num, err := fake.DoThings("stuff", 5)
+			Expect(num).To(Equal(2))
+			Expect(err).NotTo(HaveOccurred())
+
+			num, err = fake.DoThings("other-stuff", 6)
+			Expect(num).To(Equal(3))
+			Expect(err).To(MatchError("the-error"))
+		})
+
+		it("falls back to the default return values for other arguments", func() {
+			num, _ := fake.DoThings("stuff", 6)
+			Expect(num).To(Equal(1))
+		})
+
+		it("returns the values configured last for the same arguments", func() {
+			fake.DoThingsReturnsForArgs("stuff", 5)(4, nil)
+
+			num, _ := fake.DoThings("stuff", 5)
+			Expect(num).To(Equal(4))
+		})
+
+		it("checks them before the stub function", func() {
+			fake.DoThingsCalls(func(string, uint64) (int, error) {
+				return 4, nil
+			})
+
+			num, _ := fake.DoThings("stuff", 5)
+			Expect(num).To(Equal(2))
+			num, _ = fake.DoThings("stuff", 6)
+			Expect(num).To(Equal(4))
+		})
+
+		it("checks them together with the matchers, the last registered winning", func() {
+			fake.DoThingsReturnsWhen(func(arg1 string, arg2 uint64) bool {
+				return arg1 == "stuff"
+			}, 4, nil)
+
+			num, _ := fake.DoThings("stuff", 5)
+			Expect(num).To(Equal(4))
+
+			fake.DoThingsReturnsForArgs("stuff", 5)(5, nil)
+
+			num, _ = fake.DoThings("stuff", 5)
+			Expect(num).To(Equal(5))
+		})
+
+		it("compares the arguments with the configured comparer", func() {
+			fake.ArgsComparer = func(expected interface{}, actual interface{}) bool {
+				if s, ok := expected.(string); ok {
+					return strings.EqualFold(s, actual.(string))
+				}
+				return expected == actual
+			}
+
+			num, _ := fake.DoThings("STUFF", 5)
+			Expect(num).To(Equal(2))
+		})
+
+		it("forgets them when the stubs are reset", func() {
+			fake.ResetDoThingsStubs()
+
+			num, _ := fake.DoThings("stuff", 5)
+			Expect(num).To(Equal(0))
+		})
+
+		it("compares variadic arguments as a slice", func() {
+			fake := new(fixturesfakes.FakeHasVarArgs)
+			fake.DoThingsReturnsForArgs(1, "a", "b")(2)
+
+			Expect(fake.DoThings(1, "a", "b")).To(Equal(2))
+			Expect(fake.DoThings(1, "a")).To(Equal(0))
+		})
+
+		it("works for fakes of functions", func() {
+			fake := new(fixturesfakes.FakeSomethingFactory)
+			fake.Returns("default")
+			fake.ReturnsForArgs("a", map[string]interface{}{"b": 1})("c")
+
+			Expect(fake.Spy("a", map[string]interface{}{"b": 1})).To(Equal("c"))
+			Expect(fake.Spy("a", nil)).To(Equal("default"))
+
+			fake.ReturnsForArgs("a", nil)("d")
+			fake.Stub = func(string, map[string]interface{}) string { return "stub" }
+			Expect(fake.Spy("a", nil)).To(Equal("d"))
+			Expect(fake.Spy("b", nil)).To(Equal("stub"))
+		})
+	})
+
+	it("can have its behavior configured for matching arguments using stub functions", func() {
+		var calledWith []byte
+		fake.DoASliceCallsWhen(func(b []byte) bool {
+			return len(b) == 2
+		}, func(b []byte) {
+			calledWith = b
+		})
+
+		fake.DoASlice([]byte{1})
+		Expect(calledWith).To(BeNil())
+
+		fake.DoASlice([]byte{1, 2})
+		Expect(calledWith).To(Equal([]byte{1, 2}))
+	})
+
 	it("records the arguments it was called with", func() {
 		Expect(fake.DoThingsCallCount()).To(Equal(0))
 
@@ -84,6 +260,34 @@ func testFakes(t *testing.T, when spec.G, it spec.S) {
 		Expect(arg2).To(Equal(uint64(5)))
 	})
 
+	it("records a typed history of its calls", func() {
+		_, _ = fake.DoThings("stuff", 5)
+		_, _ = fake.DoThings("other-stuff", 6)
+
+		Expect(fake.DoThingsCallHistory()).To(Equal([]fixturesfakes.FakeSomethingDoThingsCall{
+			{Arg1: "stuff", Arg2: 5},
+			{Arg1: "other-stuff", Arg2: 6},
+		}))
+	})
+
+	it("names the fields of the call history after the params", func() {
+		fake := new(fixturesfakes.FakeReusesArgTypes)
+		fake.DoThings("stuff", "other-stuff")
+
+		Expect(fake.DoThingsCallHistory()).To(Equal([]fixturesfakes.FakeReusesArgTypesDoThingsCall{
+			{X: "stuff", Y: "other-stuff"},
+		}))
+	})
+
+	it("returns a copy of the call history", func() {
+		_, _ = fake.DoThings("stuff", 5)
+
+		history := fake.DoThingsCallHistory()
+		history[0].Arg1 = "changed"
+
+		Expect(fake.DoThingsCallHistory()[0].Arg1).To(Equal("stuff"))
+	})
+
 	it("records a slice argument as a copy", func() {
 		buffer := []byte{1}
 
@@ -127,12 +331,209 @@ func testFakes(t *testing.T, when spec.G, it spec.S) {
 		Expect(buffer).To(ConsistOf(byte(2)))
 	})
 
+	when("resetting the fake", func() {
+		it.Before(func() {
+			fake.DoThingsReturns(1, nil)
+			fake.DoThingsReturnsOnCall(2, 2, nil)
+			fake.DoASliceCalls(func([]byte) {})
+			_, _ = fake.DoThings("stuff", 5)
+			fake.DoASlice([]byte{1})
+		})
+
+		it("can reset the recorded calls of a single method", func() {
+			fake.ResetDoThingsCalls()
+
+			Expect(fake.DoThingsCallCount()).To(Equal(0))
+			Expect(fake.Invocations()).NotTo(HaveKey("DoThings"))
+			Expect(fake.DoASliceCallCount()).To(Equal(1))
+
+			num, _ := fake.DoThings("stuff", 5)
+			Expect(num).To(Equal(1))
+		})
+
+		it("can reset the configured behavior of a single method", func() {
+			fake.ResetDoThingsStubs()
+
+			Expect(fake.DoThingsCallCount()).To(Equal(1))
+			num, _ := fake.DoThings("stuff", 5)
+			Expect(num).To(Equal(0))
+			num, _ = fake.DoThings("stuff", 5)
+			Expect(num).To(Equal(0))
+		})
+
+		it("can reset both for a single method", func() {
+			fake.ResetDoThings()
+
+			Expect(fake.DoThingsCallCount()).To(Equal(0))
+			num, _ := fake.DoThings("stuff", 5)
+			Expect(num).To(Equal(0))
+			Expect(fake.DoASliceCallCount()).To(Equal(1))
+		})
+
+		it("can reset the recorded calls of every method", func() {
+			fake.ResetCalls()
+
+			Expect(fake.DoThingsCallCount()).To(Equal(0))
+			Expect(fake.DoASliceCallCount()).To(Equal(0))
+			Expect(fake.Invocations()).To(BeEmpty())
+		})
+
+		it("can reset the configured behavior of every method", func() {
+			fake.ResetStubs()
+
+			Expect(fake.DoASliceStub).To(BeNil())
+			num, _ := fake.DoThings("stuff", 5)
+			Expect(num).To(Equal(0))
+		})
+
+		it("can reset everything", func() {
+			fake.Reset()
+
+			Expect(fake.DoThingsCallCount()).To(Equal(0))
+			Expect(fake.DoASliceStub).To(BeNil())
+			Expect(fake.Invocations()).To(BeEmpty())
+		})
+
+		it("fakes an interface with a Reset method", func() {
+			var h hash.Hash = new(fixturesfakes.FakeHash)
+			fake := h.(*fixturesfakes.FakeHash)
+			fake.ResetCalls(func() {})
+			fake.SizeReturns(4)
+			h.Reset()
+
+			Expect(fake.ResetCallCount()).To(Equal(1))
+			fake.ResetStubs()
+			Expect(fake.ResetStub).To(BeNil())
+			Expect(h.Size()).To(Equal(0))
+		})
+
+		it("keeps the Reset helpers of methods named Calls and Stubs", func() {
+			fake := new(fixturesfakes.FakeRecordsCalls)
+			fake.CallsReturns([]string{"stuff"})
+			fake.StubsReturns(errors.New("boom"))
+			fake.Calls()
+			_ = fake.Stubs("stuff")
+
+			fake.ResetCalls()
+
+			Expect(fake.CallsCallCount()).To(Equal(0))
+			Expect(fake.Calls()).To(BeNil())
+			Expect(fake.StubsCallCount()).To(Equal(1))
+
+			fake.Reset()
+
+			Expect(fake.StubsCallCount()).To(Equal(0))
+			Expect(fake.Stubs("stuff")).To(Succeed())
+		})
+	})
+
 	it("records its calls without race conditions", func() {
 		go fake.DoNothing()
 
 		Eventually(fake.DoNothingCallCount, 1.0).Should(Equal(1))
 	})
 
+	when("waiting for calls made from other goroutines", func() {
+		it("returns once the expected number of calls has been made", func() {
+			go fake.DoNothing()
+			go fake.DoNothing()
+
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+			Expect(fake.WaitForDoNothingCalls(ctx, 2)).To(Succeed())
+			Expect(fake.DoNothingCallCount()).To(BeNumerically(">=", 2))
+		})
+
+		it("returns immediately when the calls have already been made", func() {
+			fake.DoNothing()
+
+			Expect(fake.WaitForDoNothingCalls(context.Background(), 1)).To(Succeed())
+		})
+
+		it("returns the context error when the context ends first", func() {
+			fake.DoNothing()
+
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+			defer cancel()
+			Expect(fake.WaitForDoNothingCalls(ctx, 2)).To(MatchError(context.DeadlineExceeded))
+		})
+
+		it("streams the calls on a channel", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			calls := fake.DoThingsCallsChan(ctx)
+
+			go func() {
+				_, _ = fake.DoThings("one", 1)
+				_, _ = fake.DoThings("two", 2)
+			}()
+
+			Eventually(calls).Should(Receive(Equal([]interface{}{"one", uint64(1)})))
+			Eventually(calls).Should(Receive(Equal([]interface{}{"two", uint64(2)})))
+			Expect(fake.DoNothingCallsChan(ctx)).NotTo(Receive())
+		})
+
+		it("does not block calls when nobody receives from the channel", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			calls := fake.DoThingsCallsChan(ctx)
+
+			_, _ = fake.DoThings("one", 1)
+			Eventually(calls).Should(Receive(Equal([]interface{}{"one", uint64(1)})))
+
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				_, _ = fake.DoThings("two", 2)
+				_, _ = fake.DoThings("three", 3)
+			}()
+
+			Eventually(done).Should(BeClosed())
+			Expect(fake.DoThingsCallCount()).To(Equal(3))
+			Eventually(calls).Should(Receive(Equal([]interface{}{"two", uint64(2)})))
+			Eventually(calls).Should(Receive(Equal([]interface{}{"three", uint64(3)})))
+		})
+
+		it("closes the channel when the context ends", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			calls := fake.DoThingsCallsChan(ctx)
+
+			cancel()
+
+			Eventually(calls).Should(BeClosed())
+			_, _ = fake.DoThings("stuff", 5)
+			Expect(fake.DoThingsCallCount()).To(Equal(1))
+		})
+
+		it("closes the channel when the calls are reset", func() {
+			calls := fake.DoThingsCallsChan(context.Background())
+			otherCalls := fake.DoNothingCallsChan(context.Background())
+
+			fake.ResetDoThingsCalls()
+
+			Eventually(calls).Should(BeClosed())
+			Consistently(otherCalls).ShouldNot(BeClosed())
+
+			fake.ResetCalls()
+
+			Eventually(otherCalls).Should(BeClosed())
+		})
+
+		it("also works for functions", func() {
+			fake := new(fixturesfakes.FakeSomethingFactory)
+			calls := fake.CallsChan(context.Background())
+
+			go fake.Spy("stuff", nil)
+
+			Expect(fake.WaitForCalls(context.Background(), 1)).To(Succeed())
+			Eventually(calls).Should(Receive(Equal([]interface{}{"stuff", map[string]interface{}(nil)})))
+
+			fake.ResetCalls()
+
+			Eventually(calls).Should(BeClosed())
+		})
+	})
+
 	when("implementing an interface to show recorded methoded invocations", func() {
 		it.Before(func() {
 			var ifake interface{} = fake
@@ -165,6 +566,51 @@ func testFakes(t *testing.T, when spec.G, it spec.S) {
 		})
 	})
 
+	when("recording the order of invocations", func() {
+		it("records each invocation with an increasing sequence number", func() {
+			fake.DoNothing()
+			_, _ = fake.DoThings("stuff", 5)
+			fake.DoNothing()
+
+			invocations := fake.OrderedInvocations()
+			Expect(invocations).To(HaveLen(3))
+			Expect(invocations[0].Method).To(Equal("DoNothing"))
+			Expect(invocations[1].Method).To(Equal("DoThings"))
+			Expect(invocations[1].Args).To(Equal([]interface{}{"stuff", uint64(5)}))
+			Expect(invocations[2].Method).To(Equal("DoNothing"))
+			Expect(invocations[0].Seq).To(BeNumerically("<", invocations[1].Seq))
+			Expect(invocations[1].Seq).To(BeNumerically("<", invocations[2].Seq))
+		})
+
+		it("orders invocations across fakes that share a sequencer", func() {
+			other := new(fixturesfakes.FakeSomethingFactory)
+			sequencer := new(atomic.Uint64)
+			fake.SetSequencer(sequencer)
+			other.SetSequencer(sequencer)
+
+			fake.DoNothing()
+			other.Spy("stuff", nil)
+			_, _ = fake.DoThings("stuff", 5)
+
+			Expect(fake.OrderedInvocations()[0].Seq).To(BeNumerically("<", other.OrderedInvocations()[0].Seq))
+			Expect(other.OrderedInvocations()[0].Seq).To(BeNumerically("<", fake.OrderedInvocations()[1].Seq))
+			Expect(sequencer.Load()).To(Equal(uint64(3)))
+		})
+
+		it("forgets the invocations of a method when its calls are reset", func() {
+			fake.DoNothing()
+			_, _ = fake.DoThings("stuff", 5)
+
+			fake.ResetDoNothingCalls()
+			invocations := fake.OrderedInvocations()
+			Expect(invocations).To(HaveLen(1))
+			Expect(invocations[0].Method).To(Equal("DoThings"))
+
+			fake.ResetCalls()
+			Expect(fake.OrderedInvocations()).To(BeEmpty())
+		})
+	})
+
 	when("when two methods are called at the same time", func() {
 		var start1 chan struct{}
 		var start2 chan struct{}
@@ -277,6 +723,24 @@ func testFakes(t *testing.T, when spec.G, it spec.S) {
 			Expect(strings).To(Equal([]string{"one", "two", "three"}))
 		})
 
+		it("records the var-args as a slice in the call history", func() {
+			fake.DoThings(5, "one", "two")
+
+			Expect(fake.DoThingsCallHistory()).To(ConsistOf(fixturesfakes.FakeHasVarArgsDoThingsCall{
+				Arg1: 5,
+				Arg2: []string{"one", "two"},
+			}))
+		})
+
+		it("passes the var-args to matchers", func() {
+			fake.DoThingsReturnsWhen(func(x int, strings ...string) bool {
+				return len(strings) == 3
+			}, 11)
+
+			Expect(fake.DoThings(5, "one", "two", "three")).To(Equal(11))
+			Expect(fake.DoThings(5, "one")).To(Equal(0))
+		})
+
 		it("passes the var-args to stub functions", func() {
 			fake.DoThingsStub = func(x int, strings ...string) int {
 				Expect(strings).To(Equal([]string{"one", "two", "three"}))
@@ -288,6 +752,901 @@ func testFakes(t *testing.T, when spec.G, it spec.S) {
 		})
 	})
 
+	when("faking a function", func() {
+		var fake *fixturesfakes.FakeSomethingFactory
+
+		it.Before(func() {
+			fake = new(fixturesfakes.FakeSomethingFactory)
+		})
+
+		it("implements the function type", func() {
+			var functionVal fixtures.SomethingFactory = fake.Spy
+			Expect(functionVal).NotTo(BeNil())
+		})
+
+		it("records a typed history of its calls", func() {
+			fake.Spy("stuff", map[string]interface{}{"a": 1})
+
+			Expect(fake.CallHistory()).To(Equal([]fixturesfakes.FakeSomethingFactoryCall{
+				{Arg1: "stuff", Arg2: map[string]interface{}{"a": 1}},
+			}))
+		})
+
+		it("can be reset", func() {
+			fake.Returns("stuff")
+			fake.Spy("a", nil)
+
+			fake.ResetCalls()
+			Expect(fake.CallCount()).To(Equal(0))
+			Expect(fake.Invocations()).To(BeEmpty())
+			Expect(fake.Spy("a", nil)).To(Equal("stuff"))
+
+			fake.Reset()
+			Expect(fake.CallCount()).To(Equal(0))
+			Expect(fake.Spy("a", nil)).To(Equal(""))
+		})
+	})
+
+	when("the fake is strict", func() {
+		var fake *fixturesfakes.FakeStrictSomething
+
+		it.Before(func() {
+			fake = new(fixturesfakes.FakeStrictSomething)
+		})
+
+		it("panics when a method is called without configured return values", func() {
+			Expect(func() { _, _ = fake.DoThings("stuff", 5) }).To(PanicWith(
+				"FakeStrictSomething.DoThings: call 0 with arguments [stuff 5] has no stub or return values configured",
+			))
+		})
+
+		it("calls the strict handler instead of panicking when one is set", func() {
+			var method string
+			var call int
+			var args []interface{}
+			fake.StrictHandler = func(m string, c int, a []interface{}) {
+				method, call, args = m, c, a
+			}
+
+			fake.DoThingsReturnsOnCall(0, 1, nil)
+			num, err := fake.DoThings("stuff", 5)
+			Expect(num).To(Equal(1))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(method).To(BeEmpty())
+
+			num, err = fake.DoThings("other-stuff", 6)
+			Expect(num).To(Equal(0))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(method).To(Equal("DoThings"))
+			Expect(call).To(Equal(1))
+			Expect(args).To(Equal([]interface{}{"other-stuff", uint64(6)}))
+		})
+
+		it("does not fail when return values are configured", func() {
+			fake.DoThingsReturns(1, nil)
+			Expect(func() { _, _ = fake.DoThings("stuff", 5) }).NotTo(Panic())
+		})
+
+		it("does not fail when a stub is configured", func() {
+			fake.DoThingsCalls(func(string, uint64) (int, error) { return 1, nil })
+			Expect(func() { _, _ = fake.DoThings("stuff", 5) }).NotTo(Panic())
+		})
+
+		it("does not fail when a matching stub is configured", func() {
+			fake.DoThingsReturnsWhen(func(string, uint64) bool { return true }, 1, nil)
+			Expect(func() { _, _ = fake.DoThings("stuff", 5) }).NotTo(Panic())
+		})
+
+		it("does not fail for methods without return values", func() {
+			Expect(fake.DoNothing).NotTo(Panic())
+		})
+
+		it("fails again once its stubs have been reset", func() {
+			fake.DoThingsReturns(1, nil)
+			fake.ResetStubs()
+			Expect(func() { _, _ = fake.DoThings("stuff", 5) }).To(Panic())
+		})
+
+		it("also works for functions", func() {
+			fake := new(fixturesfakes.FakeStrictFunction)
+			Expect(func() { _ = fake.Spy("stuff") }).To(PanicWith(
+				"FakeStrictFunction.StrictFunction: call 0 with arguments [stuff] has no stub or return values configured",
+			))
+
+			fake.Returns(nil)
+			Expect(func() { _ = fake.Spy("stuff") }).NotTo(Panic())
+		})
+	})
+
+	when("the fake delegates to a real implementation", func() {
+		var (
+			fake *fixturesfakes.DelegatingSomething
+			real *fixturesfakes.FakeSomething
+		)
+
+		it.Before(func() {
+			real = new(fixturesfakes.FakeSomething)
+			real.DoThingsReturns(7, errors.New("the-real-error"))
+			fake = &fixturesfakes.DelegatingSomething{Delegate: real}
+		})
+
+		it("forwards calls without configured return values to the delegate", func() {
+			num, err := fake.DoThings("stuff", 5)
+			Expect(num).To(Equal(7))
+			Expect(err).To(Equal(errors.New("the-real-error")))
+
+			fake.DoNothing()
+			fake.DoASlice([]byte{1})
+
+			Expect(real.DoThingsCallCount()).To(Equal(1))
+			Expect(real.DoNothingCallCount()).To(Equal(1))
+			Expect(real.DoASliceArgsForCall(0)).To(Equal([]byte{1}))
+		})
+
+		it("records the forwarded calls", func() {
+			_, _ = fake.DoThings("stuff", 5)
+
+			Expect(fake.DoThingsCallCount()).To(Equal(1))
+			arg1, arg2 := fake.DoThingsArgsForCall(0)
+			Expect(arg1).To(Equal("stuff"))
+			Expect(arg2).To(Equal(uint64(5)))
+			Expect(fake.Invocations()["DoThings"]).To(HaveLen(1))
+		})
+
+		it("does not forward calls with configured behavior", func() {
+			fake.DoThingsReturns(1, nil)
+			fake.DoNothingCalls(func() {})
+
+			num, err := fake.DoThings("stuff", 5)
+			Expect(num).To(Equal(1))
+			Expect(err).NotTo(HaveOccurred())
+			fake.DoNothing()
+
+			Expect(real.DoThingsCallCount()).To(Equal(0))
+			Expect(real.DoNothingCallCount()).To(Equal(0))
+		})
+
+		it("forwards calls again once its stubs have been reset", func() {
+			fake.DoThingsReturns(1, nil)
+			fake.ResetStubs()
+
+			num, _ := fake.DoThings("stuff", 5)
+			Expect(num).To(Equal(7))
+		})
+
+		it("returns zero values when there is no delegate", func() {
+			fake.Delegate = nil
+
+			num, err := fake.DoThings("stuff", 5)
+			Expect(num).To(Equal(0))
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		it("also works for functions", func() {
+			fake := &fixturesfakes.DelegatingSomethingFactory{
+				Delegate: func(s string, m map[string]interface{}) string {
+					return "real " + s
+				},
+			}
+
+			Expect(fake.Spy("stuff", nil)).To(Equal("real stuff"))
+			Expect(fake.CallCount()).To(Equal(1))
+
+			fake.Returns("fake")
+			Expect(fake.Spy("stuff", nil)).To(Equal("fake"))
+		})
+	})
+
+	when("the fake records deep copies of its arguments", func() {
+		var fake *fixturesfakes.FakeDeepCopySomething
+
+		it.Before(func() {
+			fake = new(fixturesfakes.FakeDeepCopySomething)
+		})
+
+		it("records the arguments as they were at the time of the call", func() {
+			quantity := 1
+			order := &fixtures.Order{
+				ID:    "order-1",
+				Lines: []fixtures.OrderLine{{SKU: "sku-1", Quantity: &quantity}},
+				Tags:  map[string][]string{"tag": {"a"}},
+				Next:  &fixtures.Order{ID: "order-2"},
+			}
+			lines := []*fixtures.OrderLine{{SKU: "sku-2"}}
+			receipts := map[string]fixtures.Receipt{"receipt": {Total: 1}}
+
+			Expect(fake.Save(order, lines, receipts)).To(Succeed())
+
+			quantity = 2
+			order.Lines[0].SKU = "changed"
+			order.Tags["tag"][0] = "changed"
+			order.Next.ID = "changed"
+			lines[0].SKU = "changed"
+			receipts["receipt"] = fixtures.Receipt{Total: 2}
+
+			recordedOrder, recordedLines, recordedReceipts := fake.SaveArgsForCall(0)
+			Expect(recordedOrder).NotTo(BeIdenticalTo(order))
+			Expect(recordedOrder.Lines[0].SKU).To(Equal("sku-1"))
+			Expect(*recordedOrder.Lines[0].Quantity).To(Equal(1))
+			Expect(recordedOrder.Tags["tag"]).To(Equal([]string{"a"}))
+			Expect(recordedOrder.Next.ID).To(Equal("order-2"))
+			Expect(recordedLines[0].SKU).To(Equal("sku-2"))
+			Expect(recordedReceipts["receipt"].Total).To(Equal(1))
+			Expect(fake.Invocations()["Save"][0][0]).To(Equal(recordedOrder))
+		})
+
+		it("copies values with cycles", func() {
+			order := &fixtures.Order{ID: "order-1"}
+			order.Next = &fixtures.Order{ID: "order-2", Next: order}
+
+			Expect(fake.Save(order, nil, nil)).To(Succeed())
+			order.ID = "changed"
+
+			recordedOrder, _, _ := fake.SaveArgsForCall(0)
+			Expect(recordedOrder).NotTo(BeIdenticalTo(order))
+			Expect(recordedOrder.ID).To(Equal("order-1"))
+			Expect(recordedOrder.Next.ID).To(Equal("order-2"))
+			Expect(recordedOrder.Next.Next).To(BeIdenticalTo(recordedOrder))
+		})
+
+		it("passes the original arguments to stubs", func() {
+			order := &fixtures.Order{}
+			fake.SaveCalls(func(o *fixtures.Order, _ []*fixtures.OrderLine, _ map[string]fixtures.Receipt) error {
+				Expect(o).To(BeIdenticalTo(order))
+				return nil
+			})
+
+			Expect(fake.Save(order, nil, nil)).To(Succeed())
+		})
+
+		it("copies arrays and var-args", func() {
+			buffers := [2][]byte{{1}, {2}}
+			tags := []string{"a"}
+
+			Expect(fake.Write(nil, buffers, nil)).To(Succeed())
+			fake.Tag("stuff", tags)
+			buffers[0][0] = 3
+			tags[0] = "changed"
+
+			_, recordedBuffers, _ := fake.WriteArgsForCall(0)
+			Expect(recordedBuffers).To(Equal([2][]byte{{1}, {2}}))
+			_, recordedTags := fake.TagArgsForCall(0)
+			Expect(recordedTags).To(Equal([][]string{{"a"}}))
+		})
+
+		it("hands values it cannot copy to the fallback", func() {
+			var handed []interface{}
+			fake.DeepCopyFallback = func(v interface{}) interface{} {
+				handed = append(handed, v)
+				if r, ok := v.(fixtures.Receipt); ok {
+					return fixtures.Receipt{Total: r.Total * 10}
+				}
+				return nil
+			}
+			writer := &bytes.Buffer{}
+
+			Expect(fake.Write(writer, [2][]byte{}, nil)).To(Succeed())
+			Expect(fake.Save(nil, nil, map[string]fixtures.Receipt{"receipt": {Total: 1}})).To(Succeed())
+
+			Expect(handed).To(ConsistOf(writer, fixtures.Receipt{Total: 1}))
+			recordedWriter, _, _ := fake.WriteArgsForCall(0)
+			Expect(recordedWriter).To(BeIdenticalTo(writer))
+			_, _, recordedReceipts := fake.SaveArgsForCall(0)
+			Expect(recordedReceipts["receipt"].Total).To(Equal(10))
+		})
+
+		it("keeps the pointers to values that hold locks", func() {
+			ledger := &fixtures.Ledger{Orders: []*fixtures.Order{{ID: "order-1"}}}
+			wg := &sync.WaitGroup{}
+
+			fake.Settle(ledger, wg, []*fixtures.Ledger{ledger})
+
+			recordedLedger, recordedWg, recordedLedgers := fake.SettleArgsForCall(0)
+			Expect(recordedLedger).To(BeIdenticalTo(ledger))
+			Expect(recordedWg).To(BeIdenticalTo(wg))
+			Expect(recordedLedgers[0]).To(BeIdenticalTo(ledger))
+		})
+
+		it("also works for functions", func() {
+			fake := new(fixturesfakes.FakeDeepCopyFunction)
+			orders := []fixtures.Order{{ID: "order-1"}}
+
+			Expect(fake.Spy(orders)).To(Succeed())
+			orders[0].ID = "changed"
+
+			Expect(fake.ArgsForCall(0)).To(Equal([]fixtures.Order{{ID: "order-1"}}))
+		})
+	})
+
+	when("the fake is created with a constructor", func() {
+		var tb *recordingTB
+
+		it.Before(func() {
+			tb = &recordingTB{TB: t}
+		})
+
+		it("registers a cleanup with the test", func() {
+			fixturesfakes.NewFakeSomething(tb)
+			Expect(tb.cleanups).To(HaveLen(1))
+
+			tb.cleanup()
+			Expect(tb.errors).To(BeEmpty())
+		})
+
+		it("reports return values configured for calls that were never made", func() {
+			fake := fixturesfakes.NewFakeSomething(tb)
+			fake.DoThingsReturnsOnCall(0, 1, nil)
+			fake.DoThingsReturnsOnCall(3, 3, nil)
+			fake.DoThingsReturnsOnCall(2, 2, nil)
+			_, _ = fake.DoThings("stuff", 5)
+
+			tb.cleanup()
+			Expect(tb.errors).To(ConsistOf(
+				"FakeSomething.DoThings: return values were configured for calls [2 3], which were never made",
+			))
+		})
+
+		it("reports calls to a strict fake that had no configured return values", func() {
+			fake := fixturesfakes.NewFakeStrictSomething(tb)
+
+			Expect(func() { _, _ = fake.DoThings("stuff", 5) }).NotTo(Panic())
+
+			tb.cleanup()
+			Expect(tb.errors).To(ConsistOf(
+				"FakeStrictSomething.DoThings: call 0 with arguments [stuff 5] has no stub or return values configured",
+			))
+		})
+
+		it("also works for functions", func() {
+			fake := fixturesfakes.NewFakeStrictFunction(tb)
+			fake.ReturnsOnCall(1, nil)
+			_ = fake.Spy("stuff")
+
+			tb.cleanup()
+			Expect(tb.errors).To(ConsistOf(
+				"FakeStrictFunction.StrictFunction: call 0 with arguments [stuff] has no stub or return values configured",
+				"FakeStrictFunction.StrictFunction: return values were configured for calls [1], which were never made",
+			))
+		})
+
+		it("can be used with a real test", func() {
+			fake := fixturesfakes.NewFakeSomething(t)
+			fake.DoThingsReturnsOnCall(0, 1, nil)
+
+			num, err := fake.DoThings("stuff", 5)
+			Expect(num).To(Equal(1))
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	when("the fake has expectations", func() {
+		var fake *fixturesfakes.ExpectingSomething
+
+		it.Before(func() {
+			fake = new(fixturesfakes.ExpectingSomething)
+		})
+
+		it("returns the values of the matching expectation", func() {
+			fake.ExpectDoThings("stuff", 5).Return(1, nil)
+			fake.ExpectDoThings("other", 5).Return(2, errors.New("the-error"))
+
+			num, err := fake.DoThings("other", 5)
+			Expect(num).To(Equal(2))
+			Expect(err).To(MatchError("the-error"))
+			num, err = fake.DoThings("stuff", 5)
+			Expect(num).To(Equal(1))
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fake.VerifyExpectations()).To(Succeed())
+			Expect(fake.DoThingsCallCount()).To(Equal(2))
+		})
+
+		it("falls back to the configured behavior when an expectation has no return values", func() {
+			fake.DoThingsReturns(3, nil)
+			fake.ExpectDoThings("stuff", 5)
+
+			num, _ := fake.DoThings("stuff", 5)
+			Expect(num).To(Equal(3))
+			Expect(fake.VerifyExpectations()).To(Succeed())
+		})
+
+		it("matches arguments with a matcher", func() {
+			fake.ExpectDoThingsWhen(func(s string, n uint64) bool { return n > 3 }).AnyTimes().Return(4, nil)
+
+			num, _ := fake.DoThings("stuff", 5)
+			Expect(num).To(Equal(4))
+			num, _ = fake.DoThings("stuff", 6)
+			Expect(num).To(Equal(4))
+			Expect(fake.VerifyExpectations()).To(Succeed())
+		})
+
+		it("reports unmet expectations", func() {
+			fake.ExpectDoThings("stuff", 5).Times(2)
+			fake.ExpectDoNothing().MinTimes(1)
+			fake.ExpectDoASlice([]byte("abc")).TimesBetween(1, 2)
+			_, _ = fake.DoThings("stuff", 5)
+
+			err := fake.VerifyExpectations()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(Equal(
+				"ExpectingSomething.DoThings with arguments [stuff 5]: expected 2 calls, but got 1\n" +
+					"ExpectingSomething.DoNothing: expected at least 1 call, but got 0\n" +
+					"ExpectingSomething.DoASlice with arguments [[97 98 99]]: expected between 1 and 2 calls, but got 0",
+			))
+		})
+
+		it("reports unexpected calls to methods with expectations", func() {
+			fake.ExpectDoThings("stuff", 5).Return(1, nil)
+			fake.DoNothing()
+
+			_, _ = fake.DoThings("stuff", 5)
+			num, _ := fake.DoThings("stuff", 5)
+			Expect(num).To(Equal(0))
+			_, _ = fake.DoThings("other", 1)
+
+			err := fake.VerifyExpectations()
+			Expect(err).To(MatchError(
+				"ExpectingSomething.DoThings: unexpected call with arguments [stuff 5]\n" +
+					"ExpectingSomething.DoThings: unexpected call with arguments [other 1]",
+			))
+		})
+
+		it("allows a range of calls", func() {
+			fake.ExpectDoNothing().MaxTimes(2)
+			Expect(fake.VerifyExpectations()).To(Succeed())
+
+			fake.DoNothing()
+			fake.DoNothing()
+			Expect(fake.VerifyExpectations()).To(Succeed())
+
+			fake.DoNothing()
+			Expect(fake.VerifyExpectations()).To(MatchError("ExpectingSomething.DoNothing: unexpected call with arguments []"))
+		})
+
+		when("the expectations are ordered", func() {
+			it.Before(func() {
+				fake.OrderExpectations()
+				fake.ExpectDoNothing()
+				fake.ExpectDoThings("stuff", 5).Return(1, nil)
+			})
+
+			it("accepts calls in the declared order", func() {
+				fake.DoNothing()
+				num, _ := fake.DoThings("stuff", 5)
+				Expect(num).To(Equal(1))
+
+				Expect(fake.VerifyExpectations()).To(Succeed())
+			})
+
+			it("reports calls made out of order", func() {
+				num, _ := fake.DoThings("stuff", 5)
+				Expect(num).To(Equal(0))
+				fake.DoNothing()
+
+				Expect(fake.VerifyExpectations()).To(MatchError(
+					"ExpectingSomething.DoThings with arguments [stuff 5]: expected 1 call, but got 0\n" +
+						"ExpectingSomething.DoThings: unexpected call with arguments [stuff 5]",
+				))
+			})
+		})
+
+		it("forgets expectations when its stubs are reset", func() {
+			fake.ExpectDoThings("stuff", 5)
+			_, _ = fake.DoThings("other", 5)
+			fake.ExpectDoNothing()
+
+			fake.ResetDoThingsStubs()
+			Expect(fake.VerifyExpectations()).To(MatchError("ExpectingSomething.DoNothing: expected 1 call, but got 0"))
+
+			fake.ResetExpectations()
+			Expect(fake.VerifyExpectations()).To(Succeed())
+		})
+
+		it("verifies the expectations when the test completes", func() {
+			tb := &recordingTB{TB: t}
+			fake := fixturesfakes.NewExpectingSomething(tb)
+			fake.ExpectDoNothing()
+
+			tb.cleanup()
+			Expect(tb.errors).To(ConsistOf("ExpectingSomething.DoNothing: expected 1 call, but got 0"))
+		})
+
+		it("also works for functions", func() {
+			fake := new(fixturesfakes.ExpectingSomethingFactory)
+			fake.ExpectWhen(func(s string, _ map[string]interface{}) bool { return s != "" }).Times(2).Return("stuff")
+
+			Expect(fake.Spy("a", nil)).To(Equal("stuff"))
+			Expect(fake.Spy("b", nil)).To(Equal("stuff"))
+			Expect(fake.Spy("c", nil)).To(Equal(""))
+
+			Expect(fake.VerifyExpectations()).To(MatchError("ExpectingSomethingFactory.SomethingFactory: unexpected call with arguments [c map[]]"))
+		})
+	})
+
+	when("the fake has the funcs style", func() {
+		var fake *fixturesfakes.FuncsSomething
+
+		it.Before(func() {
+			fake = new(fixturesfakes.FuncsSomething)
+		})
+
+		it("implements the interface", func() {
+			var interfaceVal fixtures.Something = fake
+			Expect(interfaceVal).NotTo(BeNil())
+		})
+
+		it("calls the function of the method", func() {
+			fake.DoThingsFunc = func(s string, n uint64) (int, error) {
+				return len(s) + int(n), nil
+			}
+
+			num, err := fake.DoThings("stuff", 5)
+			Expect(num).To(Equal(10))
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		it("returns zero values when the function is not set", func() {
+			num, err := fake.DoThings("stuff", 5)
+			Expect(num).To(Equal(0))
+			Expect(err).NotTo(HaveOccurred())
+			fake.DoNothing()
+		})
+
+		it("records the calls", func() {
+			_, _ = fake.DoThings("stuff", 5)
+			fake.DoNothing()
+			fake.DoNothing()
+
+			Expect(fake.DoThingsCalls()).To(Equal([]fixturesfakes.FuncsSomethingDoThingsCall{
+				{Arg1: "stuff", Arg2: 5},
+			}))
+			Expect(fake.DoNothingCalls()).To(HaveLen(2))
+			Expect(fake.DoASliceCalls()).To(BeEmpty())
+		})
+
+		it("records var-args as a slice", func() {
+			fake := new(fixturesfakes.FuncsHasVarArgs)
+			fake.DoThings(1, "a", "b")
+
+			Expect(fake.DoThingsCalls()).To(Equal([]fixturesfakes.FuncsHasVarArgsDoThingsCall{
+				{Arg1: 1, Arg2: []string{"a", "b"}},
+			}))
+		})
+
+		it("names the fields of the calls after the params", func() {
+			fake := new(fixturesfakes.StrictFuncsReusesArgTypes)
+			fake.DoThings("stuff", "other-stuff")
+
+			Expect(fake.DoThingsCalls()).To(Equal([]fixturesfakes.StrictFuncsReusesArgTypesDoThingsCall{
+				{X: "stuff", Y: "other-stuff"},
+			}))
+		})
+
+		it("records a slice argument as a copy", func() {
+			buffer := []byte{1}
+
+			fake.DoASlice(buffer)
+
+			buffer[0] = 2
+			Expect(fake.DoASliceCalls()[0].Arg1).To(Equal([]byte{1}))
+		})
+
+		it("panics when the function of a method with return values is not set and the fake is strict", func() {
+			fake := new(fixturesfakes.StrictFuncsSomething)
+
+			Expect(func() { _, _ = fake.DoThings("stuff", 5) }).To(PanicWith("StrictFuncsSomething.DoThings: DoThingsFunc is nil, but DoThings was called"))
+			Expect(fake.DoThingsCalls()).To(HaveLen(1))
+			Expect(fake.DoNothing).NotTo(Panic())
+			Expect(fake.DoNothingCalls()).To(HaveLen(1))
+		})
+	})
+
+	when("the fake has the gomock style", func() {
+		var (
+			tb   *recordingTB
+			ctrl *gomock.Controller
+			fake *fixturesfakes.GomockSomething
+		)
+
+		it.Before(func() {
+			tb = &recordingTB{}
+			ctrl = gomock.NewController(tb)
+			fake = fixturesfakes.NewGomockSomething(ctrl)
+		})
+
+		it("implements the interface", func() {
+			var interfaceVal fixtures.Something = fake
+			Expect(interfaceVal).NotTo(BeNil())
+		})
+
+		it("returns the values of the expected calls", func() {
+			fake.EXPECT().DoThings("stuff", uint64(5)).Return(3, errors.New("the-error"))
+
+			num, err := fake.DoThings("stuff", 5)
+			Expect(num).To(Equal(3))
+			Expect(err).To(MatchError("the-error"))
+			Expect(ctrl.Satisfied()).To(BeTrue())
+		})
+
+		it("is not satisfied until the expected calls are made", func() {
+			fake.EXPECT().DoNothing().Times(2)
+
+			fake.DoNothing()
+			Expect(ctrl.Satisfied()).To(BeFalse())
+			fake.DoNothing()
+			Expect(ctrl.Satisfied()).To(BeTrue())
+		})
+
+		it("matches var-args", func() {
+			fake := fixturesfakes.NewGomockHasVarArgs(ctrl)
+			fake.EXPECT().DoThings(1, "a", gomock.Any()).Return(2)
+
+			Expect(fake.DoThings(1, "a", "b")).To(Equal(2))
+			Expect(ctrl.Satisfied()).To(BeTrue())
+		})
+	})
+
+	when("the fake has pointer arguments", func() {
+		var fake *fixturesfakes.FakeScanner
+
+		it.Before(func() {
+			fake = new(fixturesfakes.FakeScanner)
+		})
+
+		it("assigns the configured values through them before returning", func() {
+			fake.LoadSetsArg(1, fixtures.Order{ID: "the-order"})
+			fake.LoadReturns(true, nil)
+
+			var order fixtures.Order
+			found, err := fake.Load("key", &order)
+			Expect(found).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(order.ID).To(Equal("the-order"))
+		})
+
+		it("assigns through empty interfaces that hold pointers", func() {
+			fake.DecodeSetsArg(0, map[string]int{"a": 1})
+
+			var v map[string]int
+			Expect(fake.Decode(&v)).To(Succeed())
+			Expect(v).To(Equal(map[string]int{"a": 1}))
+		})
+
+		it("counts variadic arguments individually", func() {
+			fake.ScanSetsArg(0, "stuff")
+			fake.ScanSetsArg(1, 5)
+			fake.ScanReturns(nil)
+
+			var s string
+			var n int
+			Expect(fake.Scan(&s, &n)).To(Succeed())
+			Expect(s).To(Equal("stuff"))
+			Expect(n).To(Equal(5))
+		})
+
+		it("assigns the zero value for nil", func() {
+			fake.LoadSetsArg(1, nil)
+
+			order := fixtures.Order{ID: "the-order"}
+			_, _ = fake.Load("key", &order)
+			Expect(order.ID).To(BeEmpty())
+		})
+
+		it("panics when the value cannot be assigned", func() {
+			fake.ScanSetsArg(0, 5)
+
+			var s string
+			Expect(func() { _ = fake.Scan(&s) }).To(PanicWith("FakeScanner.Scan: cannot set argument 0, because int is not assignable to string"))
+			Expect(func() { _ = fake.Scan(s) }).To(PanicWith("FakeScanner.Scan: cannot set argument 0, because it is string instead of a non-nil pointer"))
+			Expect(func() { _ = fake.Scan() }).To(PanicWith("FakeScanner.Scan: cannot set argument 0, because it was called with 0 arguments"))
+		})
+
+		it("forgets the values when the stubs are reset", func() {
+			fake.DecodeSetsArg(0, 5)
+			fake.ResetDecodeStubs()
+
+			n := 1
+			Expect(fake.Decode(&n)).To(Succeed())
+			Expect(n).To(Equal(1))
+		})
+
+		it("works for fakes of functions", func() {
+			fake := new(fixturesfakes.FakeDecodeFunction)
+			fake.SetsArg(1, "stuff")
+
+			var s string
+			Expect(fake.Spy([]byte("{}"), &s)).To(Succeed())
+			Expect(s).To(Equal("stuff"))
+		})
+	})
+
+	when("the fake has the replay style", func() {
+		var (
+			real *fixturesfakes.FakeSomething
+			path string
+		)
+
+		it.Before(func() {
+			real = new(fixturesfakes.FakeSomething)
+			real.DoThingsReturnsOnCall(0, 1, nil)
+			real.DoThingsReturnsOnCall(1, 2, nil)
+			real.DoThingsReturnsOnCall(2, 3, errors.New("the-error"))
+			path = filepath.Join(t.TempDir(), "calls.json")
+
+			recorder := fixturesfakes.NewReplaySomethingRecorder(real)
+			Expect(recorder.DoThings("stuff", 5)).To(Equal(1))
+			Expect(recorder.DoThings("stuff", 5)).To(Equal(2))
+			_, err := recorder.DoThings("other-stuff", 6)
+			Expect(err).To(MatchError("the-error"))
+			recorder.DoASlice([]byte("abc"))
+			Expect(recorder.Save(path)).To(Succeed())
+		})
+
+		it("forwards calls to the real implementation while recording them", func() {
+			Expect(real.DoThingsCallCount()).To(Equal(3))
+			Expect(real.DoASliceArgsForCall(0)).To(Equal([]byte("abc")))
+		})
+
+		it("replays the recorded results in order, repeating the last one", func() {
+			fake, err := fixturesfakes.LoadReplaySomething(path)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fake.DoThings("stuff", 5)).To(Equal(1))
+			Expect(fake.DoThings("stuff", 5)).To(Equal(2))
+			Expect(fake.DoThings("stuff", 5)).To(Equal(2))
+			_, err = fake.DoThings("other-stuff", 6)
+			Expect(err).To(MatchError("the-error"))
+			fake.DoASlice([]byte("abc"))
+		})
+
+		it("panics when a call was not recorded", func() {
+			fake, err := fixturesfakes.LoadReplaySomething(path)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(func() { _, _ = fake.DoThings("stuff", 6) }).To(PanicWith(`ReplaySomething.DoThings: no call with arguments ["stuff",6] was recorded`))
+			Expect(fake.DoNothing).To(Panic())
+		})
+
+		it("records and replays variadic arguments", func() {
+			real := new(fixturesfakes.FakeHasVarArgs)
+			real.DoThingsReturns(7)
+			recorder := fixturesfakes.NewReplayHasVarArgsRecorder(real)
+			Expect(recorder.DoThings(1, "a", "b")).To(Equal(7))
+			Expect(recorder.Save(path)).To(Succeed())
+
+			fake, err := fixturesfakes.LoadReplayHasVarArgs(path)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fake.DoThings(1, "a", "b")).To(Equal(7))
+			Expect(func() { fake.DoThings(1, "a") }).To(Panic())
+		})
+
+		it("records and replays results of concrete error types", func() {
+			recorder := fixturesfakes.NewReplayCheckerRecorder(checkerFunc(func(name string) (*fixtures.CheckError, error) {
+				if name == "bad" {
+					return &fixtures.CheckError{Code: 7}, errors.New("the-error")
+				}
+				return nil, nil
+			}))
+			_, _ = recorder.Check("bad")
+			_, _ = recorder.Check("good")
+			Expect(recorder.Save(path)).To(Succeed())
+
+			fake, err := fixturesfakes.LoadReplayChecker(path)
+			Expect(err).NotTo(HaveOccurred())
+			checkErr, err := fake.Check("bad")
+			Expect(checkErr).To(Equal(&fixtures.CheckError{Code: 7}))
+			Expect(err).To(MatchError("the-error"))
+			checkErr, err = fake.Check("good")
+			Expect(checkErr).To(BeNil())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		it("records the arguments before the real call and replays what it wrote through them", func() {
+			recorder := fixturesfakes.NewReplayFillerRecorder(stringFiller("stuff"))
+			p := make([]byte, 8)
+			Expect(recorder.Read(p)).To(Equal(5))
+			Expect(string(p[:5])).To(Equal("stuff"))
+			var total int
+			recorder.Count(&total)
+			Expect(total).To(Equal(5))
+			Expect(recorder.Save(path)).To(Succeed())
+
+			fake, err := fixturesfakes.LoadReplayFiller(path)
+			Expect(err).NotTo(HaveOccurred())
+			p = make([]byte, 8)
+			Expect(fake.Read(p)).To(Equal(5))
+			Expect(string(p[:5])).To(Equal("stuff"))
+			total = 0
+			fake.Count(&total)
+			Expect(total).To(Equal(5))
+		})
+	})
+
+	when("the interface was extracted from a concrete type", func() {
+		it("forwards calls to the concrete type through the adapter", func() {
+			store := extract.NewStore(io.NopCloser(nil))
+			var s extractshim.Store = extractshim.NewStoreAdapter(store)
+			Expect(s.Put(context.Background(), extract.Item{Key: "a"}, extract.Item{Key: "b"})).To(Succeed())
+			Expect(s.Len()).To(Equal(2))
+			item, ok := s.Get("a")
+			Expect(ok).To(BeTrue())
+			Expect(item.Key).To(Equal("a"))
+			Expect(s.Close()).To(Succeed())
+		})
+
+		it("generates a fake of the extracted interface", func() {
+			fake := new(extractshimfakes.FakeStore)
+			fake.GetReturns(extract.Item{Key: "a"}, true)
+			var s extractshim.Store = fake
+
+			item, ok := s.Get("a")
+			Expect(ok).To(BeTrue())
+			Expect(item.Key).To(Equal("a"))
+			Expect(fake.GetArgsForCall(0)).To(Equal("a"))
+		})
+	})
+
+	when("the package shim wraps concrete types", func() {
+		it("returns adapters for the concrete types", func() {
+			var w wrapshim.Wrap = new(wrapshim.WrapShim)
+			db, err := w.Open("test")
+			Expect(err).NotTo(HaveOccurred())
+			tx := db.Begin()
+			tx.Put("a", "b")
+			value, ok := tx.DB().Get("a")
+			Expect(ok).To(BeTrue())
+			Expect(value).To(Equal("b"))
+		})
+
+		it("returns nil for a nil concrete type", func() {
+			var w wrapshim.Wrap = new(wrapshim.WrapShim)
+			db, err := w.Open("")
+			Expect(err).To(HaveOccurred())
+			Expect(db == nil).To(BeTrue())
+		})
+
+		it("reads and writes the package variables", func() {
+			var w wrapshim.Wrap = new(wrapshim.WrapShim)
+			Expect(w.Current() == nil).To(BeTrue())
+
+			db, err := wrap.Open("test")
+			Expect(err).NotTo(HaveOccurred())
+			w.SetCurrent(db)
+			defer w.SetCurrent(nil)
+			Expect(wrap.Current).To(BeIdenticalTo(db))
+			w.Current().Begin().Put("a", "b")
+			value, _ := db.Get("a")
+			Expect(value).To(Equal("b"))
+		})
+
+		it("swaps the default shim for a fake until the end of a test", func() {
+			original := wrapshim.DefaultWrap
+			fake := new(wrapshimfakes.FakeWrap)
+			t.Run("with the fake", func(t *testing.T) {
+				wrapshim.SwapWrapForTest(t, fake)
+				Expect(wrapshim.DefaultWrap).To(BeIdenticalTo(fake))
+			})
+			Expect(wrapshim.DefaultWrap).To(BeIdenticalTo(original))
+			Expect(wrapshim.DefaultWrap).To(BeAssignableToTypeOf(new(wrapshim.WrapShim)))
+		})
+
+		it("generates fakes of the interfaces of the wrapped types", func() {
+			tx := new(wrapshimfakes.FakeTx)
+			db := new(wrapshimfakes.FakeDB)
+			db.BeginReturns(tx)
+			fake := new(wrapshimfakes.FakeWrap)
+			fake.OpenReturns(db, nil)
+
+			var w wrapshim.Wrap = fake
+			opened, err := w.Open("test")
+			Expect(err).NotTo(HaveOccurred())
+			opened.Begin().Put("a", "b")
+			key, value := tx.PutArgsForCall(0)
+			Expect(key).To(Equal("a"))
+			Expect(value).To(Equal("b"))
+		})
+	})
+
 	when("calling a function repeatedly and changing the returns value", func() {
 		var fake *fixturesfakes.FakeSomething
 
@@ -304,6 +1663,51 @@ func testFakes(t *testing.T, when spec.G, it spec.S) {
 	})
 }
 
+// recordingTB records the cleanups and errors of a test, so that the
+// verification of fakes can be tested without failing the test.
+type recordingTB struct {
+	testing.TB
+	cleanups []func()
+	errors   []string
+}
+
+func (tb *recordingTB) Helper() {}
+
+func (tb *recordingTB) Cleanup(f func()) {
+	tb.cleanups = append(tb.cleanups, f)
+}
+
+func (tb *recordingTB) Error(args ...interface{}) {
+	tb.errors = append(tb.errors, fmt.Sprint(args...))
+}
+
+func (tb *recordingTB) Errorf(format string, args ...interface{}) {
+	tb.errors = append(tb.errors, fmt.Sprintf(format, args...))
+}
+
+func (tb *recordingTB) cleanup() {
+	for i := len(tb.cleanups) - 1; i >= 0; i-- {
+		tb.cleanups[i]()
+	}
+}
+
 type InvocationRecorder interface {
 	Invocations() map[string][][]interface{}
 }
+
+// stringFiller reads its string into the buffers it is given.
+type stringFiller string
+
+func (s stringFiller) Read(p []byte) (int, error) {
+	return copy(p, s), nil
+}
+
+func (s stringFiller) Count(total *int) {
+	*total = len(s)
+}
+
+type checkerFunc func(name string) (*fixtures.CheckError, error)
+
+func (f checkerFunc) Check(name string) (*fixtures.CheckError, error) {
+	return f(name)
+}
@@ -0,0 +1,281 @@
+package generator
+
+import (
+	"fmt"
+	"go/types"
+	"strings"
+)
+
+// DeepCopier is a method of the fake that copies values of a type, so that the
+// fake records arguments as they were at the time of the call, even when they
+// are later mutated by the code under test.
+type DeepCopier struct {
+	Name string
+	Type string
+	Body string
+}
+
+// addDeepCopiers sets the deep copier for each of the params of the method, if
+// the fake records deep copies of its arguments.
+func (f *Fake) addDeepCopiers(sig *types.Signature, params Params) {
+	if !f.DeepCopy || f.Style != CounterfeiterStyle || f.Mode != InterfaceOrFunction {
+		return
+	}
+	for i := range params {
+		params[i].DeepCopier = f.deepCopierFor(sig.Params().At(i).Type())
+	}
+}
+
+// deepCopierFor returns the name of the method that deep copies values of the
+// given type, generating it (and the methods it depends on) if necessary. It
+// returns an empty string if values of the type do not need to be copied.
+func (f *Fake) deepCopierFor(t types.Type) string {
+	if !needsDeepCopy(t, map[types.Type]bool{}) || !isNameable(t) {
+		return ""
+	}
+	key := types.TypeString(t, nil)
+	if name, ok := f.deepCopierNames[key]; ok {
+		return name
+	}
+	if f.deepCopierNames == nil {
+		f.deepCopierNames = map[string]string{}
+	}
+
+	name := fmt.Sprintf("deepCopy%d", len(f.DeepCopiers)+1)
+	f.deepCopierNames[key] = name
+	f.addImportsFor(t)
+	typ := types.TypeString(t, f.Imports.AliasForPackage)
+	i := len(f.DeepCopiers)
+	f.DeepCopiers = append(f.DeepCopiers, DeepCopier{Name: name, Type: typ})
+	f.DeepCopiers[i].Body = f.deepCopyBody(t, typ)
+	return name
+}
+
+// deepCopyBody builds the body of the method that deep copies a value v of the
+// given type.
+func (f *Fake) deepCopyBody(t types.Type, typ string) string {
+	b := &strings.Builder{}
+	switch u := t.Underlying().(type) {
+	case *types.Pointer:
+		// Values that hold locks or unexported fields cannot be copied safely,
+		// so the pointer to them is kept rather than copied through.
+		if !isNameable(u.Elem()) || !copyableByValue(u.Elem(), map[types.Type]bool{}) {
+			break
+		}
+		elem, ok := f.deepCopyExpr(u.Elem(), "*v")
+		if !ok {
+			break
+		}
+		// The copies of pointers are remembered, so that cyclic values are
+		// copied into values with the same cycles.
+		elemTyp := types.TypeString(u.Elem(), f.Imports.AliasForPackage)
+		fmt.Fprintf(b, "\tif v == nil {\n\t\treturn nil\n\t}\n")
+		fmt.Fprintf(b, "\tif c, ok := copies[v]; ok {\n\t\treturn c.(*%s)\n\t}\n", elemTyp)
+		fmt.Fprintf(b, "\tc := new(%s)\n", elemTyp)
+		fmt.Fprintf(b, "\tcopies[v] = c\n")
+		fmt.Fprintf(b, "\t*c = %s\n", elem)
+		fmt.Fprintf(b, "\treturn c\n")
+		return b.String()
+	case *types.Slice:
+		if hasLock(u.Elem(), map[types.Type]bool{}) {
+			break
+		}
+		elem, ok := f.deepCopyExpr(u.Elem(), "v[i]")
+		if !ok {
+			break
+		}
+		fmt.Fprintf(b, "\tif v == nil {\n\t\treturn nil\n\t}\n")
+		fmt.Fprintf(b, "\tc := make(%s, len(v))\n", typ)
+		if elem == "v[i]" {
+			fmt.Fprintf(b, "\tcopy(c, v)\n")
+		} else {
+			fmt.Fprintf(b, "\tfor i := range v {\n\t\tc[i] = %s\n\t}\n", elem)
+		}
+		fmt.Fprintf(b, "\treturn c\n")
+		return b.String()
+	case *types.Map:
+		if hasLock(u.Elem(), map[types.Type]bool{}) {
+			break
+		}
+		elem, ok := f.deepCopyExpr(u.Elem(), "e")
+		if !ok {
+			break
+		}
+		fmt.Fprintf(b, "\tif v == nil {\n\t\treturn nil\n\t}\n")
+		fmt.Fprintf(b, "\tc := make(%s, len(v))\n", typ)
+		fmt.Fprintf(b, "\tfor k, e := range v {\n\t\tc[k] = %s\n\t}\n", elem)
+		fmt.Fprintf(b, "\treturn c\n")
+		return b.String()
+	case *types.Array:
+		if hasLock(u.Elem(), map[types.Type]bool{}) {
+			break
+		}
+		elem, ok := f.deepCopyExpr(u.Elem(), "v[i]")
+		if !ok {
+			break
+		}
+		fmt.Fprintf(b, "\tc := v\n")
+		fmt.Fprintf(b, "\tfor i := range v {\n\t\tc[i] = %s\n\t}\n", elem)
+		fmt.Fprintf(b, "\treturn c\n")
+		return b.String()
+	case *types.Struct:
+		var fields []string
+		ok := !hasLock(t, map[types.Type]bool{})
+		for i := 0; i < u.NumFields() && ok; i++ {
+			field := u.Field(i)
+			if !needsDeepCopy(field.Type(), map[types.Type]bool{}) {
+				continue
+			}
+			if !field.Exported() {
+				ok = false
+				break
+			}
+			var expr string
+			expr, ok = f.deepCopyExpr(field.Type(), "v."+field.Name())
+			fields = append(fields, fmt.Sprintf("\tc.%s = %s\n", field.Name(), expr))
+		}
+		if !ok {
+			break
+		}
+		fmt.Fprintf(b, "\tc := v\n")
+		for i := range fields {
+			b.WriteString(fields[i])
+		}
+		fmt.Fprintf(b, "\treturn c\n")
+		return b.String()
+	}
+
+	// The value cannot be copied by the generated code (e.g. an interface, or
+	// a value that holds locks or unexported fields), so it is handed to the fallback.
+	fmt.Fprintf(b, "\tif fake.DeepCopyFallback != nil {\n")
+	fmt.Fprintf(b, "\t\tif c, ok := fake.DeepCopyFallback(v).(%s); ok {\n\t\t\treturn c\n\t\t}\n\t}\n", typ)
+	fmt.Fprintf(b, "\treturn v\n")
+	return b.String()
+}
+
+// deepCopyExpr returns an expression that deep copies the value of expr, which
+// is of the given type. It returns false if the value needs to be copied, but
+// cannot be.
+func (f *Fake) deepCopyExpr(t types.Type, expr string) (string, bool) {
+	if !needsDeepCopy(t, map[types.Type]bool{}) {
+		return expr, true
+	}
+	name := f.deepCopierFor(t)
+	if name == "" {
+		return "", false
+	}
+	return fmt.Sprintf("fake.%s(%s, copies)", name, expr), true
+}
+
+// needsDeepCopy indicates whether a copy of a value of the given type may
+// share memory with the original value.
+func needsDeepCopy(t types.Type, seen map[types.Type]bool) bool {
+	if seen[t] {
+		return false
+	}
+	seen[t] = true
+	switch u := t.Underlying().(type) {
+	case *types.Pointer, *types.Slice, *types.Map, *types.Interface:
+		return true
+	case *types.Array:
+		return needsDeepCopy(u.Elem(), seen)
+	case *types.Struct:
+		for i := 0; i < u.NumFields(); i++ {
+			if needsDeepCopy(u.Field(i).Type(), seen) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// copyableByValue indicates whether a value of the given type can be copied
+// with an assignment, i.e. it holds no locks and no unexported fields, which
+// the copy would share with (or duplicate from) the original value.
+func copyableByValue(t types.Type, seen map[types.Type]bool) bool {
+	if seen[t] {
+		return true
+	}
+	seen[t] = true
+	if hasLock(t, map[types.Type]bool{}) {
+		return false
+	}
+	switch u := t.Underlying().(type) {
+	case *types.Array:
+		return copyableByValue(u.Elem(), seen)
+	case *types.Struct:
+		for i := 0; i < u.NumFields(); i++ {
+			if !u.Field(i).Exported() || !copyableByValue(u.Field(i).Type(), seen) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// hasLock indicates whether a value of the given type holds a lock (a value
+// with Lock and Unlock methods, such as a sync.Mutex), which go vet reports
+// when it is copied.
+func hasLock(t types.Type, seen map[types.Type]bool) bool {
+	if seen[t] {
+		return false
+	}
+	seen[t] = true
+	if _, ok := t.Underlying().(*types.Interface); ok {
+		return false
+	}
+	if _, ok := t.Underlying().(*types.Pointer); !ok {
+		methods := types.NewMethodSet(types.NewPointer(t))
+		if methods.Lookup(nil, "Lock") != nil && methods.Lookup(nil, "Unlock") != nil {
+			return true
+		}
+	}
+	switch u := t.Underlying().(type) {
+	case *types.Array:
+		return hasLock(u.Elem(), seen)
+	case *types.Struct:
+		for i := 0; i < u.NumFields(); i++ {
+			if hasLock(u.Field(i).Type(), seen) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// isNameable indicates whether the given type can be referred to from the
+// package of the fake.
+func isNameable(t types.Type) bool {
+	switch u := t.(type) {
+	case *types.Named:
+		if u.Obj().Pkg() != nil && !u.Obj().Exported() {
+			return false
+		}
+		for i := 0; i < u.TypeArgs().Len(); i++ {
+			if !isNameable(u.TypeArgs().At(i)) {
+				return false
+			}
+		}
+		return true
+	case *types.Alias:
+		return isNameable(types.Unalias(u))
+	case *types.Pointer:
+		return isNameable(u.Elem())
+	case *types.Slice:
+		return isNameable(u.Elem())
+	case *types.Array:
+		return isNameable(u.Elem())
+	case *types.Map:
+		return isNameable(u.Key()) && isNameable(u.Elem())
+	case *types.Chan:
+		return isNameable(u.Elem())
+	case *types.Struct:
+		for i := 0; i < u.NumFields(); i++ {
+			if !u.Field(i).Exported() || !isNameable(u.Field(i).Type()) {
+				return false
+			}
+		}
+		return true
+	}
+	return true
+}
@@ -0,0 +1,91 @@
+package generator
+
+import (
+	"fmt"
+	"go/types"
+)
+
+// concreteMethodSet identifies the exported methods of a concrete type, which
+// include the methods with pointer receivers and the ones promoted from its
+// embedded fields.
+func concreteMethodSet(t types.Type) []*rawMethod {
+	var result []*rawMethod
+	for _, m := range interfaceMethodSet(types.NewPointer(t)) {
+		if m.Func.Exported() {
+			result = append(result, m)
+		}
+	}
+	return result
+}
+
+func (f *Fake) validateExtract() error {
+	methods := concreteMethodSet(f.Target.Type())
+	if len(methods) == 0 {
+		return fmt.Errorf("cannot extract an interface from %s because it has no exported methods", f.TargetName)
+	}
+	for _, m := range methods {
+		if name, ok := unexportedTypeIn(m.Signature); ok {
+			return fmt.Errorf("cannot extract an interface from %s because its method %s uses the unexported type %s", f.TargetName, m.Func.Name(), name)
+		}
+	}
+	return nil
+}
+
+// unexportedTypeIn returns the name of an unexported named type used by typ,
+// which could not be referred to from the package of an extracted interface or
+// a package shim.
+func unexportedTypeIn(typ types.Type) (string, bool) {
+	switch t := typ.(type) {
+	case *types.Pointer:
+		return unexportedTypeIn(t.Elem())
+	case *types.Slice:
+		return unexportedTypeIn(t.Elem())
+	case *types.Array:
+		return unexportedTypeIn(t.Elem())
+	case *types.Chan:
+		return unexportedTypeIn(t.Elem())
+	case *types.Map:
+		if name, ok := unexportedTypeIn(t.Key()); ok {
+			return name, ok
+		}
+		return unexportedTypeIn(t.Elem())
+	case *types.Signature:
+		for _, vars := range []*types.Tuple{t.Params(), t.Results()} {
+			for i := 0; i < vars.Len(); i++ {
+				if name, ok := unexportedTypeIn(vars.At(i).Type()); ok {
+					return name, ok
+				}
+			}
+		}
+	case *types.Struct:
+		for i := 0; i < t.NumFields(); i++ {
+			if name, ok := unexportedTypeIn(t.Field(i).Type()); ok {
+				return name, ok
+			}
+		}
+	case interface {
+		Obj() *types.TypeName
+		TypeArgs() *types.TypeList
+	}:
+		if t.Obj().Pkg() != nil && !t.Obj().Exported() {
+			return t.Obj().Name(), true
+		}
+		for i := 0; i < t.TypeArgs().Len(); i++ {
+			if name, ok := unexportedTypeIn(t.TypeArgs().At(i)); ok {
+				return name, ok
+			}
+		}
+	}
+	return "", false
+}
+
+// ExtractedFake returns a Fake of the interface extracted by an Extract mode
+// Fake, which lives in the package with the given import path. The interface
+// is built from the methods that were already loaded, so the packages are not
+// loaded again.
+func (f *Fake) ExtractedFake(interfacePackage string, fakeName string, destinationPackage string, opts ...Option) (*Fake, error) {
+	if f.Mode != Extract {
+		return nil, fmt.Errorf("cannot generate a fake of an interface extracted from %s because it was not extracted", f.TargetName)
+	}
+	return f.generatedInterfaceFake(interfacePackage, fakeName, destinationPackage, opts...)
+}
@@ -0,0 +1,38 @@
+package generator
+
+const extractTemplate string = `{{.Header}}// Code generated by counterfeiter. DO NOT EDIT.
+package {{.DestinationPackage}}
+
+import (
+	{{- range $index, $import := .Imports.ByAlias}}
+	{{$import}}
+	{{- end}}
+)
+
+// {{.Name}} is a generated interface representing the exported methods of
+// {{.TargetPackage}}.{{.TargetName}}.
+type {{.Name}} interface {
+  {{- range .Methods}}
+  {{.Name}}({{.Params.AsNamedArgsWithTypes}}) {{.Returns.AsReturnSignature}}
+  {{- end}}
+}
+
+// {{.Name}}Adapter implements {{.Name}} by forwarding every call to a
+// {{.TargetPackage}}.{{.TargetName}}.
+type {{.Name}}Adapter struct {
+  target *{{.TargetAlias}}.{{.TargetName}}
+}
+
+// New{{.Name}}Adapter returns a {{.Name}}Adapter that forwards calls to target.
+func New{{.Name}}Adapter(target *{{.TargetAlias}}.{{.TargetName}}) *{{.Name}}Adapter {
+  return &{{.Name}}Adapter{target: target}
+}
+
+{{- range .Methods}}
+
+func (a *{{$.Name}}Adapter) {{.Name}}({{.Params.AsNamedArgsWithTypes}}) {{.Returns.AsReturnSignature}} {
+  {{if .Returns.HasLength}}return {{end}}a.target.{{.Name}}({{.Params.AsNamedArgsForInvocation}})
+}
+{{end}}
+var _ {{.Name}} = new({{.Name}}Adapter)
+`
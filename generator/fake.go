@@ -3,8 +3,11 @@ package generator
 import (
 	"bytes"
 	"errors"
+	"fmt"
+	"go/token"
 	"go/types"
 	"log"
+	"regexp"
 	"strings"
 	"text/template"
 	"unicode"
@@ -17,12 +20,46 @@ import (
 // FakeMode indicates the type of Fake to generate.
 type FakeMode int
 
-// FakeMode can be Interface, Function, or Package.
+// FakeMode can be Interface, Function, Package, or Extract.
 const (
 	InterfaceOrFunction FakeMode = iota
 	Package
+	Extract
 )
 
+// FakeStyle indicates the flavor of fake to generate.
+type FakeStyle int
+
+// FakeStyle can be Counterfeiter, Gomock, Funcs, or Replay.
+const (
+	CounterfeiterStyle FakeStyle = iota
+	GomockStyle
+	FuncsStyle
+	ReplayStyle
+)
+
+var styleNames = map[FakeStyle]string{
+	CounterfeiterStyle: "counterfeiter",
+	GomockStyle:        "gomock",
+	FuncsStyle:         "funcs",
+	ReplayStyle:        "replay",
+}
+
+// ParseStyle returns the FakeStyle with the given name.
+func ParseStyle(name string) (FakeStyle, error) {
+	for style, styleName := range styleNames {
+		if styleName == name {
+			return style, nil
+		}
+	}
+	return CounterfeiterStyle, fmt.Errorf("unknown style %q", name)
+}
+
+// String returns the name of the style.
+func (s FakeStyle) String() string {
+	return styleNames[s]
+}
+
 // Fake is used to generate a Fake implementation of an interface.
 type Fake struct {
 	Packages                            []*packages.Package
@@ -41,6 +78,26 @@ type Fake struct {
 	Methods                             []Method
 	Function                            Method
 	Header                              string
+	Strict                              bool
+	Delegate                            bool
+	DeepCopy                            bool
+	Expectations                        bool
+	Constructor                         bool
+	Style                               FakeStyle
+	Include                             *regexp.Regexp
+	Exclude                             *regexp.Regexp
+	Instantiations                      []string
+	Combine                             []string
+	WithDefault                         bool
+	Variables                           bool
+	WrapTypes                           []string
+	WrapDepth                           int
+	Wrapped                             []WrappedType
+	Skipped                             []SkippedFunction
+	DeepCopiers                         []DeepCopier
+	deepCopierNames                     map[string]string
+	combined                            []*packages.Package
+	rawMethods                          []*rawMethod
 }
 
 // Method is a method of the interface.
@@ -48,11 +105,14 @@ type Method struct {
 	Name    string
 	Params  Params
 	Returns Returns
+
+	FunctionName string // the function called by a package shim or an adapter, with its package and type arguments
+	IsVariable   bool   // the FunctionName is a package variable, which is read or written instead of called
 }
 
 // NewFake returns a Fake that loads the package and finds the interface or the
 // function.
-func NewFake(fakeMode FakeMode, targetName string, packagePath string, fakeName string, destinationPackage string, headerContent string, workingDir string, cache Cacher) (*Fake, error) {
+func NewFake(fakeMode FakeMode, targetName string, packagePath string, fakeName string, destinationPackage string, headerContent string, workingDir string, cache Cacher, opts ...Option) (*Fake, error) {
 	f := &Fake{
 		TargetName:         targetName,
 		TargetPackage:      packagePath,
@@ -63,7 +123,10 @@ func NewFake(fakeMode FakeMode, targetName string, packagePath string, fakeName
 		Header:             headerContent,
 	}
 
-	f.Imports.Add("sync", "sync")
+	for _, opt := range opts {
+		opt(f)
+	}
+
 	err := f.loadPackages(cache, workingDir)
 	if err != nil {
 		return nil, err
@@ -75,8 +138,18 @@ func NewFake(fakeMode FakeMode, targetName string, packagePath string, fakeName
 		return nil, err
 	}
 
-	if f.IsInterface() || f.Mode == Package {
-		f.loadMethods()
+	if f.Mode == Package {
+		err = f.loadCombinedPackages(cache, workingDir)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if f.IsInterface() || f.Mode == Package || f.Mode == Extract {
+		err = f.loadMethods()
+		if err != nil {
+			return nil, err
+		}
 	}
 	if f.IsFunction() {
 		err = f.loadMethodForFunction()
@@ -84,9 +157,196 @@ func NewFake(fakeMode FakeMode, targetName string, packagePath string, fakeName
 			return nil, err
 		}
 	}
+	err = f.validate()
+	if err != nil {
+		return nil, err
+	}
 	return f, nil
 }
 
+// generatedInterfaceFake returns a Fake of the interface generated by f, which
+// is named f.Name and lives in the package with the given import path. The
+// interface is built from the methods that were already loaded, so the
+// packages are not loaded again.
+func (f *Fake) generatedInterfaceFake(interfacePackage string, fakeName string, destinationPackage string, opts ...Option) (*Fake, error) {
+	pkg := types.NewPackage(interfacePackage, f.DestinationPackage)
+	interfaces := f.wrappedInterfaces(pkg)
+	var funcs []*types.Func
+	for _, m := range f.rawMethods {
+		name := m.Func.Name()
+		if m.Name != "" {
+			name = m.Name
+		}
+		sig := types.NewSignatureType(nil, nil, nil, m.Signature.Params(), f.wrapTuple(m.Signature.Results(), interfaces), m.Signature.Variadic())
+		funcs = append(funcs, types.NewFunc(token.NoPos, pkg, name, sig))
+	}
+	target := types.NewTypeName(token.NoPos, pkg, f.Name, nil)
+	types.NewNamed(target, types.NewInterfaceType(funcs, nil).Complete(), nil)
+	return f.interfaceFake(target, fakeName, destinationPackage, opts...)
+}
+
+// interfaceFake returns a Fake of an interface that counterfeiter generated in
+// the package of target, with the packages that were already loaded.
+func (f *Fake) interfaceFake(target *types.TypeName, fakeName string, destinationPackage string, opts ...Option) (*Fake, error) {
+	interfacePackage := target.Pkg().Path()
+	e := &Fake{
+		Packages:           f.Packages,
+		Target:             target,
+		TargetName:         target.Name(),
+		TargetPackage:      interfacePackage,
+		Name:               fakeName,
+		Mode:               InterfaceOrFunction,
+		DestinationPackage: destinationPackage,
+		Imports:            newImports(),
+		Header:             f.Header,
+	}
+	for _, opt := range opts {
+		opt(e)
+	}
+	e.addStyleImports()
+	t := e.Imports.Add(f.DestinationPackage, interfacePackage)
+	e.TargetAlias = t.Alias
+	err := e.loadMethods()
+	if err != nil {
+		return nil, err
+	}
+	err = e.validate()
+	if err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// addStyleImports adds the imports used by the template for the mode and style
+// of the fake, before any others so that they keep their aliases. The imports
+// of the counterfeiter style depend on the options and on the helpers that the
+// methods of the target need.
+func (f *Fake) addStyleImports() {
+	switch {
+	case f.Mode == Package:
+		f.Imports.Add("sync", "sync")
+		if f.WithDefault {
+			f.Imports.Add("testing", "testing")
+		}
+	case f.Mode == Extract:
+		// the extracted interface only needs the packages used by its methods
+	case f.Style == GomockStyle:
+		f.Imports.Add("gomock", gomockPackage)
+		f.Imports.Add("reflect", "reflect")
+	case f.Style == FuncsStyle:
+		f.Imports.Add("sync", "sync")
+	case f.Style == ReplayStyle:
+		f.Imports.Add("json", "encoding/json")
+		f.Imports.Add("fmt", "fmt")
+		f.Imports.Add("os", "os")
+		f.Imports.Add("reflect", "reflect")
+		f.Imports.Add("sync", "sync")
+	default:
+		var outParams, params, returnsForArgs bool
+		for _, sig := range f.targetSignatures() {
+			outParams = outParams || hasOutParams(sig)
+			params = params || sig.Params().Len() > 0
+			returnsForArgs = returnsForArgs || sig.Params().Len() > 0 && sig.Results().Len() > 0
+		}
+		f.Imports.Add("sync", "sync")
+		f.Imports.Add("context", "context")
+		f.Imports.Add("atomic", "sync/atomic")
+		if f.Constructor {
+			f.Imports.Add("sort", "sort")
+			f.Imports.Add("testing", "testing")
+		}
+		if outParams || returnsForArgs || f.Expectations && params {
+			f.Imports.Add("reflect", "reflect")
+		}
+		if outParams || f.Strict || f.Expectations {
+			f.Imports.Add("fmt", "fmt")
+		}
+		if f.Expectations {
+			f.Imports.Add("errors", "errors")
+			f.Imports.Add("strings", "strings")
+		}
+	}
+}
+
+// targetSignatures returns the signatures of the methods of an interface
+// target, or the signature of a function target.
+func (f *Fake) targetSignatures() []*types.Signature {
+	if f.IsFunction() {
+		return []*types.Signature{f.Target.Type().Underlying().(*types.Signature)}
+	}
+	if !f.IsInterface() {
+		return nil
+	}
+	var sigs []*types.Signature
+	for _, m := range interfaceMethodSet(f.Target.Type()) {
+		sigs = append(sigs, m.Signature)
+	}
+	return sigs
+}
+
+// validate reports options that cannot be used with the target of the fake.
+func (f *Fake) validate() error {
+	if f.Mode == Extract {
+		return f.validateExtract()
+	}
+	if f.Mode == Package && len(f.Methods) == 0 && (f.Include != nil || f.Exclude != nil) {
+		return fmt.Errorf("cannot generate an interface for %s because none of its functions match the filters", f.TargetPackage)
+	}
+	if f.Mode == Package && f.WithDefault {
+		names := []string{f.Name}
+		for i := range f.Wrapped {
+			names = append(names, f.Wrapped[i].Name)
+		}
+		for _, name := range names {
+			if name == f.DefaultName() || name == f.SwapForTestName() {
+				return fmt.Errorf("cannot add a %s to the shim because it declares a type named %s", f.DefaultName(), name)
+			}
+		}
+	}
+	if f.Style != CounterfeiterStyle && f.IsFunction() {
+		return fmt.Errorf("cannot generate a %s style fake for %s because it is a function", f.Style, f.TargetName)
+	}
+	if f.Delegate && f.Style == CounterfeiterStyle && f.Mode == InterfaceOrFunction {
+		if !isExported(f.TargetName) {
+			return fmt.Errorf("cannot generate a delegating fake for %s because it is not exported", f.TargetName)
+		}
+		if f.HasMethod("Delegate") {
+			return fmt.Errorf("cannot generate a delegating fake for %s because it has a method named Delegate", f.TargetName)
+		}
+	}
+	if f.Style == ReplayStyle && f.Mode == InterfaceOrFunction {
+		if !isExported(f.TargetName) {
+			return fmt.Errorf("cannot generate a replay style fake for %s because it is not exported", f.TargetName)
+		}
+		for _, name := range []string{"Save", "record", "replay", "unmarshal"} {
+			if f.HasMethod(name) {
+				return fmt.Errorf("cannot generate a replay style fake for %s because it has a method named %s", f.TargetName, name)
+			}
+		}
+		for _, m := range f.rawMethods {
+			results := m.Signature.Results()
+			for i := 0; i < results.Len(); i++ {
+				if results.At(i).Type() == types.Universe.Lookup("error").Type() {
+					continue
+				}
+				if name, ok := unreplayableTypeIn(results.At(i).Type(), map[types.Type]bool{}); ok {
+					return fmt.Errorf("cannot generate a replay style fake for %s because the results of its method %s cannot be replayed from JSON, as they contain %s", f.TargetName, m.Func.Name(), name)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// includesFunction indicates whether a package shim contains the function with
+// the given name.
+func (f *Fake) includesFunction(name string) bool {
+	if f.Include != nil && !f.Include.MatchString(name) {
+		return false
+	}
+	return f.Exclude == nil || !f.Exclude.MatchString(name)
+}
+
 // IsInterface indicates whether the fake is for an interface.
 func (f *Fake) IsInterface() bool {
 	if f.Target == nil || f.Target.Type() == nil {
@@ -104,6 +364,176 @@ func (f *Fake) IsFunction() bool {
 	return ok
 }
 
+// HasMethod indicates whether the fake has a method with the given name, which
+// means that a generated helper with the same name would collide with it.
+func (f *Fake) HasMethod(name string) bool {
+	for i := range f.Methods {
+		if f.Methods[i].Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// DefaultName is the name of the package level variable that holds the shim of
+// a package shim with a default. It is named after the shim, so that more than
+// one shim with a default can be generated in the same package.
+func (f *Fake) DefaultName() string {
+	return "Default" + f.Name
+}
+
+// SwapForTestName is the name of the function that swaps the default of a
+// package shim for a fake in a test.
+func (f *Fake) SwapForTestName() string {
+	return "Swap" + f.Name + "ForTest"
+}
+
+// fakeMethods returns the methods of an interface fake, or the function of a
+// function fake.
+func (f *Fake) fakeMethods() []Method {
+	if f.IsFunction() {
+		return []Method{f.Function}
+	}
+	return f.Methods
+}
+
+// HasOutParams indicates whether a method of the fake has a param that the fake
+// can assign a value through, so that the fake needs its setArgs helper.
+func (f *Fake) HasOutParams() bool {
+	for _, m := range f.fakeMethods() {
+		if m.Params.HasOutParams() {
+			return true
+		}
+	}
+	return false
+}
+
+// HasReturnsForArgs indicates whether a method of the fake has params and
+// returns values, so that it can be stubbed with XxxReturnsForArgs.
+func (f *Fake) HasReturnsForArgs() bool {
+	for _, m := range f.fakeMethods() {
+		if m.Params.HasLength() && m.Returns.HasLength() {
+			return true
+		}
+	}
+	return false
+}
+
+func (f *Fake) hasParams() bool {
+	for _, m := range f.fakeMethods() {
+		if m.Params.HasLength() {
+			return true
+		}
+	}
+	return false
+}
+
+// HasResetHelper indicates whether the fake has a method, a helper for one of
+// its methods, or a Reset helper for one of its methods, with the given name,
+// which means that a Reset helper for the whole fake with the same name would
+// collide with it. For example, both the Reset helper of a method named Calls
+// and the Calls helper of a method named Reset are named ResetCalls.
+func (f *Fake) HasResetHelper(name string) bool {
+	if f.hasMethodOrHelper(name) {
+		return true
+	}
+	for i := range f.Methods {
+		for _, helper := range resetHelpers(f.Methods[i].Name) {
+			if helper == name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// ResetHelper returns the name of the Reset helper of a method with the given
+// kind, which is "", "Calls" or "Stubs". A Reset helper that would collide with
+// a method, with a helper of a method, or with a Reset helper of another method
+// is left out: ResetHelper returns "" for it, or, for the kinds that the Reset
+// helpers of the whole fake use, an unexported name.
+func (f *Fake) ResetHelper(method string, kind string) string {
+	name := "Reset" + title.String(method) + kind
+	if !f.hasMethodOrHelper(name) && !f.hasOtherResetHelper(method, name) {
+		return name
+	}
+	if kind == "" {
+		return ""
+	}
+	return "reset" + title.String(method) + kind
+}
+
+// hasOtherResetHelper indicates whether a method other than the given one has a
+// Reset helper with the given name.
+func (f *Fake) hasOtherResetHelper(method string, name string) bool {
+	for i := range f.Methods {
+		if f.Methods[i].Name == method {
+			continue
+		}
+		for _, helper := range resetHelpers(f.Methods[i].Name) {
+			if helper == name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// hasMethodOrHelper indicates whether the fake has a method, or a helper other
+// than a Reset helper for one of its methods, with the given name.
+func (f *Fake) hasMethodOrHelper(name string) bool {
+	if f.HasMethod(name) {
+		return true
+	}
+	for i := range f.Methods {
+		for _, helper := range f.helpers(f.Methods[i]) {
+			if helper == name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// helpers returns the names of the exported helpers of a method, other than its
+// Reset helpers.
+func (f *Fake) helpers(m Method) []string {
+	name := title.String(m.Name)
+	result := []string{name + "CallCount", "WaitFor" + name + "Calls", name + "CallsChan", name + "Calls"}
+	if m.Params.HasOutParams() {
+		result = append(result, name+"SetsArg")
+	}
+	if m.Params.HasLength() {
+		result = append(result, name+"CallsWhen", name+"ArgsForCall", name+"CallHistory")
+	}
+	if m.Returns.HasLength() {
+		result = append(result, name+"Returns", name+"ReturnsOnCall")
+	}
+	if m.Params.HasLength() && m.Returns.HasLength() {
+		result = append(result, name+"ReturnsForArgs", name+"ReturnsWhen")
+	}
+	if f.Expectations {
+		result = append(result, "Expect"+name)
+		if m.Params.HasLength() {
+			result = append(result, "Expect"+name+"When")
+		}
+	}
+	return result
+}
+
+// resetHelpers returns the names of the Reset helpers of a method.
+func resetHelpers(method string) []string {
+	name := "Reset" + title.String(method)
+	return []string{name, name + "Calls", name + "Stubs"}
+}
+
+// TracksConfiguredReturns indicates whether the fake needs to know if return
+// values have been configured for a method, in order to decide what to do with
+// calls that have none.
+func (f *Fake) TracksConfiguredReturns() bool {
+	return f.Strict || f.Delegate
+}
+
 // IsConstraintInterface indicates whether the interface is a constraint interface
 // (contains type constraints like ~string) which cannot be implemented by concrete types.
 func (f *Fake) IsConstraintInterface() bool {
@@ -188,17 +618,28 @@ func isExported(s string) bool {
 // goimports on the output.
 func (f *Fake) Generate(runImports bool) ([]byte, error) {
 	var tmpl *template.Template
-	if f.IsInterface() {
-		log.Printf("Writing fake %s for interface %s to package %s\n", f.Name, f.TargetName, f.DestinationPackage)
-		tmpl = template.Must(template.New("fake").Funcs(interfaceFuncs).Parse(interfaceTemplate))
-	}
-	if f.IsFunction() {
-		log.Printf("Writing fake %s for function %s to package %s\n", f.Name, f.TargetName, f.DestinationPackage)
-		tmpl = template.Must(template.New("fake").Funcs(functionFuncs).Parse(functionTemplate))
-	}
-	if f.Mode == Package {
+	switch {
+	case f.Mode == Package:
 		log.Printf("Writing fake %s for package %s to package %s\n", f.Name, f.TargetPackage, f.DestinationPackage)
 		tmpl = template.Must(template.New("fake").Funcs(packageFuncs).Parse(packageTemplate))
+	case f.Mode == Extract:
+		log.Printf("Writing interface %s for type %s to package %s\n", f.Name, f.TargetName, f.DestinationPackage)
+		tmpl = template.Must(template.New("fake").Parse(extractTemplate))
+	case f.IsFunction():
+		log.Printf("Writing fake %s for function %s to package %s\n", f.Name, f.TargetName, f.DestinationPackage)
+		tmpl = template.Must(template.New("fake").Funcs(functionFuncs).Parse(functionTemplate))
+	case f.IsInterface() && f.Style == GomockStyle:
+		log.Printf("Writing gomock style fake %s for interface %s to package %s\n", f.Name, f.TargetName, f.DestinationPackage)
+		tmpl = template.Must(template.New("fake").Funcs(gomockFuncs).Parse(gomockTemplate))
+	case f.IsInterface() && f.Style == FuncsStyle:
+		log.Printf("Writing funcs style fake %s for interface %s to package %s\n", f.Name, f.TargetName, f.DestinationPackage)
+		tmpl = template.Must(template.New("fake").Funcs(funcsFuncs).Parse(funcsTemplate))
+	case f.IsInterface() && f.Style == ReplayStyle:
+		log.Printf("Writing replay style fake %s for interface %s to package %s\n", f.Name, f.TargetName, f.DestinationPackage)
+		tmpl = template.Must(template.New("fake").Funcs(replayFuncs).Parse(replayTemplate))
+	case f.IsInterface():
+		log.Printf("Writing fake %s for interface %s to package %s\n", f.Name, f.TargetName, f.DestinationPackage)
+		tmpl = template.Must(template.New("fake").Funcs(interfaceFuncs).Parse(interfaceTemplate))
 	}
 	if tmpl == nil {
 		return nil, errors.New("counterfeiter can only generate fakes for interfaces or specific functions")
@@ -0,0 +1,88 @@
+package generator
+
+import (
+	"strings"
+	"text/template"
+)
+
+var funcsFuncs = template.FuncMap{
+	"ToLower":                strings.ToLower,
+	"UnExport":               unexport,
+	"Replace":                strings.Replace,
+	"IsExported":             isExported,
+	"Title":                  title.String,
+	"HasConstraintInterface": hasConstraintInterface,
+}
+
+const funcsTemplate string = `{{.Header}}// Code generated by counterfeiter. DO NOT EDIT.
+package {{.DestinationPackage}}
+
+import (
+	{{- range $index, $import := .Imports.ByAlias}}
+	{{$import}}
+	{{- end}}
+)
+
+type {{.Name}}{{.GenericTypeParametersAndConstraints}} struct {
+	{{- range .Methods}}
+	{{Title .Name}}Func func({{.Params.AsNamedArgsWithTypes}}) {{.Returns.AsReturnSignature}}
+	{{- end}}
+	calls struct {
+		{{- range .Methods}}
+		{{.Name}} []{{$.Name}}{{Title .Name}}Call{{$.GenericTypeParameters}}
+		{{- end}}
+	}
+	lock sync.RWMutex
+}
+
+{{range .Methods -}}
+type {{$.Name}}{{Title .Name}}Call{{$.GenericTypeParametersAndConstraints}} struct{{if .Params.HasLength}} {
+	{{- range .Params}}
+	{{.FieldName}} {{if .IsVariadic}}{{Replace .Type "..." "[]" -1}}{{else}}{{.Type}}{{end}}
+	{{- end}}
+}{{else}}{}{{end}}
+
+func (fake *{{$.Name}}{{$.GenericTypeParameters}}) {{.Name}}({{.Params.AsNamedArgsWithTypes}}) {{.Returns.AsReturnSignature}} {
+	{{- range .Params}}
+	{{- if .IsSlice}}
+	var {{UnExport .Name}}Copy {{.Type}}
+	if {{UnExport .Name}} != nil {
+		{{UnExport .Name}}Copy = make({{.Type}}, len({{UnExport .Name}}))
+		copy({{UnExport .Name}}Copy, {{UnExport .Name}})
+	}
+	{{- end}}
+	{{- end}}
+	fake.lock.Lock()
+	fake.calls.{{.Name}} = append(fake.calls.{{.Name}}, {{$.Name}}{{Title .Name}}Call{{$.GenericTypeParameters}}{ {{- .Params.AsNamedArgs}}})
+	fn := fake.{{Title .Name}}Func
+	fake.lock.Unlock()
+	if fn == nil {
+		{{- if and $.Strict .Returns.HasLength}}
+		panic("{{$.Name}}.{{.Name}}: {{Title .Name}}Func is nil, but {{.Name}} was called")
+		{{- else if .Returns.HasLength}}
+		{{- range .Returns}}
+		var {{UnExport .Name}} {{.Type}}
+		{{- end}}
+		return {{.Returns.AsNamedArgs}}
+		{{- else}}
+		return
+		{{- end}}
+	}
+	{{if .Returns.HasLength}}return {{end}}fn({{.Params.AsNamedArgsForInvocation}})
+}
+
+func (fake *{{$.Name}}{{$.GenericTypeParameters}}) {{Title .Name}}Calls() []{{$.Name}}{{Title .Name}}Call{{$.GenericTypeParameters}} {
+	fake.lock.RLock()
+	defer fake.lock.RUnlock()
+	calls := make([]{{$.Name}}{{Title .Name}}Call{{$.GenericTypeParameters}}, len(fake.calls.{{.Name}}))
+	copy(calls, fake.calls.{{.Name}})
+	return calls
+}
+
+{{end -}}
+{{if IsExported .TargetName -}}
+{{if not (HasConstraintInterface .) -}}
+var _ {{.TargetAlias}}.{{.TargetName}}{{.GenericTypeConstraints}} = new({{.Name}}{{.GenericTypeConstraints}})
+{{- end}}
+{{- end}}
+`
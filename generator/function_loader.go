@@ -16,5 +16,6 @@ func (f *Fake) loadMethodForFunction() error {
 	}
 	f.addTypesForMethod(sig)
 	f.Function = methodForSignature(sig, f.TargetName, f.Imports)
+	f.addDeepCopiers(sig, f.Function.Params)
 	return nil
 }
@@ -10,6 +10,7 @@ var functionFuncs = template.FuncMap{
 	"UnExport":   unexport,
 	"Replace":    strings.Replace,
 	"IsExported": isExported,
+	"Title":      title.String,
 }
 
 const functionTemplate string = `{{.Header}}// Code generated by counterfeiter. DO NOT EDIT.
@@ -40,39 +41,250 @@ type {{.Name}} struct {
 		{{UnExport .Name}} {{.Type}}
 		{{- end}}
 	}
+	{{- if .Function.Params.HasLength}}
+	returnsForArgs []struct{
+		args []interface{}
+		{{- range .Function.Returns}}
+		{{UnExport .Name}} {{.Type}}
+		{{- end}}
+	}
+	{{- end}}
+	{{- if .TracksConfiguredReturns}}
+	returnsConfigured bool
+	{{- end}}
+	{{- end}}
+	{{- if .Function.Params.HasOutParams}}
+	setsArgs map[int]interface{}
+	{{- end}}
+	{{- if .Delegate}}
+	Delegate {{.TargetAlias}}.{{.TargetName}}
+	{{- end}}
+	{{- if .Strict}}
+	StrictHandler func(method string, call int, args []interface{})
+	{{- end}}
+	{{- if .DeepCopy}}
+	DeepCopyFallback func(v interface{}) interface{}
+	{{- end}}
+	{{- if .HasReturnsForArgs}}
+	ArgsComparer func(expected interface{}, actual interface{}) bool
+	{{- end}}
+	invocations        map[string][][]interface{}
+	orderedInvocations []struct{
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}
+	sequencer          *atomic.Uint64
+	invocationsChanged chan struct{}
+	callsSubscribers   []struct {
+		publish func(args []interface{})
+		cancel  context.CancelFunc
+		done    <-chan struct{}
+	}
+	invocationsMutex   sync.RWMutex
+	{{- if and .Strict .Constructor}}
+	strictViolations   []string
+	{{- end}}
+	{{- if .Expectations}}
+	expectations        []*{{.Name}}Expectation
+	expectationsInOrder bool
+	unexpectedCalls     [][]interface{}
+	expectationsMutex   sync.Mutex
+	{{- end}}
+}
+
+{{if .Constructor -}}
+// New{{.Name}} returns a fake that is verified when the test completes.
+func New{{.Name}}(t testing.TB) *{{.Name}} {
+	fake := &{{.Name}}{}
+	{{- if .Strict}}
+	fake.StrictHandler = fake.recordStrictViolation
+	{{- end}}
+	t.Cleanup(func() {
+		fake.verify(t)
+	})
+	return fake
+}
+
+{{end -}}
+{{if .Function.Params.HasLength -}}
+type {{.Name}}Call struct {
+	{{- range .Function.Params}}
+	{{.FieldName}} {{if .IsVariadic}}{{Replace .Type "..." "[]" -1}}{{else}}{{.Type}}{{end}}
+	{{- end}}
+}
+
+{{end -}}
+{{if .Expectations -}}
+type {{.Name}}Expectation struct {
+	fake        *{{.Name}}
+	description string
+	min         int
+	max         int
+	calls       int
+	{{- if .Function.Params.HasLength}}
+	args        []interface{}
+	matcher     func({{.Function.Params.AsArgs}}) bool
+	{{- end}}
+	{{- if .Function.Returns.HasLength}}
+	returns struct {
+		{{- range .Function.Returns}}
+		{{UnExport .Name}} {{.Type}}
+		{{- end}}
+	}
+	hasReturns bool
 	{{- end}}
-	invocations      map[string][][]interface{}
-	invocationsMutex sync.RWMutex
 }
 
+func (expectation *{{.Name}}Expectation) Times(n int) *{{.Name}}Expectation {
+	expectation.fake.setExpectedCalls(expectation, n, n)
+	return expectation
+}
+
+func (expectation *{{.Name}}Expectation) MinTimes(n int) *{{.Name}}Expectation {
+	expectation.fake.setExpectedCalls(expectation, n, -1)
+	return expectation
+}
+
+func (expectation *{{.Name}}Expectation) MaxTimes(n int) *{{.Name}}Expectation {
+	expectation.fake.setExpectedCalls(expectation, 0, n)
+	return expectation
+}
+
+func (expectation *{{.Name}}Expectation) TimesBetween(min int, max int) *{{.Name}}Expectation {
+	expectation.fake.setExpectedCalls(expectation, min, max)
+	return expectation
+}
+
+func (expectation *{{.Name}}Expectation) AnyTimes() *{{.Name}}Expectation {
+	expectation.fake.setExpectedCalls(expectation, 0, -1)
+	return expectation
+}
+{{- if .Function.Returns.HasLength}}
+
+func (expectation *{{.Name}}Expectation) Return({{.Function.Returns.AsNamedArgsWithTypes}}) *{{.Name}}Expectation {
+	expectation.fake.expectationsMutex.Lock()
+	defer expectation.fake.expectationsMutex.Unlock()
+	expectation.returns = struct {
+		{{- range .Function.Returns}}
+		{{UnExport .Name}} {{.Type}}
+		{{- end}}
+	}{ {{- .Function.Returns.AsNamedArgs -}} }
+	expectation.hasReturns = true
+	return expectation
+}
+{{- end}}
+
+func (expectation *{{.Name}}Expectation) expectedCalls() string {
+	calls := "calls"
+	if expectation.min == 1 && (expectation.max == 1 || expectation.max < 0) {
+		calls = "call"
+	}
+	switch {
+	case expectation.max < 0:
+		return fmt.Sprintf("at least %d %s", expectation.min, calls)
+	case expectation.min == expectation.max:
+		return fmt.Sprintf("%d %s", expectation.min, calls)
+	default:
+		return fmt.Sprintf("between %d and %d %s", expectation.min, expectation.max, calls)
+	}
+}
+
+{{end -}}
 func (fake *{{.Name}}) Spy({{.Function.Params.AsNamedArgsWithTypes}}) {{.Function.Returns.AsReturnSignature}} {
-	{{- range .Function.Params.Slices}}
+	{{- range .Function.Params}}
+	{{- if .DeepCopier}}
+	{{UnExport .Name}}Copy := fake.{{.DeepCopier}}({{UnExport .Name}}, map[interface{}]interface{}{})
+	{{- else if .IsSlice}}
 	var {{UnExport .Name}}Copy {{.Type}}
 	if {{UnExport .Name}} != nil {
 		{{UnExport .Name}}Copy = make({{.Type}}, len({{UnExport .Name}}))
 		copy({{UnExport .Name}}Copy, {{UnExport .Name}})
 	}
 	{{- end}}
+	{{- end}}
 	fake.mutex.Lock()
-	{{if .Function.Returns.HasLength}}ret, specificReturn := fake.returnsOnCall[len(fake.argsForCall)]
+	{{if .Function.Returns.HasLength}}{{if .Strict}}call := len(fake.argsForCall)
+	{{end}}ret, specificReturn := fake.returnsOnCall[len(fake.argsForCall)]
 	{{end}}fake.argsForCall = append(fake.argsForCall, struct{
 		{{- range .Function.Params}}
 		{{.Name}} {{if .IsVariadic}}{{Replace .Type "..." "[]" -1}}{{else}}{{.Type}}{{end}}
 		{{- end}}
 	}{ {{- .Function.Params.AsNamedArgs -}} })
 	stub := fake.Stub
+	{{- if .Delegate}}
+	delegate := fake.Delegate
+	{{- end}}
+	{{- if .Function.Params.HasOutParams}}
+	setsArgs := fake.setsArgs
+	{{- end}}
 	{{- if .Function.Returns.HasLength}}
+	{{- if .Function.Params.HasLength}}
+	returnsForArgs := fake.returnsForArgs
+	{{- end}}
 	returns := fake.returns
+	{{- if .TracksConfiguredReturns}}
+	returnsConfigured := fake.returnsConfigured
+	{{- end}}
 	{{- end}}
 	fake.recordInvocation("{{.TargetName}}", []interface{}{ {{- if .Function.Params.HasLength}}{{.Function.Params.AsNamedArgs}}{{end -}} })
 	fake.mutex.Unlock()
+	{{- if .Function.Params.HasOutParams}}
+	if len(setsArgs) > 0 {
+		args := []interface{}{ {{- range .Function.Params}}{{if not .IsVariadic}}{{UnExport .Name}}, {{end}}{{end -}} }
+		{{- range .Function.Params}}
+		{{- if .IsVariadic}}
+		for _, arg := range {{UnExport .Name}} {
+			args = append(args, arg)
+		}
+		{{- end}}
+		{{- end}}
+		fake.setArgs("{{.TargetName}}", setsArgs, args)
+	}
+	{{- end}}
+	{{- if .Expectations}}
+	{{- if .Function.Returns.HasLength}}
+	if expectation, ok := fake.matchExpectation({{.Function.Params.AsNamedArgsForInvocation}}); ok && expectation.hasReturns {
+		return {{.Function.Returns.WithPrefix "expectation.returns."}}
+	}
+	{{- else}}
+	fake.matchExpectation({{.Function.Params.AsNamedArgsForInvocation}})
+	{{- end}}
+	{{- end}}
+	{{- if and .Function.Returns.HasLength .Function.Params.HasLength}}
+	for i := len(returnsForArgs) - 1; i >= 0; i-- {
+		if fake.argsMatch(returnsForArgs[i].args, []interface{}{ {{- .Function.Params.WithPrefix ""}}}) {
+			return {{.Function.Returns.WithPrefix "returnsForArgs[i]."}}
+		}
+	}
+	{{- end}}
 	if stub != nil {
 		{{if .Function.Returns.HasLength}}return stub({{.Function.Params.AsNamedArgsForInvocation}}){{else}}fake.Stub({{.Function.Params.AsNamedArgsForInvocation}}){{end}}
+		{{- if and .Delegate (not .Function.Returns.HasLength)}}
+		return
+		{{- end}}
 	}
+	{{- if and .Delegate (not .Function.Returns.HasLength)}}
+	if delegate != nil {
+		delegate({{.Function.Params.AsNamedArgsForInvocation}})
+	}
+	{{- end}}
 	{{- if .Function.Returns.HasLength}}
 	if specificReturn {
 		return {{.Function.Returns.WithPrefix "ret."}}
 	}
+	{{- if .TracksConfiguredReturns}}
+	if !returnsConfigured {
+		{{- if .Delegate}}
+		if delegate != nil {
+			return delegate({{.Function.Params.AsNamedArgsForInvocation}})
+		}
+		{{- end}}
+		{{- if .Strict}}
+		fake.unconfiguredCall("{{.TargetName}}", call, []interface{}{ {{- if .Function.Params.HasLength}}{{.Function.Params.AsNamedArgs}}{{end -}} })
+		{{- end}}
+	}
+	{{- end}}
 	return {{.Function.Returns.WithPrefix "returns."}}
 	{{- end}}
 }
@@ -83,6 +295,70 @@ func (fake *{{.Name}}) CallCount() int {
 	return len(fake.argsForCall)
 }
 
+func (fake *{{.Name}}) WaitForCalls(ctx context.Context, n int) error {
+	for {
+		fake.invocationsMutex.Lock()
+		if fake.invocationsChanged == nil {
+			fake.invocationsChanged = make(chan struct{})
+		}
+		changed := fake.invocationsChanged
+		fake.invocationsMutex.Unlock()
+		if fake.CallCount() >= n {
+			return nil
+		}
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (fake *{{.Name}}) CallsChan(ctx context.Context) <-chan []interface{} {
+	ctx, cancel := context.WithCancel(ctx)
+	calls := make(chan []interface{})
+	ready := make(chan struct{}, 1)
+	var pendingMutex sync.Mutex
+	var pending [][]interface{}
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	fake.callsSubscribers = append(fake.callsSubscribers, struct {
+		publish func(args []interface{})
+		cancel  context.CancelFunc
+		done    <-chan struct{}
+	}{func(args []interface{}) {
+		pendingMutex.Lock()
+		pending = append(pending, args)
+		pendingMutex.Unlock()
+		select {
+		case ready <- struct{}{}:
+		default:
+		}
+	}, cancel, ctx.Done()})
+	go func() {
+		defer close(calls)
+		for {
+			select {
+			case <-ready:
+			case <-ctx.Done():
+				return
+			}
+			pendingMutex.Lock()
+			queued := pending
+			pending = nil
+			pendingMutex.Unlock()
+			for _, args := range queued {
+				select {
+				case calls <- args:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return calls
+}
+
 func (fake *{{.Name}}) Calls(stub func({{.Function.Params.AsArgs}}) {{.Function.Returns.AsReturnSignature}}) {
 	fake.mutex.Lock()
 	defer fake.mutex.Unlock()
@@ -95,8 +371,32 @@ func (fake *{{.Name}}) ArgsForCall(i int) {{.Function.Params.AsReturnSignature}}
 	defer fake.mutex.RUnlock()
 	return {{.Function.Params.WithPrefix "fake.argsForCall[i]."}}
 }
+
+func (fake *{{.Name}}) CallHistory() []{{.Name}}Call {
+	fake.mutex.RLock()
+	defer fake.mutex.RUnlock()
+	history := make([]{{.Name}}Call, len(fake.argsForCall))
+	for i, argsForCall := range fake.argsForCall {
+		history[i] = {{.Name}}Call{ {{- .Function.Params.WithPrefix "argsForCall."}}}
+	}
+	return history
+}
 {{- end}}
 
+{{if .Function.Params.HasOutParams -}}
+func (fake *{{.Name}}) SetsArg(i int, value interface{}) {
+	fake.mutex.Lock()
+	defer fake.mutex.Unlock()
+	setsArgs := map[int]interface{}{i: value}
+	for j, v := range fake.setsArgs {
+		if j != i {
+			setsArgs[j] = v
+		}
+	}
+	fake.setsArgs = setsArgs
+}
+
+{{end -}}
 {{if .Function.Returns.HasLength -}}
 func (fake *{{.Name}}) Returns({{.Function.Returns.AsNamedArgsWithTypes}}) {
 	fake.mutex.Lock()
@@ -107,6 +407,9 @@ func (fake *{{.Name}}) Returns({{.Function.Returns.AsNamedArgsWithTypes}}) {
 		{{UnExport .Name}} {{.Type}}
 		{{- end}}
 	}{ {{- .Function.Returns.AsNamedArgs -}} }
+	{{- if .TracksConfiguredReturns}}
+	fake.returnsConfigured = true
+	{{- end}}
 }
 
 func (fake *{{.Name}}) ReturnsOnCall(i int, {{.Function.Returns.AsNamedArgsWithTypes}}) {
@@ -126,6 +429,187 @@ func (fake *{{.Name}}) ReturnsOnCall(i int, {{.Function.Returns.AsNamedArgsWithT
 		{{- end}}
 	}{ {{- .Function.Returns.AsNamedArgs -}} }
 }
+{{- if .Function.Params.HasLength}}
+
+// ReturnsForArgs returns the values for the calls with the given arguments.
+// The arguments are checked before the stub, and the arguments registered last
+// win.
+func (fake *{{.Name}}) ReturnsForArgs({{.Function.Params.AsNamedArgsWithTypes}}) func({{.Function.Returns.AsArgs}}) {
+	args := []interface{}{ {{- .Function.Params.WithPrefix ""}}}
+	return func({{.Function.Returns.AsNamedArgsWithTypes}}) {
+		fake.mutex.Lock()
+		defer fake.mutex.Unlock()
+		fake.Stub = nil
+		fake.returnsForArgs = append(fake.returnsForArgs, struct {
+			args []interface{}
+			{{- range .Function.Returns}}
+			{{UnExport .Name}} {{.Type}}
+			{{- end}}
+		}{args, {{.Function.Returns.AsNamedArgs}}})
+	}
+}
+{{- end}}
+{{- end}}
+
+func (fake *{{.Name}}) Reset() {
+	fake.ResetCalls()
+	fake.ResetStubs()
+}
+
+func (fake *{{.Name}}) ResetCalls() {
+	fake.mutex.Lock()
+	fake.argsForCall = nil
+	fake.mutex.Unlock()
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	fake.invocations = nil
+	fake.orderedInvocations = nil
+	for _, subscriber := range fake.callsSubscribers {
+		subscriber.cancel()
+	}
+	fake.callsSubscribers = nil
+}
+
+func (fake *{{.Name}}) ResetStubs() {
+	fake.mutex.Lock()
+	defer fake.mutex.Unlock()
+	fake.Stub = nil
+	{{- if .Function.Params.HasOutParams}}
+	fake.setsArgs = nil
+	{{- end}}
+	{{- if .Function.Returns.HasLength}}
+	fake.returns = struct {
+		{{- range .Function.Returns}}
+		{{UnExport .Name}} {{.Type}}
+		{{- end}}
+	}{}
+	fake.returnsOnCall = nil
+	{{- if .Function.Params.HasLength}}
+	fake.returnsForArgs = nil
+	{{- end}}
+	{{- if .TracksConfiguredReturns}}
+	fake.returnsConfigured = false
+	{{- end}}
+	{{- end}}
+	{{- if .Expectations}}
+	fake.ResetExpectations()
+	{{- end}}
+}
+
+{{- if .Expectations}}
+
+func (fake *{{.Name}}) Expect({{.Function.Params.AsNamedArgsWithTypes}}) *{{.Name}}Expectation {
+	{{- if .Function.Params.HasLength}}
+	args := []interface{}{ {{- .Function.Params.WithPrefix ""}}}
+	return fake.expect(args, fmt.Sprintf("{{.TargetName}} with arguments %v", args), nil)
+	{{- else}}
+	return fake.expect("{{.TargetName}}")
+	{{- end}}
+}
+
+{{if .Function.Params.HasLength -}}
+func (fake *{{.Name}}) ExpectWhen(matcher func({{.Function.Params.AsArgs}}) bool) *{{.Name}}Expectation {
+	return fake.expect(nil, "{{.TargetName}} with matching arguments", matcher)
+}
+
+{{end -}}
+func (fake *{{.Name}}) expect({{if .Function.Params.HasLength}}args []interface{}, description string, matcher func({{.Function.Params.AsArgs}}) bool{{else}}description string{{end}}) *{{.Name}}Expectation {
+	fake.expectationsMutex.Lock()
+	defer fake.expectationsMutex.Unlock()
+	expectation := &{{.Name}}Expectation{
+		fake:        fake,
+		description: description,
+		min:         1,
+		max:         1,
+		{{- if .Function.Params.HasLength}}
+		args:        args,
+		matcher:     matcher,
+		{{- end}}
+	}
+	fake.expectations = append(fake.expectations, expectation)
+	return expectation
+}
+
+func (fake *{{.Name}}) matchExpectation({{.Function.Params.AsNamedArgsWithTypes}}) ({{.Name}}Expectation, bool) {
+	fake.expectationsMutex.Lock()
+	defer fake.expectationsMutex.Unlock()
+	{{- if .Function.Params.HasLength}}
+	args := []interface{}{ {{- .Function.Params.WithPrefix ""}}}
+	{{- end}}
+	for _, expectation := range fake.expectations {
+		if !fake.expectationCanMatch(expectation) {
+			continue
+		}
+		{{- if .Function.Params.HasLength}}
+		if expectation.matcher != nil && !expectation.matcher({{.Function.Params.AsNamedArgsForInvocation}}) || expectation.matcher == nil && !reflect.DeepEqual(expectation.args, args) {
+			continue
+		}
+		{{- end}}
+		expectation.calls++
+		return *expectation, true
+	}
+	if len(fake.expectations) > 0 {
+		fake.unexpectedCalls = append(fake.unexpectedCalls, {{if .Function.Params.HasLength}}args{{else}}nil{{end}})
+	}
+	return {{.Name}}Expectation{}, false
+}
+
+func (fake *{{.Name}}) OrderExpectations() {
+	fake.expectationsMutex.Lock()
+	defer fake.expectationsMutex.Unlock()
+	fake.expectationsInOrder = true
+}
+
+func (fake *{{.Name}}) VerifyExpectations() error {
+	fake.expectationsMutex.Lock()
+	defer fake.expectationsMutex.Unlock()
+	var problems []string
+	for _, expectation := range fake.expectations {
+		if expectation.calls < expectation.min {
+			problems = append(problems, fmt.Sprintf("{{.Name}}.%s: expected %s, but got %d", expectation.description, expectation.expectedCalls(), expectation.calls))
+		}
+	}
+	for _, args := range fake.unexpectedCalls {
+		problems = append(problems, fmt.Sprintf("{{.Name}}.{{.TargetName}}: unexpected call with arguments %v", args))
+	}
+	if len(problems) == 0 {
+		return nil
+	}
+	return errors.New(strings.Join(problems, "\n"))
+}
+
+func (fake *{{.Name}}) ResetExpectations() {
+	fake.expectationsMutex.Lock()
+	defer fake.expectationsMutex.Unlock()
+	fake.expectations = nil
+	fake.expectationsInOrder = false
+	fake.unexpectedCalls = nil
+}
+
+func (fake *{{.Name}}) setExpectedCalls(expectation *{{.Name}}Expectation, min int, max int) {
+	fake.expectationsMutex.Lock()
+	defer fake.expectationsMutex.Unlock()
+	expectation.min = min
+	expectation.max = max
+}
+
+func (fake *{{.Name}}) expectationCanMatch(expectation *{{.Name}}Expectation) bool {
+	if expectation.max >= 0 && expectation.calls >= expectation.max {
+		return false
+	}
+	if !fake.expectationsInOrder {
+		return true
+	}
+	for _, previous := range fake.expectations {
+		if previous == expectation {
+			break
+		}
+		if previous.calls < previous.min {
+			return false
+		}
+	}
+	return true
+}
 {{- end}}
 
 func (fake *{{.Name}}) Invocations() map[string][][]interface{} {
@@ -140,6 +624,118 @@ func (fake *{{.Name}}) Invocations() map[string][][]interface{} {
 	return copiedInvocations
 }
 
+func (fake *{{.Name}}) OrderedInvocations() []struct {
+	Seq    uint64
+	Method string
+	Args   []interface{}
+} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	return append([]struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}{}, fake.orderedInvocations...)
+}
+
+func (fake *{{.Name}}) SetSequencer(sequencer *atomic.Uint64) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	fake.sequencer = sequencer
+}
+
+{{if .HasOutParams -}}
+func (fake *{{.Name}}) setArgs(method string, values map[int]interface{}, args []interface{}) {
+	for i, value := range values {
+		if i < 0 || i >= len(args) {
+			panic(fmt.Sprintf("{{.Name}}.%s: cannot set argument %d, because it was called with %d arguments", method, i, len(args)))
+		}
+		target := reflect.ValueOf(args[i])
+		if target.Kind() != reflect.Ptr || target.IsNil() {
+			panic(fmt.Sprintf("{{.Name}}.%s: cannot set argument %d, because it is %T instead of a non-nil pointer", method, i, args[i]))
+		}
+		v := reflect.ValueOf(value)
+		if !v.IsValid() {
+			v = reflect.Zero(target.Elem().Type())
+		}
+		if !v.Type().AssignableTo(target.Elem().Type()) {
+			panic(fmt.Sprintf("{{.Name}}.%s: cannot set argument %d, because %T is not assignable to %s", method, i, value, target.Elem().Type()))
+		}
+		target.Elem().Set(v)
+	}
+}
+
+{{end -}}
+{{if .HasReturnsForArgs -}}
+func (fake *{{.Name}}) argsMatch(expected []interface{}, actual []interface{}) bool {
+	if len(expected) != len(actual) {
+		return false
+	}
+	for i := range expected {
+		if fake.ArgsComparer != nil && !fake.ArgsComparer(expected[i], actual[i]) || fake.ArgsComparer == nil && !reflect.DeepEqual(expected[i], actual[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+{{end -}}
+{{if .Strict -}}
+func (fake *{{.Name}}) unconfiguredCall(method string, call int, args []interface{}) {
+	if fake.StrictHandler == nil {
+		panic(fmt.Sprintf("{{.Name}}.%s: call %d with arguments %v has no stub or return values configured", method, call, args))
+	}
+	fake.StrictHandler(method, call, args)
+}
+
+{{if .Constructor -}}
+func (fake *{{.Name}}) recordStrictViolation(method string, call int, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	fake.strictViolations = append(fake.strictViolations, fmt.Sprintf("{{.Name}}.%s: call %d with arguments %v has no stub or return values configured", method, call, args))
+}
+
+{{end -}}
+{{end -}}
+{{if .Constructor -}}
+func (fake *{{.Name}}) verify(t testing.TB) {
+	t.Helper()
+	{{- if .Function.Returns.HasLength}}
+	fake.mutex.RLock()
+	var unusedReturns []int
+	for call := range fake.returnsOnCall {
+		if call >= len(fake.argsForCall) {
+			unusedReturns = append(unusedReturns, call)
+		}
+	}
+	fake.mutex.RUnlock()
+	fake.reportUnusedReturns(t, unusedReturns)
+	{{- end}}
+	{{- if .Strict}}
+	fake.invocationsMutex.RLock()
+	strictViolations := fake.strictViolations
+	fake.invocationsMutex.RUnlock()
+	for _, violation := range strictViolations {
+		t.Error(violation)
+	}
+	{{- end}}
+	{{- if .Expectations}}
+	if err := fake.VerifyExpectations(); err != nil {
+		t.Error(err)
+	}
+	{{- end}}
+}
+
+func (fake *{{.Name}}) reportUnusedReturns(t testing.TB, calls []int) {
+	t.Helper()
+	if len(calls) == 0 {
+		return
+	}
+	sort.Ints(calls)
+	t.Errorf("{{.Name}}.{{.TargetName}}: return values were configured for calls %v, which were never made", calls)
+}
+
+{{end -}}
 func (fake *{{.Name}}) recordInvocation(key string, args []interface{}) {
 	fake.invocationsMutex.Lock()
 	defer fake.invocationsMutex.Unlock()
@@ -150,8 +746,36 @@ func (fake *{{.Name}}) recordInvocation(key string, args []interface{}) {
 		fake.invocations[key] = [][]interface{}{}
 	}
 	fake.invocations[key] = append(fake.invocations[key], args)
+	if fake.sequencer == nil {
+		fake.sequencer = new(atomic.Uint64)
+	}
+	fake.orderedInvocations = append(fake.orderedInvocations, struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}{fake.sequencer.Add(1), key, args})
+	if fake.invocationsChanged != nil {
+		close(fake.invocationsChanged)
+		fake.invocationsChanged = nil
+	}
+	subscribers := fake.callsSubscribers[:0]
+	for _, subscriber := range fake.callsSubscribers {
+		select {
+		case <-subscriber.done:
+			continue
+		default:
+		}
+		subscriber.publish(args)
+		subscribers = append(subscribers, subscriber)
+	}
+	fake.callsSubscribers = subscribers
 }
 
+{{range .DeepCopiers -}}
+func (fake *{{$.Name}}) {{.Name}}(v {{.Type}}, copies map[interface{}]interface{}) {{.Type}} {
+{{.Body}}}
+
+{{end -}}
 {{if IsExported .TargetName -}}
 var _ {{.TargetAlias}}.{{.TargetName}} = new({{.Name}}).Spy
 {{- end}}
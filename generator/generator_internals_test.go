@@ -1,9 +1,13 @@
 package generator
 
 import (
+	"go/token"
+	"go/types"
 	"io"
 	"log"
+	"regexp"
 	"runtime"
+	"strings"
 	"testing"
 
 	. "github.com/onsi/gomega"
@@ -63,29 +67,37 @@ func testGenerator(t *testing.T, when spec.G, it spec.S) {
 				case "go1.15", "go1.14":
 					Expect(f.Imports).To(BeEquivalentTo(Imports{
 						ByAlias: map[string]Import{
-							"os":   {Alias: "os", PkgPath: "os"},
-							"sync": {Alias: "sync", PkgPath: "sync"},
-							"time": {Alias: "time", PkgPath: "time"},
+							"atomic":  {Alias: "atomic", PkgPath: "sync/atomic"},
+							"context": {Alias: "context", PkgPath: "context"},
+							"os":      {Alias: "os", PkgPath: "os"},
+							"sync":    {Alias: "sync", PkgPath: "sync"},
+							"time":    {Alias: "time", PkgPath: "time"},
 						},
 						ByPkgPath: map[string]Import{
-							"os":   {Alias: "os", PkgPath: "os"},
-							"sync": {Alias: "sync", PkgPath: "sync"},
-							"time": {Alias: "time", PkgPath: "time"},
+							"context":     {Alias: "context", PkgPath: "context"},
+							"os":          {Alias: "os", PkgPath: "os"},
+							"sync":        {Alias: "sync", PkgPath: "sync"},
+							"sync/atomic": {Alias: "atomic", PkgPath: "sync/atomic"},
+							"time":        {Alias: "time", PkgPath: "time"},
 						},
 					}))
 				default:
 					Expect(f.Imports).To(BeEquivalentTo(Imports{
 						ByAlias: map[string]Import{
-							"os":   {Alias: "os", PkgPath: "os"},
-							"sync": {Alias: "sync", PkgPath: "sync"},
-							"time": {Alias: "time", PkgPath: "time"},
-							"fs":   {Alias: "fs", PkgPath: "io/fs"},
+							"atomic":  {Alias: "atomic", PkgPath: "sync/atomic"},
+							"context": {Alias: "context", PkgPath: "context"},
+							"os":      {Alias: "os", PkgPath: "os"},
+							"sync":    {Alias: "sync", PkgPath: "sync"},
+							"time":    {Alias: "time", PkgPath: "time"},
+							"fs":      {Alias: "fs", PkgPath: "io/fs"},
 						},
 						ByPkgPath: map[string]Import{
-							"os":    {Alias: "os", PkgPath: "os"},
-							"sync":  {Alias: "sync", PkgPath: "sync"},
-							"time":  {Alias: "time", PkgPath: "time"},
-							"io/fs": {Alias: "fs", PkgPath: "io/fs"},
+							"context":     {Alias: "context", PkgPath: "context"},
+							"os":          {Alias: "os", PkgPath: "os"},
+							"sync":        {Alias: "sync", PkgPath: "sync"},
+							"sync/atomic": {Alias: "atomic", PkgPath: "sync/atomic"},
+							"time":        {Alias: "time", PkgPath: "time"},
+							"io/fs":       {Alias: "fs", PkgPath: "io/fs"},
 						},
 					}))
 				}
@@ -111,12 +123,20 @@ func testGenerator(t *testing.T, when spec.G, it spec.S) {
 				Expect(f.DestinationPackage).To(Equal("httpfakes"))
 				Expect(f.Imports).To(BeEquivalentTo(Imports{
 					ByAlias: map[string]Import{
-						"http": {Alias: "http", PkgPath: "net/http"},
-						"sync": {Alias: "sync", PkgPath: "sync"},
+						"atomic":  {Alias: "atomic", PkgPath: "sync/atomic"},
+						"context": {Alias: "context", PkgPath: "context"},
+						"fmt":     {Alias: "fmt", PkgPath: "fmt"},
+						"http":    {Alias: "http", PkgPath: "net/http"},
+						"reflect": {Alias: "reflect", PkgPath: "reflect"},
+						"sync":    {Alias: "sync", PkgPath: "sync"},
 					},
 					ByPkgPath: map[string]Import{
-						"net/http": {Alias: "http", PkgPath: "net/http"},
-						"sync":     {Alias: "sync", PkgPath: "sync"},
+						"context":     {Alias: "context", PkgPath: "context"},
+						"fmt":         {Alias: "fmt", PkgPath: "fmt"},
+						"net/http":    {Alias: "http", PkgPath: "net/http"},
+						"reflect":     {Alias: "reflect", PkgPath: "reflect"},
+						"sync":        {Alias: "sync", PkgPath: "sync"},
+						"sync/atomic": {Alias: "atomic", PkgPath: "sync/atomic"},
 					},
 				}))
 				Expect(f.Function).NotTo(BeZero())
@@ -130,6 +150,864 @@ func testGenerator(t *testing.T, when spec.G, it spec.S) {
 		})
 	})
 
+	when("generating a fake for an interface with a method named Reset", func() {
+		it.Before(func() {
+			c := &Cache{}
+			f, err = NewFake(InterfaceOrFunction, "Hash", "hash", "FakeHash", "hashfakes", "", "", c)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		it("does not generate Reset helpers that collide with the method or its helpers", func() {
+			Expect(f.HasMethod("Reset")).To(BeTrue())
+			Expect(f.HasResetHelper("ResetCalls")).To(BeTrue())
+
+			b, err := f.Generate(true)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(strings.Count(string(b), "func (fake *FakeHash) Reset()")).To(Equal(1))
+			Expect(strings.Count(string(b), "func (fake *FakeHash) ResetCalls(")).To(Equal(1))
+			Expect(string(b)).To(ContainSubstring("func (fake *FakeHash) ResetCalls(stub func()) {"))
+			Expect(string(b)).To(ContainSubstring("func (fake *FakeHash) ResetReset()"))
+			Expect(string(b)).To(ContainSubstring("func (fake *FakeHash) ResetStubs()"))
+		})
+	})
+
+	when("generating a fake for an interface with methods named Calls and Stubs", func() {
+		it.Before(func() {
+			c := &Cache{}
+			f, err = NewFake(InterfaceOrFunction, "RecordsCalls", "github.com/maxbrunsfeld/counterfeiter/v6/fixtures", "FakeRecordsCalls", "fixturesfakes", "", "", c)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		it("keeps the Reset helpers of the methods and resets each method", func() {
+			Expect(f.HasResetHelper("ResetCalls")).To(BeTrue())
+			Expect(f.HasResetHelper("ResetStubs")).To(BeTrue())
+			Expect(f.HasResetHelper("Reset")).To(BeFalse())
+
+			b, err := f.Generate(true)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(strings.Count(string(b), "func (fake *FakeRecordsCalls) ResetCalls()")).To(Equal(1))
+			Expect(strings.Count(string(b), "func (fake *FakeRecordsCalls) ResetStubs()")).To(Equal(1))
+			Expect(string(b)).To(ContainSubstring("func (fake *FakeRecordsCalls) Reset() {\n\tfake.ResetCallsCalls()\n\tfake.ResetCallsStubs()\n\tfake.ResetStubsCalls()\n\tfake.ResetStubsStubs()\n}"))
+		})
+	})
+
+	when("generating a fake for an interface whose Reset helpers collide with its methods", func() {
+		it("leaves out the colliding Reset helpers", func() {
+			c := &Cache{}
+			f, err = NewFake(InterfaceOrFunction, "CollidingResetHelpers", "github.com/maxbrunsfeld/counterfeiter/v6/fixtures", "FakeCollidingResetHelpers", "fixturesfakes", "", "", c)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(f.ResetHelper("Calls", "")).To(BeEmpty())
+			Expect(f.ResetHelper("Calls", "Calls")).To(Equal("resetCallsCalls"))
+			Expect(f.ResetHelper("Calls", "Stubs")).To(Equal("ResetCallsStubs"))
+
+			b, err := f.Generate(true)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(strings.Count(string(b), "func (fake *FakeCollidingResetHelpers) ResetCalls()")).To(Equal(1))
+			Expect(string(b)).To(ContainSubstring("func (fake *FakeCollidingResetHelpers) Reset() {\n\tfake.resetCallsCalls()"))
+		})
+	})
+
+	when("generating a fake whose methods cannot be stubbed for specific arguments", func() {
+		it("leaves out the ArgsComparer", func() {
+			c := &Cache{}
+			f, err = NewFake(InterfaceOrFunction, "Closer", "io", "FakeCloser", "iofakes", "", "", c)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(f.HasReturnsForArgs()).To(BeFalse())
+
+			b, err := f.Generate(true)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(b)).NotTo(ContainSubstring("ArgsComparer"))
+			Expect(string(b)).NotTo(ContainSubstring("argsMatch"))
+		})
+	})
+
+	when("generating a strict package shim", func() {
+		it("passes the flag on to the generated directive", func() {
+			c := &Cache{}
+			f, err = NewFake(Package, "", "os", "Os", "osshim", "", "", c, Strict())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(f.Strict).To(BeTrue())
+
+			b, err := f.Generate(false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(b)).To(ContainSubstring("//counterfeiter:generate -strict . Os\n"))
+		})
+	})
+
+	when("generating a filtered package shim", func() {
+		it("only contains the included functions", func() {
+			c := &Cache{}
+			include, err := ParseNamePattern("Open,Stat,ReadFile")
+			Expect(err).NotTo(HaveOccurred())
+			f, err = NewFake(Package, "", "os", "FileSystem", "osshim", "", "", c, Include(include))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(f.Methods).To(HaveLen(3))
+			Expect(f.HasMethod("Open")).To(BeTrue())
+			Expect(f.HasMethod("Stat")).To(BeTrue())
+			Expect(f.HasMethod("ReadFile")).To(BeTrue())
+			Expect(f.HasMethod("OpenFile")).To(BeFalse())
+		})
+
+		it("leaves out the excluded functions", func() {
+			c := &Cache{}
+			include, err := ParseNamePattern("Read.*")
+			Expect(err).NotTo(HaveOccurred())
+			exclude, err := ParseNamePattern("Readlink")
+			Expect(err).NotTo(HaveOccurred())
+			f, err = NewFake(Package, "", "os", "FileSystem", "osshim", "", "", c, Include(include), Exclude(exclude))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(f.HasMethod("ReadFile")).To(BeTrue())
+			Expect(f.HasMethod("ReadDir")).To(BeTrue())
+			Expect(f.HasMethod("Readlink")).To(BeFalse())
+		})
+
+		it("errors when no functions match", func() {
+			c := &Cache{}
+			include, err := ParseNamePattern("Opne")
+			Expect(err).NotTo(HaveOccurred())
+			f, err = NewFake(Package, "", "os", "FileSystem", "osshim", "", "", c, Include(include))
+			Expect(err).To(MatchError("cannot generate an interface for os because none of its functions match the filters"))
+		})
+	})
+
+	when("generating a package shim for a package with generic functions", func() {
+		const packagemode = "github.com/maxbrunsfeld/counterfeiter/v6/fixtures/packagemode"
+
+		it("skips the functions that cannot be methods of an interface", func() {
+			c := &Cache{}
+			f, err = NewFake(Package, "", packagemode, "Packagemode", "packagemodeshim", "", "", c)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(f.HasMethod("Value")).To(BeFalse())
+			Expect(f.HasMethod("Visit")).To(BeFalse())
+			Expect(f.Skipped).To(Equal([]SkippedFunction{
+				{Name: "Value", Reason: "it is generic"},
+				{Name: "Visit", Reason: "it uses the unexported type visitor"},
+			}))
+
+			b, err := f.Generate(false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(b)).To(ContainSubstring("//   - Value, because it is generic\n"))
+		})
+
+		it("adds methods for the instantiations", func() {
+			c := &Cache{}
+			f, err = NewFake(Package, "", packagemode, "Packagemode", "packagemodeshim", "", "", c, Instantiate("Value[bool]", "Value[time.Duration]", "StringValue=Value[string]"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(f.HasMethod("ValueBool")).To(BeTrue())
+			Expect(f.HasMethod("ValueTimeDuration")).To(BeTrue())
+			Expect(f.HasMethod("StringValue")).To(BeTrue())
+			Expect(f.Skipped).To(Equal([]SkippedFunction{
+				{Name: "Visit", Reason: "it uses the unexported type visitor"},
+			}))
+			Expect(f.Imports.ByPkgPath).To(HaveKey("time"))
+
+			b, err := f.Generate(false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(b)).To(ContainSubstring("ValueTimeDuration(arg1 string) (time.Duration, bool"))
+			Expect(string(b)).To(ContainSubstring("return packagemode.Value[time.Duration](arg1)"))
+			Expect(string(b)).To(ContainSubstring("return packagemode.Value[string](arg1)"))
+		})
+
+		it("instantiates generic functions of the standard library", func() {
+			c := &Cache{}
+			f, err = NewFake(Package, "", "slices", "Slices", "slicesshim", "", "", c, Include(regexp.MustCompile("^$")), Instantiate("Contains[[]string, string]"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(f.Methods).To(HaveLen(1))
+			Expect(f.Methods[0].Name).To(Equal("ContainsStringString"))
+			Expect(f.Methods[0].FunctionName).To(Equal("slices.Contains[[]string, string]"))
+		})
+
+		it("infers the type arguments that are left out from the constraints", func() {
+			c := &Cache{}
+			f, err = NewFake(Package, "", "slices", "Slices", "slicesshim", "", "", c, Include(regexp.MustCompile("^$")), Instantiate("Sort[[]int]"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(f.Methods).To(HaveLen(1))
+			Expect(f.Methods[0].Name).To(Equal("SortInt"))
+			Expect(f.Methods[0].FunctionName).To(Equal("slices.Sort[[]int, int]"))
+		})
+
+		it("errors for type arguments that cannot be inferred", func() {
+			c := &Cache{}
+			f, err = NewFake(Package, "", "maps", "Maps", "mapsshim", "", "", c, Include(regexp.MustCompile("^$")), Instantiate("Collect[string]"))
+			Expect(err).To(MatchError("cannot instantiate Collect[string]: cannot infer V"))
+		})
+
+		it("errors for an invalid instantiation", func() {
+			for instantiation, message := range map[string]string{
+				"Value":               "cannot instantiate Value because it has no type arguments",
+				"Arg[int]":            "cannot instantiate Arg[int] because Arg is not generic",
+				"Lookup[int]":         "cannot instantiate Lookup[int] because " + packagemode + " has no exported function named Lookup",
+				"Value[unknown]":      "cannot instantiate Value[unknown]: undefined: unknown",
+				"Value[int, int]":     "cannot instantiate Value[int, int] because Value has 1 type parameter",
+				"Value[visitor]":      "cannot instantiate Value[visitor] because it uses the unexported type visitor",
+				"Arg=Value[int]":      "cannot instantiate Value as Arg because the shim already has a method named Arg",
+				"packagemode.Value[]": "cannot instantiate packagemode.Value[]: 1:19: expected operand, found ']'",
+			} {
+				c := &Cache{}
+				f, err = NewFake(Package, "", packagemode, "Packagemode", "packagemodeshim", "", "", c, Instantiate(instantiation))
+				Expect(err).To(MatchError(message), instantiation)
+			}
+		})
+	})
+
+	when("generating a package shim that combines packages", func() {
+		it("adds the functions of every package, prefixing the ones with the same name", func() {
+			c := &Cache{}
+			include, err := ParseNamePattern("Open,ReadFile,Glob,Join,Sub")
+			Expect(err).NotTo(HaveOccurred())
+			f, err = NewFake(Package, "", "os", "FileSystem", "osshim", "", "", c, Include(include), Combine("io/fs", "path/filepath"))
+			Expect(err).NotTo(HaveOccurred())
+
+			var names, functions []string
+			for _, m := range f.Methods {
+				names = append(names, m.Name)
+				functions = append(functions, m.FunctionName)
+			}
+			Expect(names).To(Equal([]string{"Open", "OsReadFile", "FsGlob", "FsReadFile", "Sub", "FilepathGlob", "Join"}))
+			Expect(functions).To(Equal([]string{"os.Open", "os.ReadFile", "fs.Glob", "fs.ReadFile", "fs.Sub", "filepath.Glob", "filepath.Join"}))
+
+			b, err := f.Generate(false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(b)).To(ContainSubstring("// in the os, io/fs, path/filepath packages.\n"))
+			Expect(string(b)).To(ContainSubstring("return fs.ReadFile(arg1, arg2)"))
+		})
+
+		it("qualifies the names of skipped functions with their package", func() {
+			c := &Cache{}
+			f, err = NewFake(Package, "", "github.com/maxbrunsfeld/counterfeiter/v6/fixtures/packagemode", "Flags", "flagshim", "", "", c, Combine("flag"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(f.Skipped).To(ContainElement(SkippedFunction{Name: "packagemode.Value", Reason: "it is generic"}))
+			Expect(f.HasMethod("PackagemodeBool")).To(BeTrue())
+			Expect(f.HasMethod("FlagBool")).To(BeTrue())
+		})
+
+		it("instantiates generic functions of a combined package", func() {
+			c := &Cache{}
+			f, err = NewFake(Package, "", "flag", "Flags", "flagshim", "", "", c, Combine("github.com/maxbrunsfeld/counterfeiter/v6/fixtures/packagemode"), Instantiate("packagemode.Value[bool]"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(f.HasMethod("ValueBool")).To(BeTrue())
+			Expect(f.Skipped).NotTo(ContainElement(HaveField("Name", "packagemode.Value")))
+		})
+
+		it("errors when the functions of the packages would have the same name", func() {
+			c := &Cache{}
+			include, err := ParseNamePattern("Arg,FlagArg")
+			Expect(err).NotTo(HaveOccurred())
+			f, err = NewFake(Package, "", "github.com/maxbrunsfeld/counterfeiter/v6/fixtures/collisions", "Collisions", "collisionsshim", "", "", c, Include(include), Combine("flag"))
+			Expect(err).To(MatchError("cannot combine the packages because the functions collisions.FlagArg and flag.Arg would both be named FlagArg"))
+		})
+
+		it("errors when a package cannot be found", func() {
+			c := &Cache{}
+			f, err = NewFake(Package, "", "os", "FileSystem", "osshim", "", "", c, Combine("counterfeiternonexistentpackage"))
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	when("generating a package shim with variables", func() {
+		it("adds a getter and a setter for each exported variable", func() {
+			c := &Cache{}
+			f, err = NewFake(Package, "", "os", "Os", "osshim", "", "", c, Include(regexp.MustCompile("^(Args|Getpid)$")), Variables())
+			Expect(err).NotTo(HaveOccurred())
+			var names []string
+			for _, m := range f.Methods {
+				names = append(names, m.Name)
+			}
+			Expect(names).To(Equal([]string{"Getpid", "Args", "SetArgs"}))
+			Expect(f.Methods[1].Returns.AsReturnSignature()).To(Equal("[]string"))
+			Expect(f.Methods[2].Params.AsNamedArgsWithTypes()).To(Equal("arg1 []string"))
+
+			b, err := f.Generate(false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(b)).To(ContainSubstring("// and variables in the os package."))
+			Expect(string(b)).To(ContainSubstring("return os.Args\n"))
+			Expect(string(b)).To(ContainSubstring("os.Args = arg1\n"))
+		})
+
+		it("leaves out the variables by default", func() {
+			c := &Cache{}
+			f, err = NewFake(Package, "", "os", "Os", "osshim", "", "", c, Include(regexp.MustCompile("^(Args|Getpid)$")))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(f.Methods).To(HaveLen(1))
+		})
+
+		it("prefixes a variable with the same name as a function of a combined package", func() {
+			c := &Cache{}
+			f, err = NewFake(Package, "", "os", "Os", "osshim", "", "", c, Include(regexp.MustCompile("^Args$")), Combine("flag"), Variables())
+			Expect(err).NotTo(HaveOccurred())
+			var names []string
+			for _, m := range f.Methods {
+				names = append(names, m.Name)
+			}
+			Expect(names).To(Equal([]string{"OsArgs", "OsSetArgs", "FlagArgs"}))
+		})
+
+		it("errors when an accessor of a variable would have the name of a function", func() {
+			c := &Cache{}
+			f, err = NewFake(Package, "", "github.com/maxbrunsfeld/counterfeiter/v6/fixtures/collisions", "Collisions", "collisionsshim", "", "", c, Variables())
+			Expect(err).To(MatchError("cannot add the variables because the function collisions.SetLevel and the setter of the variable collisions.Level would both be named SetLevel"))
+		})
+
+		it("skips the variables that use unexported types", func() {
+			c := &Cache{}
+			f, err = NewFake(Package, "", "net/http", "Http", "httpshim", "", "", c, Include(regexp.MustCompile("^(NoBody|DefaultClient)$")), Variables())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(f.Skipped).To(Equal([]SkippedFunction{{Name: "NoBody", Reason: "it uses the unexported type noBody"}}))
+			Expect(f.HasMethod("DefaultClient")).To(BeTrue())
+			Expect(f.HasMethod("SetDefaultClient")).To(BeTrue())
+		})
+	})
+
+	when("generating a package shim with a default", func() {
+		it("adds a default holding the shim and a function swapping it, named after the shim", func() {
+			c := &Cache{}
+			f, err = NewFake(Package, "", "os", "FileSystem", "osshim", "", "", c, Include(regexp.MustCompile("^Open$")), WithDefault())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(f.Imports.ByAlias).To(HaveKey("testing"))
+
+			b, err := f.Generate(false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(b)).To(ContainSubstring("var DefaultFileSystem FileSystem = new(FileSystemShim)"))
+			Expect(string(b)).To(ContainSubstring("func SwapFileSystemForTest(t testing.TB, fake FileSystem) {"))
+		})
+
+		it("leaves them out by default", func() {
+			c := &Cache{}
+			f, err = NewFake(Package, "", "os", "FileSystem", "osshim", "", "", c, Include(regexp.MustCompile("^Open$")))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(f.Imports.ByAlias).NotTo(HaveKey("testing"))
+
+			b, err := f.Generate(false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(b)).NotTo(ContainSubstring("Default"))
+		})
+
+		it("errors when the shim declares a type with the same name", func() {
+			c := &Cache{}
+			f, err = NewFake(Package, "", "github.com/maxbrunsfeld/counterfeiter/v6/fixtures/collisions", "Collisions", "collisionsshim", "", "", c, Include(regexp.MustCompile("^Current$")), Wrap("DefaultCollisions"), WithDefault())
+			Expect(err).To(MatchError("cannot add a DefaultCollisions to the shim because it declares a type named DefaultCollisions"))
+		})
+	})
+
+	when("generating a package shim that wraps concrete types", func() {
+		const wrap = "github.com/maxbrunsfeld/counterfeiter/v6/fixtures/wrap"
+
+		it("returns the interfaces of the types instead of pointers to them", func() {
+			c := &Cache{}
+			f, err = NewFake(Package, "", wrap, "Wrap", "wrapshim", "", "", c, Wrap("DB"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(f.Methods[0].Returns.AsReturnSignature()).To(Equal("(DB, error, )"))
+			Expect(f.Wrapped).To(HaveLen(1))
+			Expect(f.Wrapped[0].Name).To(Equal("DB"))
+			Expect(f.Wrapped[0].TargetType).To(Equal("wrap.DB"))
+
+			b, err := f.Generate(false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(b)).To(ContainSubstring("return wrapDB(result1), result2"))
+			Expect(string(b)).To(ContainSubstring("Begin() *wrap.Tx"))
+			Expect(string(b)).To(ContainSubstring("//counterfeiter:generate . DB\n"))
+		})
+
+		it("wraps the types returned by the wrapped types down to the wrap depth", func() {
+			c := &Cache{}
+			f, err = NewFake(Package, "", wrap, "Wrap", "wrapshim", "", "", c, Wrap("wrap.DB", "Tx"), WrapDepth(2))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(f.Wrapped).To(HaveLen(2))
+			Expect(f.Wrapped[1].Name).To(Equal("Tx"))
+
+			b, err := f.Generate(false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(b)).To(ContainSubstring("Begin() Tx"))
+			Expect(string(b)).To(ContainSubstring("DB() DB"))
+			Expect(string(b)).To(ContainSubstring("return wrapTx(result1)"))
+		})
+
+		it("generates fakes of the interfaces of the shim and of the wrapped types", func() {
+			c := &Cache{}
+			f, err = NewFake(Package, "", wrap, "Wrap", "wrapshim", "", "", c, Wrap("DB", "Tx"), WrapDepth(2))
+			Expect(err).NotTo(HaveOccurred())
+
+			fake, err := f.ShimFake(wrap+"/wrapshim", "FakeWrap", "wrapshimfakes")
+			Expect(err).NotTo(HaveOccurred())
+			b, err := fake.Generate(false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(b)).To(ContainSubstring("func (fake *FakeWrap) Open(arg1 string) (wrapshim.DB, error, )"))
+
+			fake, err = f.WrappedFake(wrap+"/wrapshim", "Tx", "FakeTx", "wrapshimfakes")
+			Expect(err).NotTo(HaveOccurred())
+			b, err = fake.Generate(false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(b)).To(ContainSubstring("func (fake *FakeTx) DB() wrapshim.DB"))
+			Expect(string(b)).To(ContainSubstring("var _ wrapshim.Tx = new(FakeTx)"))
+
+			_, err = f.WrappedFake(wrap+"/wrapshim", "Cursor", "FakeCursor", "wrapshimfakes")
+			Expect(err).To(MatchError("cannot generate a fake of Cursor because the shim does not wrap a type with that name"))
+		})
+
+		it("errors when a type cannot be wrapped", func() {
+			for name, message := range map[string]string{
+				"Tx":   "cannot wrap Tx because it is not returned as a pointer within a wrap depth of 1",
+				"Nope": "cannot wrap Nope because it is not returned as a pointer within a wrap depth of 1",
+			} {
+				c := &Cache{}
+				f, err = NewFake(Package, "", wrap, "Wrap", "wrapshim", "", "", c, Wrap(name))
+				Expect(err).To(MatchError(message), name)
+			}
+
+			c := &Cache{}
+			f, err = NewFake(Package, "", wrap, "DB", "wrapshim", "", "", c, Wrap("DB"))
+			Expect(err).To(MatchError("cannot wrap wrap.DB because the shim is named DB"))
+
+			f, err = NewFake(Package, "", "flag", "Flags", "flagshim", "", "", c, Include(regexp.MustCompile("^Lookup$")), Wrap("flag.Flag"))
+			Expect(err).To(MatchError("cannot wrap flag.Flag because it has no exported methods"))
+		})
+	})
+
+	when("generating a fake of the interface of a package shim", func() {
+		it("uses the methods of the shim", func() {
+			c := &Cache{}
+			include, err := ParseNamePattern("Open,ReadFile")
+			Expect(err).NotTo(HaveOccurred())
+			f, err = NewFake(Package, "", "os", "FileSystem", "osshim", "", "", c, Include(include), Combine("io/fs"))
+			Expect(err).NotTo(HaveOccurred())
+
+			fake, err := f.ShimFake("example.com/project/osshim", "FakeFileSystem", "osshimfakes", Strict())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fake.IsInterface()).To(BeTrue())
+			Expect(fake.Strict).To(BeTrue())
+			var names []string
+			for _, m := range fake.Methods {
+				names = append(names, m.Name)
+			}
+			Expect(names).To(ConsistOf("Open", "OsReadFile", "FsReadFile"))
+
+			b, err := fake.Generate(false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(b)).To(ContainSubstring(`"example.com/project/osshim"`))
+			Expect(string(b)).To(ContainSubstring("func (fake *FakeFileSystem) FsReadFile(arg1 fs.FS, arg2 string)"))
+			Expect(string(b)).To(ContainSubstring("var _ osshim.FileSystem = new(FakeFileSystem)"))
+		})
+
+		it("errors when the fake is not a package shim", func() {
+			c := &Cache{}
+			f, err = NewFake(InterfaceOrFunction, "Reader", "io", "FakeReader", "iofakes", "", "", c)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = f.ShimFake("example.com/project/ioshim", "FakeReader", "ioshimfakes")
+			Expect(err).To(MatchError("cannot generate a fake of the interface for io because it is not a package shim"))
+		})
+	})
+
+	when("parsing a name pattern", func() {
+		it("matches whole names", func() {
+			pattern, err := ParseNamePattern("Open, Stat,Read.*")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pattern.MatchString("Open")).To(BeTrue())
+			Expect(pattern.MatchString("Stat")).To(BeTrue())
+			Expect(pattern.MatchString("ReadFile")).To(BeTrue())
+			Expect(pattern.MatchString("OpenFile")).To(BeFalse())
+			Expect(pattern.MatchString("Lstat")).To(BeFalse())
+		})
+
+		it("errors for an invalid regular expression", func() {
+			_, err := ParseNamePattern("Open,Read(")
+			Expect(err).To(MatchError(HavePrefix(`invalid name pattern "Read(":`)))
+		})
+
+		it("errors when there are no names", func() {
+			_, err := ParseNamePattern(" , ")
+			Expect(err).To(MatchError(`invalid name pattern " , ": it contains no names`))
+		})
+	})
+
+	when("naming the fields of typed call records", func() {
+		params := func(names ...string) *types.Tuple {
+			vars := make([]*types.Var, len(names))
+			for i := range names {
+				vars[i] = types.NewVar(token.NoPos, nil, names[i], types.Typ[types.String])
+			}
+			return types.NewTuple(vars...)
+		}
+
+		it("uses the exported names of the params", func() {
+			Expect(callFieldNames(params("ctx", "item"))).To(Equal([]string{"Ctx", "Item"}))
+		})
+
+		it("falls back to numbered names for unnamed params and params named _", func() {
+			Expect(callFieldNames(params("", ""))).To(Equal([]string{"Arg1", "Arg2"}))
+			Expect(callFieldNames(params("ctx", "_"))).To(Equal([]string{"Ctx", "Arg2"}))
+		})
+
+		it("falls back to numbered names for names that are not unique once exported", func() {
+			Expect(callFieldNames(params("x", "X", "y"))).To(Equal([]string{"Arg1", "Arg2", "Y"}))
+		})
+
+		it("falls back to numbered names for every param when a fallback collides with a name", func() {
+			Expect(callFieldNames(params("arg2", "_"))).To(Equal([]string{"Arg1", "Arg2"}))
+			Expect(callFieldNames(params("_", "arg1"))).To(Equal([]string{"Arg1", "Arg2"}))
+		})
+	})
+
+	when("generating a deep copying fake", func() {
+		it("copies the arguments with generated methods", func() {
+			c := &Cache{}
+			f, err = NewFake(InterfaceOrFunction, "Writer", "io", "FakeWriter", "iofakes", "", "", c, DeepCopy())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(f.Methods[0].Params[0].DeepCopier).To(Equal("deepCopy1"))
+			Expect(f.DeepCopiers).To(HaveLen(1))
+			Expect(f.DeepCopiers[0].Type).To(Equal("[]byte"))
+
+			b, err := f.Generate(false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(b)).To(ContainSubstring("arg1Copy := fake.deepCopy1(arg1, map[interface{}]interface{}{})"))
+			Expect(string(b)).To(ContainSubstring("func (fake *FakeWriter) deepCopy1(v []byte, copies map[interface{}]interface{}) []byte {"))
+			Expect(string(b)).To(ContainSubstring("DeepCopyFallback func(v interface{}) interface{}"))
+		})
+
+		it("keeps the pointers to values that hold locks instead of copying through them", func() {
+			c := &Cache{}
+			f, err = NewFake(InterfaceOrFunction, "DeepCopySomething", "github.com/maxbrunsfeld/counterfeiter/v6/fixtures", "FakeDeepCopySomething", "fixturesfakes", "", "", c, DeepCopy())
+			Expect(err).NotTo(HaveOccurred())
+			kept := 0
+			for _, copier := range f.DeepCopiers {
+				if copier.Type == "*sync.WaitGroup" || copier.Type == "*fixtures.Ledger" {
+					kept++
+					Expect(copier.Body).NotTo(ContainSubstring("*c ="))
+					Expect(copier.Body).To(HaveSuffix("\treturn v\n"))
+				}
+			}
+			Expect(kept).To(Equal(2))
+		})
+
+		it("passes the flag on to the directive of a package shim", func() {
+			c := &Cache{}
+			f, err = NewFake(Package, "", "os", "Os", "osshim", "", "", c, DeepCopy())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(f.DeepCopiers).To(BeEmpty())
+
+			b, err := f.Generate(false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(b)).To(ContainSubstring("//counterfeiter:generate -deep-copy . Os\n"))
+		})
+	})
+
+	when("generating a fake with a constructor", func() {
+		it("renders the constructor and imports the packages it uses", func() {
+			c := &Cache{}
+			f, err = NewFake(InterfaceOrFunction, "FileInfo", "os", "FakeFileInfo", "osfakes", "", "", c, Constructor())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(f.Imports.ByPkgPath).To(HaveKey("testing"))
+			Expect(f.Imports.ByPkgPath).To(HaveKey("sort"))
+
+			b, err := f.Generate(false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(b)).To(ContainSubstring("func NewFakeFileInfo(t testing.TB) *FakeFileInfo {"))
+			Expect(string(b)).To(ContainSubstring("func (fake *FakeFileInfo) verify(t testing.TB) {"))
+		})
+
+		it("leaves out the constructor and its imports without the option", func() {
+			c := &Cache{}
+			f, err = NewFake(InterfaceOrFunction, "FileInfo", "os", "FakeFileInfo", "osfakes", "", "", c)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(f.Imports.ByPkgPath).NotTo(HaveKey("testing"))
+			Expect(f.Imports.ByPkgPath).NotTo(HaveKey("sort"))
+
+			b, err := f.Generate(false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(b)).NotTo(ContainSubstring("testing.TB"))
+		})
+
+		it("keeps the imports of the packages used by the target", func() {
+			c := &Cache{}
+			f, err = NewFake(InterfaceOrFunction, "TB", "testing", "FakeTB", "testingfakes", "", "", c)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(f.Imports.ByPkgPath).To(HaveKeyWithValue("testing", Import{Alias: "testing", PkgPath: "testing"}))
+			Expect(f.Imports.ByPkgPath).To(HaveKey("fmt"))
+		})
+
+		it("only imports the packages of the helpers that the methods need", func() {
+			c := &Cache{}
+			f, err = NewFake(InterfaceOrFunction, "Closer", "io", "FakeCloser", "iofakes", "", "", c)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(f.Imports.ByPkgPath).NotTo(HaveKey("reflect"))
+			Expect(f.Imports.ByPkgPath).NotTo(HaveKey("fmt"))
+
+			f, err = NewFake(InterfaceOrFunction, "Reader", "io", "FakeReader", "iofakes", "", "", c, Strict())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(f.Imports.ByPkgPath).To(HaveKey("reflect"))
+			Expect(f.Imports.ByPkgPath).To(HaveKey("fmt"))
+		})
+
+		it("passes the flag on to the directive of a package shim", func() {
+			c := &Cache{}
+			f, err = NewFake(Package, "", "os", "Os", "osshim", "", "", c, Constructor())
+			Expect(err).NotTo(HaveOccurred())
+
+			b, err := f.Generate(false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(b)).To(ContainSubstring("//counterfeiter:generate -constructor . Os\n"))
+		})
+	})
+
+	when("generating a fake with expectations", func() {
+		it("does not generate helpers that collide with the methods of the interface", func() {
+			c := &Cache{}
+			f, err = NewFake(InterfaceOrFunction, "Verifier", "github.com/maxbrunsfeld/counterfeiter/v6/fixtures", "FakeVerifier", "fixturesfakes", "", "", c, Expectations())
+			Expect(err).NotTo(HaveOccurred())
+
+			b, err := f.Generate(false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(b)).To(ContainSubstring("func (fake *FakeVerifier) ExpectVerifyExpectations() *FakeVerifierVerifyExpectationsExpectation {"))
+			Expect(string(b)).To(ContainSubstring("func (fake *FakeVerifier) OrderExpectations() {"))
+			Expect(string(b)).NotTo(ContainSubstring("func (fake *FakeVerifier) VerifyExpectations() error {\n\treturn fake.verifyExpectations()"))
+		})
+
+		it("passes the flag on to the directive of a package shim", func() {
+			c := &Cache{}
+			f, err = NewFake(Package, "", "os", "Os", "osshim", "", "", c, Expectations())
+			Expect(err).NotTo(HaveOccurred())
+
+			b, err := f.Generate(false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(b)).To(ContainSubstring("//counterfeiter:generate -expectations . Os\n"))
+		})
+	})
+
+	when("generating a gomock style fake", func() {
+		it("renders a mock with an EXPECT() recorder", func() {
+			c := &Cache{}
+			f, err = NewFake(InterfaceOrFunction, "Something", "github.com/maxbrunsfeld/counterfeiter/v6/fixtures", "MockSomething", "fixturesfakes", "", "", c, Style(GomockStyle))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(f.Imports.ByPkgPath).To(HaveKey("go.uber.org/mock/gomock"))
+			Expect(f.Imports.ByPkgPath).NotTo(HaveKey("sync"))
+
+			b, err := f.Generate(false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(b)).To(ContainSubstring("func NewMockSomething(ctrl *gomock.Controller) *MockSomething {"))
+			Expect(string(b)).To(ContainSubstring("func (m *MockSomething) EXPECT() *MockSomethingMockRecorder {"))
+			Expect(string(b)).To(ContainSubstring("ret := m.ctrl.Call(m, \"DoThings\", arg1, arg2)"))
+			Expect(string(b)).To(ContainSubstring("result2, _ := ret[1].(error)"))
+			Expect(string(b)).To(ContainSubstring("func (mr *MockSomethingMockRecorder) DoThings(arg1 interface{}, arg2 interface{}) *gomock.Call {"))
+			Expect(string(b)).NotTo(ContainSubstring("ArgsForCall"))
+		})
+
+		it("renders var-args as a list of arguments", func() {
+			c := &Cache{}
+			f, err = NewFake(InterfaceOrFunction, "HasVarArgs", "github.com/maxbrunsfeld/counterfeiter/v6/fixtures", "MockHasVarArgs", "fixturesfakes", "", "", c, Style(GomockStyle))
+			Expect(err).NotTo(HaveOccurred())
+
+			b, err := f.Generate(false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(b)).To(ContainSubstring("m.ctrl.Call(m, \"DoThings\", varargs...)"))
+			Expect(string(b)).To(ContainSubstring("func (mr *MockHasVarArgsMockRecorder) DoThings(arg1 interface{}, arg2 ...interface{}) *gomock.Call {"))
+		})
+
+		it("errors when the target is a function", func() {
+			c := &Cache{}
+			f, err = NewFake(InterfaceOrFunction, "HandlerFunc", "net/http", "MockHandlerFunc", "httpfakes", "", "", c, Style(GomockStyle))
+			Expect(err).To(MatchError("cannot generate a gomock style fake for HandlerFunc because it is a function"))
+		})
+
+		it("passes the style on to the directive of a package shim", func() {
+			c := &Cache{}
+			f, err = NewFake(Package, "", "os", "Os", "osshim", "", "", c, Style(GomockStyle))
+			Expect(err).NotTo(HaveOccurred())
+
+			b, err := f.Generate(false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(b)).To(ContainSubstring("//counterfeiter:generate -style gomock . Os\n"))
+		})
+	})
+
+	when("generating a funcs style fake", func() {
+		it("renders a struct with a function field per method", func() {
+			c := &Cache{}
+			f, err = NewFake(InterfaceOrFunction, "Something", "github.com/maxbrunsfeld/counterfeiter/v6/fixtures", "FuncsSomething", "fixturesfakes", "", "", c, Style(FuncsStyle))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(f.Imports.ByPkgPath).To(HaveKey("sync"))
+			Expect(f.Imports.ByPkgPath).NotTo(HaveKey("sync/atomic"))
+
+			b, err := f.Generate(false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(b)).To(ContainSubstring("DoThingsFunc func(arg1 string, arg2 uint64)"))
+			Expect(string(b)).To(ContainSubstring("func (fake *FuncsSomething) DoThingsCalls() []FuncsSomethingDoThingsCall {"))
+			Expect(string(b)).NotTo(ContainSubstring("ArgsForCall"))
+		})
+
+		it("errors when the target is a function", func() {
+			c := &Cache{}
+			f, err = NewFake(InterfaceOrFunction, "HandlerFunc", "net/http", "FuncsHandlerFunc", "httpfakes", "", "", c, Style(FuncsStyle))
+			Expect(err).To(MatchError("cannot generate a funcs style fake for HandlerFunc because it is a function"))
+		})
+	})
+
+	when("generating a fake for a method with pointer arguments", func() {
+		it("generates a helper to assign values through them", func() {
+			c := &Cache{}
+			f, err = NewFake(InterfaceOrFunction, "Scanner", "database/sql", "FakeScanner", "sqlfakes", "", "", c)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(f.Methods[0].Params[0].IsOutParam).To(BeTrue())
+
+			b, err := f.Generate(false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(b)).To(ContainSubstring("func (fake *FakeScanner) ScanSetsArg(i int, value interface{}) {"))
+		})
+
+		it("does not generate the helper for other arguments", func() {
+			c := &Cache{}
+			f, err = NewFake(InterfaceOrFunction, "Writer", "io", "FakeWriter", "iofakes", "", "", c)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(f.Methods[0].Params[0].IsOutParam).To(BeFalse())
+
+			b, err := f.Generate(false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(b)).NotTo(ContainSubstring("SetsArg"))
+		})
+	})
+
+	when("generating a replay style fake", func() {
+		it("renders a recorder and a replaying fake", func() {
+			c := &Cache{}
+			f, err = NewFake(InterfaceOrFunction, "Something", "github.com/maxbrunsfeld/counterfeiter/v6/fixtures", "ReplaySomething", "fixturesfakes", "", "", c, Style(ReplayStyle))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(f.Imports.ByPkgPath).To(HaveKey("encoding/json"))
+			Expect(f.Imports.ByPkgPath).To(HaveKey("os"))
+
+			b, err := f.Generate(false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(b)).To(ContainSubstring("func NewReplaySomethingRecorder(real fixtures.Something) *ReplaySomethingRecorder {"))
+			Expect(string(b)).To(ContainSubstring("func LoadReplaySomething(path string) (*ReplaySomething, error) {"))
+		})
+
+		it("errors when the target is not exported", func() {
+			c := &Cache{}
+			f, err = NewFake(InterfaceOrFunction, "unexportedInterface", "github.com/maxbrunsfeld/counterfeiter/v6/fixtures", "ReplayUnexportedInterface", "fixturesfakes", "", "", c, Style(ReplayStyle))
+			Expect(err).To(MatchError("cannot generate a replay style fake for unexportedInterface because it is not exported"))
+		})
+
+		it("errors when the target has a method named Save", func() {
+			c := &Cache{}
+			f, err = NewFake(InterfaceOrFunction, "DeepCopySomething", "github.com/maxbrunsfeld/counterfeiter/v6/fixtures", "ReplayDeepCopySomething", "fixturesfakes", "", "", c, Style(ReplayStyle))
+			Expect(err).To(MatchError("cannot generate a replay style fake for DeepCopySomething because it has a method named Save"))
+		})
+
+		it("errors when the results of a method cannot be unmarshaled", func() {
+			c := &Cache{}
+			f, err = NewFake(InterfaceOrFunction, "Opener", "github.com/maxbrunsfeld/counterfeiter/v6/fixtures", "ReplayOpener", "fixturesfakes", "", "", c, Style(ReplayStyle))
+			Expect(err).To(MatchError("cannot generate a replay style fake for Opener because the results of its method Open cannot be replayed from JSON, as they contain io.ReadCloser"))
+		})
+
+		it("records results of concrete error types as they are", func() {
+			c := &Cache{}
+			f, err = NewFake(InterfaceOrFunction, "Checker", "github.com/maxbrunsfeld/counterfeiter/v6/fixtures", "ReplayChecker", "fixturesfakes", "", "", c, Style(ReplayStyle))
+			Expect(err).NotTo(HaveOccurred())
+
+			b, err := f.Generate(false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(b)).To(ContainSubstring(`recorder.record("Check", args, err, nil, []interface{}{result1, marshalReplayCheckerError(result2)})`))
+		})
+
+		it("records the arguments before calling the real implementation", func() {
+			c := &Cache{}
+			f, err = NewFake(InterfaceOrFunction, "Filler", "github.com/maxbrunsfeld/counterfeiter/v6/fixtures", "ReplayFiller", "fixturesfakes", "", "", c, Style(ReplayStyle))
+			Expect(err).NotTo(HaveOccurred())
+
+			b, err := f.Generate(false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(b)).To(ContainSubstring("args, err := marshalReplayFillerValues(\"Read\", []interface{}{arg1})\n\tresult1, result2 := recorder.real.Read(arg1)\n\trecorder.record(\"Read\", args, err, []interface{}{arg1}, "))
+			Expect(string(b)).To(ContainSubstring("fake.setArg(call, 0, &arg1Out)\n\tcopy(arg1, arg1Out)"))
+		})
+	})
+
+	when("extracting an interface from a concrete type", func() {
+		it("renders an interface of its exported methods and an adapter", func() {
+			c := &Cache{}
+			f, err = NewFake(Extract, "Store", "github.com/maxbrunsfeld/counterfeiter/v6/fixtures/extract", "Store", "extractshim", "", "", c)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(f.Methods).To(HaveLen(4))
+			Expect(f.HasMethod("Close")).To(BeTrue()) // promoted from the embedded io.Closer
+			Expect(f.HasMethod("Len")).To(BeTrue())   // has a value receiver
+			Expect(f.HasMethod("reset")).To(BeFalse())
+
+			b, err := f.Generate(false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(b)).To(ContainSubstring("type Store interface {"))
+			Expect(string(b)).To(ContainSubstring("func NewStoreAdapter(target *extract.Store) *StoreAdapter {"))
+			Expect(string(b)).To(ContainSubstring("var _ Store = new(StoreAdapter)"))
+		})
+
+		it("renders a fake of the extracted interface", func() {
+			c := &Cache{}
+			f, err = NewFake(Extract, "Store", "github.com/maxbrunsfeld/counterfeiter/v6/fixtures/extract", "Store", "extractshim", "", "", c)
+			Expect(err).NotTo(HaveOccurred())
+
+			fake, err := f.ExtractedFake("github.com/maxbrunsfeld/counterfeiter/v6/fixtures/extract/extractshim", "FakeStore", "extractshimfakes", Strict())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fake.IsInterface()).To(BeTrue())
+			Expect(fake.Strict).To(BeTrue())
+			Expect(fake.Methods).To(Equal(f.Methods))
+
+			b, err := fake.Generate(false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(b)).To(ContainSubstring(`"github.com/maxbrunsfeld/counterfeiter/v6/fixtures/extract/extractshim"`))
+			Expect(string(b)).To(ContainSubstring("var _ extractshim.Store = new(FakeStore)"))
+		})
+
+		it("errors when the target is an interface", func() {
+			c := &Cache{}
+			f, err = NewFake(Extract, "Something", "github.com/maxbrunsfeld/counterfeiter/v6/fixtures", "Something", "fixturesshim", "", "", c)
+			Expect(err).To(MatchError("cannot extract an interface from Something because it is already an interface"))
+		})
+
+		it("errors when the target is generic", func() {
+			c := &Cache{}
+			f, err = NewFake(Extract, "Pointer", "sync/atomic", "Pointer", "atomicshim", "", "", c)
+			Expect(err).To(MatchError("cannot extract an interface from Pointer because it is generic"))
+		})
+
+		it("errors when the target has no exported methods", func() {
+			c := &Cache{}
+			f, err = NewFake(Extract, "Item", "github.com/maxbrunsfeld/counterfeiter/v6/fixtures/extract", "Item", "extractshim", "", "", c)
+			Expect(err).To(MatchError("cannot extract an interface from Item because it has no exported methods"))
+		})
+
+		it("errors when a method uses an unexported type", func() {
+			c := &Cache{}
+			f, err = NewFake(Extract, "Cursor", "github.com/maxbrunsfeld/counterfeiter/v6/fixtures/extract", "Cursor", "extractshim", "", "", c)
+			Expect(err).To(MatchError("cannot extract an interface from Cursor because its method Position uses the unexported type position"))
+		})
+	})
+
+	when("parsing a style", func() {
+		it("returns the style with the given name", func() {
+			style, err := ParseStyle("gomock")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(style).To(Equal(GomockStyle))
+			Expect(style.String()).To(Equal("gomock"))
+		})
+
+		it("errors for an unknown style", func() {
+			style, err := ParseStyle("funcs")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(style).To(Equal(FuncsStyle))
+
+			style, err = ParseStyle("replay")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(style).To(Equal(ReplayStyle))
+
+			_, err = ParseStyle("mockery")
+			Expect(err).To(MatchError(`unknown style "mockery"`))
+		})
+	})
+
+	when("generating a delegating fake", func() {
+		it("errors when the target is not exported", func() {
+			c := &Cache{}
+			f, err = NewFake(InterfaceOrFunction, "unexportedInterface", "github.com/maxbrunsfeld/counterfeiter/v6/fixtures", "FakeUnexportedInterface", "fixturesfakes", "", "", c, Delegate())
+			Expect(err).To(MatchError(ContainSubstring("not exported")))
+			Expect(f).To(BeNil())
+		})
+	})
+
 	when("manually constructing a fake", func() {
 		it.Before(func() {
 			f = &Fake{Imports: newImports()}
@@ -305,13 +1183,14 @@ func testGenerator(t *testing.T, when spec.G, it spec.S) {
 				it("can load the methods", func() {
 					err := f.findPackage()
 					Expect(err).NotTo(HaveOccurred())
-					f.loadMethods()
+					Expect(f.loadMethods()).To(Succeed())
 					Expect(len(f.Methods)).To(BeNumerically(">=", 51)) // yes, this is crazy because go 1.11 added a function
+					Expect(f.Imports.ByPkgPath).To(HaveKey("sync"))
 					switch runtime.Version()[0:6] {
 					case "go1.15", "go1.14":
-						Expect(len(f.Imports.ByAlias)).To(Equal(2))
-					default:
 						Expect(len(f.Imports.ByAlias)).To(Equal(3))
+					default:
+						Expect(len(f.Imports.ByAlias)).To(Equal(4))
 					}
 				})
 			})
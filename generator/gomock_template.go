@@ -0,0 +1,87 @@
+package generator
+
+import (
+	"strings"
+	"text/template"
+)
+
+const gomockPackage = "go.uber.org/mock/gomock"
+
+var gomockFuncs = template.FuncMap{
+	"ToLower":                strings.ToLower,
+	"UnExport":               unexport,
+	"Replace":                strings.Replace,
+	"IsExported":             isExported,
+	"Title":                  title.String,
+	"HasConstraintInterface": hasConstraintInterface,
+}
+
+const gomockTemplate string = `{{.Header}}// Code generated by counterfeiter. DO NOT EDIT.
+package {{.DestinationPackage}}
+
+import (
+	{{- range $index, $import := .Imports.ByAlias}}
+	{{$import}}
+	{{- end}}
+)
+
+type {{.Name}}{{.GenericTypeParametersAndConstraints}} struct {
+	ctrl     *gomock.Controller
+	recorder *{{.Name}}MockRecorder{{.GenericTypeParameters}}
+}
+
+type {{.Name}}MockRecorder{{.GenericTypeParametersAndConstraints}} struct {
+	mock *{{.Name}}{{.GenericTypeParameters}}
+}
+
+func New{{.Name}}{{.GenericTypeParametersAndConstraints}}(ctrl *gomock.Controller) *{{.Name}}{{.GenericTypeParameters}} {
+	mock := &{{.Name}}{{.GenericTypeParameters}}{ctrl: ctrl}
+	mock.recorder = &{{.Name}}MockRecorder{{.GenericTypeParameters}}{mock}
+	return mock
+}
+
+func (m *{{.Name}}{{.GenericTypeParameters}}) EXPECT() *{{.Name}}MockRecorder{{.GenericTypeParameters}} {
+	return m.recorder
+}
+
+{{range .Methods -}}
+func (m *{{$.Name}}{{$.GenericTypeParameters}}) {{.Name}}({{.Params.AsNamedArgsWithTypes}}) {{.Returns.AsReturnSignature}} {
+	m.ctrl.T.Helper()
+	{{- if .Params.HasVariadic}}
+	varargs := []interface{}{ {{- range .Params}}{{if not .IsVariadic}}{{UnExport .Name}}, {{end}}{{end -}} }
+	{{- range .Params}}
+	{{- if .IsVariadic}}
+	for _, a := range {{UnExport .Name}} {
+		varargs = append(varargs, a)
+	}
+	{{- end}}
+	{{- end}}
+	{{if .Returns.HasLength}}ret := {{end}}m.ctrl.Call(m, "{{.Name}}", varargs...)
+	{{- else}}
+	{{if .Returns.HasLength}}ret := {{end}}m.ctrl.Call(m, "{{.Name}}"{{range .Params}}, {{UnExport .Name}}{{end}})
+	{{- end}}
+	{{- range $i, $ret := .Returns}}
+	{{UnExport $ret.Name}}, _ := ret[{{$i}}].({{$ret.Type}})
+	{{- end}}
+	{{- if .Returns.HasLength}}
+	return {{.Returns.AsNamedArgs}}
+	{{- end}}
+}
+
+func (mr *{{$.Name}}MockRecorder{{$.GenericTypeParameters}}) {{.Name}}({{range $i, $param := .Params}}{{if $i}}, {{end}}{{UnExport $param.Name}} {{if $param.IsVariadic}}...{{end}}interface{}{{end}}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	{{- if .Params.HasVariadic}}
+	varargs := append([]interface{}{ {{- range .Params}}{{if not .IsVariadic}}{{UnExport .Name}}, {{end}}{{end -}} }{{range .Params}}{{if .IsVariadic}}, {{UnExport .Name}}...{{end}}{{end}})
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "{{.Name}}", reflect.TypeOf((*{{$.Name}}{{$.GenericTypeParameters}})(nil).{{.Name}}), varargs...)
+	{{- else}}
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "{{.Name}}", reflect.TypeOf((*{{$.Name}}{{$.GenericTypeParameters}})(nil).{{.Name}}){{range .Params}}, {{UnExport .Name}}{{end}})
+	{{- end}}
+}
+
+{{end -}}
+{{if IsExported .TargetName -}}
+{{if not (HasConstraintInterface .) -}}
+var _ {{.TargetAlias}}.{{.TargetName}}{{.GenericTypeConstraints}} = new({{.Name}}{{.GenericTypeConstraints}})
+{{- end}}
+{{- end}}
+`
@@ -21,6 +21,7 @@ func (f *Fake) addTypesForMethod(sig *types.Signature) {
 
 func methodForSignature(sig *types.Signature, methodName string, imports Imports) Method {
 	params := []Param{}
+	fieldNames := callFieldNames(sig.Params())
 	for i := 0; i < sig.Params().Len(); i++ {
 		param := sig.Params().At(i)
 		isVariadic := i == sig.Params().Len()-1 && sig.Variadic()
@@ -28,11 +29,17 @@ func methodForSignature(sig *types.Signature, methodName string, imports Imports
 		if isVariadic {
 			typ = "..." + typ[2:] // Change []string to ...string
 		}
+		elem := param.Type()
+		if isVariadic {
+			elem = elem.(*types.Slice).Elem()
+		}
 		p := Param{
 			Name:       fmt.Sprintf("arg%v", i+1),
 			Type:       typ,
 			IsVariadic: isVariadic,
 			IsSlice:    strings.HasPrefix(typ, "[]"),
+			IsOutParam: isOutParam(elem),
+			FieldName:  fieldNames[i],
 		}
 		params = append(params, p)
 	}
@@ -52,6 +59,68 @@ func methodForSignature(sig *types.Signature, methodName string, imports Imports
 	}
 }
 
+// callFieldNames returns the names of the fields of a typed call record, which
+// are the exported names of the params in the source, such as Ctx for ctx. The
+// params that are unnamed, named _, or whose names are not unique fall back to
+// names such as Arg1.
+func callFieldNames(params *types.Tuple) []string {
+	names := make([]string, params.Len())
+	count := map[string]int{}
+	for i := range names {
+		names[i] = title.String(params.At(i).Name())
+		count[names[i]]++
+	}
+	for i := range names {
+		if names[i] == "" || !isExported(names[i]) || count[names[i]] > 1 {
+			names[i] = fmt.Sprintf("Arg%d", i+1)
+		}
+	}
+	// A fallback may collide with a name from the source, as in (arg2, _).
+	seen := map[string]bool{}
+	unique := true
+	for _, name := range names {
+		unique = unique && !seen[name]
+		seen[name] = true
+	}
+	if !unique {
+		for i := range names {
+			names[i] = fmt.Sprintf("Arg%d", i+1)
+		}
+	}
+	return names
+}
+
+// isOutParam indicates whether a fake can assign a value through a parameter of
+// type t, which is the case for pointers, and for empty interfaces that may hold
+// a pointer.
+func isOutParam(t types.Type) bool {
+	if _, ok := t.(*types.TypeParam); ok {
+		return false
+	}
+	switch u := t.Underlying().(type) {
+	case *types.Pointer:
+		return true
+	case *types.Interface:
+		return u.Empty()
+	}
+	return false
+}
+
+// hasOutParams indicates whether the signature has a param that the fake can
+// assign a value through.
+func hasOutParams(sig *types.Signature) bool {
+	for i := 0; i < sig.Params().Len(); i++ {
+		elem := sig.Params().At(i).Type()
+		if i == sig.Params().Len()-1 && sig.Variadic() {
+			elem = elem.(*types.Slice).Elem()
+		}
+		if isOutParam(elem) {
+			return true
+		}
+	}
+	return false
+}
+
 // interfaceMethodSet identifies the methods that are exported for a given
 // interface.
 func interfaceMethodSet(t types.Type) []*rawMethod {
@@ -81,23 +150,67 @@ func interfaceMethodSet(t types.Type) []*rawMethod {
 	return result
 }
 
-func (f *Fake) loadMethods() {
+func (f *Fake) loadMethods() error {
 	var methods []*rawMethod
 	if f.Mode == Package {
-		methods = packageMethodSet(f.Package)
+		var err error
+		methods, err = f.packageMethods()
+		if err != nil {
+			return err
+		}
+	} else if f.Mode == Extract {
+		methods = concreteMethodSet(f.Target.Type())
 	} else {
 		if !f.IsInterface() || f.Target == nil || f.Target.Type() == nil {
-			return
+			return nil
 		}
 		methods = interfaceMethodSet(f.Target.Type())
 	}
 
+	f.rawMethods = methods
 	for i := range methods {
 		f.addTypesForMethod(methods[i].Signature)
+		for _, arg := range methods[i].TypeArgs {
+			f.addImportsFor(arg)
+		}
+	}
+	if f.Mode == Package && len(f.WrapTypes) > 0 {
+		err := f.loadWrappedTypes(methods)
+		if err != nil {
+			return err
+		}
 	}
 
 	for i := range methods {
-		method := methodForSignature(methods[i].Signature, methods[i].Func.Name(), f.Imports)
+		name := methods[i].Func.Name()
+		if methods[i].Name != "" {
+			name = methods[i].Name
+		}
+		method := methodForSignature(methods[i].Signature, name, f.Imports)
+		if f.Mode == Package {
+			alias := f.Imports.AliasForPackage(methods[i].Func.Pkg())
+			method.FunctionName = alias + "." + methods[i].Func.Name() + f.typeArgs(methods[i].TypeArgs)
+			if methods[i].Var != nil {
+				method.FunctionName = alias + "." + methods[i].Var.Name()
+				method.IsVariable = true
+			}
+			f.wrapResults(methods[i].Signature, &method)
+		}
+		f.addDeepCopiers(methods[i].Signature, method.Params)
 		f.Methods = append(f.Methods, method)
 	}
+	return nil
+}
+
+// typeArgs renders the type arguments of an instantiation of a generic
+// function, such as [[]store.User].
+func (f *Fake) typeArgs(args []types.Type) string {
+	if len(args) == 0 {
+		return ""
+	}
+	s := make([]string, len(args))
+	for i := range args {
+		s[i] = types.TypeString(args[i], f.Imports.AliasForPackage)
+	}
+	return "[" + strings.Join(s, ", ") + "]"
 }
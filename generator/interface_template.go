@@ -39,6 +39,15 @@ type {{.Name}}{{.GenericTypeParametersAndConstraints}} struct {
 		{{.Name}} {{if .IsVariadic}}{{Replace .Type "..." "[]" -1}}{{else}}{{.Type}}{{end}}
 		{{- end}}
 	}
+	{{- if .Params.HasLength}}
+	{{UnExport .Name}}When []struct{
+		matcher func({{.Params.AsArgs}}) bool
+		stub func({{.Params.AsArgs}}) {{.Returns.AsReturnSignature}}
+	}
+	{{- end}}
+	{{- if .Params.HasOutParams}}
+	{{UnExport .Name}}SetsArgs map[int]interface{}
+	{{- end}}
 	{{- if .Returns.HasLength}}
 	{{UnExport .Name}}Returns struct{
 		{{- range .Returns}}
@@ -50,23 +59,162 @@ type {{.Name}}{{.GenericTypeParametersAndConstraints}} struct {
 		{{UnExport .Name}} {{.Type}}
 		{{- end}}
 	}
+	{{- if $.TracksConfiguredReturns}}
+	{{UnExport .Name}}ReturnsConfigured bool
+	{{- end}}
+	{{- end}}
+	{{- if $.Expectations}}
+	{{UnExport .Name}}Expectations []*{{$.Name}}{{Title .Name}}Expectation{{$.GenericTypeParameters}}
+	{{- end}}
+	{{- end}}
+	{{- if .Delegate}}
+	Delegate {{.TargetAlias}}.{{.TargetName}}{{.GenericTypeParameters}}
+	{{- end}}
+	{{- if .Strict}}
+	StrictHandler func(method string, call int, args []interface{})
+	{{- end}}
+	{{- if .DeepCopy}}
+	DeepCopyFallback func(v interface{}) interface{}
 	{{- end}}
+	{{- if .HasReturnsForArgs}}
+	ArgsComparer func(expected interface{}, actual interface{}) bool
+	{{- end}}
+	invocations        map[string][][]interface{}
+	orderedInvocations []struct{
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}
+	sequencer          *atomic.Uint64
+	invocationsChanged chan struct{}
+	callsSubscribers   map[string][]struct {
+		publish func(args []interface{})
+		cancel  context.CancelFunc
+		done    <-chan struct{}
+	}
+	invocationsMutex   sync.RWMutex
+	{{- if and .Strict .Constructor}}
+	strictViolations   []string
+	{{- end}}
+	{{- if .Expectations}}
+	expectations        []*{{.Name}}Expectation
+	expectationsInOrder bool
+	unexpectedCalls     []struct {
+		method string
+		args   []interface{}
+	}
+	expectationsMutex sync.Mutex
+	{{- end}}
+}
+
+{{if .Constructor -}}
+// New{{.Name}} returns a fake that is verified when the test completes.
+func New{{.Name}}{{.GenericTypeParametersAndConstraints}}(t testing.TB) *{{.Name}}{{.GenericTypeParameters}} {
+	fake := &{{.Name}}{{.GenericTypeParameters}}{}
+	{{- if .Strict}}
+	fake.StrictHandler = fake.recordStrictViolation
 	{{- end}}
-	invocations      map[string][][]interface{}
-	invocationsMutex sync.RWMutex
+	t.Cleanup(func() {
+		fake.verify(t)
+	})
+	return fake
 }
 
+{{end -}}
+{{if .Expectations -}}
+type {{.Name}}Expectation struct {
+	method      string
+	description string
+	min         int
+	max         int
+	calls       int
+}
+
+{{end -}}
 {{range .Methods -}}
+{{if .Params.HasLength -}}
+type {{$.Name}}{{Title .Name}}Call{{$.GenericTypeParametersAndConstraints}} struct {
+	{{- range .Params}}
+	{{.FieldName}} {{if .IsVariadic}}{{Replace .Type "..." "[]" -1}}{{else}}{{.Type}}{{end}}
+	{{- end}}
+}
+
+{{end -}}
+{{if $.Expectations -}}
+type {{$.Name}}{{Title .Name}}Expectation{{$.GenericTypeParametersAndConstraints}} struct {
+	*{{$.Name}}Expectation
+	fake *{{$.Name}}{{$.GenericTypeParameters}}
+	{{- if .Params.HasLength}}
+	args    []interface{}
+	matcher func({{.Params.AsArgs}}) bool
+	{{- end}}
+	{{- if .Returns.HasLength}}
+	returns struct {
+		{{- range .Returns}}
+		{{UnExport .Name}} {{.Type}}
+		{{- end}}
+	}
+	hasReturns bool
+	{{- end}}
+}
+
+func (expectation *{{$.Name}}{{Title .Name}}Expectation{{$.GenericTypeParameters}}) Times(n int) *{{$.Name}}{{Title .Name}}Expectation{{$.GenericTypeParameters}} {
+	expectation.fake.setExpectedCalls(expectation.{{$.Name}}Expectation, n, n)
+	return expectation
+}
+
+func (expectation *{{$.Name}}{{Title .Name}}Expectation{{$.GenericTypeParameters}}) MinTimes(n int) *{{$.Name}}{{Title .Name}}Expectation{{$.GenericTypeParameters}} {
+	expectation.fake.setExpectedCalls(expectation.{{$.Name}}Expectation, n, -1)
+	return expectation
+}
+
+func (expectation *{{$.Name}}{{Title .Name}}Expectation{{$.GenericTypeParameters}}) MaxTimes(n int) *{{$.Name}}{{Title .Name}}Expectation{{$.GenericTypeParameters}} {
+	expectation.fake.setExpectedCalls(expectation.{{$.Name}}Expectation, 0, n)
+	return expectation
+}
+
+func (expectation *{{$.Name}}{{Title .Name}}Expectation{{$.GenericTypeParameters}}) TimesBetween(min int, max int) *{{$.Name}}{{Title .Name}}Expectation{{$.GenericTypeParameters}} {
+	expectation.fake.setExpectedCalls(expectation.{{$.Name}}Expectation, min, max)
+	return expectation
+}
+
+func (expectation *{{$.Name}}{{Title .Name}}Expectation{{$.GenericTypeParameters}}) AnyTimes() *{{$.Name}}{{Title .Name}}Expectation{{$.GenericTypeParameters}} {
+	expectation.fake.setExpectedCalls(expectation.{{$.Name}}Expectation, 0, -1)
+	return expectation
+}
+{{- if .Returns.HasLength}}
+
+func (expectation *{{$.Name}}{{Title .Name}}Expectation{{$.GenericTypeParameters}}) Return({{.Returns.AsNamedArgsWithTypes}}) *{{$.Name}}{{Title .Name}}Expectation{{$.GenericTypeParameters}} {
+	expectation.fake.expectationsMutex.Lock()
+	defer expectation.fake.expectationsMutex.Unlock()
+	expectation.returns = struct {
+		{{- range .Returns}}
+		{{UnExport .Name}} {{.Type}}
+		{{- end}}
+	}{ {{- .Returns.AsNamedArgs -}} }
+	expectation.hasReturns = true
+	return expectation
+}
+{{- end}}
+
+{{end -}}
 func (fake *{{$.Name}}{{$.GenericTypeParameters}}) {{.Name}}({{.Params.AsNamedArgsWithTypes}}) {{.Returns.AsReturnSignature}} {
-	{{- range .Params.Slices}}
+	{{- range .Params}}
+	{{- if .DeepCopier}}
+	{{UnExport .Name}}Copy := fake.{{.DeepCopier}}({{UnExport .Name}}, map[interface{}]interface{}{})
+	{{- else if .IsSlice}}
 	var {{UnExport .Name}}Copy {{.Type}}
 	if {{UnExport .Name}} != nil {
 		{{UnExport .Name}}Copy = make({{.Type}}, len({{UnExport .Name}}))
 		copy({{UnExport .Name}}Copy, {{UnExport .Name}})
 	}
 	{{- end}}
+	{{- end}}
 	fake.{{UnExport .Name}}Mutex.Lock()
 	{{- if .Returns.HasLength}}
+	{{- if $.Strict}}
+	call := len(fake.{{UnExport .Name}}ArgsForCall)
+	{{- end}}
 	ret, specificReturn := fake.{{UnExport .Name}}ReturnsOnCall[len(fake.{{UnExport .Name}}ArgsForCall)]
 	{{- end}}
 	fake.{{UnExport .Name}}ArgsForCall = append(fake.{{UnExport .Name}}ArgsForCall, struct{
@@ -75,20 +223,86 @@ func (fake *{{$.Name}}{{$.GenericTypeParameters}}) {{.Name}}({{.Params.AsNamedAr
 		{{- end}}
 	}{ {{- .Params.AsNamedArgs -}} })
 	stub := fake.{{.Name}}Stub
+	{{- if .Params.HasLength}}
+	whens := fake.{{UnExport .Name}}When
+	{{- end}}
+	{{- if $.Delegate}}
+	delegate := fake.Delegate
+	{{- end}}
+	{{- if .Params.HasOutParams}}
+	setsArgs := fake.{{UnExport .Name}}SetsArgs
+	{{- end}}
 	{{- if .Returns.HasLength}}
 	fakeReturns := fake.{{UnExport .Name}}Returns
+	{{- if $.TracksConfiguredReturns}}
+	returnsConfigured := fake.{{UnExport .Name}}ReturnsConfigured
+	{{- end}}
 	{{- end}}
 	fake.recordInvocation("{{.Name}}", []interface{}{ {{- if .Params.HasLength}}{{.Params.AsNamedArgs}}{{end -}} })
 	fake.{{UnExport .Name}}Mutex.Unlock()
+	{{- if .Params.HasOutParams}}
+	if len(setsArgs) > 0 {
+		args := []interface{}{ {{- range .Params}}{{if not .IsVariadic}}{{UnExport .Name}}, {{end}}{{end -}} }
+		{{- range .Params}}
+		{{- if .IsVariadic}}
+		for _, arg := range {{UnExport .Name}} {
+			args = append(args, arg)
+		}
+		{{- end}}
+		{{- end}}
+		fake.setArgs("{{.Name}}", setsArgs, args)
+	}
+	{{- end}}
+	{{- if $.Expectations}}
+	{{- if .Returns.HasLength}}
+	if expectation, ok := fake.match{{Title .Name}}Expectation({{.Params.AsNamedArgsForInvocation}}); ok && expectation.hasReturns {
+		return {{.Returns.WithPrefix "expectation.returns."}}
+	}
+	{{- else}}
+	fake.match{{Title .Name}}Expectation({{.Params.AsNamedArgsForInvocation}})
+	{{- end}}
+	{{- end}}
+	{{- if .Params.HasLength}}
+	for i := len(whens) - 1; i >= 0; i-- {
+		if whens[i].matcher({{.Params.AsNamedArgsForInvocation}}) {
+			{{- if .Returns.HasLength}}
+			return whens[i].stub({{.Params.AsNamedArgsForInvocation}})
+			{{- else}}
+			whens[i].stub({{.Params.AsNamedArgsForInvocation}})
+			return
+			{{- end}}
+		}
+	}
+	{{- end}}
 	if stub != nil {
 		{{- if .Returns.HasLength}}
 		return stub({{.Params.AsNamedArgsForInvocation}}){{else}}fake.{{.Name}}Stub({{.Params.AsNamedArgsForInvocation}})
+		{{- if $.Delegate}}
+		return
 		{{- end}}
+		{{- end}}
+	}
+	{{- if and $.Delegate (not .Returns.HasLength)}}
+	if delegate != nil {
+		delegate.{{.Name}}({{.Params.AsNamedArgsForInvocation}})
 	}
+	{{- end}}
 	{{- if .Returns.HasLength}}
 	if specificReturn {
 		return {{.Returns.WithPrefix "ret."}}
 	}
+	{{- if $.TracksConfiguredReturns}}
+	if !returnsConfigured {
+		{{- if $.Delegate}}
+		if delegate != nil {
+			return delegate.{{.Name}}({{.Params.AsNamedArgsForInvocation}})
+		}
+		{{- end}}
+		{{- if $.Strict}}
+		fake.unconfiguredCall("{{.Name}}", call, []interface{}{ {{- if .Params.HasLength}}{{.Params.AsNamedArgs}}{{end -}} })
+		{{- end}}
+	}
+	{{- end}}
 	return {{.Returns.WithPrefix "fakeReturns."}}
 	{{- end}}
 }
@@ -99,19 +313,62 @@ func (fake *{{$.Name}}{{$.GenericTypeParameters}}) {{Title .Name}}CallCount() in
 	return len(fake.{{UnExport .Name}}ArgsForCall)
 }
 
+func (fake *{{$.Name}}{{$.GenericTypeParameters}}) WaitFor{{Title .Name}}Calls(ctx context.Context, n int) error {
+	return fake.waitForCalls(ctx, fake.{{Title .Name}}CallCount, n)
+}
+
+func (fake *{{$.Name}}{{$.GenericTypeParameters}}) {{Title .Name}}CallsChan(ctx context.Context) <-chan []interface{} {
+	return fake.callsChan(ctx, "{{.Name}}")
+}
+
 func (fake *{{$.Name}}{{$.GenericTypeParameters}}) {{Title .Name}}Calls(stub func({{.Params.AsArgs}}) {{.Returns.AsReturnSignature}}) {
 	fake.{{UnExport .Name}}Mutex.Lock()
 	defer fake.{{UnExport .Name}}Mutex.Unlock()
 	fake.{{.Name}}Stub = stub
 }
 
+{{if .Params.HasOutParams -}}
+func (fake *{{$.Name}}{{$.GenericTypeParameters}}) {{Title .Name}}SetsArg(i int, value interface{}) {
+	fake.{{UnExport .Name}}Mutex.Lock()
+	defer fake.{{UnExport .Name}}Mutex.Unlock()
+	setsArgs := map[int]interface{}{i: value}
+	for j, v := range fake.{{UnExport .Name}}SetsArgs {
+		if j != i {
+			setsArgs[j] = v
+		}
+	}
+	fake.{{UnExport .Name}}SetsArgs = setsArgs
+}
+
+{{end -}}
 {{if .Params.HasLength -}}
+// {{Title .Name}}CallsWhen calls the stub for the calls whose arguments match.
+// Matchers are checked before the stub, and the matcher registered last wins.
+func (fake *{{$.Name}}{{$.GenericTypeParameters}}) {{Title .Name}}CallsWhen(matcher func({{.Params.AsArgs}}) bool, stub func({{.Params.AsArgs}}) {{.Returns.AsReturnSignature}}) {
+	fake.{{UnExport .Name}}Mutex.Lock()
+	defer fake.{{UnExport .Name}}Mutex.Unlock()
+	fake.{{UnExport .Name}}When = append(fake.{{UnExport .Name}}When, struct {
+		matcher func({{.Params.AsArgs}}) bool
+		stub func({{.Params.AsArgs}}) {{.Returns.AsReturnSignature}}
+	}{matcher, stub})
+}
+
 func (fake *{{$.Name}}{{$.GenericTypeParameters}}) {{Title .Name}}ArgsForCall(i int) {{.Params.AsReturnSignature}} {
 	fake.{{UnExport .Name}}Mutex.RLock()
 	defer fake.{{UnExport .Name}}Mutex.RUnlock()
 	argsForCall := fake.{{UnExport .Name}}ArgsForCall[i]
 	return {{.Params.WithPrefix "argsForCall."}}
 }
+
+func (fake *{{$.Name}}{{$.GenericTypeParameters}}) {{Title .Name}}CallHistory() []{{$.Name}}{{Title .Name}}Call{{$.GenericTypeParameters}} {
+	fake.{{UnExport .Name}}Mutex.RLock()
+	defer fake.{{UnExport .Name}}Mutex.RUnlock()
+	history := make([]{{$.Name}}{{Title .Name}}Call{{$.GenericTypeParameters}}, len(fake.{{UnExport .Name}}ArgsForCall))
+	for i, argsForCall := range fake.{{UnExport .Name}}ArgsForCall {
+		history[i] = {{$.Name}}{{Title .Name}}Call{{$.GenericTypeParameters}}{ {{- .Params.WithPrefix "argsForCall."}}}
+	}
+	return history
+}
 {{- end}}
 
 {{if .Returns.HasLength -}}
@@ -124,6 +381,9 @@ func (fake *{{$.Name}}{{$.GenericTypeParameters}}) {{Title .Name}}Returns({{.Ret
 		{{UnExport .Name}} {{.Type}}
 		{{- end}}
 	}{ {{- .Returns.AsNamedArgs -}} }
+	{{- if $.TracksConfiguredReturns}}
+	fake.{{UnExport .Name}}ReturnsConfigured = true
+	{{- end}}
 }
 
 func (fake *{{$.Name}}{{$.GenericTypeParameters}}) {{Title .Name}}ReturnsOnCall(i int, {{.Returns.AsNamedArgsWithTypes}}) {
@@ -144,9 +404,282 @@ func (fake *{{$.Name}}{{$.GenericTypeParameters}}) {{Title .Name}}ReturnsOnCall(
 	}{ {{- .Returns.AsNamedArgs -}} }
 }
 
+{{if .Params.HasLength -}}
+// {{Title .Name}}ReturnsForArgs returns the values for the calls with the given
+// arguments. Like {{Title .Name}}CallsWhen, it is checked before the stub, and the
+// arguments or matcher registered last win.
+func (fake *{{$.Name}}{{$.GenericTypeParameters}}) {{Title .Name}}ReturnsForArgs({{.Params.AsNamedArgsWithTypes}}) func({{.Returns.AsArgs}}) {
+	args := []interface{}{ {{- .Params.WithPrefix ""}}}
+	return func({{.Returns.AsNamedArgsWithTypes}}) {
+		fake.{{UnExport .Name}}Mutex.Lock()
+		defer fake.{{UnExport .Name}}Mutex.Unlock()
+		fake.{{.Name}}Stub = nil
+		fake.{{UnExport .Name}}When = append(fake.{{UnExport .Name}}When, struct {
+			matcher func({{.Params.AsArgs}}) bool
+			stub func({{.Params.AsArgs}}) {{.Returns.AsReturnSignature}}
+		}{
+			func({{.Params.AsNamedArgsWithTypes}}) bool {
+				return fake.argsMatch(args, []interface{}{ {{- .Params.WithPrefix ""}}})
+			},
+			func({{.Params.AsArgs}}) {{.Returns.AsReturnSignature}} {
+				return {{.Returns.AsNamedArgs}}
+			},
+		})
+	}
+}
+
+// {{Title .Name}}ReturnsWhen returns the values for the calls whose arguments
+// match. Like {{Title .Name}}CallsWhen, it is checked before the stub, and the
+// matcher registered last wins.
+func (fake *{{$.Name}}{{$.GenericTypeParameters}}) {{Title .Name}}ReturnsWhen(matcher func({{.Params.AsArgs}}) bool, {{.Returns.AsNamedArgsWithTypes}}) {
+	fake.{{Title .Name}}CallsWhen(matcher, func({{.Params.AsArgs}}) {{.Returns.AsReturnSignature}} {
+		return {{.Returns.AsNamedArgs}}
+	})
+}
+
+{{end -}}
+{{end -}}
+{{if $.Expectations -}}
+func (fake *{{$.Name}}{{$.GenericTypeParameters}}) Expect{{Title .Name}}({{.Params.AsNamedArgsWithTypes}}) *{{$.Name}}{{Title .Name}}Expectation{{$.GenericTypeParameters}} {
+	{{- if .Params.HasLength}}
+	args := []interface{}{ {{- .Params.WithPrefix ""}}}
+	return fake.expect{{Title .Name}}(args, fmt.Sprintf("{{.Name}} with arguments %v", args), nil)
+	{{- else}}
+	return fake.expect{{Title .Name}}("{{.Name}}")
+	{{- end}}
+}
+
+{{if .Params.HasLength -}}
+func (fake *{{$.Name}}{{$.GenericTypeParameters}}) Expect{{Title .Name}}When(matcher func({{.Params.AsArgs}}) bool) *{{$.Name}}{{Title .Name}}Expectation{{$.GenericTypeParameters}} {
+	return fake.expect{{Title .Name}}(nil, "{{.Name}} with matching arguments", matcher)
+}
+
 {{end -}}
+func (fake *{{$.Name}}{{$.GenericTypeParameters}}) expect{{Title .Name}}({{if .Params.HasLength}}args []interface{}, description string, matcher func({{.Params.AsArgs}}) bool{{else}}description string{{end}}) *{{$.Name}}{{Title .Name}}Expectation{{$.GenericTypeParameters}} {
+	fake.expectationsMutex.Lock()
+	defer fake.expectationsMutex.Unlock()
+	expectation := &{{$.Name}}{{Title .Name}}Expectation{{$.GenericTypeParameters}}{
+		{{$.Name}}Expectation: &{{$.Name}}Expectation{method: "{{.Name}}", description: description, min: 1, max: 1},
+		fake:                  fake,
+		{{- if .Params.HasLength}}
+		args:                  args,
+		matcher:               matcher,
+		{{- end}}
+	}
+	fake.expectations = append(fake.expectations, expectation.{{$.Name}}Expectation)
+	fake.{{UnExport .Name}}Expectations = append(fake.{{UnExport .Name}}Expectations, expectation)
+	return expectation
+}
+
+func (fake *{{$.Name}}{{$.GenericTypeParameters}}) match{{Title .Name}}Expectation({{.Params.AsNamedArgsWithTypes}}) ({{$.Name}}{{Title .Name}}Expectation{{$.GenericTypeParameters}}, bool) {
+	fake.expectationsMutex.Lock()
+	defer fake.expectationsMutex.Unlock()
+	{{- if .Params.HasLength}}
+	args := []interface{}{ {{- .Params.WithPrefix ""}}}
+	{{- end}}
+	for _, expectation := range fake.{{UnExport .Name}}Expectations {
+		if !fake.expectationCanMatch(expectation.{{$.Name}}Expectation) {
+			continue
+		}
+		{{- if .Params.HasLength}}
+		if expectation.matcher != nil && !expectation.matcher({{.Params.AsNamedArgsForInvocation}}) || expectation.matcher == nil && !reflect.DeepEqual(expectation.args, args) {
+			continue
+		}
+		{{- end}}
+		expectation.calls++
+		return *expectation, true
+	}
+	if len(fake.{{UnExport .Name}}Expectations) > 0 {
+		fake.unexpectedCalls = append(fake.unexpectedCalls, struct {
+			method string
+			args   []interface{}
+		}{"{{.Name}}", {{if .Params.HasLength}}args{{else}}nil{{end}}})
+	}
+	return {{$.Name}}{{Title .Name}}Expectation{{$.GenericTypeParameters}}{}, false
+}
+
+{{end -}}
+{{if $.ResetHelper .Name "" -}}
+func (fake *{{$.Name}}{{$.GenericTypeParameters}}) {{$.ResetHelper .Name ""}}() {
+	fake.{{$.ResetHelper .Name "Calls"}}()
+	fake.{{$.ResetHelper .Name "Stubs"}}()
+}
+
+{{end -}}
+func (fake *{{$.Name}}{{$.GenericTypeParameters}}) {{$.ResetHelper .Name "Calls"}}() {
+	fake.{{UnExport .Name}}Mutex.Lock()
+	defer fake.{{UnExport .Name}}Mutex.Unlock()
+	fake.{{UnExport .Name}}ArgsForCall = nil
+	fake.forgetInvocations("{{.Name}}")
+}
+
+func (fake *{{$.Name}}{{$.GenericTypeParameters}}) {{$.ResetHelper .Name "Stubs"}}() {
+	fake.{{UnExport .Name}}Mutex.Lock()
+	defer fake.{{UnExport .Name}}Mutex.Unlock()
+	fake.{{.Name}}Stub = nil
+	{{- if .Params.HasLength}}
+	fake.{{UnExport .Name}}When = nil
+	{{- end}}
+	{{- if .Params.HasOutParams}}
+	fake.{{UnExport .Name}}SetsArgs = nil
+	{{- end}}
+	{{- if .Returns.HasLength}}
+	fake.{{UnExport .Name}}Returns = struct {
+		{{- range .Returns}}
+		{{UnExport .Name}} {{.Type}}
+		{{- end}}
+	}{}
+	fake.{{UnExport .Name}}ReturnsOnCall = nil
+	{{- if $.TracksConfiguredReturns}}
+	fake.{{UnExport .Name}}ReturnsConfigured = false
+	{{- end}}
+	{{- end}}
+	{{- if $.Expectations}}
+	fake.expectationsMutex.Lock()
+	defer fake.expectationsMutex.Unlock()
+	fake.{{UnExport .Name}}Expectations = nil
+	fake.forgetExpectations("{{.Name}}")
+	{{- end}}
+}
+
+{{end}}
+
+{{- if not (.HasMethod "Reset")}}
+func (fake *{{.Name}}{{$.GenericTypeParameters}}) Reset() {
+	{{- if or (.HasResetHelper "ResetCalls") (.HasResetHelper "ResetStubs")}}
+	{{- range .Methods}}
+	fake.{{$.ResetHelper .Name "Calls"}}()
+	fake.{{$.ResetHelper .Name "Stubs"}}()
+	{{- end}}
+	{{- else}}
+	fake.ResetCalls()
+	fake.ResetStubs()
+	{{- end}}
+}
+{{end}}
+
+{{- if not (.HasResetHelper "ResetCalls")}}
+func (fake *{{.Name}}{{$.GenericTypeParameters}}) ResetCalls() {
+	{{- range .Methods}}
+	fake.{{$.ResetHelper .Name "Calls"}}()
+	{{- end}}
+}
+{{end}}
+
+{{- if not (.HasResetHelper "ResetStubs")}}
+func (fake *{{.Name}}{{$.GenericTypeParameters}}) ResetStubs() {
+	{{- range .Methods}}
+	fake.{{$.ResetHelper .Name "Stubs"}}()
+	{{- end}}
+}
 {{end}}
 
+{{- if .Expectations}}
+{{- if not (.HasMethod "OrderExpectations")}}
+func (fake *{{.Name}}{{$.GenericTypeParameters}}) OrderExpectations() {
+	fake.expectationsMutex.Lock()
+	defer fake.expectationsMutex.Unlock()
+	fake.expectationsInOrder = true
+}
+{{end}}
+
+{{- if not (.HasMethod "VerifyExpectations")}}
+func (fake *{{.Name}}{{$.GenericTypeParameters}}) VerifyExpectations() error {
+	return fake.verifyExpectations()
+}
+{{end}}
+
+{{- if not (.HasMethod "ResetExpectations")}}
+func (fake *{{.Name}}{{$.GenericTypeParameters}}) ResetExpectations() {
+	fake.expectationsMutex.Lock()
+	defer fake.expectationsMutex.Unlock()
+	{{- range .Methods}}
+	fake.{{UnExport .Name}}Expectations = nil
+	{{- end}}
+	fake.expectations = nil
+	fake.expectationsInOrder = false
+	fake.unexpectedCalls = nil
+}
+{{end}}
+
+func (fake *{{.Name}}{{$.GenericTypeParameters}}) verifyExpectations() error {
+	fake.expectationsMutex.Lock()
+	defer fake.expectationsMutex.Unlock()
+	var problems []string
+	for _, expectation := range fake.expectations {
+		if expectation.calls < expectation.min {
+			problems = append(problems, fmt.Sprintf("{{.Name}}.%s: expected %s, but got %d", expectation.description, expectation.expectedCalls(), expectation.calls))
+		}
+	}
+	for _, call := range fake.unexpectedCalls {
+		problems = append(problems, fmt.Sprintf("{{.Name}}.%s: unexpected call with arguments %v", call.method, call.args))
+	}
+	if len(problems) == 0 {
+		return nil
+	}
+	return errors.New(strings.Join(problems, "\n"))
+}
+
+func (fake *{{.Name}}{{$.GenericTypeParameters}}) setExpectedCalls(expectation *{{.Name}}Expectation, min int, max int) {
+	fake.expectationsMutex.Lock()
+	defer fake.expectationsMutex.Unlock()
+	expectation.min = min
+	expectation.max = max
+}
+
+func (fake *{{.Name}}{{$.GenericTypeParameters}}) expectationCanMatch(expectation *{{.Name}}Expectation) bool {
+	if expectation.max >= 0 && expectation.calls >= expectation.max {
+		return false
+	}
+	if !fake.expectationsInOrder {
+		return true
+	}
+	for _, previous := range fake.expectations {
+		if previous == expectation {
+			break
+		}
+		if previous.calls < previous.min {
+			return false
+		}
+	}
+	return true
+}
+
+func (fake *{{.Name}}{{$.GenericTypeParameters}}) forgetExpectations(method string) {
+	var expectations []*{{.Name}}Expectation
+	for _, expectation := range fake.expectations {
+		if expectation.method != method {
+			expectations = append(expectations, expectation)
+		}
+	}
+	fake.expectations = expectations
+	var unexpectedCalls []struct {
+		method string
+		args   []interface{}
+	}
+	for _, call := range fake.unexpectedCalls {
+		if call.method != method {
+			unexpectedCalls = append(unexpectedCalls, call)
+		}
+	}
+	fake.unexpectedCalls = unexpectedCalls
+}
+
+func (expectation *{{.Name}}Expectation) expectedCalls() string {
+	calls := "calls"
+	if expectation.min == 1 && (expectation.max == 1 || expectation.max < 0) {
+		calls = "call"
+	}
+	switch {
+	case expectation.max < 0:
+		return fmt.Sprintf("at least %d %s", expectation.min, calls)
+	case expectation.min == expectation.max:
+		return fmt.Sprintf("%d %s", expectation.min, calls)
+	default:
+		return fmt.Sprintf("between %d and %d %s", expectation.min, expectation.max, calls)
+	}
+}
+{{end}}
 func (fake *{{.Name}}{{$.GenericTypeParameters}}) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -157,6 +690,191 @@ func (fake *{{.Name}}{{$.GenericTypeParameters}}) Invocations() map[string][][]i
 	return copiedInvocations
 }
 
+{{if .HasOutParams -}}
+func (fake *{{.Name}}{{$.GenericTypeParameters}}) setArgs(method string, values map[int]interface{}, args []interface{}) {
+	for i, value := range values {
+		if i < 0 || i >= len(args) {
+			panic(fmt.Sprintf("{{.Name}}.%s: cannot set argument %d, because it was called with %d arguments", method, i, len(args)))
+		}
+		target := reflect.ValueOf(args[i])
+		if target.Kind() != reflect.Ptr || target.IsNil() {
+			panic(fmt.Sprintf("{{.Name}}.%s: cannot set argument %d, because it is %T instead of a non-nil pointer", method, i, args[i]))
+		}
+		v := reflect.ValueOf(value)
+		if !v.IsValid() {
+			v = reflect.Zero(target.Elem().Type())
+		}
+		if !v.Type().AssignableTo(target.Elem().Type()) {
+			panic(fmt.Sprintf("{{.Name}}.%s: cannot set argument %d, because %T is not assignable to %s", method, i, value, target.Elem().Type()))
+		}
+		target.Elem().Set(v)
+	}
+}
+
+{{end -}}
+{{if .HasReturnsForArgs -}}
+func (fake *{{.Name}}{{$.GenericTypeParameters}}) argsMatch(expected []interface{}, actual []interface{}) bool {
+	if len(expected) != len(actual) {
+		return false
+	}
+	for i := range expected {
+		if fake.ArgsComparer != nil && !fake.ArgsComparer(expected[i], actual[i]) || fake.ArgsComparer == nil && !reflect.DeepEqual(expected[i], actual[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+{{end -}}
+{{if .Strict -}}
+func (fake *{{.Name}}{{$.GenericTypeParameters}}) unconfiguredCall(method string, call int, args []interface{}) {
+	if fake.StrictHandler == nil {
+		panic(fmt.Sprintf("{{.Name}}.%s: call %d with arguments %v has no stub or return values configured", method, call, args))
+	}
+	fake.StrictHandler(method, call, args)
+}
+
+{{if .Constructor -}}
+func (fake *{{.Name}}{{$.GenericTypeParameters}}) recordStrictViolation(method string, call int, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	fake.strictViolations = append(fake.strictViolations, fmt.Sprintf("{{.Name}}.%s: call %d with arguments %v has no stub or return values configured", method, call, args))
+}
+
+{{end -}}
+{{end -}}
+func (fake *{{.Name}}{{$.GenericTypeParameters}}) OrderedInvocations() []struct {
+	Seq    uint64
+	Method string
+	Args   []interface{}
+} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	return append([]struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}{}, fake.orderedInvocations...)
+}
+
+func (fake *{{.Name}}{{$.GenericTypeParameters}}) SetSequencer(sequencer *atomic.Uint64) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	fake.sequencer = sequencer
+}
+
+func (fake *{{.Name}}{{$.GenericTypeParameters}}) waitForCalls(ctx context.Context, callCount func() int, n int) error {
+	for {
+		fake.invocationsMutex.Lock()
+		if fake.invocationsChanged == nil {
+			fake.invocationsChanged = make(chan struct{})
+		}
+		changed := fake.invocationsChanged
+		fake.invocationsMutex.Unlock()
+		if callCount() >= n {
+			return nil
+		}
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (fake *{{.Name}}{{$.GenericTypeParameters}}) callsChan(ctx context.Context, key string) <-chan []interface{} {
+	ctx, cancel := context.WithCancel(ctx)
+	calls := make(chan []interface{})
+	ready := make(chan struct{}, 1)
+	var pendingMutex sync.Mutex
+	var pending [][]interface{}
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.callsSubscribers == nil {
+		fake.callsSubscribers = map[string][]struct {
+			publish func(args []interface{})
+			cancel  context.CancelFunc
+			done    <-chan struct{}
+		}{}
+	}
+	fake.callsSubscribers[key] = append(fake.callsSubscribers[key], struct {
+		publish func(args []interface{})
+		cancel  context.CancelFunc
+		done    <-chan struct{}
+	}{func(args []interface{}) {
+		pendingMutex.Lock()
+		pending = append(pending, args)
+		pendingMutex.Unlock()
+		select {
+		case ready <- struct{}{}:
+		default:
+		}
+	}, cancel, ctx.Done()})
+	go func() {
+		defer close(calls)
+		for {
+			select {
+			case <-ready:
+			case <-ctx.Done():
+				return
+			}
+			pendingMutex.Lock()
+			queued := pending
+			pending = nil
+			pendingMutex.Unlock()
+			for _, args := range queued {
+				select {
+				case calls <- args:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return calls
+}
+
+{{if .Constructor -}}
+func (fake *{{.Name}}{{$.GenericTypeParameters}}) verify(t testing.TB) {
+	t.Helper()
+	{{- range .Methods}}
+	{{- if .Returns.HasLength}}
+	fake.{{UnExport .Name}}Mutex.RLock()
+	var unused{{.Name}}Returns []int
+	for call := range fake.{{UnExport .Name}}ReturnsOnCall {
+		if call >= len(fake.{{UnExport .Name}}ArgsForCall) {
+			unused{{.Name}}Returns = append(unused{{.Name}}Returns, call)
+		}
+	}
+	fake.{{UnExport .Name}}Mutex.RUnlock()
+	fake.reportUnusedReturns(t, "{{.Name}}", unused{{.Name}}Returns)
+	{{- end}}
+	{{- end}}
+	{{- if .Strict}}
+	fake.invocationsMutex.RLock()
+	strictViolations := fake.strictViolations
+	fake.invocationsMutex.RUnlock()
+	for _, violation := range strictViolations {
+		t.Error(violation)
+	}
+	{{- end}}
+	{{- if .Expectations}}
+	if err := fake.verifyExpectations(); err != nil {
+		t.Error(err)
+	}
+	{{- end}}
+}
+
+func (fake *{{.Name}}{{$.GenericTypeParameters}}) reportUnusedReturns(t testing.TB, method string, calls []int) {
+	t.Helper()
+	if len(calls) == 0 {
+		return
+	}
+	sort.Ints(calls)
+	t.Errorf("{{.Name}}.%s: return values were configured for calls %v, which were never made", method, calls)
+}
+
+{{end -}}
 func (fake *{{.Name}}{{$.GenericTypeParameters}}) recordInvocation(key string, args []interface{}) {
 	fake.invocationsMutex.Lock()
 	defer fake.invocationsMutex.Unlock()
@@ -167,8 +885,61 @@ func (fake *{{.Name}}{{$.GenericTypeParameters}}) recordInvocation(key string, a
 		fake.invocations[key] = [][]interface{}{}
 	}
 	fake.invocations[key] = append(fake.invocations[key], args)
+	if fake.sequencer == nil {
+		fake.sequencer = new(atomic.Uint64)
+	}
+	fake.orderedInvocations = append(fake.orderedInvocations, struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}{fake.sequencer.Add(1), key, args})
+	if fake.invocationsChanged != nil {
+		close(fake.invocationsChanged)
+		fake.invocationsChanged = nil
+	}
+	subscribers := fake.callsSubscribers[key][:0]
+	for _, subscriber := range fake.callsSubscribers[key] {
+		select {
+		case <-subscriber.done:
+			continue
+		default:
+		}
+		subscriber.publish(args)
+		subscribers = append(subscribers, subscriber)
+	}
+	if len(subscribers) > 0 {
+		fake.callsSubscribers[key] = subscribers
+	} else {
+		delete(fake.callsSubscribers, key)
+	}
+}
+
+func (fake *{{.Name}}{{$.GenericTypeParameters}}) forgetInvocations(key string) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	delete(fake.invocations, key)
+	for _, subscriber := range fake.callsSubscribers[key] {
+		subscriber.cancel()
+	}
+	delete(fake.callsSubscribers, key)
+	var orderedInvocations []struct {
+		Seq    uint64
+		Method string
+		Args   []interface{}
+	}
+	for _, invocation := range fake.orderedInvocations {
+		if invocation.Method != key {
+			orderedInvocations = append(orderedInvocations, invocation)
+		}
+	}
+	fake.orderedInvocations = orderedInvocations
 }
 
+{{range .DeepCopiers -}}
+func (fake *{{$.Name}}{{$.GenericTypeParameters}}) {{.Name}}(v {{.Type}}, copies map[interface{}]interface{}) {{.Type}} {
+{{.Body}}}
+
+{{end -}}
 {{if IsExported .TargetName -}}
 {{if not (HasConstraintInterface .) -}}
 var _ {{.TargetAlias}}.{{.TargetName}}{{.GenericTypeConstraints}} = new({{.Name}}{{.GenericTypeConstraints}})
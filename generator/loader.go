@@ -116,7 +116,7 @@ func (f *Fake) findPackage() error {
 		switch f.Mode {
 		case Package:
 			return fmt.Errorf("cannot find package with name: %s", f.TargetPackage)
-		case InterfaceOrFunction:
+		case InterfaceOrFunction, Extract:
 			return fmt.Errorf("cannot find package with target: %s", f.TargetName)
 		}
 	}
@@ -128,6 +128,9 @@ func (f *Fake) findPackage() error {
 		f.GenericTypeParameters = fmt.Sprintf("[%s]", strings.Join(genericTypeParameters, ", "))
 		f.GenericTypeConstraints = fmt.Sprintf("[%s]", strings.Join(genericTypeConstraints, ", "))
 	}
+	// The imports of the template are added once the target is known, since
+	// they depend on its methods, but before its own import.
+	f.addStyleImports()
 	t := f.Imports.Add(pkg.Name, f.TargetPackage)
 	f.TargetAlias = t.Alias
 	if f.Mode != Package {
@@ -144,6 +147,21 @@ func (f *Fake) findPackage() error {
 		}
 	}
 
+	if f.Mode == Extract {
+		if f.IsInterface() {
+			return fmt.Errorf("cannot extract an interface from %s because it is already an interface", f.TargetName)
+		}
+		named, ok := types.Unalias(target.Type()).(*types.Named)
+		if !ok {
+			return fmt.Errorf("cannot extract an interface from %s because it is not a named type", f.TargetName)
+		}
+		if named.TypeParams().Len() > 0 {
+			return fmt.Errorf("cannot extract an interface from %s because it is generic", f.TargetName)
+		}
+		log.Printf("Found type with name: [%s]\n", f.TargetName)
+		return nil
+	}
+
 	if f.IsInterface() {
 		log.Printf("Found interface with name: [%s]\n", f.TargetName)
 	}
@@ -0,0 +1,152 @@
+package generator
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Option configures optional behavior of the generated fake.
+type Option func(*Fake)
+
+// Strict makes the generated fake fail when a method that returns values is
+// called without a stub or return values having been configured, instead of
+// silently returning zero values.
+func Strict() Option {
+	return func(f *Fake) {
+		f.Strict = true
+	}
+}
+
+// Delegate makes the generated fake forward calls without a configured stub or
+// return values to a real implementation, while still recording them.
+func Delegate() Option {
+	return func(f *Fake) {
+		f.Delegate = true
+	}
+}
+
+// DeepCopy makes the generated fake record deep copies of its arguments, so that
+// mutations made to them after a call do not change what the fake recorded.
+func DeepCopy() Option {
+	return func(f *Fake) {
+		f.DeepCopy = true
+	}
+}
+
+// Expectations makes the generated fake support declaring the calls it expects
+// up front, and verifying them once the code under test has run.
+func Expectations() Option {
+	return func(f *Fake) {
+		f.Expectations = true
+	}
+}
+
+// Constructor makes the generator render a constructor for the fake, which
+// takes a testing.TB and verifies the fake when the test completes.
+func Constructor() Option {
+	return func(f *Fake) {
+		f.Constructor = true
+	}
+}
+
+// Style makes the generator render a different flavor of fake, such as a
+// gomock style mock, from the same interface.
+func Style(style FakeStyle) Option {
+	return func(f *Fake) {
+		f.Style = style
+	}
+}
+
+// Include makes a package shim only contain the functions whose names match
+// the pattern.
+func Include(pattern *regexp.Regexp) Option {
+	return func(f *Fake) {
+		f.Include = pattern
+	}
+}
+
+// Exclude leaves the functions whose names match the pattern out of a package
+// shim.
+func Exclude(pattern *regexp.Regexp) Option {
+	return func(f *Fake) {
+		f.Exclude = pattern
+	}
+}
+
+// Combine adds the exported functions of more packages to a package shim.
+// Functions with the same name in more than one of the packages are prefixed
+// with their package name, as in OsReadFile and FsReadFile.
+func Combine(packagePaths ...string) Option {
+	return func(f *Fake) {
+		f.Combine = append(f.Combine, packagePaths...)
+	}
+}
+
+// Instantiate adds methods for instantiations of generic functions, such as
+// "Decode[User]", to a package shim. A method name can be given as in
+// "DecodeUser=Decode[User]".
+func Instantiate(instantiations ...string) Option {
+	return func(f *Fake) {
+		f.Instantiations = append(f.Instantiations, instantiations...)
+	}
+}
+
+// Variables adds a getter and a setter, such as Args and SetArgs, for each of
+// the exported variables of a package to a package shim.
+func Variables() Option {
+	return func(f *Fake) {
+		f.Variables = true
+	}
+}
+
+// WithDefault adds a package level DefaultMyShim to a package shim, which holds
+// the shim, and a SwapMyShimForTest function that replaces it with a fake in a
+// test.
+func WithDefault() Option {
+	return func(f *Fake) {
+		f.WithDefault = true
+	}
+}
+
+// Wrap makes a package shim return an interface of the exported methods of the
+// given concrete types, such as "os.File", instead of pointers to them. Types of
+// the target package can be named without their package, as in "File".
+func Wrap(typeNames ...string) Option {
+	return func(f *Fake) {
+		for _, name := range typeNames {
+			if name = strings.TrimSpace(name); name != "" {
+				f.WrapTypes = append(f.WrapTypes, name)
+			}
+		}
+	}
+}
+
+// WrapDepth sets how deep the types to wrap are found: at a depth of 1, the
+// default, among the results of the functions of a package shim, and at each
+// further level among the results of the methods of the types wrapped before.
+func WrapDepth(depth int) Option {
+	return func(f *Fake) {
+		f.WrapDepth = depth
+	}
+}
+
+// ParseNamePattern returns a pattern that matches whole names, from a comma
+// separated list of names or regular expressions, such as "Open,Stat,Read.*".
+func ParseNamePattern(list string) (*regexp.Regexp, error) {
+	var alternatives []string
+	for _, s := range strings.Split(list, ",") {
+		s = strings.TrimSpace(s)
+		if s == "" {
+			continue
+		}
+		if _, err := regexp.Compile(s); err != nil {
+			return nil, fmt.Errorf("invalid name pattern %q: %v", s, err)
+		}
+		alternatives = append(alternatives, "(?:"+s+")")
+	}
+	if len(alternatives) == 0 {
+		return nil, fmt.Errorf("invalid name pattern %q: it contains no names", list)
+	}
+	return regexp.Compile("^(?:" + strings.Join(alternatives, "|") + ")$")
+}
@@ -1,14 +1,67 @@
 package generator
 
 import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
 	"go/types"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
 
 	"golang.org/x/tools/go/packages"
+	"golang.org/x/tools/imports"
 )
 
 type rawMethod struct {
 	Func      *types.Func
 	Signature *types.Signature
+	Name      string       // the name of the method, if it differs from the name of Func
+	TypeArgs  []types.Type // the type arguments of an instantiation of a generic Func
+	Var       *types.Var   // the package variable read or written by a getter or setter Func
+}
+
+// isSetter indicates whether the method writes a package variable.
+func (m *rawMethod) isSetter() bool {
+	return m.Var != nil && m.Signature.Results().Len() == 0
+}
+
+// baseName is the name of the function, or of the variable of a getter or
+// setter, which a package shim prefixes when it is in more than one package.
+func (m *rawMethod) baseName() string {
+	if m.Var != nil {
+		return m.Var.Name()
+	}
+	return m.Func.Name()
+}
+
+// qualifiedName is the base name of the method qualified with the name of its
+// package, as in "os.Args".
+func (m *rawMethod) qualifiedName() string {
+	return m.Func.Pkg().Name() + "." + m.baseName()
+}
+
+// description describes the function, getter or setter of the method in an
+// error.
+func (m *rawMethod) description() string {
+	switch {
+	case m.isSetter():
+		return "the setter of the variable " + m.qualifiedName()
+	case m.Var != nil:
+		return "the getter of the variable " + m.qualifiedName()
+	default:
+		return "the function " + m.qualifiedName()
+	}
+}
+
+// SkippedFunction is an exported function that was left out of a package shim,
+// because it cannot be a method of an interface.
+type SkippedFunction struct {
+	Name   string
+	Reason string
 }
 
 // packageMethodSet identifies the functions that are exported from a given
@@ -40,3 +93,310 @@ func packageMethodSet(p *packages.Package) []*rawMethod {
 
 	return result
 }
+
+// packageVariables returns a getter and a setter, such as Args and SetArgs, for
+// each of the variables that are exported from a given package.
+func packageVariables(p *packages.Package) []*rawMethod {
+	if p == nil || p.Types == nil || p.Types.Scope() == nil {
+		return nil
+	}
+	var result []*rawMethod
+	scope := p.Types.Scope()
+	for _, name := range scope.Names() {
+		v, ok := scope.Lookup(name).(*types.Var)
+		if !ok || !v.Exported() {
+			continue
+		}
+		value := types.NewTuple(types.NewVar(token.NoPos, v.Pkg(), "", v.Type()))
+		getter := types.NewSignatureType(nil, nil, nil, nil, value, false)
+		setter := types.NewSignatureType(nil, nil, nil, value, nil, false)
+		result = append(result,
+			&rawMethod{Func: types.NewFunc(v.Pos(), v.Pkg(), name, getter), Signature: getter, Var: v},
+			&rawMethod{Func: types.NewFunc(v.Pos(), v.Pkg(), "Set"+name, setter), Signature: setter, Var: v},
+		)
+	}
+	return result
+}
+
+// loadCombinedPackages loads the packages whose exported functions are added to
+// a package shim along with the ones of the target package.
+func (f *Fake) loadCombinedPackages(c Cacher, workingDir string) error {
+	for _, path := range f.Combine {
+		combined := &Fake{Mode: Package, TargetPackage: path, Imports: newImports()}
+		err := combined.loadPackages(c, workingDir)
+		if err != nil {
+			return err
+		}
+		err = combined.findPackage()
+		if err != nil {
+			return err
+		}
+		f.Imports.Add(combined.Package.Name, combined.TargetPackage)
+		f.combined = append(f.combined, combined.Package)
+	}
+	return nil
+}
+
+// shimPackages returns the packages whose exported functions are added to a
+// package shim.
+func (f *Fake) shimPackages() []*packages.Package {
+	return append([]*packages.Package{f.Package}, f.combined...)
+}
+
+// packageMethods identifies the methods of a package shim: the exported
+// functions that pass the filters, followed by the requested instantiations of
+// generic functions. The functions that cannot be methods of an interface are
+// recorded in f.Skipped. When packages are combined, the functions with the
+// same name in more than one of them are prefixed with their package name.
+func (f *Fake) packageMethods() ([]*rawMethod, error) {
+	var instantiations []*rawMethod
+	instantiated := map[*types.Func]bool{}
+	for _, spec := range f.Instantiations {
+		m, err := f.instantiate(spec)
+		if err != nil {
+			return nil, err
+		}
+		instantiations = append(instantiations, m)
+		instantiated[m.Func] = true
+	}
+
+	var result []*rawMethod
+	count := map[string]int{}
+	for _, p := range f.shimPackages() {
+		methods := packageMethodSet(p)
+		if f.Variables {
+			methods = append(methods, packageVariables(p)...)
+		}
+		for _, m := range methods {
+			name := m.baseName()
+			if !f.includesFunction(name) {
+				continue
+			}
+			if len(f.combined) > 0 {
+				name = p.Name + "." + name
+			}
+			if m.Signature.TypeParams().Len() > 0 {
+				if !instantiated[m.Func] {
+					f.Skipped = append(f.Skipped, SkippedFunction{Name: name, Reason: "it is generic"})
+				}
+				continue
+			}
+			if typ, ok := unexportedTypeIn(m.Signature); ok {
+				if !m.isSetter() {
+					f.Skipped = append(f.Skipped, SkippedFunction{Name: name, Reason: "it uses the unexported type " + typ})
+				}
+				continue
+			}
+			result = append(result, m)
+			if !m.isSetter() {
+				count[m.baseName()]++
+			}
+		}
+	}
+
+	names := map[string]*rawMethod{}
+	for _, m := range result {
+		m.Name = m.Func.Name()
+		if count[m.baseName()] > 1 {
+			m.Name = packagePrefix(m.Func.Pkg()) + m.Name
+		}
+		if other, ok := names[m.Name]; ok {
+			if m.Var == nil && other.Var == nil {
+				return nil, fmt.Errorf("cannot combine the packages because the functions %s and %s would both be named %s", other.qualifiedName(), m.qualifiedName(), m.Name)
+			}
+			return nil, fmt.Errorf("cannot add the variables because %s and %s would both be named %s", other.description(), m.description(), m.Name)
+		}
+		names[m.Name] = m
+	}
+	for _, m := range instantiations {
+		if _, ok := names[m.Name]; ok {
+			return nil, fmt.Errorf("cannot instantiate %s as %s because the shim already has a method named %s", m.Func.Name(), m.Name, m.Name)
+		}
+		names[m.Name] = m
+	}
+	return append(result, instantiations...), nil
+}
+
+// ShimFake returns a Fake of the interface generated by a Package mode Fake,
+// which lives in the package of the shim with the given import path. The
+// interface is built from the methods that were already loaded, so the
+// packages are not loaded again.
+func (f *Fake) ShimFake(interfacePackage string, fakeName string, destinationPackage string, opts ...Option) (*Fake, error) {
+	if f.Mode != Package {
+		return nil, fmt.Errorf("cannot generate a fake of the interface for %s because it is not a package shim", f.TargetPackage)
+	}
+	return f.generatedInterfaceFake(interfacePackage, fakeName, destinationPackage, opts...)
+}
+
+// packagePrefix is the prefix of the name of a method for a function that
+// has the same name as a function of another combined package, such as Fs for
+// io/fs.
+func packagePrefix(p *types.Package) string {
+	r, n := utf8.DecodeRuneInString(p.Name())
+	return string(unicode.ToUpper(r)) + p.Name()[n:]
+}
+
+// instantiate finds the generic function and the type arguments of an
+// instantiation, such as "Decode[User]", which becomes a method named
+// DecodeUser, or "DecodeAsUser=Decode[User]". The function of a combined
+// package is qualified with its package name, as in "store.Decode[User]".
+func (f *Fake) instantiate(spec string) (*rawMethod, error) {
+	name, expr, ok := strings.Cut(spec, "=")
+	if !ok {
+		name, expr = "", spec
+	}
+	x, err := parser.ParseExpr(expr)
+	if err != nil {
+		return nil, fmt.Errorf("cannot instantiate %s: %v", expr, err)
+	}
+	var fun ast.Expr
+	var indices []ast.Expr
+	switch x := x.(type) {
+	case *ast.IndexExpr:
+		fun, indices = x.X, []ast.Expr{x.Index}
+	case *ast.IndexListExpr:
+		fun, indices = x.X, x.Indices
+	default:
+		return nil, fmt.Errorf("cannot instantiate %s because it has no type arguments", expr)
+	}
+	pkg := f.Package
+	ident, ok := fun.(*ast.Ident)
+	if sel, isSelector := fun.(*ast.SelectorExpr); isSelector {
+		pkg, ident = nil, sel.Sel
+		if x, isIdent := sel.X.(*ast.Ident); isIdent {
+			for _, p := range f.shimPackages() {
+				if p.Name == x.Name {
+					pkg, ok = p, true
+				}
+			}
+		}
+	}
+	if !ok {
+		return nil, fmt.Errorf("cannot instantiate %s because %s is not the name of a function", expr, types.ExprString(fun))
+	}
+	generic, ok := pkg.Types.Scope().Lookup(ident.Name).(*types.Func)
+	if !ok || !generic.Exported() {
+		return nil, fmt.Errorf("cannot instantiate %s because %s has no exported function named %s", expr, imports.VendorlessPath(pkg.PkgPath), ident.Name)
+	}
+	sig := generic.Type().(*types.Signature)
+	if sig.TypeParams().Len() == 0 {
+		return nil, fmt.Errorf("cannot instantiate %s because %s is not generic", expr, ident.Name)
+	}
+	if n := sig.TypeParams().Len(); len(indices) > n {
+		if n == 1 {
+			return nil, fmt.Errorf("cannot instantiate %s because %s has 1 type parameter", expr, ident.Name)
+		}
+		return nil, fmt.Errorf("cannot instantiate %s because %s has %d type parameters", expr, ident.Name, n)
+	}
+
+	scope := instantiationScope(pkg.Types)
+	var typeArgs []types.Type
+	for _, index := range indices {
+		tv, err := types.Eval(token.NewFileSet(), scope, token.NoPos, types.ExprString(index))
+		if terr, ok := err.(types.Error); ok {
+			return nil, fmt.Errorf("cannot instantiate %s: %s", expr, terr.Msg)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("cannot instantiate %s: %v", expr, err)
+		}
+		if !tv.IsType() {
+			return nil, fmt.Errorf("cannot instantiate %s because %s is not a type", expr, types.ExprString(index))
+		}
+		typeArgs = append(typeArgs, tv.Type)
+	}
+	if len(typeArgs) < sig.TypeParams().Len() {
+		typeArgs, err = inferTypeArgs(scope, ident.Name, indices)
+		if err != nil {
+			return nil, fmt.Errorf("cannot instantiate %s: %v", expr, err)
+		}
+	}
+	inst, err := types.Instantiate(nil, sig, typeArgs, true)
+	if err != nil {
+		return nil, fmt.Errorf("cannot instantiate %s: %v", expr, err)
+	}
+	if typ, ok := unexportedTypeIn(inst); ok {
+		return nil, fmt.Errorf("cannot instantiate %s because it uses the unexported type %s", expr, typ)
+	}
+	if name == "" {
+		name = instantiationName(ident.Name, indices)
+	}
+	return &rawMethod{
+		Func:      generic,
+		Signature: inst.(*types.Signature),
+		Name:      name,
+		TypeArgs:  typeArgs,
+	}, nil
+}
+
+// inferTypeArgs infers the trailing type arguments of an instantiation that
+// leaves them out, such as "Sort[[]int]" for slices.Sort[S ~[]E, E
+// cmp.Ordered], from the constraints of the type parameters.
+func inferTypeArgs(scope *types.Package, name string, indices []ast.Expr) ([]types.Type, error) {
+	args := make([]string, len(indices))
+	for i := range indices {
+		args[i] = types.ExprString(indices[i])
+	}
+	x, err := parser.ParseExpr(name + "[" + strings.Join(args, ", ") + "]")
+	if err != nil {
+		return nil, err
+	}
+	info := &types.Info{Instances: map[*ast.Ident]types.Instance{}}
+	if err := types.CheckExpr(token.NewFileSet(), scope, token.NoPos, x, info); err != nil {
+		if terr, ok := err.(types.Error); ok {
+			// The declarations of the package have no positions to report.
+			msg, _, _ := strings.Cut(terr.Msg, " (declared at")
+			return nil, errors.New(msg)
+		}
+		return nil, err
+	}
+	for _, inst := range info.Instances {
+		typeArgs := make([]types.Type, inst.TypeArgs.Len())
+		for i := range typeArgs {
+			typeArgs[i] = inst.TypeArgs.At(i)
+		}
+		return typeArgs, nil
+	}
+	return nil, fmt.Errorf("cannot infer the type arguments of %s", name)
+}
+
+// instantiationScope returns a package in which the type arguments of an
+// instantiation of a function of the target package are evaluated. It can
+// refer to the declarations of the target package, and to the packages it
+// depends on by their names, preferring its direct imports.
+func instantiationScope(target *types.Package) *types.Package {
+	pkg := types.NewPackage(target.Path()+".instantiate", target.Name())
+	scope := pkg.Scope()
+	for _, name := range target.Scope().Names() {
+		scope.Insert(target.Scope().Lookup(name))
+	}
+	scope.Insert(types.NewPkgName(token.NoPos, pkg, target.Name(), target))
+	seen := map[*types.Package]bool{target: true}
+	queue := target.Imports()
+	for len(queue) > 0 {
+		imp := queue[0]
+		queue = queue[1:]
+		if seen[imp] {
+			continue
+		}
+		seen[imp] = true
+		scope.Insert(types.NewPkgName(token.NoPos, pkg, imp.Name(), imp))
+		queue = append(queue, imp.Imports()...)
+	}
+	return pkg
+}
+
+var identifierRegexp = regexp.MustCompile(`[\pL\pN_]+`)
+
+// instantiationName names the method of an instantiation after the function
+// and the identifiers in its type arguments, so that Decode[[]store.User]
+// becomes DecodeStoreUser.
+func instantiationName(function string, typeArgs []ast.Expr) string {
+	name := function
+	for _, arg := range typeArgs {
+		for _, s := range identifierRegexp.FindAllString(types.ExprString(arg), -1) {
+			r, n := utf8.DecodeRuneInString(s)
+			name += string(unicode.ToUpper(r)) + s[n:]
+		}
+	}
+	return name
+}
@@ -22,10 +22,20 @@ import (
 )
 
 //{{Generate "go"}} go run github.com/maxbrunsfeld/counterfeiter/v6 -generate
-//{{Generate "counterfeiter"}} . {{.Name}}
+//{{Generate "counterfeiter"}} {{template "flags" .}}. {{.Name}}
+{{- range .Wrapped}}
+//{{Generate "counterfeiter"}} {{template "flags" $}}. {{.Name}}
+{{- end}}
 
 // {{.Name}} is a generated interface representing the exported functions
-// in the {{.TargetPackage}} package.
+// {{if .Variables}}and variables {{end}}in the {{.TargetPackage}}{{range .Combine}}, {{.}}{{end}} package{{if .Combine}}s{{end}}.
+{{- if .Skipped}}
+//
+// It leaves out the functions that cannot be methods of an interface:
+{{- range .Skipped}}
+//   - {{.Name}}, because {{.Reason}}
+{{- end}}
+{{- end}}
 type {{.Name}} interface {
   {{- range .Methods}}
   {{.Name}}({{.Params.AsNamedArgsWithTypes}}) {{.Returns.AsReturnSignature}}
@@ -36,8 +46,80 @@ type {{.Name}}Shim struct {}
 
 {{- range .Methods}}
 func (p *{{$.Name}}Shim) {{.Name}}({{.Params.AsNamedArgsWithTypes}}) {{.Returns.AsReturnSignature}} {
-  {{if .Returns.HasLength}}return {{end}}{{$.TargetAlias}}.{{.Name}}({{.Params.AsNamedArgsForInvocation}})
+  {{- template "call" .}}
 }
 {{end}}
 var _ {{.Name}} = new({{.Name}}Shim)
-`
+{{- if .WithDefault}}
+
+// {{.DefaultName}} is the {{.Name}} used by code that calls it instead of the
+// functions of the package, and that does not have a {{.Name}} injected.
+var {{.DefaultName}} {{.Name}} = new({{.Name}}Shim)
+
+// {{.SwapForTestName}} makes fake the {{.DefaultName}} until the end of the test, when
+// the original is restored. Tests that swap the {{.DefaultName}} cannot run in
+// parallel.
+func {{.SwapForTestName}}(t testing.TB, fake {{.Name}}) {
+  t.Helper()
+  original := {{.DefaultName}}
+  {{.DefaultName}} = fake
+  t.Cleanup(func() {
+    {{.DefaultName}} = original
+  })
+}
+{{- end}}
+
+{{- range .Wrapped}}
+
+// {{.Name}} is a generated interface representing the exported methods of
+// {{.TargetType}}.
+type {{.Name}} interface {
+  {{- range .Methods}}
+  {{.Name}}({{.Params.AsNamedArgsWithTypes}}) {{.Returns.AsReturnSignature}}
+  {{- end}}
+}
+
+// {{.Name}}Adapter implements {{.Name}} by forwarding every call to a
+// {{.TargetType}}.
+type {{.Name}}Adapter struct {
+  target *{{.TargetType}}
+}
+
+// New{{.Name}}Adapter returns a {{.Name}}Adapter that forwards calls to target.
+func New{{.Name}}Adapter(target *{{.TargetType}}) *{{.Name}}Adapter {
+  return &{{.Name}}Adapter{target: target}
+}
+
+// wrap{{.Name}} returns nil for a nil target, so that a nil {{.Name}} compares
+// equal to nil.
+func wrap{{.Name}}(target *{{.TargetType}}) {{.Name}} {
+  if target == nil {
+    return nil
+  }
+  return New{{.Name}}Adapter(target)
+}
+{{- $name := .Name}}
+{{- range .Methods}}
+
+func (a *{{$name}}Adapter) {{.Name}}({{.Params.AsNamedArgsWithTypes}}) {{.Returns.AsReturnSignature}} {
+  {{- template "call" .}}
+}
+{{- end}}
+
+var _ {{.Name}} = new({{.Name}}Adapter)
+{{- end}}
+{{define "flags"}}{{if .Constructor}}-constructor {{end}}{{if .Strict}}-strict {{end}}{{if .Delegate}}-delegate {{end}}{{if .DeepCopy}}-deep-copy {{end}}{{if .Expectations}}-expectations {{end}}{{if .Style}}-style {{.Style}} {{end}}{{end}}
+{{define "call"}}
+  {{- if .Returns.HasWrappers}}
+  {{.Returns.AsNamedArgs}} := {{template "invoke" .}}
+  return {{.Returns.AsWrappedArgs}}
+  {{- else}}
+  {{if .Returns.HasLength}}return {{end}}{{template "invoke" .}}
+  {{- end}}
+{{- end}}
+{{define "invoke"}}
+  {{- if not .IsVariable}}{{.FunctionName}}({{.Params.AsNamedArgsForInvocation}})
+  {{- else if .Params.HasLength}}{{.FunctionName}} = {{.Params.AsNamedArgsForInvocation}}
+  {{- else}}{{.FunctionName}}
+  {{- end}}
+{{- end}}`
@@ -11,6 +11,9 @@ type Param struct {
 	Type       string
 	IsVariadic bool
 	IsSlice    bool
+	IsOutParam bool
+	DeepCopier string
+	FieldName  string // the name of the param in the typed call record
 }
 
 // Slices returns those params that are a slice.
@@ -24,6 +27,40 @@ func (p Params) Slices() Params {
 	return result
 }
 
+// HasVariadic returns true if the last param is variadic.
+func (p Params) HasVariadic() bool {
+	return len(p) > 0 && p[len(p)-1].IsVariadic
+}
+
+// HasOutParams returns true if any of the params is a pointer, or an empty
+// interface that may hold one, so that a fake can assign a value through it.
+func (p Params) HasOutParams() bool {
+	for i := range p {
+		if p[i].IsOutParam {
+			return true
+		}
+	}
+	return false
+}
+
+// IsReplayedOut indicates whether a replay style fake writes the recorded
+// value of the param back through it, which it does for slices and for out
+// params, as the real implementation may have written through them.
+func (p Param) IsReplayedOut() bool {
+	return p.IsOutParam || p.IsSlice && !p.IsVariadic
+}
+
+// HasReplayedOut returns true if a replay style fake writes the recorded value
+// of any of the params back through it.
+func (p Params) HasReplayedOut() bool {
+	for i := range p {
+		if p[i].IsReplayedOut() {
+			return true
+		}
+	}
+	return false
+}
+
 // HasLength returns true if there are params. It returns false if there are no
 // params.
 func (p Params) HasLength() bool {
@@ -48,6 +85,20 @@ func (p Params) WithPrefix(prefix string) string {
 	return strings.Join(params, ", ")
 }
 
+// WithErrorsPassedTo builds a string of the parameters of a function, in which
+// each parameter of type error is passed to the function with the given name.
+func (p Params) WithErrorsPassedTo(function string) string {
+	params := []string{}
+	for i := range p {
+		if p[i].Type == "error" {
+			params = append(params, function+"("+unexport(p[i].Name)+")")
+		} else {
+			params = append(params, unexport(p[i].Name))
+		}
+	}
+	return strings.Join(params, ", ")
+}
+
 // AsArgs builds a string that represents the parameters to a function as
 // arguments to a function invocation.
 func (p Params) AsArgs() string {
@@ -84,7 +135,7 @@ func (p Params) AsNamedArgs() string {
 
 	params := []string{}
 	for i := range p {
-		if p[i].IsSlice {
+		if p[i].IsSlice || p[i].DeepCopier != "" {
 			params = append(params, unexport(p[i].Name)+"Copy")
 		} else {
 			params = append(params, unexport(p[i].Name))
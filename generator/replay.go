@@ -0,0 +1,64 @@
+package generator
+
+import (
+	"go/types"
+	"reflect"
+)
+
+// unreplayableTypeIn returns the name of a type within the given type that a
+// replay style fake cannot unmarshal from the JSON of a recorded call, such as
+// an interface with methods, a function, or a channel. The error type is only
+// replayable as the type of a result, so it is reported when it is nested. Type
+// parameters are replayable, as their values are unmarshaled into the type
+// arguments.
+func unreplayableTypeIn(t types.Type, seen map[types.Type]bool) (string, bool) {
+	if seen[t] {
+		return "", false
+	}
+	seen[t] = true
+	switch t.(type) {
+	case *types.TypeParam:
+		return "", false
+	case *types.Named:
+		if implementsUnmarshaler(t) {
+			return "", false
+		}
+	}
+	switch u := t.Underlying().(type) {
+	case *types.Interface:
+		if u.NumMethods() > 0 {
+			return types.TypeString(t, nil), true
+		}
+	case *types.Signature, *types.Chan:
+		return types.TypeString(t, nil), true
+	case *types.Basic:
+		if u.Kind() == types.UnsafePointer {
+			return types.TypeString(t, nil), true
+		}
+	case *types.Pointer:
+		return unreplayableTypeIn(u.Elem(), seen)
+	case *types.Slice:
+		return unreplayableTypeIn(u.Elem(), seen)
+	case *types.Array:
+		return unreplayableTypeIn(u.Elem(), seen)
+	case *types.Map:
+		return unreplayableTypeIn(u.Elem(), seen)
+	case *types.Struct:
+		for i := 0; i < u.NumFields(); i++ {
+			if !u.Field(i).Exported() || reflect.StructTag(u.Tag(i)).Get("json") == "-" {
+				continue
+			}
+			if name, ok := unreplayableTypeIn(u.Field(i).Type(), seen); ok {
+				return name, ok
+			}
+		}
+	}
+	return "", false
+}
+
+// implementsUnmarshaler indicates whether a pointer to a value of the given
+// type implements json.Unmarshaler, so that it unmarshals itself.
+func implementsUnmarshaler(t types.Type) bool {
+	sel := types.NewMethodSet(types.NewPointer(t)).Lookup(nil, "UnmarshalJSON")
+	return sel != nil
+}
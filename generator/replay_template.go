@@ -0,0 +1,250 @@
+package generator
+
+import (
+	"strings"
+	"text/template"
+)
+
+var replayFuncs = template.FuncMap{
+	"ToLower":                strings.ToLower,
+	"UnExport":               unexport,
+	"Replace":                strings.Replace,
+	"IsExported":             isExported,
+	"Title":                  title.String,
+	"HasConstraintInterface": hasConstraintInterface,
+}
+
+const replayTemplate string = `{{.Header}}// Code generated by counterfeiter. DO NOT EDIT.
+package {{.DestinationPackage}}
+
+import (
+	{{- range $index, $import := .Imports.ByAlias}}
+	{{$import}}
+	{{- end}}
+)
+
+// {{.Name}}RecordedCall is a call recorded by a {{.Name}}Recorder.
+type {{.Name}}RecordedCall struct {
+	Method  string            ` + "`" + `json:"method"` + "`" + `
+	Args    []json.RawMessage ` + "`" + `json:"args"` + "`" + `
+	Out     []json.RawMessage ` + "`" + `json:"out,omitempty"` + "`" + `
+	Results []json.RawMessage ` + "`" + `json:"results"` + "`" + `
+}
+
+// {{.Name}}Recorder wraps a real implementation, and records the arguments and
+// results of every call, so that they can be replayed by a {{.Name}}. The
+// arguments are recorded as they were passed to the real implementation, and
+// the values of slices and pointers as they were after it returned.
+type {{.Name}}Recorder{{.GenericTypeParametersAndConstraints}} struct {
+	real  {{.TargetAlias}}.{{.TargetName}}{{.GenericTypeParameters}}
+	calls []{{.Name}}RecordedCall
+	err   error
+	lock  sync.Mutex
+}
+
+// New{{.Name}}Recorder returns a recorder that forwards every call to real.
+func New{{.Name}}Recorder{{.GenericTypeParametersAndConstraints}}(real {{.TargetAlias}}.{{.TargetName}}{{.GenericTypeParameters}}) *{{.Name}}Recorder{{.GenericTypeParameters}} {
+	return &{{.Name}}Recorder{{.GenericTypeParameters}}{real: real}
+}
+
+{{range .Methods -}}
+func (recorder *{{$.Name}}Recorder{{$.GenericTypeParameters}}) {{.Name}}({{.Params.AsNamedArgsWithTypes}}) {{.Returns.AsReturnSignature}} {
+	args, err := marshal{{$.Name}}Values("{{.Name}}", []interface{}{ {{- .Params.WithErrorsPassedTo (printf "marshal%sError" $.Name)}}})
+	{{if .Returns.HasLength}}{{.Returns.WithPrefix ""}} := {{end}}recorder.real.{{.Name}}({{.Params.AsNamedArgsForInvocation}})
+	recorder.record("{{.Name}}", args, err, {{if .Params.HasReplayedOut}}[]interface{}{ {{- range $i, $p := .Params}}{{if $i}}, {{end}}{{if $p.IsReplayedOut}}{{$p.Name}}{{else}}nil{{end}}{{end}}}{{else}}nil{{end}}, []interface{}{ {{- .Returns.WithErrorsPassedTo (printf "marshal%sError" $.Name)}}})
+	{{- if .Returns.HasLength}}
+	return {{.Returns.WithPrefix ""}}
+	{{- end}}
+}
+
+{{end -}}
+// Save writes the recorded calls to the file at path, as JSON.
+func (recorder *{{.Name}}Recorder{{.GenericTypeParameters}}) Save(path string) error {
+	recorder.lock.Lock()
+	defer recorder.lock.Unlock()
+	if recorder.err != nil {
+		return recorder.err
+	}
+	calls := recorder.calls
+	if calls == nil {
+		calls = []{{.Name}}RecordedCall{}
+	}
+	b, err := json.MarshalIndent(calls, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(b, '\n'), 0644)
+}
+
+func (recorder *{{.Name}}Recorder{{.GenericTypeParameters}}) record(method string, args []json.RawMessage, err error, out []interface{}, results []interface{}) {
+	recorder.lock.Lock()
+	defer recorder.lock.Unlock()
+	if recorder.err != nil {
+		return
+	}
+	if err != nil {
+		recorder.err = err
+		return
+	}
+	call := {{.Name}}RecordedCall{Method: method, Args: args}
+	if out != nil {
+		call.Out, recorder.err = marshal{{.Name}}Values(method, out)
+		if recorder.err != nil {
+			return
+		}
+	}
+	call.Results, recorder.err = marshal{{.Name}}Values(method, results)
+	if recorder.err != nil {
+		return
+	}
+	recorder.calls = append(recorder.calls, call)
+}
+
+// {{.Name}} replays the calls recorded by a {{.Name}}Recorder. Each call
+// returns the results of the first recorded call of the same method with the
+// same arguments that has not been replayed yet, or else of the last recorded
+// call with the same arguments, and writes the recorded values of slices and
+// pointers back through them. Calls that were never recorded panic.
+type {{.Name}}{{.GenericTypeParametersAndConstraints}} struct {
+	calls    []{{.Name}}RecordedCall
+	replayed []bool
+	lock     sync.Mutex
+}
+
+// Load{{.Name}} returns a fake that replays the calls saved to the file at path
+// by a {{.Name}}Recorder.
+func Load{{.Name}}{{.GenericTypeParametersAndConstraints}}(path string) (*{{.Name}}{{.GenericTypeParameters}}, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var calls []{{.Name}}RecordedCall
+	if err := json.Unmarshal(b, &calls); err != nil {
+		return nil, fmt.Errorf("cannot load the recorded calls from %s: %v", path, err)
+	}
+	return &{{.Name}}{{.GenericTypeParameters}}{calls: calls, replayed: make([]bool, len(calls))}, nil
+}
+
+{{range .Methods -}}
+func (fake *{{$.Name}}{{$.GenericTypeParameters}}) {{.Name}}({{.Params.AsNamedArgsWithTypes}}) {{.Returns.AsReturnSignature}} {
+	{{if or .Returns.HasLength .Params.HasReplayedOut}}call := {{end}}fake.replay("{{.Name}}", []interface{}{ {{- .Params.WithErrorsPassedTo (printf "marshal%sError" $.Name)}}}, {{len .Returns}})
+	{{- range $i, $p := .Params}}
+	{{- if $p.IsReplayedOut}}
+	{{- if $p.IsSlice}}
+	var {{$p.Name}}Out {{$p.Type}}
+	fake.setArg(call, {{$i}}, &{{$p.Name}}Out)
+	copy({{$p.Name}}, {{$p.Name}}Out)
+	{{- else}}
+	fake.setArg(call, {{$i}}, {{$p.Name}})
+	{{- end}}
+	{{- end}}
+	{{- end}}
+	{{- range $i, $ret := .Returns}}
+	{{- if eq $ret.Type "error"}}
+	var {{UnExport $ret.Name}} error
+	var {{UnExport $ret.Name}}Message *string
+	fake.unmarshal(call, {{$i}}, &{{UnExport $ret.Name}}Message)
+	if {{UnExport $ret.Name}}Message != nil {
+		{{UnExport $ret.Name}} = fmt.Errorf("%s", *{{UnExport $ret.Name}}Message)
+	}
+	{{- else}}
+	var {{UnExport $ret.Name}} {{$ret.Type}}
+	fake.unmarshal(call, {{$i}}, &{{UnExport $ret.Name}})
+	{{- end}}
+	{{- end}}
+	{{- if .Returns.HasLength}}
+	return {{.Returns.WithPrefix ""}}
+	{{- end}}
+}
+
+{{end -}}
+func (fake *{{.Name}}{{.GenericTypeParameters}}) replay(method string, args []interface{}, results int) {{.Name}}RecordedCall {
+	raw, err := marshal{{.Name}}Values(method, args)
+	if err != nil {
+		panic(err)
+	}
+	fake.lock.Lock()
+	defer fake.lock.Unlock()
+	last := -1
+	for i, call := range fake.calls {
+		if call.Method != method || len(call.Results) != results || !equal{{.Name}}Values(call.Args, raw) {
+			continue
+		}
+		if !fake.replayed[i] {
+			fake.replayed[i] = true
+			return call
+		}
+		last = i
+	}
+	if last < 0 {
+		b, _ := json.Marshal(raw)
+		panic(fmt.Sprintf("{{.Name}}.%s: no call with arguments %s was recorded", method, b))
+	}
+	return fake.calls[last]
+}
+
+func (fake *{{.Name}}{{.GenericTypeParameters}}) setArg(call {{.Name}}RecordedCall, i int, arg interface{}) {
+	if i >= len(call.Out) {
+		return
+	}
+	if v := reflect.ValueOf(arg); v.Kind() != reflect.Ptr || v.IsNil() {
+		return
+	}
+	if err := json.Unmarshal(call.Out[i], arg); err != nil {
+		panic(fmt.Sprintf("{{.Name}}.%s: cannot replay argument %d: %v", call.Method, i+1, err))
+	}
+}
+
+func (fake *{{.Name}}{{.GenericTypeParameters}}) unmarshal(call {{.Name}}RecordedCall, i int, result interface{}) {
+	if err := json.Unmarshal(call.Results[i], result); err != nil {
+		panic(fmt.Sprintf("{{.Name}}.%s: cannot replay result %d: %v", call.Method, i+1, err))
+	}
+}
+
+func marshal{{.Name}}Values(method string, values []interface{}) ([]json.RawMessage, error) {
+	raw := []json.RawMessage{}
+	for _, v := range values {
+		if v != nil {
+			switch reflect.TypeOf(v).Kind() {
+			case reflect.Func, reflect.Chan, reflect.UnsafePointer:
+				v = fmt.Sprintf("%T", v)
+			}
+		}
+		b, err := json.Marshal(v)
+		if err != nil {
+			return nil, fmt.Errorf("{{.Name}}.%s: cannot record %T: %v", method, v, err)
+		}
+		raw = append(raw, b)
+	}
+	return raw, nil
+}
+
+// marshal{{.Name}}Error records an error as its message, as the type of
+// the error is not known when it is replayed.
+func marshal{{.Name}}Error(err error) interface{} {
+	if err == nil {
+		return nil
+	}
+	return err.Error()
+}
+
+func equal{{.Name}}Values(a []json.RawMessage, b []json.RawMessage) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		var x, y interface{}
+		if json.Unmarshal(a[i], &x) != nil || json.Unmarshal(b[i], &y) != nil || !reflect.DeepEqual(x, y) {
+			return false
+		}
+	}
+	return true
+}
+
+{{if IsExported .TargetName -}}
+{{if not (HasConstraintInterface .) -}}
+var _ {{.TargetAlias}}.{{.TargetName}}{{.GenericTypeConstraints}} = new({{.Name}}{{.GenericTypeConstraints}})
+var _ {{.TargetAlias}}.{{.TargetName}}{{.GenericTypeConstraints}} = new({{.Name}}Recorder{{.GenericTypeConstraints}})
+{{- end}}
+{{- end}}
+`
@@ -11,6 +11,8 @@ type Returns []Return
 type Return struct {
 	Name string
 	Type string
+
+	Wrapper string // the function that wraps a pointer to a concrete type in a package shim
 }
 
 // HasLength is true if there are returns, else false.
@@ -36,6 +38,21 @@ func (r Returns) WithPrefix(p string) string {
 	return strings.Join(rets, ", ")
 }
 
+// WithErrorsPassedTo builds a string representing the parameters returned from
+// a function, in which each parameter of type error is passed to the function
+// with the given name.
+func (r Returns) WithErrorsPassedTo(function string) string {
+	rets := []string{}
+	for i := range r {
+		if r[i].Type == "error" {
+			rets = append(rets, function+"("+unexport(r[i].Name)+")")
+		} else {
+			rets = append(rets, unexport(r[i].Name))
+		}
+	}
+	return strings.Join(rets, ", ")
+}
+
 // AsArgs builds a string representing the arguments passed to a function.
 func (r Returns) AsArgs() string {
 	if len(r) == 0 {
@@ -76,6 +93,30 @@ func (r Returns) AsNamedArgs() string {
 	return strings.Join(rets, ", ")
 }
 
+// HasWrappers is true if any of the returns is wrapped by a package shim.
+func (r Returns) HasWrappers() bool {
+	for i := range r {
+		if r[i].Wrapper != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// AsWrappedArgs builds a string representing the returns of a function, passed
+// to their wrappers.
+func (r Returns) AsWrappedArgs() string {
+	rets := []string{}
+	for i := range r {
+		if r[i].Wrapper != "" {
+			rets = append(rets, r[i].Wrapper+"("+unexport(r[i].Name)+")")
+		} else {
+			rets = append(rets, unexport(r[i].Name))
+		}
+	}
+	return strings.Join(rets, ", ")
+}
+
 // AsReturnSignature builds a string representing signature for the returns of
 // a function.
 func (r Returns) AsReturnSignature() string {
@@ -0,0 +1,216 @@
+package generator
+
+import (
+	"fmt"
+	"go/token"
+	"go/types"
+)
+
+// WrappedType is a concrete type returned by a package shim, which the shim
+// wraps in an adapter that implements an interface of its exported methods.
+type WrappedType struct {
+	Name       string // the name of the interface
+	TargetType string // the concrete type, such as os.File
+	Methods    []Method
+
+	target  *types.TypeName
+	methods []*rawMethod
+}
+
+// wrappableType returns the type of a result that is a pointer to one of the
+// concrete types that a package shim wraps. The types are named as in
+// "os.File", or as in "File" for the types of the target package.
+func (f *Fake) wrappableType(t types.Type) (*types.TypeName, bool) {
+	ptr, ok := types.Unalias(t).(*types.Pointer)
+	if !ok {
+		return nil, false
+	}
+	named, ok := types.Unalias(ptr.Elem()).(*types.Named)
+	if !ok || named.TypeParams().Len() > 0 || named.TypeArgs().Len() > 0 {
+		return nil, false
+	}
+	if _, ok := named.Underlying().(*types.Interface); ok {
+		return nil, false
+	}
+	obj := named.Obj()
+	if obj.Pkg() == nil || !obj.Exported() {
+		return nil, false
+	}
+	for _, name := range f.WrapTypes {
+		if f.wrapTypeMatches(name, obj) {
+			return obj, true
+		}
+	}
+	return nil, false
+}
+
+func (f *Fake) wrapTypeMatches(name string, obj *types.TypeName) bool {
+	if name == obj.Pkg().Name()+"."+obj.Name() {
+		return true
+	}
+	return name == obj.Name() && obj.Pkg().Path() == f.Package.Types.Path()
+}
+
+// wrappedFor returns the wrapped type of a result, if it is a pointer to one.
+func (f *Fake) wrappedFor(t types.Type) (*WrappedType, bool) {
+	target, ok := f.wrappableType(t)
+	if !ok {
+		return nil, false
+	}
+	for i := range f.Wrapped {
+		if sameTypeName(f.Wrapped[i].target, target) {
+			return &f.Wrapped[i], true
+		}
+	}
+	return nil, false
+}
+
+// sameTypeName compares types by their package path and name, as combined
+// packages are loaded separately.
+func sameTypeName(a *types.TypeName, b *types.TypeName) bool {
+	return a.Pkg().Path() == b.Pkg().Path() && a.Name() == b.Name()
+}
+
+// loadWrappedTypes finds the types to wrap among the results of the methods of
+// a package shim, and then among the results of the methods of the types it
+// wraps, down to the wrap depth.
+func (f *Fake) loadWrappedTypes(methods []*rawMethod) error {
+	depth := f.WrapDepth
+	if depth < 1 {
+		depth = 1
+	}
+	for level := 0; level < depth && len(methods) > 0; level++ {
+		var next []*rawMethod
+		for _, m := range methods {
+			for i := 0; i < m.Signature.Results().Len(); i++ {
+				target, ok := f.wrappableType(m.Signature.Results().At(i).Type())
+				if !ok {
+					continue
+				}
+				if _, ok := f.wrappedFor(m.Signature.Results().At(i).Type()); ok {
+					continue
+				}
+				w, err := newWrappedType(target)
+				if err != nil {
+					return err
+				}
+				f.Wrapped = append(f.Wrapped, w)
+				next = append(next, w.methods...)
+			}
+		}
+		methods = next
+	}
+
+	for _, name := range f.WrapTypes {
+		found := false
+		for i := range f.Wrapped {
+			found = found || f.wrapTypeMatches(name, f.Wrapped[i].target)
+		}
+		if !found {
+			return fmt.Errorf("cannot wrap %s because it is not returned as a pointer within a wrap depth of %d", name, depth)
+		}
+	}
+
+	count := map[string]int{}
+	for i := range f.Wrapped {
+		count[f.Wrapped[i].target.Name()]++
+	}
+	for i := range f.Wrapped {
+		w := &f.Wrapped[i]
+		if count[w.Name] > 1 {
+			w.Name = packagePrefix(w.target.Pkg()) + w.Name
+		}
+		if w.Name == f.Name || w.Name == f.Name+"Shim" {
+			return fmt.Errorf("cannot wrap %s.%s because the shim is named %s", w.target.Pkg().Name(), w.target.Name(), f.Name)
+		}
+		f.addImportsFor(w.target.Type())
+		for _, m := range w.methods {
+			f.addTypesForMethod(m.Signature)
+		}
+	}
+	for i := range f.Wrapped {
+		w := &f.Wrapped[i]
+		w.TargetType = types.TypeString(w.target.Type(), f.Imports.AliasForPackage)
+		for _, m := range w.methods {
+			method := methodForSignature(m.Signature, m.Func.Name(), f.Imports)
+			method.FunctionName = "a.target." + m.Func.Name()
+			f.wrapResults(m.Signature, &method)
+			w.Methods = append(w.Methods, method)
+		}
+	}
+	return nil
+}
+
+func newWrappedType(target *types.TypeName) (WrappedType, error) {
+	methods := concreteMethodSet(target.Type())
+	if len(methods) == 0 {
+		return WrappedType{}, fmt.Errorf("cannot wrap %s.%s because it has no exported methods", target.Pkg().Name(), target.Name())
+	}
+	for _, m := range methods {
+		if name, ok := unexportedTypeIn(m.Signature); ok {
+			return WrappedType{}, fmt.Errorf("cannot wrap %s.%s because its method %s uses the unexported type %s", target.Pkg().Name(), target.Name(), m.Func.Name(), name)
+		}
+	}
+	return WrappedType{Name: target.Name(), target: target, methods: methods}, nil
+}
+
+// wrapResults makes a method return the interfaces of the wrapped types, instead
+// of pointers to them.
+func (f *Fake) wrapResults(sig *types.Signature, method *Method) {
+	for i := 0; i < sig.Results().Len(); i++ {
+		if w, ok := f.wrappedFor(sig.Results().At(i).Type()); ok {
+			method.Returns[i].Type = w.Name
+			method.Returns[i].Wrapper = "wrap" + w.Name
+		}
+	}
+}
+
+// wrappedInterfaces declares the interfaces of the wrapped types of a package
+// shim in pkg, so that the interface of the shim can return them.
+func (f *Fake) wrappedInterfaces(pkg *types.Package) []*types.Named {
+	result := make([]*types.Named, len(f.Wrapped))
+	for i := range f.Wrapped {
+		result[i] = types.NewNamed(types.NewTypeName(token.NoPos, pkg, f.Wrapped[i].Name, nil), nil, nil)
+	}
+	for i := range f.Wrapped {
+		var funcs []*types.Func
+		for _, m := range f.Wrapped[i].methods {
+			sig := types.NewSignatureType(nil, nil, nil, m.Signature.Params(), f.wrapTuple(m.Signature.Results(), result), m.Signature.Variadic())
+			funcs = append(funcs, types.NewFunc(token.NoPos, pkg, m.Func.Name(), sig))
+		}
+		result[i].SetUnderlying(types.NewInterfaceType(funcs, nil).Complete())
+	}
+	return result
+}
+
+// wrapTuple replaces the pointers to wrapped types in the results of a
+// function with the interfaces declared by wrappedInterfaces.
+func (f *Fake) wrapTuple(results *types.Tuple, interfaces []*types.Named) *types.Tuple {
+	vars := make([]*types.Var, results.Len())
+	for i := range vars {
+		vars[i] = results.At(i)
+		for j := range f.Wrapped {
+			if target, ok := f.wrappableType(vars[i].Type()); ok && sameTypeName(f.Wrapped[j].target, target) {
+				vars[i] = types.NewVar(vars[i].Pos(), vars[i].Pkg(), vars[i].Name(), interfaces[j])
+			}
+		}
+	}
+	return types.NewTuple(vars...)
+}
+
+// WrappedFake returns a Fake of the interface of a type wrapped by a Package
+// mode Fake, which lives in the package of the shim with the given import path.
+// The interface is built from the methods that were already loaded, so the
+// packages are not loaded again.
+func (f *Fake) WrappedFake(interfacePackage string, name string, fakeName string, destinationPackage string, opts ...Option) (*Fake, error) {
+	if f.Mode != Package {
+		return nil, fmt.Errorf("cannot generate a fake of %s because %s is not a package shim", name, f.TargetPackage)
+	}
+	interfaces := f.wrappedInterfaces(types.NewPackage(interfacePackage, f.DestinationPackage))
+	for i := range f.Wrapped {
+		if f.Wrapped[i].Name == name {
+			return f.interfaceFake(interfaces[i].Obj(), fakeName, destinationPackage, opts...)
+		}
+	}
+	return nil, fmt.Errorf("cannot generate a fake of %s because the shim does not wrap a type with that name", name)
+}
@@ -3,6 +3,8 @@ module github.com/maxbrunsfeld/counterfeiter/v6
 require (
 	github.com/onsi/gomega v1.42.1
 	github.com/sclevine/spec v1.4.0
+	go.uber.org/mock v0.6.0
+	golang.org/x/mod v0.39.0
 	golang.org/x/text v0.41.0
 	golang.org/x/tools v0.49.0
 )
@@ -10,7 +12,6 @@ require (
 require (
 	github.com/google/go-cmp v0.7.0 // indirect
 	go.yaml.in/yaml/v3 v3.0.4 // indirect
-	golang.org/x/mod v0.39.0 // indirect
 	golang.org/x/net v0.58.0 // indirect
 	golang.org/x/sync v0.22.0 // indirect
 	gopkg.in/check.v1 v1.0.0-20201130134442-10cb98267c6c // indirect
@@ -121,6 +121,44 @@ func runTests(t *testing.T, when spec.G, it spec.S) {
 		})
 	})
 
+	when("generating an interface and a fake for a package", func() {
+		it("succeeds", func() {
+			initModuleFunc()
+			cache := &generator.FakeCache{}
+			f, err := generator.NewFake(generator.Package, "", "os", "FileSystem", "osshim", "", baseDir, cache, generator.Combine("io/fs"))
+			Expect(err).NotTo(HaveOccurred())
+			b, err := f.Generate(true) // Flip to false to see output if goimports fails
+			Expect(err).NotTo(HaveOccurred())
+			WriteOutput(b, filepath.Join(baseDir, "osshim", "file_system.go"))
+
+			fake, err := f.ShimFake("github.com/maxbrunsfeld/counterfeiter/v6/fixtures/osshim", "FakeFileSystem", "osshimfakes")
+			Expect(err).NotTo(HaveOccurred())
+			b, err = fake.Generate(true)
+			Expect(err).NotTo(HaveOccurred())
+			WriteOutput(b, filepath.Join(baseDir, "osshim", "osshimfakes", "fake_file_system.go"))
+			RunBuild(baseDir)
+		})
+	})
+
+	when("extracting an interface from a concrete type", func() {
+		it("succeeds", func() {
+			initModuleFunc()
+			cache := &generator.FakeCache{}
+			f, err := generator.NewFake(generator.Extract, "Client", "net/http", "Client", "httpshim", "", baseDir, cache)
+			Expect(err).NotTo(HaveOccurred())
+			b, err := f.Generate(true) // Flip to false to see output if goimports fails
+			Expect(err).NotTo(HaveOccurred())
+			WriteOutput(b, filepath.Join(baseDir, "httpshim", "client.go"))
+
+			fake, err := f.ExtractedFake("github.com/maxbrunsfeld/counterfeiter/v6/fixtures/httpshim", "FakeClient", "httpshimfakes")
+			Expect(err).NotTo(HaveOccurred())
+			b, err = fake.Generate(true)
+			Expect(err).NotTo(HaveOccurred())
+			WriteOutput(b, filepath.Join(baseDir, "httpshim", "httpshimfakes", "fake_client.go"))
+			RunBuild(baseDir)
+		})
+	})
+
 	when("generating interfaces using type aliases", func() {
 		it.Before(func() {
 			relativeDir = filepath.Join(relativeDir, "type_aliases")
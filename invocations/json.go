@@ -0,0 +1,98 @@
+// Package invocations works with the invocations recorded by fakes generated
+// by counterfeiter.
+package invocations
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// Invocation is a call to a method of a fake, as returned by the
+// OrderedInvocations method of the fake.
+type Invocation = struct {
+	Seq    uint64
+	Method string
+	Args   []interface{}
+}
+
+// Fake is implemented by the fakes of the default counterfeiter style, and not
+// by those generated with -style gomock, funcs or replay.
+type Fake interface {
+	OrderedInvocations() []Invocation
+}
+
+type jsonInvocation struct {
+	Fake   string            `json:"fake"`
+	Method string            `json:"method"`
+	Args   []json.RawMessage `json:"args"`
+}
+
+// JSON serializes the invocations recorded by the fakes into indented JSON, in
+// the order in which they were made, so that the interactions with the fakes
+// can be compared with a golden file. The invocations of several fakes are only
+// interleaved correctly if the fakes share a sequencer (see SetSequencer).
+//
+// Arguments are serialized with encoding/json, except for errors, which are
+// serialized as their message, and functions and channels, which are
+// serialized as their type.
+func JSON(fakes ...Fake) ([]byte, error) {
+	type entry struct {
+		fake       int
+		invocation Invocation
+	}
+	var entries []entry
+	for i := range fakes {
+		for _, invocation := range fakes[i].OrderedInvocations() {
+			entries = append(entries, entry{fake: i, invocation: invocation})
+		}
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].invocation.Seq < entries[j].invocation.Seq
+	})
+
+	result := []jsonInvocation{}
+	for _, e := range entries {
+		args := []json.RawMessage{}
+		for _, arg := range e.invocation.Args {
+			b, err := marshalArg(arg)
+			if err != nil {
+				return nil, fmt.Errorf("cannot serialize the arguments of %s: %v", e.invocation.Method, err)
+			}
+			args = append(args, b)
+		}
+		result = append(result, jsonInvocation{
+			Fake:   fakeName(fakes[e.fake]),
+			Method: e.invocation.Method,
+			Args:   args,
+		})
+	}
+	return json.MarshalIndent(result, "", "  ")
+}
+
+func marshalArg(arg interface{}) (json.RawMessage, error) {
+	if err, ok := arg.(error); ok && err != nil {
+		return json.Marshal(err.Error())
+	}
+	if arg != nil {
+		switch reflect.TypeOf(arg).Kind() {
+		case reflect.Func, reflect.Chan, reflect.UnsafePointer:
+			return json.Marshal(fmt.Sprintf("%T", arg))
+		}
+	}
+	return json.Marshal(arg)
+}
+
+func fakeName(fake Fake) string {
+	t := reflect.TypeOf(fake)
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	name := t.Name()
+	if i := strings.Index(name, "["); i >= 0 {
+		name = name[:i]
+	}
+	return name
+}
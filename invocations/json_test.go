@@ -0,0 +1,92 @@
+package invocations_test
+
+import (
+	"errors"
+	"os"
+	"sync/atomic"
+	"testing"
+
+	"github.com/maxbrunsfeld/counterfeiter/v6/fixtures/fixturesfakes"
+	"github.com/maxbrunsfeld/counterfeiter/v6/invocations"
+	. "github.com/onsi/gomega"
+	"github.com/sclevine/spec"
+	"github.com/sclevine/spec/report"
+)
+
+func TestInvocations(t *testing.T) {
+	spec.Run(t, "Invocations", testInvocations, spec.Report(report.Terminal{}))
+}
+
+func testInvocations(t *testing.T, when spec.G, it spec.S) {
+	var (
+		fake    *fixturesfakes.FakeSomething
+		factory *fixturesfakes.FakeSomethingFactory
+	)
+
+	it.Before(func() {
+		RegisterTestingT(t)
+		fake = new(fixturesfakes.FakeSomething)
+		factory = new(fixturesfakes.FakeSomethingFactory)
+	})
+
+	when("serializing invocations to JSON", func() {
+		it("serializes the invocations of a fake in order", func() {
+			_, _ = fake.DoThings("stuff", 5)
+			fake.DoNothing()
+			fake.DoASlice([]byte("abc"))
+
+			b, err := invocations.JSON(fake)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(b)).To(MatchJSON(`[
+				{"fake": "FakeSomething", "method": "DoThings", "args": ["stuff", 5]},
+				{"fake": "FakeSomething", "method": "DoNothing", "args": []},
+				{"fake": "FakeSomething", "method": "DoASlice", "args": ["YWJj"]}
+			]`))
+		})
+
+		it("interleaves the invocations of fakes that share a sequencer", func() {
+			sequencer := new(atomic.Uint64)
+			fake.SetSequencer(sequencer)
+			factory.SetSequencer(sequencer)
+
+			factory.Spy("first", map[string]interface{}{"b": 2, "a": 1})
+			fake.DoNothing()
+			factory.Spy("second", nil)
+
+			b, err := invocations.JSON(fake, factory)
+			Expect(err).NotTo(HaveOccurred())
+			golden, err := os.ReadFile("testdata/interleaved.golden")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(b)).To(Equal(string(golden)))
+		})
+
+		it("serializes errors as their message, and functions as their type", func() {
+			fake := new(fixturesfakes.FakeDeepCopySomething)
+			_ = fake.Write(nil, [2][]byte{}, func() {})
+
+			b, err := invocations.JSON(fake, fakeWithArgs{errors.New("the-error"), nil})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(b)).To(MatchJSON(`[
+				{"fake": "FakeDeepCopySomething", "method": "Write", "args": [null, [null, null], "func()"]},
+				{"fake": "fakeWithArgs", "method": "Fail", "args": ["the-error", null]}
+			]`))
+		})
+
+		it("returns an error if an argument cannot be serialized", func() {
+			_, err := invocations.JSON(fakeWithArgs{map[string]interface{}{"c": make(chan int)}})
+			Expect(err).To(MatchError(ContainSubstring("cannot serialize the arguments of Fail")))
+		})
+
+		it("serializes no invocations as an empty list", func() {
+			b, err := invocations.JSON(fake)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(b)).To(Equal("[]"))
+		})
+	})
+}
+
+type fakeWithArgs []interface{}
+
+func (f fakeWithArgs) OrderedInvocations() []invocations.Invocation {
+	return []invocations.Invocation{{Seq: 1, Method: "Fail", Args: f}}
+}
@@ -7,13 +7,16 @@ import (
 	"io"
 	"log"
 	"os"
+	"path"
 	"path/filepath"
 	"runtime/debug"
 	"runtime/pprof"
+	"strings"
 
 	"github.com/maxbrunsfeld/counterfeiter/v6/arguments"
 	"github.com/maxbrunsfeld/counterfeiter/v6/command"
 	"github.com/maxbrunsfeld/counterfeiter/v6/generator"
+	"golang.org/x/mod/modfile"
 )
 
 func main() {
@@ -89,6 +92,16 @@ func run() error {
 		// once per package, which is probably the most common case for adding
 		// licence headers (i.e. all the fakes will have the same licence headers).
 		a.HeaderFile = or(a.HeaderFile, args.HeaderFile)
+		a.Constructor = a.Constructor || args.Constructor
+		a.Strict = a.Strict || args.Strict
+		a.Delegate = a.Delegate || args.Delegate
+		a.DeepCopy = a.DeepCopy || args.DeepCopy
+		a.Expectations = a.Expectations || args.Expectations
+		a.Style = or(a.Style, args.Style)
+		err = a.ValidateStyleOptions()
+		if err != nil {
+			return err
+		}
 
 		err = generate(cwd, a, cache, headerReader)
 		if err != nil {
@@ -124,13 +137,24 @@ func invokedByGoGenerate() bool {
 }
 
 func generate(workingDir string, args *arguments.ParsedArguments, cache generator.Cacher, headerReader generator.FileReader) error {
+	if args.ExtractMode {
+		return generateExtracted(workingDir, args, cache, headerReader)
+	}
+	if args.WithFake {
+		return generateShimWithFake(workingDir, args, cache, headerReader)
+	}
+
 	if !args.Quiet {
 		if err := reportStarting(workingDir, args.OutputPath, args.FakeImplName); err != nil {
 			return err
 		}
 	}
 
-	b, err := doGenerate(workingDir, args, cache, headerReader)
+	f, err := newFake(workingDir, args, cache, headerReader)
+	if err != nil {
+		return err
+	}
+	b, err := f.Generate(true)
 	if err != nil {
 		return err
 	}
@@ -141,12 +165,21 @@ func generate(workingDir string, args *arguments.ParsedArguments, cache generato
 
 	if !args.Quiet {
 		fmt.Fprint(os.Stderr, "Done\n")
+		reportSkipped(f.Skipped)
 	}
 
 	return nil
 }
 
 func doGenerate(workingDir string, args *arguments.ParsedArguments, cache generator.Cacher, headerReader generator.FileReader) ([]byte, error) {
+	f, err := newFake(workingDir, args, cache, headerReader)
+	if err != nil {
+		return nil, err
+	}
+	return f.Generate(true)
+}
+
+func newFake(workingDir string, args *arguments.ParsedArguments, cache generator.Cacher, headerReader generator.FileReader) (*generator.Fake, error) {
 	mode := generator.InterfaceOrFunction
 	if args.GenerateInterfaceAndShimFromPackageDirectory {
 		mode = generator.Package
@@ -157,11 +190,221 @@ func doGenerate(workingDir string, args *arguments.ParsedArguments, cache genera
 		return nil, err
 	}
 
-	f, err := generator.NewFake(mode, args.InterfaceName, args.PackagePath, args.FakeImplName, args.DestinationPackageName, headerContent, workingDir, cache)
+	opts, err := fakeOptions(args)
 	if err != nil {
 		return nil, err
 	}
-	return f.Generate(true)
+
+	return generator.NewFake(mode, args.InterfaceName, args.PackagePath, args.FakeImplName, args.DestinationPackageName, headerContent, workingDir, cache, opts...)
+}
+
+// generateExtracted writes the interface and adapter extracted from a concrete
+// type, followed by a fake of the interface.
+func generateExtracted(workingDir string, args *arguments.ParsedArguments, cache generator.Cacher, headerReader generator.FileReader) error {
+	headerContent, err := headerReader.Get(workingDir, args.HeaderFile)
+	if err != nil {
+		return err
+	}
+
+	opts, err := fakeOptions(args)
+	if err != nil {
+		return err
+	}
+
+	if !args.Quiet {
+		if err := reportStarting(workingDir, args.ExtractedInterfacePath, args.InterfaceName); err != nil {
+			return err
+		}
+	}
+
+	f, err := generator.NewFake(generator.Extract, args.InterfaceName, args.PackagePath, args.InterfaceName, args.ExtractedPackageName, headerContent, workingDir, cache)
+	if err != nil {
+		return err
+	}
+	b, err := f.Generate(true)
+	if err != nil {
+		return err
+	}
+	if err := printCode(b, args.ExtractedInterfacePath, args.PrintToStdOut); err != nil {
+		return err
+	}
+
+	if !args.Quiet {
+		fmt.Fprint(os.Stderr, "Done\n")
+		if err := reportStarting(workingDir, args.OutputPath, args.FakeImplName); err != nil {
+			return err
+		}
+	}
+
+	interfacePackage, err := importPathForDir(filepath.Dir(args.ExtractedInterfacePath))
+	if err != nil {
+		return err
+	}
+	fake, err := f.ExtractedFake(interfacePackage, args.FakeImplName, args.DestinationPackageName, opts...)
+	if err != nil {
+		return err
+	}
+	b, err = fake.Generate(true)
+	if err != nil {
+		return err
+	}
+	if err := printCode(b, args.OutputPath, args.PrintToStdOut); err != nil {
+		return err
+	}
+
+	if !args.Quiet {
+		fmt.Fprint(os.Stderr, "Done\n")
+	}
+	return nil
+}
+
+// generateShimWithFake writes a package shim together with a fake of its
+// interface, and of the interfaces of the types it wraps, which are generated
+// from the same Fake, so that the packages are only loaded once.
+func generateShimWithFake(workingDir string, args *arguments.ParsedArguments, cache generator.Cacher, headerReader generator.FileReader) error {
+	if !args.Quiet {
+		if err := reportStarting(workingDir, args.OutputPath, args.FakeImplName); err != nil {
+			return err
+		}
+	}
+
+	f, err := newFake(workingDir, args, cache, headerReader)
+	if err != nil {
+		return err
+	}
+	shim, err := f.Generate(true)
+	if err != nil {
+		return err
+	}
+
+	if !args.Quiet {
+		fmt.Fprint(os.Stderr, "Done\n")
+		reportSkipped(f.Skipped)
+		if err := reportStarting(workingDir, args.ShimFakePath, args.ShimFakeName); err != nil {
+			return err
+		}
+	}
+
+	opts, err := fakeOptions(args)
+	if err != nil {
+		return err
+	}
+	interfacePackage, err := importPathForDir(filepath.Dir(args.OutputPath))
+	if err != nil {
+		return err
+	}
+	fake, err := f.ShimFake(interfacePackage, args.ShimFakeName, args.ShimFakePackageName, opts...)
+	if err != nil {
+		return err
+	}
+	b, err := fake.Generate(true)
+	if err != nil {
+		return err
+	}
+	files := []generatedFile{{args.OutputPath, shim}, {args.ShimFakePath, b}}
+
+	for _, w := range f.Wrapped {
+		fakeName, fakePath := args.WrappedFakePath(w.Name)
+		if !args.Quiet {
+			fmt.Fprint(os.Stderr, "Done\n")
+			if err := reportStarting(workingDir, fakePath, fakeName); err != nil {
+				return err
+			}
+		}
+		fake, err := f.WrappedFake(interfacePackage, w.Name, fakeName, args.ShimFakePackageName, opts...)
+		if err != nil {
+			return err
+		}
+		b, err := fake.Generate(true)
+		if err != nil {
+			return err
+		}
+		files = append(files, generatedFile{fakePath, b})
+	}
+
+	err = printFiles(args.PrintToStdOut, files...)
+	if err != nil {
+		return err
+	}
+
+	if !args.Quiet {
+		fmt.Fprint(os.Stderr, "Done\n")
+	}
+	return nil
+}
+
+// importPathForDir determines the import path of a directory in a module,
+// which may not exist yet.
+func importPathForDir(dir string) (string, error) {
+	for d := dir; ; d = filepath.Dir(d) {
+		b, err := os.ReadFile(filepath.Join(d, "go.mod"))
+		if err == nil {
+			rel, err := filepath.Rel(d, dir)
+			if err != nil {
+				return "", err
+			}
+			return path.Join(modfile.ModulePath(b), filepath.ToSlash(rel)), nil
+		}
+		if filepath.Dir(d) == d {
+			return "", fmt.Errorf("cannot determine the import path of %s because it is not in a module", dir)
+		}
+	}
+}
+
+func fakeOptions(args *arguments.ParsedArguments) ([]generator.Option, error) {
+	var opts []generator.Option
+	if args.Constructor {
+		opts = append(opts, generator.Constructor())
+	}
+	if args.Strict {
+		opts = append(opts, generator.Strict())
+	}
+	if args.Delegate {
+		opts = append(opts, generator.Delegate())
+	}
+	if args.DeepCopy {
+		opts = append(opts, generator.DeepCopy())
+	}
+	if args.Expectations {
+		opts = append(opts, generator.Expectations())
+	}
+	if args.Style != "" {
+		style, err := generator.ParseStyle(args.Style)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, generator.Style(style))
+	}
+	if args.Include != "" {
+		pattern, err := generator.ParseNamePattern(args.Include)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, generator.Include(pattern))
+	}
+	if args.Exclude != "" {
+		pattern, err := generator.ParseNamePattern(args.Exclude)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, generator.Exclude(pattern))
+	}
+	if len(args.CombinedPackagePaths) > 0 {
+		opts = append(opts, generator.Combine(args.CombinedPackagePaths...))
+	}
+	if len(args.Instantiate) > 0 {
+		opts = append(opts, generator.Instantiate(args.Instantiate...))
+	}
+	if args.Variables {
+		opts = append(opts, generator.Variables())
+	}
+	if args.WithDefault {
+		opts = append(opts, generator.WithDefault())
+	}
+	if args.Wrap != "" {
+		opts = append(opts, generator.Wrap(strings.Split(args.Wrap, ",")...), generator.WrapDepth(args.WrapDepth))
+	}
+	return opts, nil
 }
 
 func printCode(code []byte, outputPath string, printToStdOut bool) error {
@@ -187,6 +430,85 @@ func printCode(code []byte, outputPath string, printToStdOut bool) error {
 	return nil
 }
 
+type generatedFile struct {
+	path string
+	code []byte
+}
+
+// printFiles formats all of the files before writing any of them, and writes
+// each one to a temporary file next to it, which replaces it once all of them
+// have been written. If replacing one of them fails, the ones already replaced
+// are restored, so that a failure does not leave them out of sync.
+func printFiles(printToStdOut bool, files ...generatedFile) error {
+	formatted := make([][]byte, len(files))
+	for i := range files {
+		b, err := format.Source(files[i].code)
+		if err != nil {
+			return err
+		}
+		formatted[i] = b
+	}
+
+	if printToStdOut {
+		for i := range formatted {
+			fmt.Println(string(formatted[i]))
+		}
+		return nil
+	}
+
+	var temps []string
+	defer func() {
+		for i := range temps {
+			_ = os.Remove(temps[i])
+		}
+	}()
+	for i := range files {
+		dir := filepath.Dir(files[i].path)
+		_ = os.MkdirAll(dir, 0777)
+		temp := filepath.Join(dir, "."+filepath.Base(files[i].path)+".tmp")
+		temps = append(temps, temp)
+		if err := os.WriteFile(temp, formatted[i], 0666); err != nil {
+			return fmt.Errorf("Couldn't write to fake file - %v", err)
+		}
+	}
+	originals := make([]*generatedFile, len(files))
+	for i := range files {
+		b, err := os.ReadFile(files[i].path)
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("Couldn't read fake file - %v", err)
+		}
+		originals[i] = &generatedFile{path: files[i].path, code: b}
+	}
+	for i := range files {
+		if err := os.Rename(temps[i], files[i].path); err != nil {
+			restoreFiles(files[:i], originals)
+			return fmt.Errorf("Couldn't write to fake file - %v", err)
+		}
+	}
+	return nil
+}
+
+// restoreFiles puts back the originals of files that were replaced, and removes
+// the ones that did not exist before.
+func restoreFiles(files []generatedFile, originals []*generatedFile) {
+	for i := range files {
+		if originals[i] == nil {
+			_ = os.Remove(files[i].path)
+			continue
+		}
+		_ = os.WriteFile(originals[i].path, originals[i].code, 0666)
+	}
+}
+
+func reportSkipped(skipped []generator.SkippedFunction) {
+	for _, s := range skipped {
+		fmt.Fprintf(os.Stderr, "Skipped `%s`, because %s\n", s.Name, s.Reason)
+	}
+}
+
 func reportStarting(workingDir string, outputPath, fakeName string) error {
 	rel, err := filepath.Rel(workingDir, outputPath)
 	if err != nil {
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/sclevine/spec"
+	"github.com/sclevine/spec/report"
+)
+
+func TestPrintFiles(t *testing.T) {
+	spec.Run(t, "printFiles", testPrintFiles, spec.Report(report.Terminal{}))
+}
+
+func testPrintFiles(t *testing.T, when spec.G, it spec.S) {
+	var dir string
+
+	it.Before(func() {
+		RegisterTestingT(t)
+		dir = t.TempDir()
+	})
+
+	it("replaces all of the files", func() {
+		existing := filepath.Join(dir, "existing.go")
+		Expect(os.WriteFile(existing, []byte("package old\n"), 0666)).To(Succeed())
+
+		err := printFiles(false,
+			generatedFile{path: existing, code: []byte("package existing")},
+			generatedFile{path: filepath.Join(dir, "new.go"), code: []byte("package new")},
+		)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(os.ReadFile(existing)).To(BeEquivalentTo("package existing\n"))
+		Expect(os.ReadFile(filepath.Join(dir, "new.go"))).To(BeEquivalentTo("package new\n"))
+		Expect(filepath.Glob(filepath.Join(dir, ".*.tmp"))).To(BeEmpty())
+	})
+
+	when("a file cannot be replaced", func() {
+		it("restores the files that were already replaced", func() {
+			existing := filepath.Join(dir, "existing.go")
+			Expect(os.WriteFile(existing, []byte("package old\n"), 0666)).To(Succeed())
+			blocked := filepath.Join(dir, "blocked.go")
+			Expect(os.MkdirAll(filepath.Join(blocked, "child"), 0777)).To(Succeed())
+
+			err := printFiles(false,
+				generatedFile{path: existing, code: []byte("package existing")},
+				generatedFile{path: filepath.Join(dir, "new.go"), code: []byte("package new")},
+				generatedFile{path: blocked, code: []byte("package blocked")},
+			)
+			Expect(err).To(HaveOccurred())
+			Expect(os.ReadFile(existing)).To(BeEquivalentTo("package old\n"))
+			Expect(filepath.Join(dir, "new.go")).NotTo(BeAnExistingFile())
+			Expect(filepath.Glob(filepath.Join(dir, ".*.tmp"))).To(BeEmpty())
+		})
+	})
+}
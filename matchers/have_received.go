@@ -0,0 +1,180 @@
+// Package matchers provides gomega matchers for fakes generated by
+// counterfeiter.
+package matchers
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/onsi/gomega/types"
+)
+
+// Fake is implemented by the fakes of the default counterfeiter style, and not
+// by those generated with -style gomock, funcs or replay.
+type Fake interface {
+	Invocations() map[string][][]interface{}
+}
+
+// ReceivedMatcher succeeds if a fake has received calls to a method.
+type ReceivedMatcher struct {
+	method string
+	args   []interface{}
+	times  int
+
+	calls    [][]interface{}
+	received int
+}
+
+// HaveReceived succeeds if the fake has received at least one call to the
+// method, which is the name of the fake's target for fakes of functions:
+//
+//	Expect(fake).To(HaveReceived("DoThings"))
+//	Expect(fake).To(HaveReceived("DoThings").With("stuff", uint64(5)).Times(2))
+func HaveReceived(method string) *ReceivedMatcher {
+	return &ReceivedMatcher{method: method, times: -1}
+}
+
+// With makes the matcher only count the calls with the given arguments. Each
+// argument is either a value, which is compared with reflect.DeepEqual, or a
+// gomega matcher. Variadic arguments are passed as a single slice.
+func (m *ReceivedMatcher) With(args ...interface{}) *ReceivedMatcher {
+	m.args = args
+	return m
+}
+
+// Times makes the matcher succeed only if the fake has received exactly n
+// matching calls.
+func (m *ReceivedMatcher) Times(n int) *ReceivedMatcher {
+	m.times = n
+	return m
+}
+
+// Match implements types.GomegaMatcher.
+func (m *ReceivedMatcher) Match(actual interface{}) (bool, error) {
+	fake, ok := actual.(Fake)
+	if !ok {
+		return false, fmt.Errorf("HaveReceived expects a fake generated by counterfeiter, but got %T", actual)
+	}
+	if !hasMethod(actual, m.method) {
+		return false, fmt.Errorf("HaveReceived expects a method of %s, but it has no method named %s", fakeName(actual), m.method)
+	}
+
+	m.calls = fake.Invocations()[m.method]
+	m.received = 0
+	for _, args := range m.calls {
+		matches, err := m.matchArgs(args)
+		if err != nil {
+			return false, err
+		}
+		if matches {
+			m.received++
+		}
+	}
+	if m.times < 0 {
+		return m.received > 0, nil
+	}
+	return m.received == m.times, nil
+}
+
+// FailureMessage implements types.GomegaMatcher.
+func (m *ReceivedMatcher) FailureMessage(actual interface{}) string {
+	return fmt.Sprintf("Expected %s to have received %s, but it received %s\n%s", fakeName(actual), m.description(), times(m.received), m.receivedCalls())
+}
+
+// NegatedFailureMessage implements types.GomegaMatcher.
+func (m *ReceivedMatcher) NegatedFailureMessage(actual interface{}) string {
+	return fmt.Sprintf("Expected %s not to have received %s, but it received %s\n%s", fakeName(actual), m.description(), times(m.received), m.receivedCalls())
+}
+
+func (m *ReceivedMatcher) matchArgs(args []interface{}) (bool, error) {
+	if m.args == nil {
+		return true, nil
+	}
+	if len(args) != len(m.args) {
+		return false, nil
+	}
+	for i := range args {
+		if matcher, ok := m.args[i].(types.GomegaMatcher); ok {
+			matches, err := matcher.Match(args[i])
+			if err != nil || !matches {
+				return false, err
+			}
+		} else if !reflect.DeepEqual(m.args[i], args[i]) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func (m *ReceivedMatcher) description() string {
+	description := m.method
+	if m.args != nil {
+		args := make([]string, len(m.args))
+		for i := range m.args {
+			args[i] = formatArg(m.args[i])
+		}
+		description += "(" + strings.Join(args, ", ") + ")"
+	}
+	if m.times >= 0 {
+		description += " " + times(m.times)
+	}
+	return description
+}
+
+func (m *ReceivedMatcher) receivedCalls() string {
+	if len(m.calls) == 0 {
+		return fmt.Sprintf("It received no calls to %s.", m.method)
+	}
+	lines := []string{fmt.Sprintf("It received these calls to %s:", m.method)}
+	for _, args := range m.calls {
+		formatted := make([]string, len(args))
+		for i := range args {
+			formatted[i] = formatArg(args[i])
+		}
+		lines = append(lines, "    "+m.method+"("+strings.Join(formatted, ", ")+")")
+	}
+	return strings.Join(lines, "\n")
+}
+
+func formatArg(arg interface{}) string {
+	switch arg := arg.(type) {
+	case string:
+		return fmt.Sprintf("%q", arg)
+	case types.GomegaMatcher:
+		return fmt.Sprintf("%T", arg)
+	default:
+		return fmt.Sprintf("%v", arg)
+	}
+}
+
+func times(n int) string {
+	if n == 1 {
+		return "1 time"
+	}
+	return fmt.Sprintf("%d times", n)
+}
+
+// hasMethod reports whether the fake has the method, or, for fakes of
+// functions, which only have a Spy method, whether it could be the name of the
+// function.
+func hasMethod(fake interface{}, method string) bool {
+	t := reflect.TypeOf(fake)
+	if _, ok := t.MethodByName(method); ok {
+		return true
+	}
+	_, ok := t.MethodByName("Spy")
+	return ok
+}
+
+func fakeName(fake interface{}) string {
+	t := reflect.TypeOf(fake)
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	name := t.Name()
+	if i := strings.Index(name, "["); i >= 0 {
+		name = name[:i]
+	}
+	return name
+}
@@ -0,0 +1,101 @@
+package matchers_test
+
+import (
+	"testing"
+
+	"github.com/maxbrunsfeld/counterfeiter/v6/fixtures/fixturesfakes"
+	. "github.com/maxbrunsfeld/counterfeiter/v6/matchers"
+	. "github.com/onsi/gomega"
+	"github.com/sclevine/spec"
+	"github.com/sclevine/spec/report"
+)
+
+func TestMatchers(t *testing.T) {
+	spec.Run(t, "Matchers", testMatchers, spec.Report(report.Terminal{}))
+}
+
+func testMatchers(t *testing.T, when spec.G, it spec.S) {
+	var fake *fixturesfakes.FakeSomething
+
+	it.Before(func() {
+		RegisterTestingT(t)
+		fake = new(fixturesfakes.FakeSomething)
+	})
+
+	when("the fake has received calls", func() {
+		it.Before(func() {
+			_, _ = fake.DoThings("stuff", 5)
+			_, _ = fake.DoThings("stuff", 5)
+			_, _ = fake.DoThings("other-stuff", 6)
+		})
+
+		it("succeeds if the method was called", func() {
+			Expect(fake).To(HaveReceived("DoThings"))
+			Expect(fake).NotTo(HaveReceived("DoNothing"))
+		})
+
+		it("only counts the calls with the given arguments", func() {
+			Expect(fake).To(HaveReceived("DoThings").With("stuff", uint64(5)))
+			Expect(fake).To(HaveReceived("DoThings").With("other-stuff", uint64(6)).Times(1))
+			Expect(fake).NotTo(HaveReceived("DoThings").With("stuff", uint64(6)))
+		})
+
+		it("matches arguments with gomega matchers", func() {
+			Expect(fake).To(HaveReceived("DoThings").With(HavePrefix("other"), BeNumerically(">", 5)).Times(1))
+			Expect(fake).To(HaveReceived("DoThings").With(ContainSubstring("stuff"), BeNumerically(">", 0)).Times(3))
+		})
+
+		it("succeeds only if the number of calls matches", func() {
+			Expect(fake).To(HaveReceived("DoThings").Times(3))
+			Expect(fake).To(HaveReceived("DoThings").With("stuff", uint64(5)).Times(2))
+			Expect(fake).NotTo(HaveReceived("DoThings").Times(2))
+			Expect(fake).To(HaveReceived("DoNothing").Times(0))
+		})
+
+		it("lists the calls that were received in the failure message", func() {
+			matcher := HaveReceived("DoThings").With("stuff", uint64(6)).Times(2)
+			Expect(matcher.Match(fake)).To(BeFalse())
+			Expect(matcher.FailureMessage(fake)).To(Equal(`Expected FakeSomething to have received DoThings("stuff", 6) 2 times, but it received 0 times
+It received these calls to DoThings:
+    DoThings("stuff", 5)
+    DoThings("stuff", 5)
+    DoThings("other-stuff", 6)`))
+		})
+
+		it("describes a negated failure", func() {
+			matcher := HaveReceived("DoThings").With("stuff", uint64(5))
+			Expect(matcher.Match(fake)).To(BeTrue())
+			Expect(matcher.NegatedFailureMessage(fake)).To(HavePrefix(`Expected FakeSomething not to have received DoThings("stuff", 5), but it received 2 times`))
+		})
+	})
+
+	it("reports when no calls were received", func() {
+		matcher := HaveReceived("DoNothing")
+		Expect(matcher.Match(fake)).To(BeFalse())
+		Expect(matcher.FailureMessage(fake)).To(Equal("Expected FakeSomething to have received DoNothing, but it received 0 times\nIt received no calls to DoNothing."))
+	})
+
+	it("works with fakes of functions", func() {
+		fake := new(fixturesfakes.FakeSomethingFactory)
+		fake.Spy("stuff", nil)
+
+		Expect(fake).To(HaveReceived("SomethingFactory").With("stuff", BeNil()).Times(1))
+	})
+
+	it("works with variadic arguments", func() {
+		fake := new(fixturesfakes.FakeHasVarArgs)
+		fake.DoThings(1, "a", "b")
+
+		Expect(fake).To(HaveReceived("DoThings").With(1, []string{"a", "b"}))
+	})
+
+	it("errors if the actual value is not a fake", func() {
+		_, err := HaveReceived("DoThings").Match("stuff")
+		Expect(err).To(MatchError("HaveReceived expects a fake generated by counterfeiter, but got string"))
+	})
+
+	it("errors if the fake has no such method", func() {
+		_, err := HaveReceived("DoStuff").Match(fake)
+		Expect(err).To(MatchError("HaveReceived expects a method of FakeSomething, but it has no method named DoStuff"))
+	})
+}